@@ -165,6 +165,8 @@ const (
 	ApplicationUnhealthy ApplicationPhase = "unhealthy"
 	// ApplicationDeleting means application is being deleted
 	ApplicationDeleting ApplicationPhase = "deleting"
+	// ApplicationPendingDeployWindow means the new revision of the app is held until the deploy window opens
+	ApplicationPendingDeployWindow ApplicationPhase = "pendingDeployWindow"
 )
 
 // ApplicationComponentStatus record the health status of App component
@@ -246,6 +248,50 @@ type AppStatus struct {
 	// PolicyStatus records the status of policy
 	// Deprecated This field is only used by EnvBinding Policy which is deprecated.
 	PolicyStatus []PolicyStatus `json:"policy,omitempty"`
+
+	// Failover records the decisions made by the failover policy
+	// +optional
+	Failover []FailoverDecision `json:"failover,omitempty"`
+
+	// DeployWindow records the revision held by the deploy-window policy
+	// +optional
+	DeployWindow *DeployWindowStatus `json:"deployWindow,omitempty"`
+}
+
+// DeployWindowStatus records the revision held until the deploy window opens
+type DeployWindowStatus struct {
+	// PendingRevision is the revision waiting for the deploy window
+	PendingRevision string `json:"pendingRevision"`
+	// NextWindowTime is the time when the next deploy window opens. It is empty if no window
+	// opens in the foreseeable future.
+	// +nullable
+	NextWindowTime metav1.Time `json:"nextWindowTime,omitempty"`
+	// Message describes why the rollout is held
+	Message string `json:"message,omitempty"`
+}
+
+// FailoverDecision records the failover state of one cluster watched by the failover policy
+type FailoverDecision struct {
+	// Cluster is the cluster selected by the topology policy
+	Cluster string `json:"cluster"`
+	// Namespace is the namespace selected by the topology policy
+	Namespace string `json:"namespace,omitempty"`
+	// StandbyCluster is the cluster taking over the workloads of the failed cluster.
+	// It is empty while the cluster is still within the grace period.
+	StandbyCluster string `json:"standbyCluster,omitempty"`
+	// UnhealthySince records the time since when the cluster is unhealthy
+	// +nullable
+	UnhealthySince metav1.Time `json:"unhealthySince,omitempty"`
+	// RecoveredSince records the time since when the failed cluster is healthy again
+	// +nullable
+	RecoveredSince metav1.Time `json:"recoveredSince,omitempty"`
+	// Message describes why the cluster is regarded as unhealthy
+	Message string `json:"message,omitempty"`
+}
+
+// IsFailedOver checks if the workloads of the cluster have been moved to the standby cluster
+func (in FailoverDecision) IsFailedOver() bool {
+	return in.StandbyCluster != ""
 }
 
 // PolicyStatus records the status of policy
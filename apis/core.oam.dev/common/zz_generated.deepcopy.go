@@ -70,6 +70,18 @@ func (in *AppStatus) DeepCopyInto(out *AppStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Failover != nil {
+		in, out := &in.Failover, &out.Failover
+		*out = make([]FailoverDecision, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.DeployWindow != nil {
+		in, out := &in.DeployWindow, &out.DeployWindow
+		*out = new(DeployWindowStatus)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AppStatus.
@@ -298,6 +310,39 @@ func (in *DefinitionReference) DeepCopy() *DefinitionReference {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeployWindowStatus) DeepCopyInto(out *DeployWindowStatus) {
+	*out = *in
+	in.NextWindowTime.DeepCopyInto(&out.NextWindowTime)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeployWindowStatus.
+func (in *DeployWindowStatus) DeepCopy() *DeployWindowStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DeployWindowStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FailoverDecision) DeepCopyInto(out *FailoverDecision) {
+	*out = *in
+	in.UnhealthySince.DeepCopyInto(&out.UnhealthySince)
+	in.RecoveredSince.DeepCopyInto(&out.RecoveredSince)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FailoverDecision.
+func (in *FailoverDecision) DeepCopy() *FailoverDecision {
+	if in == nil {
+		return nil
+	}
+	out := new(FailoverDecision)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *OAMObjectReference) DeepCopyInto(out *OAMObjectReference) {
 	*out = *in
@@ -0,0 +1,181 @@
+/*
+Copyright 2026 The KubeVela Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// SubscriptionEvent is the kind of application changes to notify
+// +kubebuilder:validation:Enum=PhaseChanged;HealthChanged;WorkflowFailed;Drifted
+type SubscriptionEvent string
+
+const (
+	// SubscriptionEventPhaseChanged is fired when the phase of the application changes
+	SubscriptionEventPhaseChanged SubscriptionEvent = "PhaseChanged"
+	// SubscriptionEventHealthChanged is fired when the application turns unhealthy or recovers
+	SubscriptionEventHealthChanged SubscriptionEvent = "HealthChanged"
+	// SubscriptionEventWorkflowFailed is fired when the workflow of the application fails
+	SubscriptionEventWorkflowFailed SubscriptionEvent = "WorkflowFailed"
+	// SubscriptionEventDrifted is fired when the controller fails to keep the resources of the application from
+	// drifting, which is reported by the StateKeep condition of the application
+	SubscriptionEventDrifted SubscriptionEvent = "Drifted"
+)
+
+// +kubebuilder:object:root=true
+
+// ApplicationSubscription sends notifications to the channels when the applications matched by the selector change
+// their phase or health, fail the workflow or drift.
+// +kubebuilder:resource:scope=Namespaced,categories={oam},shortName=appsub
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="SUSPEND",type=boolean,JSONPath=`.spec.suspend`
+// +kubebuilder:printcolumn:name="APPLICATIONS",type=integer,JSONPath=`.status.applicationCount`
+// +kubebuilder:printcolumn:name="LAST-NOTIFY",type=date,JSONPath=`.status.lastNotifyTime`
+// +kubebuilder:printcolumn:name="AGE",type=date,JSONPath=".metadata.creationTimestamp"
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type ApplicationSubscription struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ApplicationSubscriptionSpec   `json:"spec,omitempty"`
+	Status ApplicationSubscriptionStatus `json:"status,omitempty"`
+}
+
+// ApplicationSubscriptionSpec describes which changes of which applications to notify and where
+type ApplicationSubscriptionSpec struct {
+	// Selector selects the applications in the namespace of the subscription, all the applications are selected
+	// if not set
+	// +optional
+	Selector *metav1.LabelSelector `json:"selector,omitempty"`
+	// Events are the changes to notify, all the changes are notified if empty
+	// +optional
+	Events []SubscriptionEvent `json:"events,omitempty"`
+	// Channels are where the notifications are sent to
+	Channels []NotificationChannel `json:"channels"`
+	// ThrottlePeriod is the minimum interval between two notifications of the same application. The changes during
+	// the period are merged into the next notification, and the changes reverted during the period are dropped.
+	// +optional
+	ThrottlePeriod *metav1.Duration `json:"throttlePeriod,omitempty"`
+	// Suspend stops sending notifications
+	// +optional
+	Suspend bool `json:"suspend,omitempty"`
+}
+
+// NotificationChannel is a channel to send the notifications to, the fields follow the parameters of the
+// notification workflow step. Exactly one of the channels should be set.
+type NotificationChannel struct {
+	// Name is the name of the channel, used in the status and events
+	Name     string                 `json:"name"`
+	Slack    *WebhookURLChannel     `json:"slack,omitempty"`
+	DingDing *WebhookURLChannel     `json:"dingding,omitempty"`
+	Lark     *WebhookURLChannel     `json:"lark,omitempty"`
+	Email    *EmailNotification     `json:"email,omitempty"`
+	Webhook  *GenericWebhookChannel `json:"webhook,omitempty"`
+}
+
+// WebhookURLChannel is a channel of the robot webhook of slack, dingding or lark
+type WebhookURLChannel struct {
+	URL NotificationValue `json:"url"`
+}
+
+// GenericWebhookChannel posts the notifications in json to the url
+type GenericWebhookChannel struct {
+	URL NotificationValue `json:"url"`
+	// Headers are the headers added to the requests
+	// +optional
+	Headers map[string]string `json:"headers,omitempty"`
+}
+
+// EmailNotification sends the notifications by email
+type EmailNotification struct {
+	From EmailSender `json:"from"`
+	To   []string    `json:"to"`
+}
+
+// EmailSender is the account sending the notification emails
+type EmailSender struct {
+	Address  string            `json:"address"`
+	Alias    string            `json:"alias,omitempty"`
+	Password NotificationValue `json:"password"`
+	Host     string            `json:"host"`
+	// Port is the port of the email host, default to 587
+	// +optional
+	Port int `json:"port,omitempty"`
+}
+
+// NotificationValue is a value given either in plain text or by a key of a Secret in the namespace of the
+// subscription
+type NotificationValue struct {
+	Value     string              `json:"value,omitempty"`
+	SecretRef *NotificationSecret `json:"secretRef,omitempty"`
+}
+
+// NotificationSecret refers to a key of a Secret
+type NotificationSecret struct {
+	// Name is the name of the secret
+	Name string `json:"name"`
+	// Key is the key in the secret
+	Key string `json:"key"`
+}
+
+// ApplicationSubscriptionStatus is the status of the subscription
+type ApplicationSubscriptionStatus struct {
+	// ApplicationCount is the number of the applications matched
+	ApplicationCount int `json:"applicationCount,omitempty"`
+	// Applications are the states of the matched applications last observed, used to detect the changes
+	Applications []SubscribedApplication `json:"applications,omitempty"`
+	// LastNotifyTime is the last time a notification is sent
+	LastNotifyTime *metav1.Time `json:"lastNotifyTime,omitempty"`
+	// Message is the error of the last notification, empty if succeeded
+	Message string `json:"message,omitempty"`
+}
+
+// SubscribedApplication is the state of an application last notified
+type SubscribedApplication struct {
+	Name string `json:"name"`
+	// Phase is the phase of the application
+	Phase string `json:"phase,omitempty"`
+	// Healthy is whether all the components and traits of the application are healthy
+	Healthy bool `json:"healthy"`
+	// WorkflowFailed is whether the workflow of the application failed
+	WorkflowFailed bool `json:"workflowFailed,omitempty"`
+	// Drifted is whether the controller failed to keep the resources of the application from drifting
+	Drifted bool `json:"drifted,omitempty"`
+	// LastNotifyTime is the last time a notification of the application is sent
+	LastNotifyTime *metav1.Time `json:"lastNotifyTime,omitempty"`
+	// Delivery is the notification of the application being sent, recorded until it is sent to all the channels
+	Delivery *NotificationDelivery `json:"delivery,omitempty"`
+}
+
+// NotificationDelivery is the delivery of a notification to the channels
+type NotificationDelivery struct {
+	// ID identifies the notification by the changes of the application
+	ID string `json:"id"`
+	// Channels are the names of the channels the notification has been sent to
+	Channels []string `json:"channels,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ApplicationSubscriptionList contains a list of ApplicationSubscription
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type ApplicationSubscriptionList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ApplicationSubscription `json:"items"`
+}
@@ -0,0 +1,74 @@
+/*
+Copyright 2026 The KubeVela Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// DeployWindowPolicyType refers to the type of deploy-window policy
+	DeployWindowPolicyType = "deploy-window"
+)
+
+// DeployWindowPolicySpec defines the spec of deploy-window policy. New revisions of the application
+// are only rolled out when the current time is inside one of the windows and outside all the blackouts.
+type DeployWindowPolicySpec struct {
+	// Windows are the periods in which the rollout is allowed. If empty, the rollout is allowed at any
+	// time except in the blackouts.
+	Windows []DeployWindow `json:"windows,omitempty"`
+
+	// Blackouts are the periods in which the rollout is frozen, they take precedence over the windows.
+	Blackouts []DeployBlackout `json:"blackouts,omitempty"`
+
+	// TimeZone is the IANA time zone used to evaluate the schedules of the windows, defaults to UTC.
+	TimeZone string `json:"timeZone,omitempty"`
+
+	// Selector selects the applications the policy applies to. It is only used by the cluster-wide
+	// default policies declared in the system namespace, and ignored in the application.
+	Selector *DeployWindowSelector `json:"selector,omitempty"`
+}
+
+// DeployWindow is a recurring period in which the rollout is allowed
+type DeployWindow struct {
+	// Schedule is the cron expression of the start of the window, e.g. "0 22 * * 1-5"
+	Schedule string `json:"schedule"`
+
+	// Duration is the length of the window, e.g. "4h"
+	Duration string `json:"duration"`
+}
+
+// DeployBlackout is a period in which the rollout is frozen
+type DeployBlackout struct {
+	Start  metav1.Time `json:"start"`
+	End    metav1.Time `json:"end"`
+	Reason string      `json:"reason,omitempty"`
+}
+
+// DeployWindowSelector selects the applications by their namespaces and labels
+type DeployWindowSelector struct {
+	// Namespaces of the selected applications. If empty, applications in all namespaces are selected.
+	Namespaces []string `json:"namespaces,omitempty"`
+
+	// ApplicationLabels the selected applications must have. If empty, all applications are selected.
+	ApplicationLabels map[string]string `json:"applicationLabels,omitempty"`
+}
+
+// Type the type name of the policy
+func (in *DeployWindowPolicySpec) Type() string {
+	return DeployWindowPolicyType
+}
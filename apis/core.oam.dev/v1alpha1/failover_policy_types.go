@@ -0,0 +1,60 @@
+/*
+Copyright 2026 The KubeVela Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import "time"
+
+const (
+	// FailoverPolicyType refers to the type of failover policy
+	FailoverPolicyType = "failover"
+
+	// DefaultFailoverGracePeriod is the default time a cluster must stay unhealthy (or healthy again)
+	// before the failover policy moves its workloads away (or back)
+	DefaultFailoverGracePeriod = 5 * time.Minute
+)
+
+// FailoverPolicySpec defines the spec of failover policy
+type FailoverPolicySpec struct {
+	// Topologies is the names of the topology policies whose clusters are watched.
+	// If empty, all the topology policies in the application are watched.
+	Topologies []string `json:"topologies,omitempty"`
+
+	// StandbyClusterLabelSelector is the label selector for the clusters that can
+	// take over the workloads of a failed cluster.
+	StandbyClusterLabelSelector map[string]string `json:"standbyClusterLabelSelector"`
+
+	// GracePeriodSeconds is the time a cluster must stay unhealthy before its workloads
+	// are re-placed, and the time it must stay healthy before they are moved back.
+	GracePeriodSeconds *int64 `json:"gracePeriodSeconds,omitempty"`
+
+	// DisableFailback if set, the workloads stay on the standby cluster after the
+	// failed cluster recovers.
+	DisableFailback bool `json:"disableFailback,omitempty"`
+}
+
+// Type the type name of the policy
+func (in *FailoverPolicySpec) Type() string {
+	return FailoverPolicyType
+}
+
+// GetGracePeriod returns the grace period of the failover policy
+func (in *FailoverPolicySpec) GetGracePeriod() time.Duration {
+	if in.GracePeriodSeconds == nil {
+		return DefaultFailoverGracePeriod
+	}
+	return time.Duration(*in.GracePeriodSeconds) * time.Second
+}
@@ -0,0 +1,112 @@
+/*
+Copyright 2026 The KubeVela Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// PrivilegeAction is the action recorded by a PrivilegeAudit
+// +kubebuilder:validation:Enum=grant;revoke
+type PrivilegeAction string
+
+const (
+	// PrivilegeGrant records the privileges granted to the identity
+	PrivilegeGrant PrivilegeAction = "grant"
+	// PrivilegeRevoke records the privileges revoked from the identity
+	PrivilegeRevoke PrivilegeAction = "revoke"
+)
+
+// PrivilegeAuditPhase is the phase of the privileges granted by a PrivilegeAudit
+type PrivilegeAuditPhase string
+
+const (
+	// PrivilegeAuditActive means the granted privileges are in effect
+	PrivilegeAuditActive PrivilegeAuditPhase = "active"
+	// PrivilegeAuditExpired means the granted privileges are revoked as the ttl is reached
+	PrivilegeAuditExpired PrivilegeAuditPhase = "expired"
+)
+
+// +kubebuilder:object:root=true
+
+// PrivilegeAudit records one grant or revoke of privileges by vela auth. The granted privileges with an expiration
+// time are revoked by the controller once they expire.
+// +kubebuilder:resource:scope=Namespaced,categories={oam},shortName=paudit
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="ACTION",type=string,JSONPath=`.spec.action`
+// +kubebuilder:printcolumn:name="GRANTER",type=string,JSONPath=`.spec.granter`
+// +kubebuilder:printcolumn:name="EXPIRE",type=date,JSONPath=`.spec.expireTime`
+// +kubebuilder:printcolumn:name="PHASE",type=string,JSONPath=`.status.phase`
+// +kubebuilder:printcolumn:name="AGE",type=date,JSONPath=".metadata.creationTimestamp"
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type PrivilegeAudit struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   PrivilegeAuditSpec   `json:"spec,omitempty"`
+	Status PrivilegeAuditStatus `json:"status,omitempty"`
+}
+
+// PrivilegeAuditSpec describes who granted or revoked which privileges to whom
+type PrivilegeAuditSpec struct {
+	Action PrivilegeAction `json:"action"`
+	// Identity is the identity the privileges are granted to or revoked from
+	Identity PrivilegeIdentity `json:"identity"`
+	// Granter is the user granting or revoking the privileges. It is set to the requesting user by the admission
+	// webhook, and is only reported by the client if the admission webhooks are disabled.
+	Granter string `json:"granter,omitempty"`
+	// Scopes are the scopes of the privileges
+	Scopes []PrivilegeScope `json:"scopes"`
+	// ExpireTime is when the granted privileges will be revoked. The privileges never expire if not set.
+	// +optional
+	ExpireTime *metav1.Time `json:"expireTime,omitempty"`
+}
+
+// PrivilegeIdentity is the identity owning the privileges
+type PrivilegeIdentity struct {
+	User                    string   `json:"user,omitempty"`
+	Groups                  []string `json:"groups,omitempty"`
+	ServiceAccount          string   `json:"serviceAccount,omitempty"`
+	ServiceAccountNamespace string   `json:"serviceAccountNamespace,omitempty"`
+}
+
+// PrivilegeScope is where the privileges take effect
+type PrivilegeScope struct {
+	Cluster string `json:"cluster"`
+	// Namespace is the namespace of the privileges, the privileges are cluster-scoped if empty
+	Namespace string `json:"namespace,omitempty"`
+	ReadOnly  bool   `json:"readOnly,omitempty"`
+}
+
+// PrivilegeAuditStatus is the status of the granted privileges
+type PrivilegeAuditStatus struct {
+	Phase PrivilegeAuditPhase `json:"phase,omitempty"`
+	// RevokeTime is when the expired privileges were revoked
+	RevokeTime *metav1.Time `json:"revokeTime,omitempty"`
+	Message    string       `json:"message,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// PrivilegeAuditList contains a list of PrivilegeAudit
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type PrivilegeAuditList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []PrivilegeAudit `json:"items"`
+}
@@ -55,8 +55,29 @@ var (
 	WorkflowGroupVersionKind = SchemeGroupVersion.WithKind(WorkflowKind)
 )
 
+// VelaQuota meta
+var (
+	VelaQuotaKind             = "VelaQuota"
+	VelaQuotaGroupVersionKind = SchemeGroupVersion.WithKind(VelaQuotaKind)
+)
+
+// PrivilegeAudit meta
+var (
+	PrivilegeAuditKind             = "PrivilegeAudit"
+	PrivilegeAuditGroupVersionKind = SchemeGroupVersion.WithKind(PrivilegeAuditKind)
+)
+
+// ApplicationSubscription meta
+var (
+	ApplicationSubscriptionKind             = "ApplicationSubscription"
+	ApplicationSubscriptionGroupVersionKind = SchemeGroupVersion.WithKind(ApplicationSubscriptionKind)
+)
+
 func init() {
 	SchemeBuilder.Register(&Policy{}, &PolicyList{})
+	SchemeBuilder.Register(&VelaQuota{}, &VelaQuotaList{})
+	SchemeBuilder.Register(&PrivilegeAudit{}, &PrivilegeAuditList{})
+	SchemeBuilder.Register(&ApplicationSubscription{}, &ApplicationSubscriptionList{})
 	SchemeBuilder.Register(&wfTypesv1alpha1.Workflow{}, &wfTypesv1alpha1.WorkflowList{})
 	_ = SchemeBuilder.AddToScheme(k8sscheme.Scheme)
 }
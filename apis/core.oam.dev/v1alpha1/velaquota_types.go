@@ -0,0 +1,61 @@
+/*
+Copyright 2026 The KubeVela Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +kubebuilder:object:root=true
+
+// VelaQuota restricts the applications in its namespace and the resources dispatched by them
+// +kubebuilder:resource:scope=Namespaced,categories={oam},shortName=vquota
+// +kubebuilder:printcolumn:name="APPS",type=integer,JSONPath=`.spec.applications`
+// +kubebuilder:printcolumn:name="REPLICAS",type=integer,JSONPath=`.spec.replicas`
+// +kubebuilder:printcolumn:name="AGE",type=date,JSONPath=".metadata.creationTimestamp"
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type VelaQuota struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec VelaQuotaSpec `json:"spec,omitempty"`
+}
+
+// VelaQuotaSpec defines the limits of the applications in the namespace. The limit not set is unrestricted.
+type VelaQuotaSpec struct {
+	// Applications is the maximal number of applications in the namespace
+	Applications *int64 `json:"applications,omitempty"`
+	// ComponentsPerApplication is the maximal number of components in one application
+	ComponentsPerApplication *int64 `json:"componentsPerApplication,omitempty"`
+	// ManagedResourcesPerApplication is the maximal number of resources managed by one application
+	ManagedResourcesPerApplication *int64 `json:"managedResourcesPerApplication,omitempty"`
+	// ClustersPerApplication is the maximal number of clusters one application can dispatch resources to
+	ClustersPerApplication *int64 `json:"clustersPerApplication,omitempty"`
+	// Replicas is the maximal number of total replicas of the workloads dispatched by the applications in the namespace
+	Replicas *int64 `json:"replicas,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// VelaQuotaList contains a list of VelaQuota
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type VelaQuotaList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []VelaQuota `json:"items"`
+}
@@ -21,11 +21,134 @@ limitations under the License.
 package v1alpha1
 
 import (
+	"k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 
 	"github.com/oam-dev/kubevela/apis/core.oam.dev/common"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApplicationSubscription) DeepCopyInto(out *ApplicationSubscription) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApplicationSubscription.
+func (in *ApplicationSubscription) DeepCopy() *ApplicationSubscription {
+	if in == nil {
+		return nil
+	}
+	out := new(ApplicationSubscription)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ApplicationSubscription) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApplicationSubscriptionList) DeepCopyInto(out *ApplicationSubscriptionList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ApplicationSubscription, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApplicationSubscriptionList.
+func (in *ApplicationSubscriptionList) DeepCopy() *ApplicationSubscriptionList {
+	if in == nil {
+		return nil
+	}
+	out := new(ApplicationSubscriptionList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ApplicationSubscriptionList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApplicationSubscriptionSpec) DeepCopyInto(out *ApplicationSubscriptionSpec) {
+	*out = *in
+	if in.Selector != nil {
+		in, out := &in.Selector, &out.Selector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Events != nil {
+		in, out := &in.Events, &out.Events
+		*out = make([]SubscriptionEvent, len(*in))
+		copy(*out, *in)
+	}
+	if in.Channels != nil {
+		in, out := &in.Channels, &out.Channels
+		*out = make([]NotificationChannel, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ThrottlePeriod != nil {
+		in, out := &in.ThrottlePeriod, &out.ThrottlePeriod
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApplicationSubscriptionSpec.
+func (in *ApplicationSubscriptionSpec) DeepCopy() *ApplicationSubscriptionSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ApplicationSubscriptionSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApplicationSubscriptionStatus) DeepCopyInto(out *ApplicationSubscriptionStatus) {
+	*out = *in
+	if in.Applications != nil {
+		in, out := &in.Applications, &out.Applications
+		*out = make([]SubscribedApplication, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.LastNotifyTime != nil {
+		in, out := &in.LastNotifyTime, &out.LastNotifyTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApplicationSubscriptionStatus.
+func (in *ApplicationSubscriptionStatus) DeepCopy() *ApplicationSubscriptionStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ApplicationSubscriptionStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ApplyOncePolicyRule) DeepCopyInto(out *ApplyOncePolicyRule) {
 	*out = *in
@@ -104,6 +227,134 @@ func (in *ClusterConnection) DeepCopy() *ClusterConnection {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeployBlackout) DeepCopyInto(out *DeployBlackout) {
+	*out = *in
+	in.Start.DeepCopyInto(&out.Start)
+	in.End.DeepCopyInto(&out.End)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeployBlackout.
+func (in *DeployBlackout) DeepCopy() *DeployBlackout {
+	if in == nil {
+		return nil
+	}
+	out := new(DeployBlackout)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeployWindow) DeepCopyInto(out *DeployWindow) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeployWindow.
+func (in *DeployWindow) DeepCopy() *DeployWindow {
+	if in == nil {
+		return nil
+	}
+	out := new(DeployWindow)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeployWindowPolicySpec) DeepCopyInto(out *DeployWindowPolicySpec) {
+	*out = *in
+	if in.Windows != nil {
+		in, out := &in.Windows, &out.Windows
+		*out = make([]DeployWindow, len(*in))
+		copy(*out, *in)
+	}
+	if in.Blackouts != nil {
+		in, out := &in.Blackouts, &out.Blackouts
+		*out = make([]DeployBlackout, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Selector != nil {
+		in, out := &in.Selector, &out.Selector
+		*out = new(DeployWindowSelector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeployWindowPolicySpec.
+func (in *DeployWindowPolicySpec) DeepCopy() *DeployWindowPolicySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DeployWindowPolicySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeployWindowSelector) DeepCopyInto(out *DeployWindowSelector) {
+	*out = *in
+	if in.Namespaces != nil {
+		in, out := &in.Namespaces, &out.Namespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ApplicationLabels != nil {
+		in, out := &in.ApplicationLabels, &out.ApplicationLabels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeployWindowSelector.
+func (in *DeployWindowSelector) DeepCopy() *DeployWindowSelector {
+	if in == nil {
+		return nil
+	}
+	out := new(DeployWindowSelector)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EmailNotification) DeepCopyInto(out *EmailNotification) {
+	*out = *in
+	in.From.DeepCopyInto(&out.From)
+	if in.To != nil {
+		in, out := &in.To, &out.To
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EmailNotification.
+func (in *EmailNotification) DeepCopy() *EmailNotification {
+	if in == nil {
+		return nil
+	}
+	out := new(EmailNotification)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EmailSender) DeepCopyInto(out *EmailSender) {
+	*out = *in
+	in.Password.DeepCopyInto(&out.Password)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EmailSender.
+func (in *EmailSender) DeepCopy() *EmailSender {
+	if in == nil {
+		return nil
+	}
+	out := new(EmailSender)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *EnvBindingSpec) DeepCopyInto(out *EnvBindingSpec) {
 	*out = *in
@@ -309,6 +560,38 @@ func (in *EnvTraitPatch) DeepCopy() *EnvTraitPatch {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FailoverPolicySpec) DeepCopyInto(out *FailoverPolicySpec) {
+	*out = *in
+	if in.Topologies != nil {
+		in, out := &in.Topologies, &out.Topologies
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.StandbyClusterLabelSelector != nil {
+		in, out := &in.StandbyClusterLabelSelector, &out.StandbyClusterLabelSelector
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.GracePeriodSeconds != nil {
+		in, out := &in.GracePeriodSeconds, &out.GracePeriodSeconds
+		*out = new(int64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FailoverPolicySpec.
+func (in *FailoverPolicySpec) DeepCopy() *FailoverPolicySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(FailoverPolicySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GarbageCollectPolicyRule) DeepCopyInto(out *GarbageCollectPolicyRule) {
 	*out = *in
@@ -357,6 +640,29 @@ func (in *GarbageCollectPolicySpec) DeepCopy() *GarbageCollectPolicySpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GenericWebhookChannel) DeepCopyInto(out *GenericWebhookChannel) {
+	*out = *in
+	in.URL.DeepCopyInto(&out.URL)
+	if in.Headers != nil {
+		in, out := &in.Headers, &out.Headers
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GenericWebhookChannel.
+func (in *GenericWebhookChannel) DeepCopy() *GenericWebhookChannel {
+	if in == nil {
+		return nil
+	}
+	out := new(GenericWebhookChannel)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *LegacyObjectTypeIdentifier) DeepCopyInto(out *LegacyObjectTypeIdentifier) {
 	*out = *in
@@ -394,6 +700,101 @@ func (in *NamespaceSelector) DeepCopy() *NamespaceSelector {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NotificationChannel) DeepCopyInto(out *NotificationChannel) {
+	*out = *in
+	if in.Slack != nil {
+		in, out := &in.Slack, &out.Slack
+		*out = new(WebhookURLChannel)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DingDing != nil {
+		in, out := &in.DingDing, &out.DingDing
+		*out = new(WebhookURLChannel)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Lark != nil {
+		in, out := &in.Lark, &out.Lark
+		*out = new(WebhookURLChannel)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Email != nil {
+		in, out := &in.Email, &out.Email
+		*out = new(EmailNotification)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Webhook != nil {
+		in, out := &in.Webhook, &out.Webhook
+		*out = new(GenericWebhookChannel)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NotificationChannel.
+func (in *NotificationChannel) DeepCopy() *NotificationChannel {
+	if in == nil {
+		return nil
+	}
+	out := new(NotificationChannel)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NotificationDelivery) DeepCopyInto(out *NotificationDelivery) {
+	*out = *in
+	if in.Channels != nil {
+		in, out := &in.Channels, &out.Channels
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NotificationDelivery.
+func (in *NotificationDelivery) DeepCopy() *NotificationDelivery {
+	if in == nil {
+		return nil
+	}
+	out := new(NotificationDelivery)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NotificationSecret) DeepCopyInto(out *NotificationSecret) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NotificationSecret.
+func (in *NotificationSecret) DeepCopy() *NotificationSecret {
+	if in == nil {
+		return nil
+	}
+	out := new(NotificationSecret)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NotificationValue) DeepCopyInto(out *NotificationValue) {
+	*out = *in
+	if in.SecretRef != nil {
+		in, out := &in.SecretRef, &out.SecretRef
+		*out = new(NotificationSecret)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NotificationValue.
+func (in *NotificationValue) DeepCopy() *NotificationValue {
+	if in == nil {
+		return nil
+	}
+	out := new(NotificationValue)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ObjectReferrer) DeepCopyInto(out *ObjectReferrer) {
 	*out = *in
@@ -594,6 +995,144 @@ func (in *PolicyList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PrivilegeAudit) DeepCopyInto(out *PrivilegeAudit) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PrivilegeAudit.
+func (in *PrivilegeAudit) DeepCopy() *PrivilegeAudit {
+	if in == nil {
+		return nil
+	}
+	out := new(PrivilegeAudit)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *PrivilegeAudit) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PrivilegeAuditList) DeepCopyInto(out *PrivilegeAuditList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]PrivilegeAudit, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PrivilegeAuditList.
+func (in *PrivilegeAuditList) DeepCopy() *PrivilegeAuditList {
+	if in == nil {
+		return nil
+	}
+	out := new(PrivilegeAuditList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *PrivilegeAuditList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PrivilegeAuditSpec) DeepCopyInto(out *PrivilegeAuditSpec) {
+	*out = *in
+	in.Identity.DeepCopyInto(&out.Identity)
+	if in.Scopes != nil {
+		in, out := &in.Scopes, &out.Scopes
+		*out = make([]PrivilegeScope, len(*in))
+		copy(*out, *in)
+	}
+	if in.ExpireTime != nil {
+		in, out := &in.ExpireTime, &out.ExpireTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PrivilegeAuditSpec.
+func (in *PrivilegeAuditSpec) DeepCopy() *PrivilegeAuditSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(PrivilegeAuditSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PrivilegeAuditStatus) DeepCopyInto(out *PrivilegeAuditStatus) {
+	*out = *in
+	if in.RevokeTime != nil {
+		in, out := &in.RevokeTime, &out.RevokeTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PrivilegeAuditStatus.
+func (in *PrivilegeAuditStatus) DeepCopy() *PrivilegeAuditStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(PrivilegeAuditStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PrivilegeIdentity) DeepCopyInto(out *PrivilegeIdentity) {
+	*out = *in
+	if in.Groups != nil {
+		in, out := &in.Groups, &out.Groups
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PrivilegeIdentity.
+func (in *PrivilegeIdentity) DeepCopy() *PrivilegeIdentity {
+	if in == nil {
+		return nil
+	}
+	out := new(PrivilegeIdentity)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PrivilegeScope) DeepCopyInto(out *PrivilegeScope) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PrivilegeScope.
+func (in *PrivilegeScope) DeepCopy() *PrivilegeScope {
+	if in == nil {
+		return nil
+	}
+	out := new(PrivilegeScope)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ReadOnlyPolicyRule) DeepCopyInto(out *ReadOnlyPolicyRule) {
 	*out = *in
@@ -826,6 +1365,30 @@ func (in *SharedResourcePolicySpec) DeepCopy() *SharedResourcePolicySpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SubscribedApplication) DeepCopyInto(out *SubscribedApplication) {
+	*out = *in
+	if in.LastNotifyTime != nil {
+		in, out := &in.LastNotifyTime, &out.LastNotifyTime
+		*out = (*in).DeepCopy()
+	}
+	if in.Delivery != nil {
+		in, out := &in.Delivery, &out.Delivery
+		*out = new(NotificationDelivery)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SubscribedApplication.
+func (in *SubscribedApplication) DeepCopy() *SubscribedApplication {
+	if in == nil {
+		return nil
+	}
+	out := new(SubscribedApplication)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TakeOverPolicyRule) DeepCopyInto(out *TakeOverPolicyRule) {
 	*out = *in
@@ -879,3 +1442,117 @@ func (in *TopologyPolicySpec) DeepCopy() *TopologyPolicySpec {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VelaQuota) DeepCopyInto(out *VelaQuota) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VelaQuota.
+func (in *VelaQuota) DeepCopy() *VelaQuota {
+	if in == nil {
+		return nil
+	}
+	out := new(VelaQuota)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VelaQuota) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VelaQuotaList) DeepCopyInto(out *VelaQuotaList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]VelaQuota, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VelaQuotaList.
+func (in *VelaQuotaList) DeepCopy() *VelaQuotaList {
+	if in == nil {
+		return nil
+	}
+	out := new(VelaQuotaList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VelaQuotaList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VelaQuotaSpec) DeepCopyInto(out *VelaQuotaSpec) {
+	*out = *in
+	if in.Applications != nil {
+		in, out := &in.Applications, &out.Applications
+		*out = new(int64)
+		**out = **in
+	}
+	if in.ComponentsPerApplication != nil {
+		in, out := &in.ComponentsPerApplication, &out.ComponentsPerApplication
+		*out = new(int64)
+		**out = **in
+	}
+	if in.ManagedResourcesPerApplication != nil {
+		in, out := &in.ManagedResourcesPerApplication, &out.ManagedResourcesPerApplication
+		*out = new(int64)
+		**out = **in
+	}
+	if in.ClustersPerApplication != nil {
+		in, out := &in.ClustersPerApplication, &out.ClustersPerApplication
+		*out = new(int64)
+		**out = **in
+	}
+	if in.Replicas != nil {
+		in, out := &in.Replicas, &out.Replicas
+		*out = new(int64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VelaQuotaSpec.
+func (in *VelaQuotaSpec) DeepCopy() *VelaQuotaSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(VelaQuotaSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WebhookURLChannel) DeepCopyInto(out *WebhookURLChannel) {
+	*out = *in
+	in.URL.DeepCopyInto(&out.URL)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WebhookURLChannel.
+func (in *WebhookURLChannel) DeepCopy() *WebhookURLChannel {
+	if in == nil {
+		return nil
+	}
+	out := new(WebhookURLChannel)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -167,8 +167,27 @@ type TraitDefinitionSpec struct {
 
 	// +optional
 	Version string `json:"version,omitempty"`
+
+	// ConflictPolicy defines how a field patched by the trait and, with a different value, by another trait
+	// of the same component is handled. When the policies of the two traits differ, the stricter one is used.
+	// Defaults to error.
+	// +optional
+	// +kubebuilder:validation:Enum=error;warn;last-wins
+	ConflictPolicy TraitConflictPolicy `json:"conflictPolicy,omitempty"`
 }
 
+// TraitConflictPolicy describes how the conflicting patches of the traits on the same component are handled.
+type TraitConflictPolicy string
+
+const (
+	// TraitConflictError fails the rendering of the component
+	TraitConflictError TraitConflictPolicy = "error"
+	// TraitConflictWarn applies the patch of the latter trait and reports a warning
+	TraitConflictWarn TraitConflictPolicy = "warn"
+	// TraitConflictLastWins applies the patch of the latter trait silently
+	TraitConflictLastWins TraitConflictPolicy = "last-wins"
+)
+
 // StageType describes how the manifests should be dispatched.
 // Only one of the following stage types may be specified.
 // If none of the following types is specified, the default one
@@ -0,0 +1,102 @@
+/*
+Copyright 2026 The KubeVela Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/oam-dev/kubevela/apis/core.oam.dev/common"
+	"github.com/oam-dev/kubevela/apis/core.oam.dev/condition"
+)
+
+// GuardrailMode is how the violations of a guardrail are handled
+// +kubebuilder:validation:Enum=enforce;warn;audit
+type GuardrailMode string
+
+const (
+	// GuardrailEnforce fails the dispatch of the resources violating the guardrail
+	GuardrailEnforce GuardrailMode = "enforce"
+	// GuardrailWarn dispatches the resources violating the guardrail, and records a warning event on the application
+	GuardrailWarn GuardrailMode = "warn"
+	// GuardrailAudit dispatches the resources violating the guardrail, and only records the violations in the logs
+	// and the metrics
+	GuardrailAudit GuardrailMode = "audit"
+)
+
+// GuardrailDefinitionSpec defines the desired state of GuardrailDefinition
+type GuardrailDefinitionSpec struct {
+	// Mode is how the violations of the guardrail are handled, enforce by default
+	// +optional
+	Mode GuardrailMode `json:"mode,omitempty"`
+
+	// Kinds are the kinds of the rendered resources checked by the guardrail, all the resources if empty
+	// +optional
+	Kinds []string `json:"kinds,omitempty"`
+
+	// Schematic defines the rule of the guardrail. Only CUE schematic is supported for now.
+	// The template reads the rendered resource as resource and the application as context,
+	// and outputs the messages of the violations as violations.
+	// +optional
+	Schematic *common.Schematic `json:"schematic,omitempty"`
+
+	//+optional
+	Version string `json:"version,omitempty"`
+}
+
+// GuardrailDefinitionStatus is the status of GuardrailDefinition
+type GuardrailDefinitionStatus struct {
+	// ConditionedStatus reflects the observed status of a resource
+	condition.ConditionedStatus `json:",inline"`
+}
+
+// SetConditions set condition for GuardrailDefinition
+func (d *GuardrailDefinition) SetConditions(c ...condition.Condition) {
+	d.Status.SetConditions(c...)
+}
+
+// GetCondition gets condition from GuardrailDefinition
+func (d *GuardrailDefinition) GetCondition(conditionType condition.ConditionType) condition.Condition {
+	return d.Status.GetCondition(conditionType)
+}
+
+// +kubebuilder:object:root=true
+
+// GuardrailDefinition is the Schema for the guardraildefinitions API. The guardrails in the vela-system namespace
+// check the resources rendered by the applications of all namespaces, the others the applications of their namespace.
+// +kubebuilder:resource:scope=Namespaced,categories={oam},shortName=def-guardrail
+// +kubebuilder:storageversion
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="MODE",type=string,JSONPath=".spec.mode"
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type GuardrailDefinition struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   GuardrailDefinitionSpec   `json:"spec,omitempty"`
+	Status GuardrailDefinitionStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// GuardrailDefinitionList contains a list of GuardrailDefinition
+type GuardrailDefinitionList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []GuardrailDefinition `json:"items"`
+}
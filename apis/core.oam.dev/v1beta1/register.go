@@ -78,6 +78,15 @@ var (
 	PolicyDefinitionGVR              = SchemeGroupVersion.WithResource("policydefinitions")
 )
 
+// GuardrailDefinition type metadata.
+var (
+	GuardrailDefinitionKind             = reflect.TypeOf(GuardrailDefinition{}).Name()
+	GuardrailDefinitionGroupKind        = schema.GroupKind{Group: Group, Kind: GuardrailDefinitionKind}.String()
+	GuardrailDefinitionKindAPIVersion   = GuardrailDefinitionKind + "." + SchemeGroupVersion.String()
+	GuardrailDefinitionGroupVersionKind = SchemeGroupVersion.WithKind(GuardrailDefinitionKind)
+	GuardrailDefinitionGVR              = SchemeGroupVersion.WithResource("guardraildefinitions")
+)
+
 // WorkflowStepDefinition type metadata.
 var (
 	WorkflowStepDefinitionKind             = reflect.TypeOf(WorkflowStepDefinition{}).Name()
@@ -139,6 +148,7 @@ func init() {
 	SchemeBuilder.Register(&TraitDefinition{}, &TraitDefinitionList{})
 	SchemeBuilder.Register(&PolicyDefinition{}, &PolicyDefinitionList{})
 	SchemeBuilder.Register(&WorkflowStepDefinition{}, &WorkflowStepDefinitionList{})
+	SchemeBuilder.Register(&GuardrailDefinition{}, &GuardrailDefinitionList{})
 	SchemeBuilder.Register(&DefinitionRevision{}, &DefinitionRevisionList{})
 	SchemeBuilder.Register(&Application{}, &ApplicationList{})
 	SchemeBuilder.Register(&ApplicationRevision{}, &ApplicationRevisionList{})
@@ -24,13 +24,19 @@ const (
 	ReasonRevisoned       = "Revisioned"
 	ReasonApplied         = "Applied"
 	ReasonDeployed        = "Deployed"
+	ReasonFailover        = "Failover"
+	ReasonDeployWindow    = "DeployWindow"
 
-	ReasonFailedParse     = "FailedParse"
-	ReasonFailedRevision  = "FailedRevision"
-	ReasonFailedWorkflow  = "FailedWorkflow"
-	ReasonFailedApply     = "FailedApply"
-	ReasonFailedStateKeep = "FailedStateKeep"
-	ReasonFailedGC        = "FailedGC"
+	ReasonGuardrailViolated = "GuardrailViolated"
+
+	ReasonFailedParse        = "FailedParse"
+	ReasonFailedRevision     = "FailedRevision"
+	ReasonFailedWorkflow     = "FailedWorkflow"
+	ReasonFailedApply        = "FailedApply"
+	ReasonFailedStateKeep    = "FailedStateKeep"
+	ReasonFailedGC           = "FailedGC"
+	ReasonFailedFailover     = "FailedFailover"
+	ReasonFailedDeployWindow = "FailedDeployWindow"
 )
 
 // event message for Application
@@ -17,6 +17,8 @@ limitations under the License.
 package config
 
 import (
+	"time"
+
 	"github.com/spf13/pflag"
 
 	oamcontroller "github.com/oam-dev/kubevela/pkg/controller/core.oam.dev"
@@ -42,6 +44,7 @@ func NewControllerConfig() *ControllerConfig {
 			ConcurrentReconciles:                         4,
 			IgnoreAppWithoutControllerRequirement:        false,
 			IgnoreDefinitionWithoutControllerRequirement: false,
+			EventExportInterval:                          30 * time.Second,
 		},
 	}
 }
@@ -64,4 +67,8 @@ func (c *ControllerConfig) AddFlags(fs *pflag.FlagSet) {
 		"If true, application controller will not process the app without 'app.oam.dev/controller-version-require' annotation")
 	fs.BoolVar(&c.IgnoreDefinitionWithoutControllerRequirement, "ignore-definition-without-controller-version", c.IgnoreDefinitionWithoutControllerRequirement,
 		"If true, trait/component/workflowstep definition controller will not process the definition without 'definition.oam.dev/controller-version-require' annotation")
+	fs.StringVar(&c.EventWebhookURL, "event-webhook-url", c.EventWebhookURL,
+		"The webhook to forward the event timelines of the applications to, including the events of the resources they dispatch in all clusters. Disabled if empty.")
+	fs.DurationVar(&c.EventExportInterval, "event-export-interval", c.EventExportInterval,
+		"The interval to export the new events of an application to the event webhook. The default value is 30s.")
 }
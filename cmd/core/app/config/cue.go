@@ -19,12 +19,15 @@ package config
 import (
 	"github.com/kubevela/pkg/cue/cuex"
 	"github.com/spf13/pflag"
+
+	"github.com/oam-dev/kubevela/pkg/cue/definition"
 )
 
 // CUEConfig contains CUE language configuration.
 type CUEConfig struct {
 	EnableExternalPackage      bool
 	EnableExternalPackageWatch bool
+	TemplateCacheMaxBytes      int64
 }
 
 // NewCUEConfig creates a new CUEConfig with defaults.
@@ -32,6 +35,7 @@ func NewCUEConfig() *CUEConfig {
 	return &CUEConfig{
 		EnableExternalPackage:      cuex.EnableExternalPackageForDefaultCompiler,
 		EnableExternalPackageWatch: cuex.EnableExternalPackageWatchForDefaultCompiler,
+		TemplateCacheMaxBytes:      definition.DefaultTemplateCacheMaxBytes,
 	}
 }
 
@@ -45,6 +49,10 @@ func (c *CUEConfig) AddFlags(fs *pflag.FlagSet) {
 		"enable-external-package-watch-for-default-compiler",
 		c.EnableExternalPackageWatch,
 		"Enable watching for changes in external CUE packages and automatically reload them when modified. Requires enable-external-package-for-default-compiler to be enabled.")
+	fs.Int64Var(&c.TemplateCacheMaxBytes,
+		"template-cache-max-bytes",
+		c.TemplateCacheMaxBytes,
+		"The estimated memory bound in bytes of the cache of compiled definition templates used for rendering components and traits. Set to 0 to disable the cache.")
 }
 
 // SyncToCUEGlobals syncs the parsed configuration values to CUE package global variables.
@@ -58,4 +66,5 @@ func (c *CUEConfig) AddFlags(fs *pflag.FlagSet) {
 func (c *CUEConfig) SyncToCUEGlobals() {
 	cuex.EnableExternalPackageForDefaultCompiler = c.EnableExternalPackage
 	cuex.EnableExternalPackageWatchForDefaultCompiler = c.EnableExternalPackageWatch
+	definition.DefaultTemplateCache.SetMaxBytes(c.TemplateCacheMaxBytes)
 }
@@ -84,6 +84,8 @@ func TestNewCoreOptions_DefaultValues(t *testing.T) {
 	assert.Equal(t, 4, opt.Controller.ConcurrentReconciles)
 	assert.Equal(t, false, opt.Controller.IgnoreAppWithoutControllerRequirement)
 	assert.Equal(t, false, opt.Controller.IgnoreDefinitionWithoutControllerRequirement)
+	assert.Equal(t, "", opt.Controller.EventWebhookURL)
+	assert.Equal(t, 30*time.Second, opt.Controller.EventExportInterval)
 
 	// Test Workflow defaults
 	assert.Equal(t, 60, opt.Workflow.MaxWaitBackoffTime)
@@ -141,6 +143,7 @@ func TestCoreOptions_FlagsCompleteSet(t *testing.T) {
 		// CUE flags
 		"--enable-external-package-for-default-compiler=true",
 		"--enable-external-package-watch-for-default-compiler=true",
+		"--template-cache-max-bytes=1048576",
 		// Application flags
 		"--application-re-sync-period=5s",
 		// OAM flags
@@ -155,6 +158,8 @@ func TestCoreOptions_FlagsCompleteSet(t *testing.T) {
 		"--concurrent-reconciles=8",
 		"--ignore-app-without-controller-version=true",
 		"--ignore-definition-without-controller-version=true",
+		"--event-webhook-url=https://events.example.com/vela",
+		"--event-export-interval=1m",
 		// Workflow flags
 		"--max-workflow-wait-backoff-time=30",
 		"--max-workflow-failed-backoff-time=150",
@@ -199,6 +204,7 @@ func TestCoreOptions_FlagsCompleteSet(t *testing.T) {
 	// Verify CUE flags
 	assert.True(t, opt.CUE.EnableExternalPackage)
 	assert.True(t, opt.CUE.EnableExternalPackageWatch)
+	assert.Equal(t, int64(1048576), opt.CUE.TemplateCacheMaxBytes)
 
 	// Verify Application flags
 	assert.Equal(t, 5*time.Second, opt.Application.ReSyncPeriod)
@@ -217,6 +223,8 @@ func TestCoreOptions_FlagsCompleteSet(t *testing.T) {
 	assert.Equal(t, 8, opt.Controller.ConcurrentReconciles)
 	assert.Equal(t, true, opt.Controller.IgnoreAppWithoutControllerRequirement)
 	assert.Equal(t, true, opt.Controller.IgnoreDefinitionWithoutControllerRequirement)
+	assert.Equal(t, "https://events.example.com/vela", opt.Controller.EventWebhookURL)
+	assert.Equal(t, time.Minute, opt.Controller.EventExportInterval)
 
 	// Verify Workflow flags
 	assert.Equal(t, 30, opt.Workflow.MaxWaitBackoffTime)
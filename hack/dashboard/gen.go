@@ -0,0 +1,40 @@
+/*
+Copyright 2026 The KubeVela Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/oam-dev/kubevela/pkg/monitor/metrics"
+)
+
+// generate the grafana dashboard of the metrics registered by the vela-core controller
+func main() {
+	if len(os.Args) < 2 {
+		log.Fatal(fmt.Errorf("not enough args"))
+	}
+	data, err := metrics.GenerateDashboard()
+	if err != nil {
+		log.Fatal(err)
+	}
+	/* #nosec */
+	if err = os.WriteFile(os.Args[1], data, 0644); err != nil {
+		log.Fatal(err)
+	}
+}
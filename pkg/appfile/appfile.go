@@ -22,6 +22,7 @@ import (
 	"fmt"
 	"reflect"
 	"strings"
+	"time"
 
 	"github.com/oam-dev/kubevela/pkg/cue/definition/health"
 
@@ -50,6 +51,7 @@ import (
 	"github.com/oam-dev/kubevela/pkg/component"
 	"github.com/oam-dev/kubevela/pkg/cue/definition"
 	velaprocess "github.com/oam-dev/kubevela/pkg/cue/process"
+	"github.com/oam-dev/kubevela/pkg/monitor/metrics"
 	"github.com/oam-dev/kubevela/pkg/oam"
 	"github.com/oam-dev/kubevela/pkg/oam/util"
 )
@@ -95,9 +97,15 @@ type Component struct {
 
 // EvalContext eval workload template and set the result to context
 func (comp *Component) EvalContext(ctx process.Context) error {
+	defer observeRenderDuration("component", comp.Type, time.Now())
 	return comp.engine.Complete(ctx, comp.FullTemplate.TemplateStr, comp.Params)
 }
 
+// observeRenderDuration reports the time cost of rendering the template of the definition since begin
+func observeRenderDuration(definitionType, definition string, begin time.Time) {
+	metrics.CUERenderDurationHistogram.WithLabelValues(definitionType, definition).Observe(time.Since(begin).Seconds())
+}
+
 // GetTemplateContext get workload template context, it will be used to eval status and health
 func (comp *Component) GetTemplateContext(ctx process.Context, client client.Client, accessor util.NamespaceAccessor) (map[string]interface{}, error) {
 	// if the standard workload is managed by trait, just return empty context
@@ -139,6 +147,7 @@ type Trait struct {
 
 // EvalContext eval trait template and set result to context
 func (trait *Trait) EvalContext(ctx process.Context) error {
+	defer observeRenderDuration("trait", trait.Name, time.Now())
 	return trait.engine.Complete(ctx, trait.Template, trait.Params)
 }
 
@@ -185,6 +194,8 @@ type Appfile struct {
 	ReferredObjects  []*unstructured.Unstructured
 
 	app *v1beta1.Application
+	// patchConflicts are the conflicting patches of the traits found by ValidateCUESchematicAppfile
+	patchConflicts map[string][]definition.PatchConflict
 
 	Debug bool
 }
@@ -194,7 +205,7 @@ type Appfile struct {
 func (af *Appfile) GeneratePolicyManifests(_ context.Context) ([]*unstructured.Unstructured, error) {
 	var manifests []*unstructured.Unstructured
 	for _, policy := range af.ParsedPolicies {
-		un, err := af.generatePolicyUnstructured(policy)
+		un, err := af.GeneratePolicyManifest(policy)
 		if err != nil {
 			return nil, err
 		}
@@ -203,7 +214,8 @@ func (af *Appfile) GeneratePolicyManifests(_ context.Context) ([]*unstructured.U
 	return manifests, nil
 }
 
-func (af *Appfile) generatePolicyUnstructured(workload *Component) ([]*unstructured.Unstructured, error) {
+// GeneratePolicyManifest generates the manifests of one policy
+func (af *Appfile) GeneratePolicyManifest(workload *Component) ([]*unstructured.Unstructured, error) {
 	ctxData := GenerateContextDataFromAppFile(af, workload.Name)
 	uns, err := generatePolicyUnstructuredFromCUEModule(workload, af.Artifacts, ctxData)
 	if err != nil {
@@ -560,11 +572,9 @@ func makeWorkloadWithContext(pCtx process.Context, comp *Component, ns, appName
 		workload, err = base.Unstructured()
 		if err != nil {
 			// Try to get the full workload template for comprehensive error analysis
-			if fullTemplateData := pCtx.GetData(definition.GetWorkloadTemplateKey(comp.Name)); fullTemplateData != nil {
-				if fullTemplate, ok := fullTemplateData.(cue.Value); ok {
-					if formattedErr := definition.FormatCUEError(err, "cannot generate manifests from", "component", comp.Name, &fullTemplate); formattedErr != nil {
-						return nil, formattedErr
-					}
+			if fullTemplate, ok := definition.GetWorkloadTemplate(pCtx, comp.Name); ok {
+				if formattedErr := definition.FormatCUEError(err, "cannot generate manifests from", "component", comp.Name, &fullTemplate); formattedErr != nil {
+					return nil, formattedErr
 				}
 			}
 			// Fallback to using the base's value
@@ -0,0 +1,235 @@
+/*
+Copyright 2026 The KubeVela Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package debugger
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/aryann/difflib"
+)
+
+const consoleHelp = `Commands:
+  next, n              go to the next stage
+  stage <n>            go to the stage n
+  context              print the context passed to the template
+  parameter            print the parameter passed to the template
+  template             print the template with line numbers
+  resources            print the resources rendered after the stage
+  diff                 print the changes of the resources made by the stage
+  errors               print the error and the conflicts of the stage
+  eval <expr>, <expr>  evaluate a CUE expression in the scope of the template,
+                       e.g. patch, output.spec, context.output.spec.replicas
+  help, h              print this help
+  quit, q              exit the debugger
+`
+
+// Console is an interactive console stepping through the stages of the rendering
+type Console struct {
+	stages  []*Stage
+	current int
+	out     io.Writer
+}
+
+// NewConsole creates a console for the stages
+func NewConsole(stages []*Stage, out io.Writer) *Console {
+	return &Console{stages: stages, out: out}
+}
+
+// Run reads the commands from in until quit. When the input ends, the remaining stages are
+// printed without stopping, so that the debugger can be run without a terminal.
+func (c *Console) Run(in io.Reader) {
+	if len(c.stages) == 0 {
+		return
+	}
+	c.printStage()
+	scanner := bufio.NewScanner(in)
+	for {
+		_, _ = fmt.Fprint(c.out, "(debug) ")
+		if !scanner.Scan() {
+			_, _ = fmt.Fprintln(c.out)
+			for c.current < len(c.stages)-1 {
+				c.current++
+				c.printStage()
+			}
+			return
+		}
+		if !c.Exec(strings.TrimSpace(scanner.Text())) {
+			return
+		}
+	}
+}
+
+// Exec executes a command of the console and returns false if the console is exited
+func (c *Console) Exec(line string) bool {
+	stage := c.stages[c.current]
+	cmd, arg, _ := strings.Cut(line, " ")
+	arg = strings.TrimSpace(arg)
+	switch cmd {
+	case "":
+	case "next", "n":
+		if c.current == len(c.stages)-1 {
+			c.printf("%s is the last stage\n", stage.Name())
+			return true
+		}
+		c.current++
+		c.printStage()
+	case "stage":
+		var n int
+		if _, err := fmt.Sscanf(arg, "%d", &n); err != nil || n < 1 || n > len(c.stages) {
+			c.printf("the stage must be between 1 and %d\n", len(c.stages))
+			return true
+		}
+		c.current = n - 1
+		c.printStage()
+	case "context":
+		c.printf("%s\n", strings.TrimRight(stage.Context, "\n"))
+	case "parameter":
+		c.eval("parameter")
+	case "template":
+		for i, line := range strings.Split(strings.TrimRight(stage.Template, "\n"), "\n") {
+			c.printf("%4d  %s\n", i+1, line)
+		}
+	case "resources":
+		for _, r := range stage.After {
+			c.printf("# %s\n%s", r.Name, r.Content)
+		}
+	case "diff":
+		c.printDiff(stage)
+	case "errors":
+		c.printErrors(stage)
+	case "help", "h":
+		c.printf("%s", consoleHelp)
+	case "quit", "q", "exit":
+		return false
+	case "eval":
+		c.eval(arg)
+	default:
+		c.eval(line)
+	}
+	return true
+}
+
+func (c *Console) printf(format string, args ...interface{}) {
+	_, _ = fmt.Fprintf(c.out, format, args...)
+}
+
+func (c *Console) eval(expr string) {
+	v, err := c.stages[c.current].Eval(expr)
+	if err != nil {
+		c.printf("error: %v\n", err)
+		return
+	}
+	c.printf("%v\n", v)
+}
+
+func (c *Console) printStage() {
+	stage := c.stages[c.current]
+	c.printf("== [%d/%d] %s ==\n", c.current+1, len(c.stages), stage.Name())
+	switch {
+	case stage.Err != nil:
+		c.printErrors(stage)
+	case stage.Kind == StageComponent:
+		for _, r := range stage.After {
+			c.printf("# %s\n%s", r.Name, r.Content)
+		}
+	default:
+		c.printDiff(stage)
+	}
+}
+
+func (c *Console) printErrors(stage *Stage) {
+	if stage.Err == nil && len(stage.Conflicts) == 0 {
+		c.printf("no error\n")
+		return
+	}
+	if stage.Err != nil {
+		c.printf("error: %v\n", stage.Err)
+	}
+	for _, conflict := range stage.Conflicts {
+		if conflict.Path != "" {
+			c.printf("conflict at %s: %s\n", conflict.Path, conflict.Message)
+		} else {
+			c.printf("conflict: %s\n", conflict.Message)
+		}
+		for _, p := range conflict.Positions {
+			c.printf("    %-16s %s\n", p.String(), strings.TrimSpace(p.Text))
+		}
+	}
+}
+
+func (c *Console) printDiff(stage *Stage) {
+	before := map[string]string{}
+	for _, r := range stage.Before {
+		before[r.Name] = r.Content
+	}
+	changed := false
+	for _, r := range stage.After {
+		if before[r.Name] == r.Content {
+			continue
+		}
+		changed = true
+		c.printf("# %s\n", r.Name)
+		for _, line := range diffLines(before[r.Name], r.Content) {
+			c.printf("%s\n", line)
+		}
+	}
+	if !changed {
+		c.printf("no resource is changed by %s\n", stage.Name())
+	}
+}
+
+// diffLines returns the changed lines with two lines of context
+func diffLines(before, after string) []string {
+	split := func(s string) []string {
+		if s == "" {
+			return nil
+		}
+		return strings.Split(strings.TrimRight(s, "\n"), "\n")
+	}
+	records := difflib.Diff(split(before), split(after))
+	const around = 2
+	var lines []string
+	last := -1
+	for i, r := range records {
+		near := false
+		for j := max(0, i-around); j <= min(len(records)-1, i+around); j++ {
+			if records[j].Delta != difflib.Common {
+				near = true
+				break
+			}
+		}
+		if !near {
+			continue
+		}
+		if last >= 0 && i > last+1 {
+			lines = append(lines, "  ...")
+		}
+		last = i
+		switch r.Delta {
+		case difflib.LeftOnly:
+			lines = append(lines, "- "+r.Payload)
+		case difflib.RightOnly:
+			lines = append(lines, "+ "+r.Payload)
+		default:
+			lines = append(lines, "  "+r.Payload)
+		}
+	}
+	return lines
+}
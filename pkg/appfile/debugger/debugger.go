@@ -0,0 +1,346 @@
+/*
+Copyright 2026 The KubeVela Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package debugger steps through the rendering of a component, the evaluation of the component
+// template and of every trait template, and keeps what each stage sees and produces.
+package debugger
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"cuelang.org/go/cue"
+	cueerrors "cuelang.org/go/cue/errors"
+	"github.com/kubevela/pkg/cue/cuex"
+	"github.com/kubevela/workflow/pkg/cue/model"
+	"github.com/kubevela/workflow/pkg/cue/model/sets"
+	"github.com/kubevela/workflow/pkg/cue/process"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/yaml"
+
+	"github.com/oam-dev/kubevela/apis/types"
+	"github.com/oam-dev/kubevela/pkg/appfile"
+	velaprocess "github.com/oam-dev/kubevela/pkg/cue/process"
+)
+
+const (
+	// StageComponent is the stage evaluating the component template
+	StageComponent = "component"
+	// StageTrait is the stage evaluating a trait template and patching the resources
+	StageTrait = "trait"
+)
+
+// Stage is a step of the rendering of a component
+type Stage struct {
+	// Kind is StageComponent or StageTrait
+	Kind string
+	// Type is the name of the definition evaluated
+	Type string
+	// Template is the CUE template of the definition
+	Template string
+	// Parameter is the parameter passed to the template
+	Parameter map[string]interface{}
+	// Context is the context passed to the template, in CUE
+	Context string
+	// Before are the resources rendered before the stage
+	Before []Resource
+	// After are the resources rendered after the stage
+	After []Resource
+	// Err is the error returned by the evaluation of the stage
+	Err error
+	// Conflicts are the unification errors found in the stage
+	Conflicts []Conflict
+
+	src   *source
+	value cue.Value
+}
+
+// Resource is a resource rendered by the templates
+type Resource struct {
+	// Name is output or outputs.<name>
+	Name string
+	// Content is the resource in YAML, or in CUE if it is not concrete yet
+	Content string
+}
+
+// Conflict is a unification error with the positions of the values involved
+type Conflict struct {
+	Path      string
+	Message   string
+	Positions []Position
+}
+
+// Position is a position in the source evaluated by a stage
+type Position struct {
+	// Section is the part of the source the position is in: template, parameter or context
+	Section string
+	Line    int
+	Column  int
+	// Text is the source line
+	Text string
+}
+
+// String returns the position as section:line:column
+func (p Position) String() string {
+	return fmt.Sprintf("%s:%d:%d", p.Section, p.Line, p.Column)
+}
+
+// Name returns the name of the stage
+func (s *Stage) Name() string {
+	return fmt.Sprintf("%s %s", s.Kind, s.Type)
+}
+
+// Value returns the evaluated template of the stage, with the parameter and the context filled
+func (s *Stage) Value() cue.Value {
+	return s.value
+}
+
+// Eval evaluates the expression in the scope of the stage, in which the fields of the template
+// such as context, parameter, output, outputs and patch can be referenced.
+func (s *Stage) Eval(expr string) (cue.Value, error) {
+	if !s.value.Exists() {
+		return cue.Value{}, errors.Errorf("the template of %s cannot be compiled", s.Name())
+	}
+	v := s.value.Context().CompileString(expr, cue.Scope(s.value), cue.InferBuiltins(true))
+	if err := v.Err(); err != nil {
+		return cue.Value{}, errors.New(strings.TrimSpace(cueerrors.Details(err, nil)))
+	}
+	return v, nil
+}
+
+// Render renders the component of the appfile stage by stage, as the application controller does.
+// It stops at the first stage failing, which is the last stage returned.
+func Render(ctx context.Context, af *appfile.Appfile, compName string) ([]*Stage, error) {
+	var comp *appfile.Component
+	for _, c := range af.ParsedComponents {
+		if c.Name == compName {
+			comp = c
+		}
+	}
+	if comp == nil {
+		return nil, errors.Errorf("component %s not found in application %s", compName, af.Name)
+	}
+	if comp.CapabilityCategory != types.CUECategory || comp.FullTemplate == nil {
+		return nil, errors.Errorf("component %s is not rendered by a CUE template", compName)
+	}
+
+	ctxData := appfile.GenerateContextDataFromAppFile(af, comp.Name)
+	pCtx := appfile.NewBasicContext(ctxData, comp.Params)
+	stage, err := runStage(ctx, pCtx, StageComponent, comp.Type, comp.FullTemplate.TemplateStr, comp.Params, comp.EvalContext)
+	if err != nil {
+		return nil, err
+	}
+	stages := []*Stage{stage}
+	if stage.Err != nil {
+		return stages, nil
+	}
+	pCtx.PushData(velaprocess.ContextComponentType, comp.Type)
+	for _, tr := range comp.Traits {
+		if tr.CapabilityCategory != types.CUECategory {
+			continue
+		}
+		stage, err = runStage(ctx, pCtx, StageTrait, tr.Name, tr.Template, tr.Params, tr.EvalContext)
+		if err != nil {
+			return nil, err
+		}
+		stages = append(stages, stage)
+		if stage.Err != nil {
+			break
+		}
+	}
+	return stages, nil
+}
+
+func runStage(ctx context.Context, pCtx process.Context, kind, typ, template string, params map[string]interface{}, eval func(process.Context) error) (*Stage, error) {
+	contextFile, err := pCtx.BaseContextFile()
+	if err != nil {
+		return nil, errors.WithMessagef(err, "failed to generate the context of %s %s", kind, typ)
+	}
+	paramFile := velaprocess.ParameterFieldName + ": {}"
+	if params != nil {
+		bt, err := json.Marshal(params)
+		if err != nil {
+			return nil, errors.WithMessagef(err, "failed to marshal the parameter of %s %s", kind, typ)
+		}
+		paramFile = fmt.Sprintf("%s: %s", velaprocess.ParameterFieldName, string(bt))
+	}
+	s := &Stage{
+		Kind:      kind,
+		Type:      typ,
+		Template:  template,
+		Parameter: params,
+		Context:   contextFile,
+		Before:    resourcesOf(pCtx),
+		src:       newSource(template, paramFile, contextFile),
+	}
+	// the provider functions are not called again, they have been called by the evaluation of the stage
+	s.value, err = cuex.DefaultCompiler.Get().CompileStringWithOptions(ctx, s.src.text, cuex.DisableResolveProviderFunctions{})
+	switch {
+	case err != nil:
+		s.Conflicts = s.src.conflicts(err)
+	case s.value.Validate() != nil:
+		s.Conflicts = s.src.conflicts(s.value.Validate())
+	}
+	s.Err = eval(pCtx)
+	if s.Err != nil && len(s.Conflicts) == 0 && kind == StageTrait {
+		s.Conflicts = s.patchConflicts()
+	}
+	s.After = resourcesOf(pCtx)
+	return s, nil
+}
+
+// patchConflicts reproduces the patch of the workload to find the conflicts between the patch and the workload
+func (s *Stage) patchConflicts() []Conflict {
+	patch := s.value.LookupPath(cue.ParsePath("patch"))
+	output := s.value.LookupPath(cue.ParsePath("context.output"))
+	if !patch.Exists() || !output.Exists() {
+		return nil
+	}
+	var conflicts []Conflict
+	if _, err := sets.StrategyUnify(output, patch, sets.CreateUnifyOptionsForPatcher(patch)...); err != nil {
+		conflicts = s.src.conflicts(err)
+	}
+	// the strategic patch does not keep the positions, the plain unification tells where the values come from
+	if !hasPositions(conflicts) {
+		if err := output.Unify(patch).Validate(); err != nil && hasPositions(s.src.conflicts(err)) {
+			conflicts = s.src.conflicts(err)
+		}
+	}
+	for i, c := range conflicts {
+		path := strings.TrimPrefix(c.Path, "context.output.")
+		field := patch.LookupPath(cue.ParsePath(path))
+		if !field.Exists() {
+			continue
+		}
+		conflicts[i].Path = "patch." + path
+		if pos := field.Pos(); pos.Filename() == "-" {
+			if p, ok := s.src.position(pos.Line(), pos.Column()); ok {
+				conflicts[i].Positions = append([]Position{p}, c.Positions...)
+			}
+		}
+	}
+	return conflicts
+}
+
+func hasPositions(conflicts []Conflict) bool {
+	for _, c := range conflicts {
+		if len(c.Positions) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+func resourcesOf(pCtx process.Context) []Resource {
+	base, auxiliaries := pCtx.Output()
+	var resources []Resource
+	if base != nil {
+		resources = append(resources, Resource{Name: "output", Content: contentOf(base)})
+	}
+	for _, aux := range auxiliaries {
+		resources = append(resources, Resource{Name: "outputs." + aux.Name, Content: contentOf(aux.Ins)})
+	}
+	return resources
+}
+
+func contentOf(ins model.Instance) string {
+	if obj, err := ins.Unstructured(); err == nil {
+		if bs, err := yaml.Marshal(obj.Object); err == nil {
+			return string(bs)
+		}
+	}
+	s, err := ins.String()
+	if err != nil {
+		return err.Error()
+	}
+	return s
+}
+
+// source is the CUE source evaluated by a stage, made of the template, the parameter and the context
+type source struct {
+	text     string
+	lines    []string
+	sections []section
+}
+
+type section struct {
+	name  string
+	start int
+}
+
+func newSource(template, paramFile, contextFile string) *source {
+	src := &source{}
+	add := func(name, text string) {
+		text = strings.TrimRight(text, "\n") + "\n"
+		src.sections = append(src.sections, section{name: name, start: len(src.lines) + 1})
+		src.lines = append(src.lines, strings.Split(strings.TrimSuffix(text, "\n"), "\n")...)
+		src.text += text
+	}
+	add("template", template+"\ncontext: _\nparameter: _")
+	add("parameter", paramFile)
+	add("context", contextFile)
+	return src
+}
+
+func (src *source) position(line, column int) (Position, bool) {
+	if line < 1 || line > len(src.lines) {
+		return Position{}, false
+	}
+	p := Position{Line: line, Column: column, Text: clip(src.lines[line-1], column)}
+	for _, s := range src.sections {
+		if line >= s.start {
+			p.Section, p.Line = s.name, line-s.start+1
+		}
+	}
+	return p, true
+}
+
+// clip shortens the long lines, such as the context in JSON, around the column
+func clip(text string, column int) string {
+	const width = 40
+	if len(text) <= 2*width {
+		return text
+	}
+	start, end := max(0, column-1-width), min(len(text), column-1+width)
+	clipped := text[start:end]
+	if start > 0 {
+		clipped = "..." + clipped
+	}
+	if end < len(text) {
+		clipped += "..."
+	}
+	return clipped
+}
+
+func (src *source) conflicts(err error) []Conflict {
+	var conflicts []Conflict
+	for _, e := range cueerrors.Errors(err) {
+		format, args := e.Msg()
+		c := Conflict{Path: strings.Join(e.Path(), "."), Message: fmt.Sprintf(format, args...)}
+		for _, pos := range cueerrors.Positions(e) {
+			if pos.Filename() != "-" {
+				continue
+			}
+			if p, ok := src.position(pos.Line(), pos.Column()); ok {
+				c.Positions = append(c.Positions, p)
+			}
+		}
+		conflicts = append(conflicts, c)
+	}
+	return conflicts
+}
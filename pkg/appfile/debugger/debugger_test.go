@@ -0,0 +1,219 @@
+/*
+Copyright 2026 The KubeVela Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package debugger
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/kubevela/pkg/cue/cuex"
+	"github.com/kubevela/pkg/util/singleton"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/config"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/yaml"
+
+	"github.com/oam-dev/kubevela/apis/core.oam.dev/v1beta1"
+	"github.com/oam-dev/kubevela/pkg/appfile"
+)
+
+const workerDef = `
+apiVersion: core.oam.dev/v1beta1
+kind: ComponentDefinition
+metadata:
+  name: worker
+  namespace: vela-system
+spec:
+  workload:
+    definition:
+      apiVersion: apps/v1
+      kind: Deployment
+  schematic:
+    cue:
+      template: |
+        output: {
+        	apiVersion: "apps/v1"
+        	kind:       "Deployment"
+        	spec: {
+        		replicas: parameter.replicas
+        		template: spec: containers: [{name: context.name, image: parameter.image}]
+        	}
+        }
+        parameter: {
+        	image:    string
+        	replicas: *1 | int
+        }
+`
+
+const scalerDef = `
+apiVersion: core.oam.dev/v1beta1
+kind: TraitDefinition
+metadata:
+  name: pinned
+  namespace: vela-system
+spec:
+  schematic:
+    cue:
+      template: |
+        patch: spec: replicas: parameter.replicas
+        outputs: service: {
+        	apiVersion: "v1"
+        	kind:       "Service"
+        	metadata: name: context.name
+        }
+        parameter: replicas: int
+`
+
+const labelsDef = `
+apiVersion: core.oam.dev/v1beta1
+kind: TraitDefinition
+metadata:
+  name: team
+  namespace: vela-system
+spec:
+  schematic:
+    cue:
+      template: |
+        patch: metadata: labels: team: parameter.team
+        parameter: team: string
+`
+
+const app = `
+apiVersion: core.oam.dev/v1beta1
+kind: Application
+metadata:
+  name: demo
+  namespace: default
+spec:
+  components:
+  - name: api
+    type: worker
+    properties:
+      image: nginx
+      replicas: 2
+    traits:
+    - type: team
+      properties:
+        team: infra
+    - type: pinned
+      properties:
+        replicas: 3
+`
+
+func renderApp(t *testing.T, appYAML string) []*Stage {
+	r := require.New(t)
+	// the cuex compiler loads the external packages with the dynamic client, which requires a kubeconfig otherwise
+	singleton.DynamicClient.Set(dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(), map[schema.GroupVersionResource]string{
+		{Group: "cue.oam.dev", Version: "v1alpha1", Resource: "packages"}: "PackageList",
+	}))
+	cuex.DefaultCompiler.Reload()
+	t.Cleanup(func() {
+		// reloading the clients requires a kubeconfig, the fake client is kept otherwise
+		if _, err := config.GetConfig(); err == nil {
+			singleton.ReloadClients()
+			cuex.DefaultCompiler.Reload()
+		}
+	})
+	var defs []*unstructured.Unstructured
+	for _, def := range []string{workerDef, scalerDef, labelsDef} {
+		obj := &unstructured.Unstructured{}
+		r.NoError(yaml.Unmarshal([]byte(def), &obj.Object))
+		defs = append(defs, obj)
+	}
+	application := &v1beta1.Application{}
+	r.NoError(yaml.Unmarshal([]byte(appYAML), application))
+	parser := appfile.NewDryRunApplicationParser(fake.NewClientBuilder().Build(), defs)
+	af, err := parser.GenerateAppFileFromApp(context.Background(), application)
+	r.NoError(err)
+	stages, err := Render(context.Background(), af, "api")
+	r.NoError(err)
+	return stages
+}
+
+func TestRender(t *testing.T) {
+	r := require.New(t)
+	stages := renderApp(t, app)
+	r.Len(stages, 3)
+	r.Equal("component worker", stages[0].Name())
+	r.NoError(stages[0].Err)
+	r.Empty(stages[0].Before)
+	r.Equal("output", stages[0].After[0].Name)
+	r.Contains(stages[0].After[0].Content, "replicas: 2")
+	r.Contains(stages[0].Context, `"name":"api"`)
+
+	r.Equal("trait team", stages[1].Name())
+	r.NoError(stages[1].Err)
+	r.Contains(stages[1].After[0].Content, "team: infra")
+
+	v, err := stages[1].Eval("context.output.spec.replicas + 1")
+	r.NoError(err)
+	n, err := v.Int64()
+	r.NoError(err)
+	r.Equal(int64(3), n)
+	_, err = stages[1].Eval("parameter.missing")
+	r.Error(err)
+
+	failed := stages[2]
+	r.Equal("trait pinned", failed.Name())
+	r.Error(failed.Err)
+	r.NotEmpty(failed.Conflicts)
+	conflict := failed.Conflicts[0]
+	r.Equal("patch.spec.replicas", conflict.Path)
+	r.Equal("conflicting values 3 and 2", conflict.Message)
+	var sections []string
+	for _, p := range conflict.Positions {
+		sections = append(sections, p.Section)
+	}
+	r.Contains(sections, "template")
+	for _, p := range conflict.Positions {
+		if p.Section == "template" {
+			r.Equal(1, p.Line)
+			r.Equal("patch: spec: replicas: parameter.replicas", p.Text)
+		}
+	}
+
+	_, err = Render(context.Background(), &appfile.Appfile{Name: "demo"}, "api")
+	r.EqualError(err, "component api not found in application demo")
+}
+
+func TestConsole(t *testing.T) {
+	r := require.New(t)
+	stages := renderApp(t, app)
+	out := &bytes.Buffer{}
+	NewConsole(stages, out).Run(strings.NewReader("parameter\nn\ncontext.output.spec.replicas\ndiff\nstage 9\nnext\nerrors\nnext\nq\n"))
+	s := out.String()
+	r.Contains(s, "== [1/3] component worker ==")
+	r.Contains(s, "image:    \"nginx\"")
+	r.Contains(s, "== [2/3] trait team ==")
+	r.Contains(s, "(debug) 2\n")
+	r.Contains(s, "+     team: infra")
+	r.Contains(s, "the stage must be between 1 and 3")
+	r.Contains(s, "== [3/3] trait pinned ==")
+	r.Contains(s, "conflict at patch.spec.replicas: conflicting values 3 and 2\n    template:1:14    patch: spec: replicas: parameter.replicas\n")
+	r.Contains(s, "trait pinned is the last stage")
+
+	// the remaining stages are printed when the input ends
+	out.Reset()
+	NewConsole(stages, out).Run(strings.NewReader(""))
+	r.Contains(out.String(), "== [3/3] trait pinned ==")
+}
@@ -26,7 +26,9 @@ import (
 
 	"github.com/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/rest"
 	k8scmdutil "k8s.io/kubectl/pkg/cmd/util"
 	"k8s.io/kubectl/pkg/validation"
@@ -38,6 +40,7 @@ import (
 	"github.com/oam-dev/kubevela/apis/types"
 	"github.com/oam-dev/kubevela/pkg/appfile"
 	"github.com/oam-dev/kubevela/pkg/cue/definition"
+	"github.com/oam-dev/kubevela/pkg/guardrail"
 	"github.com/oam-dev/kubevela/pkg/oam"
 	oamutil "github.com/oam-dev/kubevela/pkg/oam/util"
 	"github.com/oam-dev/kubevela/pkg/policy/envbinding"
@@ -55,7 +58,7 @@ type DryRun interface {
 // NewDryRunOption creates a dry-run option
 func NewDryRunOption(c client.Client, cfg *rest.Config, as []*unstructured.Unstructured, serverSideDryRun bool) *Option {
 	parser := appfile.NewDryRunApplicationParser(c, as)
-	return &Option{c, parser, parser.GenerateAppFileFromApp, cfg, as, serverSideDryRun}
+	return &Option{c, parser, parser.GenerateAppFileFromApp, cfg, as, serverSideDryRun, nil}
 }
 
 // GenerateAppFileFunc generate the app file model from an application
@@ -74,6 +77,10 @@ type Option struct {
 
 	// serverSideDryRun If set to true, means will dry run via the apiserver.
 	serverSideDryRun bool
+
+	// GuardrailViolations are the violations of the guardrails in the warn or audit mode by the last dry run.
+	// The violations of the guardrails in the enforce mode fail the dry run.
+	GuardrailViolations []guardrail.Violation
 }
 
 // validateObjectFromFile will read file into Unstructured object
@@ -158,9 +165,16 @@ func (d *Option) ExecuteDryRun(ctx context.Context, application *v1beta1.Applica
 	if err != nil {
 		return nil, nil, errors.WithMessage(err, "cannot generate manifests from components and traits")
 	}
-	policyManifests, err := appFile.GeneratePolicyManifests(ctx)
-	if err != nil {
-		return nil, nil, errors.WithMessage(err, "cannot generate manifests from policies")
+	var policyManifests []*unstructured.Unstructured
+	policies := make([][]*unstructured.Unstructured, len(appFile.ParsedPolicies))
+	for i, policy := range appFile.ParsedPolicies {
+		if policies[i], err = appFile.GeneratePolicyManifest(policy); err != nil {
+			return nil, nil, errors.WithMessage(err, "cannot generate manifests from policies")
+		}
+		policyManifests = append(policyManifests, policies[i]...)
+	}
+	if err = d.checkGuardrails(ctx, appFile, comps, policies); err != nil {
+		return nil, nil, err
 	}
 	if d.serverSideDryRun {
 		applyUtil := apply.NewAPIApplicator(d.Client)
@@ -171,6 +185,40 @@ func (d *Option) ExecuteDryRun(ctx context.Context, application *v1beta1.Applica
 	return comps, policyManifests, nil
 }
 
+// checkGuardrails checks the resources rendered for the components and the policies against the guardrails of the
+// cluster and of the auxiliaries
+func (d *Option) checkGuardrails(ctx context.Context, af *appfile.Appfile, comps []*types.ComponentManifest, policies [][]*unstructured.Unstructured) error {
+	d.GuardrailViolations = nil
+	guardrails, err := guardrail.List(ctx, d.Client, af.Namespace)
+	if err != nil && !meta.IsNoMatchError(errors.Cause(err)) && !runtime.IsNotRegisteredError(errors.Cause(err)) {
+		return err
+	}
+	auxiliaries, err := guardrail.FromUnstructured(d.Auxiliaries)
+	if err != nil {
+		return err
+	}
+	checker := guardrail.NewChecker(ctx, guardrail.Merge(guardrails, auxiliaries))
+	if checker.Empty() {
+		return nil
+	}
+	var violations []guardrail.Violation
+	for i, comp := range af.ParsedComponents {
+		if i < len(comps) {
+			violations = append(violations, checker.CheckComponent(af, comp, comps[i], nil)...)
+		}
+	}
+	for i, policy := range af.ParsedPolicies {
+		if i < len(policies) {
+			violations = append(violations, checker.CheckPolicy(af, policy, policies[i])...)
+		}
+	}
+	if err = guardrail.Enforced(violations); err != nil {
+		return err
+	}
+	d.GuardrailViolations = violations
+	return nil
+}
+
 // PrintDryRun will print the result of dry-run
 func (d *Option) PrintDryRun(buff *bytes.Buffer, appName string, comps []*types.ComponentManifest, policies []*unstructured.Unstructured) error {
 	var components = make(map[string]*unstructured.Unstructured)
@@ -217,6 +265,13 @@ func (d *Option) PrintDryRun(buff *bytes.Buffer, appName string, comps []*types.
 		buff.Write(result)
 		buff.WriteString("\n---\n")
 	}
+	if len(d.GuardrailViolations) > 0 {
+		fmt.Fprintf(buff, "---\n# Application(%s) -- Guardrail violations\n---\n\n", appName)
+		for _, v := range d.GuardrailViolations {
+			fmt.Fprintf(buff, "# [%s] %s\n", v.Mode, v)
+		}
+		buff.WriteString("\n")
+	}
 	return nil
 }
 
@@ -21,6 +21,7 @@ import (
 	"context"
 	"encoding/json"
 	"os"
+	"slices"
 	"strings"
 
 	"github.com/oam-dev/kubevela/apis/types"
@@ -28,6 +29,7 @@ import (
 	"github.com/google/go-cmp/cmp"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"sigs.k8s.io/yaml"
 
 	"github.com/oam-dev/kubevela/apis/core.oam.dev/v1beta1"
@@ -181,3 +183,29 @@ var _ = Describe("Test dry run with policies", func() {
 		Expect(buff.String()).Should(ContainSubstring("trait.oam.dev/type: nocalhost"))
 	})
 })
+
+var _ = Describe("Test dry run with guardrails", func() {
+	It("Test dry run with guardrails in the warn and enforce mode", func() {
+		app := &v1beta1.Application{}
+		Expect(yaml.Unmarshal([]byte(readDataFromFile("./testdata/dryrun-app.yaml")), app)).Should(BeNil())
+		guardrail := &unstructured.Unstructured{}
+		Expect(yaml.Unmarshal([]byte(readDataFromFile("./testdata/gd-no-busybox.yaml")), &guardrail.Object)).Should(BeNil())
+
+		By("Report the violations of the guardrail in the warn mode")
+		opt := NewDryRunOption(k8sClient, cfg, append(slices.Clone(dryrunOpt.Auxiliaries), guardrail), false)
+		comps, policies, err := opt.ExecuteDryRun(context.Background(), app)
+		Expect(err).Should(BeNil())
+		Expect(opt.GuardrailViolations).Should(HaveLen(1))
+		Expect(opt.GuardrailViolations[0].String()).Should(Equal("guardrail no-busybox: Deployment/myweb of component myweb: container myweb runs busybox"))
+		buff := bytes.Buffer{}
+		Expect(opt.PrintDryRun(&buff, app.Name, comps, policies)).Should(BeNil())
+		Expect(buff.String()).Should(ContainSubstring("# Application(app-dryrun) -- Guardrail violations"))
+		Expect(buff.String()).Should(ContainSubstring("# [warn] guardrail no-busybox"))
+
+		By("Fail the dry run violating the guardrail in the enforce mode")
+		Expect(unstructured.SetNestedField(guardrail.Object, "enforce", "spec", "mode")).Should(Succeed())
+		_, _, err = opt.ExecuteDryRun(context.Background(), app)
+		Expect(err).ShouldNot(BeNil())
+		Expect(err.Error()).Should(ContainSubstring("the rendered resources violate the enforced guardrails"))
+	})
+})
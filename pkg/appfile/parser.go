@@ -338,6 +338,8 @@ func (p *Parser) parsePoliciesFromRevision(ctx context.Context, af *Appfile) (er
 		case v1alpha1.ResourceUpdatePolicyType:
 		case v1alpha1.EnvBindingPolicyType:
 		case v1alpha1.TopologyPolicyType:
+		case v1alpha1.FailoverPolicyType:
+		case v1alpha1.DeployWindowPolicyType:
 		case v1alpha1.OverridePolicyType:
 		case v1alpha1.DebugPolicyType:
 			af.Debug = true
@@ -370,6 +372,8 @@ func (p *Parser) parsePolicies(ctx context.Context, af *Appfile) (err error) {
 		case v1alpha1.ResourceUpdatePolicyType:
 		case v1alpha1.EnvBindingPolicyType:
 		case v1alpha1.TopologyPolicyType:
+		case v1alpha1.FailoverPolicyType:
+		case v1alpha1.DeployWindowPolicyType:
 		case v1alpha1.ReplicationPolicyType:
 		case v1alpha1.DebugPolicyType:
 			af.Debug = true
@@ -513,7 +517,7 @@ func (p *Parser) convertTemplate2Component(name, typ string, props *runtime.RawE
 		CapabilityCategory: templ.CapabilityCategory,
 		FullTemplate:       templ,
 		Params:             settings,
-		engine:             definition.NewWorkloadAbstractEngine(name),
+		engine:             definition.NewWorkloadAbstractEngine(name, definition.WithRevisionHash(templ.RevisionHash())),
 	}, nil
 }
 
@@ -700,6 +704,10 @@ func (p *Parser) convertTemplate2Trait(name string, properties map[string]interf
 	if err != nil {
 		traitName = name
 	}
+	var conflictPolicy v1beta1.TraitConflictPolicy
+	if templ.TraitDefinition != nil {
+		conflictPolicy = templ.TraitDefinition.Spec.ConflictPolicy
+	}
 	return &Trait{
 		Name:               traitName,
 		CapabilityCategory: templ.CapabilityCategory,
@@ -707,7 +715,7 @@ func (p *Parser) convertTemplate2Trait(name string, properties map[string]interf
 		Template:           templ.TemplateStr,
 		CustomStatusFormat: templ.CustomStatus,
 		FullTemplate:       templ,
-		engine:             definition.NewTraitAbstractEngine(traitName),
+		engine:             definition.NewTraitAbstractEngine(traitName, definition.WithRevisionHash(templ.RevisionHash()), definition.WithConflictPolicy(conflictPolicy)),
 	}, nil
 }
 
@@ -411,3 +411,19 @@ func (t *Template) AsStatusRequest(parameter map[string]interface{}) *health.Sta
 		Parameter: parameter,
 	}
 }
+
+// RevisionHash returns the hash of the latest revision of the definition, which identifies the compiled
+// template of the definition
+func (t *Template) RevisionHash() string {
+	var rev *common.Revision
+	switch {
+	case t.ComponentDefinition != nil:
+		rev = t.ComponentDefinition.Status.LatestRevision
+	case t.TraitDefinition != nil:
+		rev = t.TraitDefinition.Status.LatestRevision
+	}
+	if rev == nil {
+		return ""
+	}
+	return rev.RevisionHash
+}
@@ -37,6 +37,7 @@ import (
 
 	"github.com/oam-dev/kubevela/apis/core.oam.dev/v1alpha1"
 	"github.com/oam-dev/kubevela/apis/types"
+	"github.com/oam-dev/kubevela/pkg/cue/definition"
 	velaprocess "github.com/oam-dev/kubevela/pkg/cue/process"
 )
 
@@ -93,10 +94,59 @@ func (p *Parser) ValidateCUESchematicAppfile(a *Appfile) error {
 				return errors.WithMessagef(err, "cannot evaluate trait %q", tr.Name)
 			}
 		}
+		if found := definition.GetPatchConflicts(pCtx); len(found) > 0 {
+			if a.patchConflicts == nil {
+				a.patchConflicts = map[string][]definition.PatchConflict{}
+			}
+			a.patchConflicts[wl.Name] = found
+		}
 	}
 	return nil
 }
 
+// ValidatedPatchConflicts returns, by component name, the fields patched with different values by two traits of the
+// same component, found when the traits are evaluated by ValidateCUESchematicAppfile
+func (af *Appfile) ValidatedPatchConflicts() map[string][]definition.PatchConflict {
+	return af.patchConflicts
+}
+
+// PatchConflicts evaluates the traits of the CUE components and returns, by component name, the fields patched
+// with different values by two traits of the same component. A conflict failing the evaluation by the error
+// policy ends the evaluation of the component.
+func (af *Appfile) PatchConflicts() (map[string][]definition.PatchConflict, error) {
+	conflicts := map[string][]definition.PatchConflict{}
+	for _, comp := range af.ParsedComponents {
+		if comp.CapabilityCategory != types.CUECategory || comp.Type == v1alpha1.RefObjectsComponentType {
+			continue
+		}
+		pCtx, err := newValidationProcessContext(comp, GenerateContextDataFromAppFile(af, comp.Name))
+		if err != nil {
+			return nil, err
+		}
+		pCtx.PushData(velaprocess.ContextComponentType, comp.Type)
+		for _, tr := range comp.Traits {
+			if tr.CapabilityCategory != types.CUECategory ||
+				(tr.FullTemplate != nil && tr.FullTemplate.TraitDefinition != nil && tr.FullTemplate.TraitDefinition.Spec.Stage == v1beta1.PostDispatch) {
+				continue
+			}
+			err := tr.EvalContext(pCtx)
+			if err == nil {
+				continue
+			}
+			if found := definition.GetPatchConflicts(pCtx); len(found) > 0 {
+				if last := found[len(found)-1]; last.Traits[1] == tr.Name && last.Policy == v1beta1.TraitConflictError {
+					break
+				}
+			}
+			return nil, errors.WithMessagef(err, "cannot evaluate trait %q of component %q", tr.Name, comp.Name)
+		}
+		if found := definition.GetPatchConflicts(pCtx); len(found) > 0 {
+			conflicts[comp.Name] = found
+		}
+	}
+	return conflicts, nil
+}
+
 // ValidateComponentParams performs CUE‑level validation for a Component’s
 // parameters and emits helpful, context‑rich errors.
 //
@@ -17,6 +17,7 @@ limitations under the License.
 package appfile
 
 import (
+	"fmt"
 	"testing"
 
 	"cuelang.org/go/cue"
@@ -1094,3 +1095,59 @@ func TestValidateCUESchematicAppfile_WorkflowSuppliedParams(t *testing.T) {
 		assert.NoError(t, err, "Should use existing param value, not augment from workflow")
 	})
 }
+
+func TestAppfile_PatchConflicts(t *testing.T) {
+	newComponent := func(name string, policies ...v1beta1.TraitConflictPolicy) *Component {
+		comp := &Component{
+			Name:               name,
+			Type:               "worker",
+			CapabilityCategory: types.CUECategory,
+			FullTemplate: &Template{
+				TemplateStr: `
+					output: {
+						apiVersion: "apps/v1"
+						kind: "Deployment"
+						spec: replicas: *1 | int
+					}
+				`,
+			},
+			engine: definition.NewWorkloadAbstractEngine(name),
+		}
+		for i, policy := range policies {
+			traitName := fmt.Sprintf("scaler-%d", i)
+			comp.Traits = append(comp.Traits, &Trait{
+				Name:               traitName,
+				CapabilityCategory: types.CUECategory,
+				Template:           `patch: spec: replicas: parameter.replicas`,
+				Params:             map[string]any{"replicas": i + 2},
+				engine:             definition.NewTraitAbstractEngine(traitName, definition.WithConflictPolicy(policy)),
+			})
+		}
+		return comp
+	}
+	af := &Appfile{
+		Name:      "test-app",
+		Namespace: "test-ns",
+		ParsedComponents: []*Component{
+			newComponent("warned", v1beta1.TraitConflictWarn, v1beta1.TraitConflictWarn),
+			newComponent("failed", "", "", ""),
+			newComponent("single", ""),
+		},
+	}
+	conflicts, err := af.PatchConflicts()
+	assert.NoError(t, err)
+	assert.Equal(t, map[string][]definition.PatchConflict{
+		"warned": {{Path: "spec.replicas", Traits: [2]string{"scaler-0", "scaler-1"}, Policy: v1beta1.TraitConflictWarn}},
+		"failed": {{Path: "spec.replicas", Traits: [2]string{"scaler-0", "scaler-1"}, Policy: v1beta1.TraitConflictError}},
+	}, conflicts)
+
+	p := &Parser{}
+	assert.ErrorContains(t, p.ValidateCUESchematicAppfile(&Appfile{Name: "test-app", ParsedComponents: []*Component{newComponent("failed", "", "")}}),
+		"conflicting patch of trait scaler-1: traits scaler-0 and scaler-1 patch spec.replicas with different values")
+
+	validated := &Appfile{Name: "test-app", ParsedComponents: []*Component{newComponent("warned", v1beta1.TraitConflictWarn, v1beta1.TraitConflictWarn)}}
+	assert.NoError(t, p.ValidateCUESchematicAppfile(validated))
+	assert.Equal(t, map[string][]definition.PatchConflict{
+		"warned": {{Path: "spec.replicas", Traits: [2]string{"scaler-0", "scaler-1"}, Policy: v1beta1.TraitConflictWarn}},
+	}, validated.ValidatedPatchConflicts())
+}
@@ -0,0 +1,229 @@
+/*
+Copyright 2026 The KubeVela Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package auth
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	authenticationv1 "k8s.io/api/authentication/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/utils/strings/slices"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/oam-dev/kubevela/apis/core.oam.dev/v1alpha1"
+)
+
+// ControllerGranter is the granter of the privileges revoked by the controller when they expire
+const ControllerGranter = "kubevela:controller"
+
+// PrivilegeIdentity converts the identity to the one recorded in the PrivilegeAudit
+func (identity *Identity) PrivilegeIdentity() v1alpha1.PrivilegeIdentity {
+	return v1alpha1.PrivilegeIdentity{
+		User:                    identity.User,
+		Groups:                  identity.Groups,
+		ServiceAccount:          identity.ServiceAccount,
+		ServiceAccountNamespace: identity.ServiceAccountNamespace,
+	}
+}
+
+// IdentityFromPrivilegeIdentity converts the identity recorded in the PrivilegeAudit to the Identity
+func IdentityFromPrivilegeIdentity(identity v1alpha1.PrivilegeIdentity) *Identity {
+	return &Identity{
+		User:                    identity.User,
+		Groups:                  identity.Groups,
+		ServiceAccount:          identity.ServiceAccount,
+		ServiceAccountNamespace: identity.ServiceAccountNamespace,
+	}
+}
+
+// ScopedPrivileges returns the privileges of the scopes
+func ScopedPrivileges(scopes []v1alpha1.PrivilegeScope) []PrivilegeDescription {
+	var privileges []PrivilegeDescription
+	for _, scope := range scopes {
+		privileges = append(privileges, &ScopedPrivilege{Cluster: scope.Cluster, Namespace: scope.Namespace, ReadOnly: scope.ReadOnly})
+	}
+	return privileges
+}
+
+// GetGranter returns the user of the current credential, who grants or revokes the privileges
+func GetGranter(ctx context.Context, cli kubernetes.Interface) (string, error) {
+	review, err := cli.AuthenticationV1().SelfSubjectReviews().Create(ctx, &authenticationv1.SelfSubjectReview{}, metav1.CreateOptions{})
+	if err != nil {
+		return "", fmt.Errorf("failed to review the current user: %w", err)
+	}
+	return review.Status.UserInfo.Username, nil
+}
+
+// RecordPrivilegeAudit records the grant or revoke of the privileges of the scopes in the namespace. The granted
+// privileges expire after the ttl if it is positive.
+func RecordPrivilegeAudit(ctx context.Context, cli client.Client, namespace string, action v1alpha1.PrivilegeAction, identity *Identity, granter string, scopes []v1alpha1.PrivilegeScope, ttl time.Duration) (*v1alpha1.PrivilegeAudit, error) {
+	audit := &v1alpha1.PrivilegeAudit{
+		ObjectMeta: metav1.ObjectMeta{GenerateName: string(action) + "-", Namespace: namespace},
+		Spec: v1alpha1.PrivilegeAuditSpec{
+			Action:   action,
+			Identity: identity.PrivilegeIdentity(),
+			Granter:  granter,
+			Scopes:   scopes,
+		},
+	}
+	if action == v1alpha1.PrivilegeGrant && ttl > 0 {
+		audit.Spec.ExpireTime = &metav1.Time{Time: time.Now().Add(ttl)}
+	}
+	if err := cli.Create(ctx, audit); err != nil {
+		return nil, fmt.Errorf("failed to record the audit of the privileges: %w", err)
+	}
+	if action == v1alpha1.PrivilegeGrant {
+		audit.Status.Phase = v1alpha1.PrivilegeAuditActive
+		if err := cli.Status().Update(ctx, audit); err != nil {
+			return nil, fmt.Errorf("failed to update the status of the audit %s: %w", audit.Name, err)
+		}
+	}
+	return audit, nil
+}
+
+// ListPrivilegeAudits lists the audits of the privileges in the namespace, sorted by time. Only the audits with a
+// scope in the clusters are listed if clusters are set, and only those of the identity if the identity is set.
+func ListPrivilegeAudits(ctx context.Context, cli client.Client, namespace string, clusters []string, identity *Identity) ([]v1alpha1.PrivilegeAudit, error) {
+	audits := &v1alpha1.PrivilegeAuditList{}
+	if err := cli.List(ctx, audits, client.InNamespace(namespace)); err != nil {
+		return nil, fmt.Errorf("failed to list the audits of the privileges: %w", err)
+	}
+	var matched []v1alpha1.PrivilegeAudit
+	for _, audit := range audits.Items {
+		if identity != nil && !identity.MatchAny(IdentityFromPrivilegeIdentity(audit.Spec.Identity).Subjects()) {
+			continue
+		}
+		if len(clusters) > 0 && !hasScopeInClusters(audit.Spec.Scopes, clusters) {
+			continue
+		}
+		matched = append(matched, audit)
+	}
+	sort.SliceStable(matched, func(i, j int) bool {
+		return matched[i].CreationTimestamp.Before(&matched[j].CreationTimestamp)
+	})
+	return matched, nil
+}
+
+func hasScopeInClusters(scopes []v1alpha1.PrivilegeScope, clusters []string) bool {
+	for _, scope := range scopes {
+		if slices.Contains(clusters, scope.Cluster) {
+			return true
+		}
+	}
+	return false
+}
+
+// RevokeExpiredPrivileges revokes the privileges granted by the audit once they expire, and records the revoke.
+// The scopes still granted to the identity by the other audits not expiring earlier are kept.
+// It returns how long to wait for the expiration if the privileges are not expired yet.
+func RevokeExpiredPrivileges(ctx context.Context, cli client.Client, audit *v1alpha1.PrivilegeAudit, writer io.Writer) (time.Duration, error) {
+	if audit.Spec.Action != v1alpha1.PrivilegeGrant || audit.Spec.ExpireTime == nil || audit.Status.Phase == v1alpha1.PrivilegeAuditExpired {
+		return 0, nil
+	}
+	if wait := time.Until(audit.Spec.ExpireTime.Time); wait > 0 {
+		return wait, nil
+	}
+	audits := &v1alpha1.PrivilegeAuditList{}
+	if err := cli.List(ctx, audits, client.InNamespace(audit.Namespace)); err != nil {
+		return 0, fmt.Errorf("failed to list the audits of the privileges: %w", err)
+	}
+	var scopes, kept []v1alpha1.PrivilegeScope
+	for _, scope := range audit.Spec.Scopes {
+		if stillGranted(audit, scope, audits.Items) {
+			kept = append(kept, scope)
+			continue
+		}
+		scopes = append(scopes, scope)
+	}
+	identity := IdentityFromPrivilegeIdentity(audit.Spec.Identity)
+	if len(scopes) > 0 {
+		if err := RevokePrivileges(ctx, cli, ScopedPrivileges(scopes), identity, writer); err != nil {
+			return 0, err
+		}
+		if _, err := RecordPrivilegeAudit(ctx, cli, audit.Namespace, v1alpha1.PrivilegeRevoke, identity, ControllerGranter, scopes, 0); err != nil {
+			return 0, err
+		}
+	}
+	audit.Status.Phase = v1alpha1.PrivilegeAuditExpired
+	audit.Status.RevokeTime = &metav1.Time{Time: time.Now()}
+	audit.Status.Message = ""
+	if len(kept) > 0 {
+		audit.Status.Message = "kept the privileges still granted by other audits in " + FormatPrivilegeScopes(kept)
+	}
+	if err := cli.Status().Update(ctx, audit); err != nil {
+		return 0, fmt.Errorf("failed to update the status of the audit %s: %w", audit.Name, err)
+	}
+	return 0, nil
+}
+
+// stillGranted tells if the scope is granted to the identity of the audit by another active audit expiring later,
+// and not revoked since then
+func stillGranted(audit *v1alpha1.PrivilegeAudit, scope v1alpha1.PrivilegeScope, audits []v1alpha1.PrivilegeAudit) bool {
+	for _, grant := range audits {
+		if grant.Name == audit.Name || grant.Spec.Action != v1alpha1.PrivilegeGrant || grant.Status.Phase == v1alpha1.PrivilegeAuditExpired ||
+			!reflect.DeepEqual(grant.Spec.Identity, audit.Spec.Identity) || !containsScope(grant.Spec.Scopes, scope) {
+			continue
+		}
+		if grant.Spec.ExpireTime != nil && !grant.Spec.ExpireTime.After(audit.Spec.ExpireTime.Time) {
+			continue
+		}
+		revoked := false
+		for _, revoke := range audits {
+			if revoke.Spec.Action == v1alpha1.PrivilegeRevoke && reflect.DeepEqual(revoke.Spec.Identity, audit.Spec.Identity) &&
+				containsScope(revoke.Spec.Scopes, scope) && !revoke.CreationTimestamp.Before(&grant.CreationTimestamp) {
+				revoked = true
+				break
+			}
+		}
+		if !revoked {
+			return true
+		}
+	}
+	return false
+}
+
+func containsScope(scopes []v1alpha1.PrivilegeScope, scope v1alpha1.PrivilegeScope) bool {
+	for _, s := range scopes {
+		if s == scope {
+			return true
+		}
+	}
+	return false
+}
+
+// FormatPrivilegeScopes returns the description of the scopes, such as local/demo, cluster-1(readonly)
+func FormatPrivilegeScopes(scopes []v1alpha1.PrivilegeScope) string {
+	var tokens []string
+	for _, scope := range scopes {
+		token := scope.Cluster
+		if scope.Namespace != "" {
+			token += "/" + scope.Namespace
+		}
+		if scope.ReadOnly {
+			token += "(readonly)"
+		}
+		tokens = append(tokens, token)
+	}
+	return strings.Join(tokens, ", ")
+}
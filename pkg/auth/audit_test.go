@@ -0,0 +1,135 @@
+/*
+Copyright 2026 The KubeVela Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package auth
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	rbacv1 "k8s.io/api/rbac/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/oam-dev/kubevela/apis/core.oam.dev/v1alpha1"
+)
+
+func TestPrivilegeAudits(t *testing.T) {
+	r := require.New(t)
+	ctx := context.Background()
+	scheme := runtime.NewScheme()
+	r.NoError(rbacv1.AddToScheme(scheme))
+	r.NoError(v1alpha1.AddToScheme(scheme))
+	cli := fake.NewClientBuilder().WithScheme(scheme).WithStatusSubresource(&v1alpha1.PrivilegeAudit{}).Build()
+	writer := &bytes.Buffer{}
+	alice, bob := &Identity{User: "alice"}, &Identity{User: "bob", Groups: []string{"dev"}}
+	demo := v1alpha1.PrivilegeScope{Cluster: "local", Namespace: "demo"}
+	cluster := v1alpha1.PrivilegeScope{Cluster: "local"}
+	remote := v1alpha1.PrivilegeScope{Cluster: "cluster-1", ReadOnly: true}
+
+	grant := func(identity *Identity, ttl time.Duration, scopes ...v1alpha1.PrivilegeScope) *v1alpha1.PrivilegeAudit {
+		r.NoError(GrantPrivileges(ctx, cli, ScopedPrivileges(scopes), identity, writer))
+		audit, err := RecordPrivilegeAudit(ctx, cli, "vela-system", v1alpha1.PrivilegeGrant, identity, "admin", scopes, ttl)
+		r.NoError(err)
+		return audit
+	}
+	expire := func(audit *v1alpha1.PrivilegeAudit) {
+		audit.Spec.ExpireTime = &metav1.Time{Time: time.Now().Add(-time.Minute)}
+		r.NoError(cli.Update(ctx, audit))
+	}
+	bound := func(identity *Identity, scope v1alpha1.PrivilegeScope) bool {
+		binding := ScopedPrivileges([]v1alpha1.PrivilegeScope{scope})[0].GetRoleBinding(nil)
+		if err := cli.Get(ctx, client.ObjectKeyFromObject(binding), binding); err != nil {
+			r.True(kerrors.IsNotFound(err))
+			return false
+		}
+		switch b := binding.(type) {
+		case *rbacv1.RoleBinding:
+			return identity.MatchAny(b.Subjects)
+		case *rbacv1.ClusterRoleBinding:
+			return identity.MatchAny(b.Subjects)
+		}
+		return false
+	}
+
+	temporary := grant(alice, time.Hour, demo, cluster)
+	r.Equal(v1alpha1.PrivilegeAuditActive, temporary.Status.Phase)
+	r.NotNil(temporary.Spec.ExpireTime)
+	permanent := grant(alice, 0, cluster)
+	r.Nil(permanent.Spec.ExpireTime)
+	grant(bob, 0, remote)
+
+	wait, err := RevokeExpiredPrivileges(ctx, cli, temporary, writer)
+	r.NoError(err)
+	r.True(wait > 59*time.Minute)
+	wait, err = RevokeExpiredPrivileges(ctx, cli, permanent, writer)
+	r.NoError(err)
+	r.Zero(wait)
+
+	// the cluster-scoped privileges are kept as they are still granted permanently
+	expire(temporary)
+	wait, err = RevokeExpiredPrivileges(ctx, cli, temporary, writer)
+	r.NoError(err)
+	r.Zero(wait)
+	r.False(bound(alice, demo))
+	r.True(bound(alice, cluster))
+	r.True(bound(bob, remote))
+	r.NoError(cli.Get(ctx, types.NamespacedName{Namespace: "vela-system", Name: temporary.Name}, temporary))
+	r.Equal(v1alpha1.PrivilegeAuditExpired, temporary.Status.Phase)
+	r.NotNil(temporary.Status.RevokeTime)
+	r.Equal("kept the privileges still granted by other audits in local", temporary.Status.Message)
+
+	// the privileges revoked by hand are no longer granted by the permanent audit
+	r.NoError(RevokePrivileges(ctx, cli, ScopedPrivileges([]v1alpha1.PrivilegeScope{cluster}), alice, writer))
+	_, err = RecordPrivilegeAudit(ctx, cli, "vela-system", v1alpha1.PrivilegeRevoke, alice, "admin", []v1alpha1.PrivilegeScope{cluster}, 0)
+	r.NoError(err)
+	again := grant(alice, time.Hour, cluster)
+	expire(again)
+	_, err = RevokeExpiredPrivileges(ctx, cli, again, writer)
+	r.NoError(err)
+	r.False(bound(alice, cluster))
+
+	audits, err := ListPrivilegeAudits(ctx, cli, "vela-system", nil, nil)
+	r.NoError(err)
+	r.Len(audits, 7)
+	audits, err = ListPrivilegeAudits(ctx, cli, "vela-system", nil, alice)
+	r.NoError(err)
+	r.Len(audits, 6)
+	var controllerRevokes int
+	for _, audit := range audits {
+		if audit.Spec.Granter == ControllerGranter {
+			r.Equal(v1alpha1.PrivilegeRevoke, audit.Spec.Action)
+			controllerRevokes++
+		}
+	}
+	r.Equal(2, controllerRevokes)
+	audits, err = ListPrivilegeAudits(ctx, cli, "vela-system", []string{"cluster-1"}, nil)
+	r.NoError(err)
+	r.Len(audits, 1)
+	r.Equal("User=bob Groups=dev", IdentityFromPrivilegeIdentity(audits[0].Spec.Identity).String())
+	r.Equal("cluster-1(readonly)", FormatPrivilegeScopes(audits[0].Spec.Scopes))
+	audits, err = ListPrivilegeAudits(ctx, cli, "vela-system", nil, &Identity{Groups: []string{"dev"}})
+	r.NoError(err)
+	r.Len(audits, 1)
+	r.Equal("local/demo, local", FormatPrivilegeScopes([]v1alpha1.PrivilegeScope{demo, cluster}))
+}
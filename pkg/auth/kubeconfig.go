@@ -26,8 +26,10 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"strings"
 	"time"
 
+	clustergatewayapi "github.com/oam-dev/cluster-gateway/pkg/apis/cluster/v1alpha1"
 	"github.com/pkg/errors"
 	authenticationv1 "k8s.io/api/authentication/v1"
 	certificatesv1 "k8s.io/api/certificates/v1"
@@ -44,6 +46,7 @@ import (
 	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
 	"k8s.io/utils/ptr"
 
+	"github.com/oam-dev/kubevela/pkg/multicluster"
 	"github.com/oam-dev/kubevela/pkg/utils"
 )
 
@@ -54,6 +57,13 @@ const DefaultExpireTime = time.Hour * 24 * 365
 type KubeConfigGenerateOptions struct {
 	X509           *KubeConfigGenerateX509Options
 	ServiceAccount *KubeConfigGenerateServiceAccountOptions
+	OIDC           *KubeConfigGenerateOIDCOptions
+	// ExpireTime overrides the expire time of the X509 certificate or the ServiceAccount token if set
+	ExpireTime time.Duration
+	// TokenRequest requests a new ServiceAccount token even if the ServiceAccount has a secret token
+	TokenRequest bool
+	// Clusters are the managed clusters accessed through the cluster gateway in the generated KubeConfig
+	Clusters []string
 }
 
 // KubeConfigGenerateX509Options options for create X509 based KubeConfig
@@ -69,6 +79,18 @@ type KubeConfigGenerateServiceAccountOptions struct {
 	ServiceAccountName      string
 	ServiceAccountNamespace string
 	ExpireTime              time.Duration
+	TokenRequest            bool
+}
+
+// KubeConfigGenerateOIDCOptions options for create KubeConfig which gets the id token from the OIDC issuer by the
+// exec plugin
+type KubeConfigGenerateOIDCOptions struct {
+	IssuerURL    string
+	ClientID     string
+	ClientSecret string
+	Scopes       []string
+	// Command is the command of the exec plugin, which runs vela auth oidc-login
+	Command string
 }
 
 // KubeConfigWithUserGenerateOption option for setting user in KubeConfig
@@ -124,6 +146,45 @@ func (opt KubeConfigWithIdentityGenerateOption) ApplyToOptions(options *KubeConf
 	}
 }
 
+// KubeConfigWithExpireTimeGenerateOption option for setting the expire time of the credential in KubeConfig
+type KubeConfigWithExpireTimeGenerateOption time.Duration
+
+// ApplyToOptions .
+func (opt KubeConfigWithExpireTimeGenerateOption) ApplyToOptions(options *KubeConfigGenerateOptions) {
+	options.ExpireTime = time.Duration(opt)
+}
+
+// KubeConfigWithTokenRequestGenerateOption option for requesting a new ServiceAccount token in KubeConfig
+type KubeConfigWithTokenRequestGenerateOption bool
+
+// ApplyToOptions .
+func (opt KubeConfigWithTokenRequestGenerateOption) ApplyToOptions(options *KubeConfigGenerateOptions) {
+	options.TokenRequest = bool(opt)
+}
+
+// KubeConfigWithOIDCGenerateOption option for getting the id token from the OIDC issuer in KubeConfig
+type KubeConfigWithOIDCGenerateOption KubeConfigGenerateOIDCOptions
+
+// ApplyToOptions .
+func (opt KubeConfigWithOIDCGenerateOption) ApplyToOptions(options *KubeConfigGenerateOptions) {
+	options.X509 = nil
+	options.ServiceAccount = nil
+	oidc := KubeConfigGenerateOIDCOptions(opt)
+	if oidc.Command == "" {
+		oidc.Command = "vela"
+	}
+	options.OIDC = &oidc
+}
+
+// KubeConfigWithClustersGenerateOption option for accessing the managed clusters through the cluster gateway in
+// KubeConfig
+type KubeConfigWithClustersGenerateOption []string
+
+// ApplyToOptions .
+func (opt KubeConfigWithClustersGenerateOption) ApplyToOptions(options *KubeConfigGenerateOptions) {
+	options.Clusters = append(options.Clusters, opt...)
+}
+
 // KubeConfigGenerateOption option for create KubeConfig
 type KubeConfigGenerateOption interface {
 	ApplyToOptions(options *KubeConfigGenerateOptions)
@@ -142,6 +203,17 @@ func newKubeConfigGenerateOptions(options ...KubeConfigGenerateOption) *KubeConf
 	for _, op := range options {
 		op.ApplyToOptions(opts)
 	}
+	if opts.ExpireTime > 0 {
+		if opts.X509 != nil {
+			opts.X509.ExpireTime = opts.ExpireTime
+		}
+		if opts.ServiceAccount != nil {
+			opts.ServiceAccount.ExpireTime = opts.ExpireTime
+		}
+	}
+	if opts.ServiceAccount != nil {
+		opts.ServiceAccount.TokenRequest = opts.TokenRequest
+	}
 	return opts
 }
 
@@ -155,12 +227,69 @@ const (
 // GenerateKubeConfig generate KubeConfig for users with given options.
 func GenerateKubeConfig(ctx context.Context, cli kubernetes.Interface, cfg *clientcmdapi.Config, writer io.Writer, options ...KubeConfigGenerateOption) (*clientcmdapi.Config, error) {
 	opts := newKubeConfigGenerateOptions(options...)
-	if opts.X509 != nil {
-		return generateX509KubeConfig(ctx, cli, cfg, writer, opts.X509)
-	} else if opts.ServiceAccount != nil {
-		return generateServiceAccountKubeConfig(ctx, cli, cfg, writer, opts.ServiceAccount)
+	var err error
+	switch {
+	case opts.OIDC != nil:
+		cfg, err = generateOIDCKubeConfig(cfg, writer, opts.OIDC)
+	case opts.TokenRequest && opts.ServiceAccount == nil:
+		return nil, errors.New("token request is only supported for serviceaccount")
+	case opts.X509 != nil:
+		cfg, err = generateX509KubeConfig(ctx, cli, cfg, writer, opts.X509)
+	case opts.ServiceAccount != nil:
+		cfg, err = generateServiceAccountKubeConfig(ctx, cli, cfg, writer, opts.ServiceAccount)
+	default:
+		return nil, errors.New("either x509, serviceaccount or oidc must be set for creating KubeConfig")
+	}
+	if err != nil {
+		return nil, err
+	}
+	return withClusterGateway(cfg, writer, opts.Clusters), nil
+}
+
+// withClusterGateway adds the contexts of the managed clusters, which access the clusters with the same credential
+// through the cluster gateway of the control plane
+func withClusterGateway(cfg *clientcmdapi.Config, writer io.Writer, clusters []string) *clientcmdapi.Config {
+	current, ok := cfg.Contexts[cfg.CurrentContext]
+	if !ok || cfg.Clusters[current.Cluster] == nil {
+		return cfg
 	}
-	return nil, errors.New("either x509 or serviceaccount must be set for creating KubeConfig")
+	for _, cluster := range clusters {
+		if cluster == "" || cluster == multicluster.ClusterLocalName {
+			continue
+		}
+		proxy := cfg.Clusters[current.Cluster].DeepCopy()
+		proxy.LocationOfOrigin = ""
+		proxy.Server = strings.Join([]string{strings.TrimSuffix(proxy.Server, "/"), "apis",
+			clustergatewayapi.SchemeGroupVersion.Group,
+			clustergatewayapi.SchemeGroupVersion.Version,
+			"clustergateways", cluster, "proxy"}, "/")
+		cfg.Clusters[cluster] = proxy
+		cfg.Contexts[cluster] = &clientcmdapi.Context{Cluster: cluster, AuthInfo: current.AuthInfo}
+		_, _ = fmt.Fprintf(writer, "Context %s added for cluster %s through the cluster gateway.\n", cluster, cluster)
+	}
+	return cfg
+}
+
+func generateOIDCKubeConfig(cfg *clientcmdapi.Config, writer io.Writer, opts *KubeConfigGenerateOIDCOptions) (*clientcmdapi.Config, error) {
+	if opts.IssuerURL == "" || opts.ClientID == "" {
+		return nil, errors.New("both the issuer url and the client id must be set for the OIDC login")
+	}
+	args := []string{"auth", "oidc-login", "--issuer-url=" + opts.IssuerURL, "--client-id=" + opts.ClientID}
+	if opts.ClientSecret != "" {
+		args = append(args, "--client-secret="+opts.ClientSecret)
+	}
+	for _, scope := range opts.Scopes {
+		args = append(args, "--scope="+scope)
+	}
+	_, _ = fmt.Fprintf(writer, "Exec plugin for OIDC issuer %s generated.\n", opts.IssuerURL)
+	return genKubeConfig(cfg, &clientcmdapi.AuthInfo{
+		Exec: &clientcmdapi.ExecConfig{
+			APIVersion:      ExecCredentialAPIVersion,
+			Command:         opts.Command,
+			Args:            args,
+			InteractiveMode: clientcmdapi.IfAvailableExecInteractiveMode,
+		},
+	}, nil)
 }
 
 func genKubeConfig(cfg *clientcmdapi.Config, authInfo *clientcmdapi.AuthInfo, caData []byte) (*clientcmdapi.Config, error) {
@@ -344,8 +473,12 @@ func generateServiceAccountKubeConfig(ctx context.Context, cli kubernetes.Interf
 		return nil, err
 	}
 	_, _ = fmt.Fprintf(writer, "ServiceAccount %s/%s found.\n", opts.ServiceAccountNamespace, opts.ServiceAccountName)
-	if len(sa.Secrets) == 0 {
-		_, _ = fmt.Fprintf(writer, "ServiceAccount %s/%s has no secret. Requesting token", opts.ServiceAccountNamespace, opts.ServiceAccountName)
+	if len(sa.Secrets) == 0 || opts.TokenRequest {
+		if opts.TokenRequest {
+			_, _ = fmt.Fprintf(writer, "Requesting token for ServiceAccount %s/%s expiring in %s.\n", opts.ServiceAccountNamespace, opts.ServiceAccountName, opts.ExpireTime)
+		} else {
+			_, _ = fmt.Fprintf(writer, "ServiceAccount %s/%s has no secret. Requesting token", opts.ServiceAccountNamespace, opts.ServiceAccountName)
+		}
 		request := authenticationv1.TokenRequest{
 			Spec: authenticationv1.TokenRequestSpec{
 				Audiences:         []string{},
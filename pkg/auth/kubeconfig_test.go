@@ -332,3 +332,64 @@ func TestReadIdentityFromKubeConfig(t *testing.T) {
 		r.Contains(err.Error(), "cannot find client certificate or serviceaccount token in kubeconfig")
 	})
 }
+
+func TestGenerateShortLivedKubeConfig(t *testing.T) {
+	r := require.New(t)
+	ctx := context.Background()
+	minimalCfg := func() *clientcmdapi.Config {
+		return &clientcmdapi.Config{
+			Clusters:       map[string]*clientcmdapi.Cluster{"c": {Server: "https://example", CertificateAuthorityData: []byte("CA")}},
+			Contexts:       map[string]*clientcmdapi.Context{"ctx": {Cluster: "c", AuthInfo: "ai"}},
+			AuthInfos:      map[string]*clientcmdapi.AuthInfo{"ai": {Token: "admin"}},
+			CurrentContext: "ctx",
+		}
+	}
+
+	t.Run("token request with expiry through cluster gateway", func(t *testing.T) {
+		sa := &corev1.ServiceAccount{ObjectMeta: metav1.ObjectMeta{Name: "sa", Namespace: "ns"}, Secrets: []corev1.ObjectReference{{Name: "s"}}}
+		cm := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "kube-root-ca.crt", Namespace: "ns"}, Data: map[string]string{"ca.crt": "CA"}}
+		cli := fake.NewSimpleClientset(sa, cm)
+		var expiration int64
+		cli.Fake.PrependReactor("create", "serviceaccounts/token", func(action ktesting.Action) (bool, runtime.Object, error) {
+			expiration = *action.(ktesting.CreateAction).GetObject().(*authenticationv1.TokenRequest).Spec.ExpirationSeconds
+			return true, &authenticationv1.TokenRequest{Status: authenticationv1.TokenRequestStatus{Token: "short"}}, nil
+		})
+
+		got, err := GenerateKubeConfig(ctx, cli, minimalCfg(), &bytes.Buffer{},
+			KubeConfigWithIdentityGenerateOption(Identity{ServiceAccount: "sa", ServiceAccountNamespace: "ns"}),
+			KubeConfigWithExpireTimeGenerateOption(30*time.Minute),
+			KubeConfigWithTokenRequestGenerateOption(true),
+			KubeConfigWithClustersGenerateOption{"local", "cluster-1"})
+		r.NoError(err)
+		r.Equal(int64(1800), expiration)
+		r.Equal("short", got.AuthInfos["ai"].Token)
+		r.Len(got.Contexts, 2)
+		r.Equal(&clientcmdapi.Context{Cluster: "cluster-1", AuthInfo: "ai"}, got.Contexts["cluster-1"])
+		r.Equal("https://example/apis/cluster.core.oam.dev/v1alpha1/clustergateways/cluster-1/proxy", got.Clusters["cluster-1"].Server)
+		r.Equal("CA", string(got.Clusters["cluster-1"].CertificateAuthorityData))
+		r.Equal("https://example", got.Clusters["c"].Server)
+	})
+
+	t.Run("token request for user", func(t *testing.T) {
+		_, err := GenerateKubeConfig(ctx, fake.NewSimpleClientset(), minimalCfg(), &bytes.Buffer{},
+			KubeConfigWithUserGenerateOption("alice"), KubeConfigWithTokenRequestGenerateOption(true))
+		r.EqualError(err, "token request is only supported for serviceaccount")
+	})
+
+	t.Run("oidc exec plugin", func(t *testing.T) {
+		got, err := GenerateKubeConfig(ctx, fake.NewSimpleClientset(), minimalCfg(), &bytes.Buffer{},
+			KubeConfigWithOIDCGenerateOption{IssuerURL: "https://issuer.example", ClientID: "vela", Scopes: []string{"openid", "groups"}})
+		r.NoError(err)
+		ai := got.AuthInfos["ai"]
+		r.Empty(ai.Token)
+		r.Equal(&clientcmdapi.ExecConfig{
+			APIVersion:      ExecCredentialAPIVersion,
+			Command:         "vela",
+			Args:            []string{"auth", "oidc-login", "--issuer-url=https://issuer.example", "--client-id=vela", "--scope=openid", "--scope=groups"},
+			InteractiveMode: clientcmdapi.IfAvailableExecInteractiveMode,
+		}, ai.Exec)
+
+		_, err = GenerateKubeConfig(ctx, fake.NewSimpleClientset(), minimalCfg(), &bytes.Buffer{}, KubeConfigWithOIDCGenerateOption{ClientID: "vela"})
+		r.Error(err)
+	})
+}
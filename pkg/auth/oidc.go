@@ -0,0 +1,220 @@
+/*
+Copyright 2026 The KubeVela Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package auth
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"golang.org/x/oauth2"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	clientauthv1 "k8s.io/client-go/pkg/apis/clientauthentication/v1"
+)
+
+const (
+	// ExecCredentialAPIVersion the api version of the ExecCredential returned by the exec plugin
+	ExecCredentialAPIVersion = "client.authentication.k8s.io/v1"
+	// DefaultOIDCScope the scope requested from the OIDC issuer by default
+	DefaultOIDCScope = "openid"
+	// tokenExpiryDelta the token expiring within the delta is refreshed in advance
+	tokenExpiryDelta = 30 * time.Second
+)
+
+// OIDCLoginOptions options for logging in the OIDC issuer by the exec plugin
+type OIDCLoginOptions struct {
+	IssuerURL    string
+	ClientID     string
+	ClientSecret string
+	Scopes       []string
+	// CacheDir is where the tokens are cached, the tokens are not cached if empty
+	CacheDir string
+	// HTTPClient is the client to access the issuer, http.DefaultClient is used if nil
+	HTTPClient *http.Client
+}
+
+// oidcDiscovery the endpoints in the OpenID provider configuration
+type oidcDiscovery struct {
+	TokenEndpoint               string `json:"token_endpoint"`
+	DeviceAuthorizationEndpoint string `json:"device_authorization_endpoint"`
+}
+
+// oidcTokenCache the tokens cached for the issuer and client
+type oidcTokenCache struct {
+	IDToken      string    `json:"id_token"`
+	RefreshToken string    `json:"refresh_token,omitempty"`
+	Expiry       time.Time `json:"expiry"`
+}
+
+// OIDCLogin returns the ExecCredential with the id token issued by the OIDC issuer. The cached id token is reused
+// until it expires, then it is refreshed by the refresh token. If there is no valid token, the device authorization
+// flow is used to log in, and the instructions are printed to the writer.
+func OIDCLogin(ctx context.Context, opts OIDCLoginOptions, writer io.Writer) (*clientauthv1.ExecCredential, error) {
+	if opts.HTTPClient != nil {
+		ctx = context.WithValue(ctx, oauth2.HTTPClient, opts.HTTPClient)
+	}
+	cache := opts.loadCache()
+	if cache != nil && time.Until(cache.Expiry) > tokenExpiryDelta {
+		return newExecCredential(cache), nil
+	}
+	discovery, err := discoverOIDC(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	cfg := &oauth2.Config{
+		ClientID:     opts.ClientID,
+		ClientSecret: opts.ClientSecret,
+		Scopes:       opts.scopes(),
+		Endpoint: oauth2.Endpoint{
+			TokenURL:      discovery.TokenEndpoint,
+			DeviceAuthURL: discovery.DeviceAuthorizationEndpoint,
+		},
+	}
+	var token *oauth2.Token
+	if cache != nil && cache.RefreshToken != "" {
+		// the refresh token may be expired or revoked, log in again if so
+		token, _ = cfg.TokenSource(ctx, &oauth2.Token{RefreshToken: cache.RefreshToken}).Token()
+	}
+	if token == nil {
+		if discovery.DeviceAuthorizationEndpoint == "" {
+			return nil, errors.Errorf("the OIDC issuer %s does not support the device authorization", opts.IssuerURL)
+		}
+		auth, err := cfg.DeviceAuth(ctx)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to request the device authorization")
+		}
+		uri := auth.VerificationURIComplete
+		if uri == "" {
+			uri = auth.VerificationURI
+		}
+		_, _ = fmt.Fprintf(writer, "Open %s and enter the code %s to log in.\n", uri, auth.UserCode)
+		if token, err = cfg.DeviceAccessToken(ctx, auth); err != nil {
+			return nil, errors.Wrap(err, "failed to log in")
+		}
+	}
+	if cache, err = newOIDCTokenCache(token); err != nil {
+		return nil, err
+	}
+	if err = opts.saveCache(cache); err != nil {
+		_, _ = fmt.Fprintf(writer, "Warning: failed to cache the token: %s\n", err.Error())
+	}
+	return newExecCredential(cache), nil
+}
+
+func discoverOIDC(ctx context.Context, opts OIDCLoginOptions) (*oidcDiscovery, error) {
+	cli := opts.HTTPClient
+	if cli == nil {
+		cli = http.DefaultClient
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, strings.TrimSuffix(opts.IssuerURL, "/")+"/.well-known/openid-configuration", nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := cli.Do(req)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to discover the OIDC issuer %s", opts.IssuerURL)
+	}
+	defer func() { _ = resp.Body.Close() }()
+	if resp.StatusCode != http.StatusOK {
+		return nil, errors.Errorf("failed to discover the OIDC issuer %s: %s", opts.IssuerURL, resp.Status)
+	}
+	discovery := &oidcDiscovery{}
+	if err = json.NewDecoder(resp.Body).Decode(discovery); err != nil {
+		return nil, errors.Wrapf(err, "invalid OpenID provider configuration of %s", opts.IssuerURL)
+	}
+	return discovery, nil
+}
+
+// newOIDCTokenCache reads the id token from the token, which expires as the exp claim of the id token
+func newOIDCTokenCache(token *oauth2.Token) (*oidcTokenCache, error) {
+	idToken, _ := token.Extra("id_token").(string)
+	if idToken == "" {
+		return nil, errors.New("no id_token found in the token response of the OIDC issuer")
+	}
+	cache := &oidcTokenCache{IDToken: idToken, RefreshToken: token.RefreshToken, Expiry: token.Expiry}
+	if parts := strings.Split(idToken, "."); len(parts) == 3 {
+		claims := struct {
+			Exp int64 `json:"exp"`
+		}{}
+		if payload, err := base64.RawURLEncoding.DecodeString(parts[1]); err == nil && json.Unmarshal(payload, &claims) == nil && claims.Exp > 0 {
+			cache.Expiry = time.Unix(claims.Exp, 0)
+		}
+	}
+	return cache, nil
+}
+
+func newExecCredential(cache *oidcTokenCache) *clientauthv1.ExecCredential {
+	cred := &clientauthv1.ExecCredential{
+		TypeMeta: metav1.TypeMeta{APIVersion: ExecCredentialAPIVersion, Kind: "ExecCredential"},
+		Status:   &clientauthv1.ExecCredentialStatus{Token: cache.IDToken},
+	}
+	if !cache.Expiry.IsZero() {
+		cred.Status.ExpirationTimestamp = &metav1.Time{Time: cache.Expiry}
+	}
+	return cred
+}
+
+func (opts OIDCLoginOptions) scopes() []string {
+	if len(opts.Scopes) == 0 {
+		return []string{DefaultOIDCScope}
+	}
+	return opts.Scopes
+}
+
+func (opts OIDCLoginOptions) cachePath() string {
+	key := sha256.Sum256([]byte(strings.Join([]string{opts.IssuerURL, opts.ClientID, strings.Join(opts.scopes(), " ")}, "\n")))
+	return filepath.Join(opts.CacheDir, hex.EncodeToString(key[:])+".json")
+}
+
+func (opts OIDCLoginOptions) loadCache() *oidcTokenCache {
+	if opts.CacheDir == "" {
+		return nil
+	}
+	bs, err := os.ReadFile(opts.cachePath())
+	if err != nil {
+		return nil
+	}
+	cache := &oidcTokenCache{}
+	if err = json.Unmarshal(bs, cache); err != nil {
+		return nil
+	}
+	return cache
+}
+
+func (opts OIDCLoginOptions) saveCache(cache *oidcTokenCache) error {
+	if opts.CacheDir == "" {
+		return nil
+	}
+	if err := os.MkdirAll(opts.CacheDir, 0700); err != nil {
+		return err
+	}
+	bs, err := json.Marshal(cache)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(opts.cachePath(), bs, 0600)
+}
@@ -0,0 +1,109 @@
+/*
+Copyright 2026 The KubeVela Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package auth
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// newMockIssuer starts an OIDC issuer supporting the device authorization and the refresh token
+func newMockIssuer(t *testing.T, logins, refreshes *int32) *httptest.Server {
+	idToken := func(n int32) string {
+		claims, _ := json.Marshal(map[string]interface{}{"sub": "alice", "exp": time.Now().Add(time.Hour).Unix()})
+		return "header." + base64.RawURLEncoding.EncodeToString(claims) + fmt.Sprintf(".sig%d", n)
+	}
+	mux := http.NewServeMux()
+	var server *httptest.Server
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, _ *http.Request) {
+		_ = json.NewEncoder(w).Encode(map[string]string{
+			"issuer":                        server.URL,
+			"token_endpoint":                server.URL + "/token",
+			"device_authorization_endpoint": server.URL + "/device",
+		})
+	})
+	mux.HandleFunc("/device", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "vela", r.FormValue("client_id"))
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"device_code": "device", "user_code": "ABCD-EFGH", "verification_uri": server.URL + "/verify", "interval": 1, "expires_in": 60,
+		})
+	})
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		var n int32
+		switch r.FormValue("grant_type") {
+		case "urn:ietf:params:oauth:grant-type:device_code":
+			n = atomic.AddInt32(logins, 1)
+		case "refresh_token":
+			n = atomic.AddInt32(refreshes, 1) + 100
+		}
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"access_token": "access", "token_type": "Bearer", "expires_in": 3600, "refresh_token": "refresh", "id_token": idToken(n),
+		})
+	})
+	server = httptest.NewServer(mux)
+	return server
+}
+
+func TestOIDCLogin(t *testing.T) {
+	r := require.New(t)
+	ctx := context.Background()
+	var logins, refreshes int32
+	server := newMockIssuer(t, &logins, &refreshes)
+	defer server.Close()
+	opts := OIDCLoginOptions{IssuerURL: server.URL, ClientID: "vela", CacheDir: t.TempDir()}
+
+	writer := &bytes.Buffer{}
+	cred, err := OIDCLogin(ctx, opts, writer)
+	r.NoError(err)
+	r.Equal(ExecCredentialAPIVersion, cred.APIVersion)
+	r.Equal("ExecCredential", cred.Kind)
+	r.Contains(cred.Status.Token, ".sig1")
+	r.True(cred.Status.ExpirationTimestamp.After(time.Now().Add(59 * time.Minute)))
+	r.Contains(writer.String(), "enter the code ABCD-EFGH")
+
+	// the cached token is reused until it expires
+	cred, err = OIDCLogin(ctx, opts, writer)
+	r.NoError(err)
+	r.Contains(cred.Status.Token, ".sig1")
+	r.Equal(int32(1), atomic.LoadInt32(&logins))
+
+	// the expired token is refreshed by the refresh token
+	cache := opts.loadCache()
+	r.NotNil(cache)
+	cache.Expiry = time.Now().Add(-time.Minute)
+	r.NoError(opts.saveCache(cache))
+	cred, err = OIDCLogin(ctx, opts, writer)
+	r.NoError(err)
+	r.Contains(cred.Status.Token, ".sig101")
+	r.Equal(int32(1), atomic.LoadInt32(&logins))
+	r.Equal(int32(1), atomic.LoadInt32(&refreshes))
+
+	_, err = OIDCLogin(ctx, OIDCLoginOptions{IssuerURL: server.URL + "/missing", ClientID: "vela"}, writer)
+	r.Error(err)
+}
@@ -520,12 +520,13 @@ func RevokePrivileges(ctx context.Context, cli client.Client, privileges []Privi
 			if !kerrors.IsNotFound(err) {
 				return fmt.Errorf("failed to fetch %s %s in cluster %s: %w", kind, key, cluster, err)
 			}
-			return nil
+			continue
 		}
 		if remove {
 			if err = cli.Delete(_ctx, toDel); err != nil {
 				return fmt.Errorf("failed to delete %s %s in cluster %s: %w", kind, key, cluster, err)
 			}
+			_, _ = fmt.Fprintf(writer, "%s %s deleted in cluster %s.\n", kind, key, cluster)
 		} else {
 			res, err := utils.CreateOrUpdate(_ctx, cli, binding)
 			if err != nil {
@@ -16,6 +16,8 @@ limitations under the License.
 
 package core_oam_dev
 
+import "time"
+
 // Args args used by controller
 type Args struct {
 
@@ -42,4 +44,10 @@ type Args struct {
 
 	// IgnoreDefinitionWithoutControllerRequirement indicates that trait/component/workflowstep definition controller will not process the definition without 'definition.oam.dev/controller-version-require' annotation.
 	IgnoreDefinitionWithoutControllerRequirement bool
+
+	// EventWebhookURL is the webhook that the event timelines of the applications are forwarded to, disabled if empty
+	EventWebhookURL string
+
+	// EventExportInterval is the interval to export the new events of an application to the webhook
+	EventExportInterval time.Duration
 }
@@ -195,6 +195,11 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 	app.Status.SetConditions(condition.ReadyCondition(common.PolicyCondition.String()))
 	r.Recorder.Event(app, event.Normal(velatypes.ReasonPolicyGenerated, velatypes.MessagePolicyGenerated))
 
+	if held, requeue := r.checkDeployWindow(logCtx, app, handler); held {
+		logCtx.Info("Rollout held by deploy window", "revision", app.Status.DeployWindow.PendingRevision)
+		return r.result(r.patchStatus(logCtx, app, common.ApplicationPendingDeployWindow)).requeue(requeue).ret()
+	}
+
 	// Check if workflow needs restart (combines scheduled restart + revision-based restart)
 	r.checkWorkflowRestart(logCtx, app, handler)
 
@@ -258,6 +263,14 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 		postDispatchApplied = true
 		return nil
 	}
+	// the failover policy is evaluated in every workflow state, as the clusters may fail during the rollout
+	failoverRequeue, failoverChanged := r.evalFailover(logCtx, app, appFile)
+	withFailoverRequeue := func(result ctrl.Result, err error) (ctrl.Result, error) {
+		if err == nil && failoverRequeue > 0 && (result.RequeueAfter == 0 || failoverRequeue < result.RequeueAfter) {
+			result.RequeueAfter = failoverRequeue
+		}
+		return result, err
+	}
 	switch workflowState {
 	case workflowv1alpha1.WorkflowStateSuspending:
 		if err := applyPostDispatchTraits(); err != nil {
@@ -265,28 +278,28 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 		}
 		if duration := workflowExecutor.GetSuspendBackoffWaitTime(); duration > 0 {
 			_, err = r.gcResourceTrackers(logCtx, handler, common.ApplicationWorkflowSuspending, false, workflowUpdated)
-			return r.result(err).requeue(duration).ret()
+			return withFailoverRequeue(r.result(err).requeue(duration).ret())
 		}
 		if !workflow.IsFailedAfterRetry(app) || !feature.DefaultMutableFeatureGate.Enabled(wffeatures.EnableSuspendOnFailure) {
 			r.stateKeep(logCtx, handler, app)
 		}
-		return r.gcResourceTrackers(logCtx, handler, common.ApplicationWorkflowSuspending, false, workflowUpdated)
+		return withFailoverRequeue(r.gcResourceTrackers(logCtx, handler, common.ApplicationWorkflowSuspending, false, workflowUpdated))
 	case workflowv1alpha1.WorkflowStateTerminated:
 		if workflowInstance.Status.EndTime.IsZero() {
 			r.doWorkflowFinish(logCtx, app, handler, workflowState)
 		}
-		return r.gcResourceTrackers(logCtx, handler, common.ApplicationWorkflowTerminated, false, workflowUpdated)
+		return withFailoverRequeue(r.gcResourceTrackers(logCtx, handler, common.ApplicationWorkflowTerminated, false, workflowUpdated))
 	case workflowv1alpha1.WorkflowStateFailed:
 		if workflowInstance.Status.EndTime.IsZero() {
 			r.doWorkflowFinish(logCtx, app, handler, workflowState)
 		}
-		return r.gcResourceTrackers(logCtx, handler, common.ApplicationWorkflowFailed, false, workflowUpdated)
+		return withFailoverRequeue(r.gcResourceTrackers(logCtx, handler, common.ApplicationWorkflowFailed, false, workflowUpdated))
 	case workflowv1alpha1.WorkflowStateExecuting:
 		if err := applyPostDispatchTraits(); err != nil {
 			return r.endWithNegativeCondition(logCtx, app, condition.ReconcileError(err), common.ApplicationRunningWorkflow)
 		}
 		_, err = r.gcResourceTrackers(logCtx, handler, common.ApplicationRunningWorkflow, false, workflowUpdated)
-		return r.result(err).requeue(workflowExecutor.GetBackoffWaitTime()).ret()
+		return withFailoverRequeue(r.result(err).requeue(workflowExecutor.GetBackoffWaitTime()).ret())
 	case workflowv1alpha1.WorkflowStateSucceeded:
 		if workflowInstance.Status.EndTime.IsZero() {
 			r.doWorkflowFinish(logCtx, app, handler, workflowState)
@@ -295,7 +308,13 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 		if err := applyPostDispatchTraits(); err != nil {
 			return r.endWithNegativeCondition(logCtx, app, condition.ReconcileError(err), common.ApplicationRunningWorkflow)
 		}
-		return r.result(nil).requeue(workflowExecutor.GetBackoffWaitTime()).ret()
+		// the status is not written for the skipped workflow, except for the changed failover decisions
+		if failoverChanged {
+			if err := r.patchStatus(logCtx, app, app.Status.Phase); err != nil {
+				return r.result(err).ret()
+			}
+		}
+		return withFailoverRequeue(r.result(nil).requeue(workflowExecutor.GetBackoffWaitTime()).ret())
 	default:
 	}
 
@@ -336,7 +355,7 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 	})
 	r.Recorder.Event(app, event.Normal(velatypes.ReasonDeployed, velatypes.MessageDeployed))
 	// Use Update instead of Patch when components were removed to properly clear status arrays
-	return r.gcResourceTrackers(logCtx, handler, phase, true, componentsRemoved)
+	return withFailoverRequeue(r.gcResourceTrackers(logCtx, handler, phase, true, componentsRemoved))
 }
 
 func (r *Reconciler) stateKeep(logCtx monitorContext.Context, handler *AppHandler, app *v1beta1.Application) {
@@ -465,6 +484,7 @@ func (r *Reconciler) handleFinalizers(ctx monitorContext.Context, app *v1beta1.A
 					klog.Infof("garbage collecting application revisions for application %s/%s, rest: %d, err: %s", app.Namespace, app.Name, len(revs), err)
 					return r.result(err).requeue(baseGCBackoffWaitTime).end(true)
 				}
+				guardrailHistory.Forget(client.ObjectKeyFromObject(app).String())
 				meta.RemoveFinalizer(app, oam.FinalizerResourceTracker)
 				meta.RemoveFinalizer(app, oam.FinalizerOrphanResource)
 				return r.result(errors.Wrap(r.Client.Update(ctx, app), errUpdateApplicationFinalizer)).end(true)
@@ -549,6 +569,7 @@ func (r *Reconciler) doWorkflowFinish(logCtx monitorContext.Context, app *v1beta
 		r.Recorder.Event(app, event.Normal(velatypes.ReasonApplied, velatypes.MessageWorkflowFinished))
 	}
 	handler.UpdateApplicationRevisionStatus(logCtx, handler.currentAppRev, app.Status.Workflow)
+	r.recordQuotaMetrics(logCtx, app)
 	logCtx.Info("Application manifests has applied by workflow successfully")
 }
 
@@ -20,11 +20,13 @@ import (
 	"context"
 
 	workflowv1alpha1 "github.com/kubevela/workflow/api/v1alpha1"
+	"github.com/prometheus/client_golang/prometheus"
 	"k8s.io/klog/v2"
 
 	"github.com/oam-dev/kubevela/apis/core.oam.dev/common"
 	"github.com/oam-dev/kubevela/apis/core.oam.dev/v1beta1"
 	"github.com/oam-dev/kubevela/pkg/monitor/metrics"
+	"github.com/oam-dev/kubevela/pkg/multicluster"
 )
 
 // HealthStatus represents the health status of an application
@@ -40,6 +42,8 @@ func (r *Reconciler) updateMetricsAndLog(_ context.Context, app *v1beta1.Applica
 
 	updateHealthMetric(app, healthStatus.Healthy)
 	updatePhaseMetrics(app)
+	updateComponentHealthMetrics(app)
+	updateManagedResourcesMetric(app)
 
 	workflowStatus := buildWorkflowStatus(app.Status.Workflow)
 	serviceDetails := buildServiceDetails(app, app.Status.Services)
@@ -92,6 +96,39 @@ func updatePhaseMetrics(app *v1beta1.Application) {
 	}
 }
 
+// updateComponentHealthMetrics updates the health status metrics of the components, the components no longer
+// in the application status are removed
+func updateComponentHealthMetrics(app *v1beta1.Application) {
+	metrics.ComponentHealthStatus.DeletePartialMatch(prometheus.Labels{
+		"app_name":  app.Name,
+		"namespace": app.Namespace,
+	})
+	for _, svc := range app.Status.Services {
+		cluster := svc.Cluster
+		if cluster == "" {
+			cluster = multicluster.ClusterLocalName
+		}
+		healthValue := float64(1)
+		if !svc.Healthy {
+			healthValue = float64(0)
+		}
+		metrics.ComponentHealthStatus.WithLabelValues(
+			app.Name,
+			app.Namespace,
+			svc.Name,
+			cluster,
+		).Set(healthValue)
+	}
+}
+
+// updateManagedResourcesMetric updates the number of the resources managed by the application
+func updateManagedResourcesMetric(app *v1beta1.Application) {
+	metrics.ApplicationManagedResources.WithLabelValues(
+		app.Name,
+		app.Namespace,
+	).Set(float64(len(app.Status.AppliedResources)))
+}
+
 // buildWorkflowStatus builds workflow status information for logging
 func buildWorkflowStatus(workflow *common.WorkflowStatus) map[string]interface{} {
 	if workflow == nil {
@@ -206,6 +243,8 @@ func appPhaseToNumeric(phase common.ApplicationPhase) float64 {
 		return 8
 	case common.ApplicationDeleting:
 		return 9
+	case common.ApplicationPendingDeployWindow:
+		return 10
 	default:
 		return -1
 	}
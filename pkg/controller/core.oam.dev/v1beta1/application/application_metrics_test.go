@@ -110,6 +110,7 @@ func TestAppPhaseToNumeric(t *testing.T) {
 		{"workflow failed", common.ApplicationWorkflowFailed, 7},
 		{"unhealthy", common.ApplicationUnhealthy, 8},
 		{"deleting", common.ApplicationDeleting, 9},
+		{"pending deploy window", common.ApplicationPendingDeployWindow, 10},
 		{"unknown", common.ApplicationPhase("unknown"), -1},
 	}
 
@@ -439,6 +440,36 @@ func TestUpdatePhaseMetrics(t *testing.T) {
 	}
 }
 
+func TestUpdateComponentHealthMetrics(t *testing.T) {
+	metrics.ComponentHealthStatus.Reset()
+	metrics.ApplicationManagedResources.Reset()
+
+	app := &v1beta1.Application{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-app",
+			Namespace: "default",
+		},
+		Status: common.AppStatus{
+			Services: []common.ApplicationComponentStatus{
+				{Name: "web", Healthy: true},
+				{Name: "db", Cluster: "cluster-a", Healthy: false},
+			},
+			AppliedResources: []common.ClusterObjectReference{{}, {}, {}},
+		},
+	}
+	updateComponentHealthMetrics(app)
+	updateManagedResourcesMetric(app)
+
+	assert.Equal(t, float64(1), testutil.ToFloat64(metrics.ComponentHealthStatus.WithLabelValues("test-app", "default", "web", "local")))
+	assert.Equal(t, float64(0), testutil.ToFloat64(metrics.ComponentHealthStatus.WithLabelValues("test-app", "default", "db", "cluster-a")))
+	assert.Equal(t, float64(3), testutil.ToFloat64(metrics.ApplicationManagedResources.WithLabelValues("test-app", "default")))
+
+	// the components removed from the application are no longer reported
+	app.Status.Services = app.Status.Services[:1]
+	updateComponentHealthMetrics(app)
+	assert.Equal(t, 1, testutil.CollectAndCount(metrics.ComponentHealthStatus))
+}
+
 func TestLogApplicationStatus(t *testing.T) {
 	tests := []struct {
 		name           string
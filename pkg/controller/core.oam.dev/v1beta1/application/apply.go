@@ -22,6 +22,7 @@ import (
 	"slices"
 	"sync"
 
+	"github.com/crossplane/crossplane-runtime/pkg/event"
 	"github.com/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
 	kerrors "k8s.io/apimachinery/pkg/api/errors"
@@ -41,6 +42,7 @@ import (
 	"github.com/oam-dev/kubevela/pkg/appfile"
 	velaprocess "github.com/oam-dev/kubevela/pkg/cue/process"
 	"github.com/oam-dev/kubevela/pkg/features"
+	"github.com/oam-dev/kubevela/pkg/guardrail"
 	"github.com/oam-dev/kubevela/pkg/monitor/metrics"
 	"github.com/oam-dev/kubevela/pkg/multicluster"
 	"github.com/oam-dev/kubevela/pkg/oam"
@@ -64,6 +66,9 @@ type AppHandler struct {
 	appliedResources []common.ClusterObjectReference
 	deletedResources []common.ClusterObjectReference
 
+	recorder   event.Recorder
+	guardrails *guardrail.Checker
+
 	mu sync.Mutex
 }
 
@@ -83,6 +88,7 @@ func NewAppHandler(ctx context.Context, r *Reconciler, app *v1beta1.Application)
 		Client:         r.Client,
 		app:            app,
 		resourceKeeper: resourceHandler,
+		recorder:       r.Recorder,
 	}, nil
 }
 
@@ -465,9 +471,16 @@ func (h *AppHandler) ApplyPolicies(ctx context.Context, af *appfile.Appfile) err
 		}))
 		defer subCtx.Commit("finish apply policies")
 	}
-	policyManifests, err := af.GeneratePolicyManifests(ctx)
-	if err != nil {
-		return errors.Wrapf(err, "failed to render policy manifests")
+	var policyManifests []*unstructured.Unstructured
+	for _, policy := range af.ParsedPolicies {
+		manifests, err := af.GeneratePolicyManifest(policy)
+		if err != nil {
+			return errors.Wrapf(err, "failed to render policy manifests")
+		}
+		if err = h.checkPolicyGuardrails(ctx, af, policy, manifests); err != nil {
+			return err
+		}
+		policyManifests = append(policyManifests, manifests...)
 	}
 	if len(policyManifests) > 0 {
 		for _, policyManifest := range policyManifests {
@@ -476,7 +489,7 @@ func (h *AppHandler) ApplyPolicies(ctx context.Context, af *appfile.Appfile) err
 				oam.LabelAppNamespace: h.app.GetNamespace(),
 			})
 		}
-		if err = h.Dispatch(ctx, h.Client, "", common.PolicyResourceCreator, policyManifests...); err != nil {
+		if err := h.Dispatch(ctx, h.Client, "", common.PolicyResourceCreator, policyManifests...); err != nil {
 			return errors.Wrapf(err, "failed to dispatch policy manifests")
 		}
 	}
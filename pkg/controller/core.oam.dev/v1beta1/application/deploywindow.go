@@ -0,0 +1,96 @@
+/*
+Copyright 2026 The KubeVela Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package application
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	monitorContext "github.com/kubevela/pkg/monitor/context"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/oam-dev/kubevela/apis/core.oam.dev/common"
+	"github.com/oam-dev/kubevela/apis/core.oam.dev/v1beta1"
+	velatypes "github.com/oam-dev/kubevela/apis/types"
+	"github.com/oam-dev/kubevela/pkg/oam"
+	"github.com/oam-dev/kubevela/pkg/policy"
+)
+
+// deployWindowRecheckInterval is the interval to recheck the deploy windows if no window opens in the foreseeable
+// future, or the policies cannot be evaluated
+const deployWindowRecheckInterval = time.Hour
+
+// checkDeployWindow checks whether the new revision of the application can be rolled out now. The rollout of a new
+// revision is held if any of the deploy-window policies of the application or the cluster-wide defaults is closed,
+// unless the override annotation is set. The first rollout of the application and the restarts of the current
+// revision, such as the ones scheduled by the failover policy, are never held. A restart requested together with a
+// new revision is held as the new revision. It returns whether the rollout is held and when to check again.
+func (r *Reconciler) checkDeployWindow(ctx monitorContext.Context, app *v1beta1.Application, handler *AppHandler) (bool, time.Duration) {
+	desiredRev, currentRev := workflowRevisions(app, handler)
+	if currentRev == "" || desiredRev == currentRev {
+		app.Status.DeployWindow = nil
+		return false, 0
+	}
+	if app.GetAnnotations()[oam.AnnotationDeployWindowOverride] == "true" {
+		if app.Status.DeployWindow != nil {
+			r.Recorder.Event(app, event.Normal(velatypes.ReasonDeployWindow, fmt.Sprintf("Deploy window overridden for revision %s", desiredRev)))
+		}
+		app.Status.DeployWindow = nil
+		return false, 0
+	}
+	specs, err := policy.GetDeployWindowPolicies(ctx, r.Client, app)
+	if err == nil && len(specs) == 0 {
+		app.Status.DeployWindow = nil
+		return false, 0
+	}
+	var result *policy.DeployWindowResult
+	if err == nil {
+		result, err = policy.EvaluateDeployWindows(specs, time.Now())
+	}
+	if err != nil {
+		// hold the rollout as the deploy windows are unknown
+		ctx.Error(err, "failed to evaluate deploy windows")
+		r.Recorder.Event(app, event.Warning(velatypes.ReasonFailedDeployWindow, err))
+		app.Status.DeployWindow = &common.DeployWindowStatus{PendingRevision: desiredRev, Message: err.Error()}
+		return true, deployWindowRecheckInterval
+	}
+	if result.Open {
+		if app.Status.DeployWindow != nil {
+			r.Recorder.Event(app, event.Normal(velatypes.ReasonDeployWindow, fmt.Sprintf("Deploy window opened for revision %s", desiredRev)))
+		}
+		app.Status.DeployWindow = nil
+		return false, 0
+	}
+	status := &common.DeployWindowStatus{PendingRevision: desiredRev, Message: result.Message}
+	requeue := deployWindowRecheckInterval
+	if !result.NextOpen.IsZero() {
+		status.NextWindowTime = metav1.NewTime(result.NextOpen)
+		if d := time.Until(result.NextOpen); d < requeue {
+			requeue = max(d, time.Second)
+		}
+	}
+	if app.Status.DeployWindow == nil || app.Status.DeployWindow.PendingRevision != desiredRev {
+		msg := fmt.Sprintf("Revision %s held: %s", desiredRev, result.Message)
+		if !result.NextOpen.IsZero() {
+			msg = fmt.Sprintf("%s, next window opens at %s", msg, result.NextOpen.UTC().Format(time.RFC3339))
+		}
+		r.Recorder.Event(app, event.Normal(velatypes.ReasonDeployWindow, msg))
+	}
+	app.Status.DeployWindow = status
+	return true, requeue
+}
@@ -0,0 +1,69 @@
+/*
+Copyright 2026 The KubeVela Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package application
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	monitorContext "github.com/kubevela/pkg/monitor/context"
+
+	"github.com/oam-dev/kubevela/apis/core.oam.dev/common"
+	"github.com/oam-dev/kubevela/apis/core.oam.dev/v1alpha1"
+	"github.com/oam-dev/kubevela/apis/core.oam.dev/v1beta1"
+	velacommon "github.com/oam-dev/kubevela/pkg/utils/common"
+)
+
+func TestCheckDeployWindowWithRestart(t *testing.T) {
+	freeze, err := json.Marshal(v1alpha1.DeployWindowPolicySpec{Blackouts: []v1alpha1.DeployBlackout{{
+		Start: metav1.NewTime(time.Now().Add(-time.Hour)), End: metav1.NewTime(time.Now().Add(time.Hour)), Reason: "release freeze",
+	}}})
+	require.NoError(t, err)
+	app := &v1beta1.Application{
+		ObjectMeta: metav1.ObjectMeta{Name: "app", Namespace: "default"},
+		Spec: v1beta1.ApplicationSpec{Policies: []v1beta1.AppPolicy{{
+			Name: "freeze", Type: v1alpha1.DeployWindowPolicyType, Properties: &runtime.RawExtension{Raw: freeze},
+		}}},
+		Status: common.AppStatus{
+			Workflow:                   &common.WorkflowStatus{AppRevision: "app-v1"},
+			WorkflowRestartScheduledAt: &metav1.Time{Time: time.Now()},
+		},
+	}
+	r := &Reconciler{Client: fake.NewClientBuilder().WithScheme(velacommon.Scheme).Build(), Recorder: event.NewNopRecorder()}
+	ctx := monitorContext.NewTraceContext(context.Background(), "")
+
+	// the restart of the current revision is not held
+	handler := &AppHandler{currentAppRev: &v1beta1.ApplicationRevision{ObjectMeta: metav1.ObjectMeta{Name: "app-v1"}}}
+	held, _ := r.checkDeployWindow(ctx, app, handler)
+	require.False(t, held)
+	require.Nil(t, app.Status.DeployWindow)
+
+	// the restart requested together with a new revision is held as the new revision
+	handler.currentAppRev.Name = "app-v2"
+	held, requeue := r.checkDeployWindow(ctx, app, handler)
+	require.True(t, held)
+	require.Greater(t, requeue, time.Duration(0))
+	require.Equal(t, "app-v2", app.Status.DeployWindow.PendingRevision)
+}
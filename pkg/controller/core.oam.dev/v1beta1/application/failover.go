@@ -0,0 +1,86 @@
+/*
+Copyright 2026 The KubeVela Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package application
+
+import (
+	"fmt"
+	"reflect"
+	"time"
+
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	monitorContext "github.com/kubevela/pkg/monitor/context"
+
+	"github.com/oam-dev/kubevela/apis/core.oam.dev/v1alpha1"
+	"github.com/oam-dev/kubevela/apis/core.oam.dev/v1beta1"
+	velatypes "github.com/oam-dev/kubevela/apis/types"
+	"github.com/oam-dev/kubevela/pkg/appfile"
+	"github.com/oam-dev/kubevela/pkg/policy"
+	"github.com/oam-dev/kubevela/pkg/resourcekeeper"
+)
+
+// evalFailover evaluates the failover policy of the application and records the decisions in the application
+// status. If the decisions change the placements of the application, a workflow restart is scheduled so that the
+// workloads are re-placed by the deploy steps. It returns the time after which the decisions should be re-evaluated,
+// and whether the decisions changed so that the status should be written.
+func (r *Reconciler) evalFailover(ctx monitorContext.Context, app *v1beta1.Application, appFile *appfile.Appfile) (time.Duration, bool) {
+	spec, err := policy.ParsePolicy[v1alpha1.FailoverPolicySpec](app)
+	if err != nil {
+		ctx.Error(err, "failed to parse failover policy")
+		r.Recorder.Event(app, event.Warning(velatypes.ReasonFailedFailover, err))
+		return 0, false
+	}
+	if spec == nil {
+		changed := app.Status.Failover != nil
+		app.Status.Failover = nil
+		return 0, changed
+	}
+	placements, err := policy.GetPlacementsFromTopologyPolicies(ctx, r.Client, app.Namespace, policy.GetFailoverWatchedPolicies(spec, appFile.Policies), resourcekeeper.AllowCrossNamespaceResource)
+	if err != nil {
+		ctx.Error(err, "failed to get placements watched by failover policy")
+		r.Recorder.Event(app, event.Warning(velatypes.ReasonFailedFailover, err))
+		return 0, false
+	}
+	standbyClusters, err := policy.ListStandbyClusters(ctx, r.Client, spec)
+	if err != nil {
+		ctx.Error(err, "failed to list standby clusters of failover policy")
+		r.Recorder.Event(app, event.Warning(velatypes.ReasonFailedFailover, err))
+		return 0, false
+	}
+	var observations []policy.ClusterHealth
+	for _, placement := range placements {
+		failedOver := false
+		for _, decision := range app.Status.Failover {
+			if decision.Cluster == placement.Cluster && decision.Namespace == placement.Namespace {
+				failedOver = decision.IsFailedOver()
+			}
+		}
+		observations = append(observations, policy.ObserveClusterHealth(ctx, r.Client, placement, app.Status.Services, failedOver))
+	}
+	decisions, requeue := policy.UpdateFailoverDecisions(spec, app.Status.Failover, observations, standbyClusters, time.Now())
+	before := policy.ApplyFailoverDecisions(placements, app.Status.Failover)
+	after := policy.ApplyFailoverDecisions(placements, decisions)
+	changed := !reflect.DeepEqual(app.Status.Failover, decisions)
+	app.Status.Failover = decisions
+	if !reflect.DeepEqual(before, after) {
+		ctx.Info("Failover decisions changed the placements, schedule workflow restart", "before", before, "after", after)
+		r.Recorder.Event(app, event.Normal(velatypes.ReasonFailover, fmt.Sprintf("Placements changed from %v to %v", before, after)))
+		app.Status.WorkflowRestartScheduledAt = &metav1.Time{Time: time.Now()}
+	}
+	return requeue, changed
+}
@@ -317,6 +317,9 @@ func (h *AppHandler) applyComponentFunc(appParser *appfile.Parser, af *appfile.A
 		if err != nil {
 			return nil, nil, false, err
 		}
+		if err = h.checkComponentGuardrails(ctx, af, wl, manifest); err != nil {
+			return nil, nil, false, err
+		}
 		wl.Ctx.SetCtx(auth.ContextWithUserInfo(ctx, h.app))
 
 		readyWorkload, readyTraits, err := renderComponentsAndTraits(manifest, appRev, clusterName, overrideNamespace)
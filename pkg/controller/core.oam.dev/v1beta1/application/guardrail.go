@@ -0,0 +1,91 @@
+/*
+Copyright 2026 The KubeVela Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package application
+
+import (
+	"context"
+
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/klog/v2"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/oam-dev/kubevela/apis/core.oam.dev/v1beta1"
+	"github.com/oam-dev/kubevela/apis/types"
+	"github.com/oam-dev/kubevela/pkg/appfile"
+	"github.com/oam-dev/kubevela/pkg/guardrail"
+	"github.com/oam-dev/kubevela/pkg/monitor/metrics"
+)
+
+// guardrailHistory keeps the violations of the last reconcile of the applications, so that the violations are
+// counted and reported once when they appear
+var guardrailHistory = guardrail.NewHistory()
+
+// guardrailChecker returns the checker of the guardrails of the application, loaded once for the reconcile
+func (h *AppHandler) guardrailChecker(ctx context.Context) (*guardrail.Checker, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if h.guardrails != nil {
+		return h.guardrails, nil
+	}
+	guardrails, err := guardrail.List(ctx, h.Client, h.app.Namespace)
+	if err != nil && !meta.IsNoMatchError(errors.Cause(err)) {
+		return nil, err
+	}
+	h.guardrails = guardrail.NewChecker(ctx, guardrails)
+	return h.guardrails, nil
+}
+
+// checkComponentGuardrails checks the resources rendered for the component against the guardrails
+func (h *AppHandler) checkComponentGuardrails(ctx context.Context, af *appfile.Appfile, comp *appfile.Component, manifest *types.ComponentManifest) error {
+	checker, err := h.guardrailChecker(ctx)
+	if err != nil {
+		return err
+	}
+	// the attribution of the violations holds as long as the traits are the same
+	source := "component " + comp.Name
+	for _, trait := range comp.Traits {
+		source += "/" + trait.Name
+	}
+	known := guardrailHistory.Get(client.ObjectKeyFromObject(h.app).String(), source)
+	return h.handleGuardrailViolations(source, checker.CheckComponent(af, comp, manifest, known))
+}
+
+// checkPolicyGuardrails checks the resources rendered for the policy against the guardrails
+func (h *AppHandler) checkPolicyGuardrails(ctx context.Context, af *appfile.Appfile, policy *appfile.Component, manifests []*unstructured.Unstructured) error {
+	checker, err := h.guardrailChecker(ctx)
+	if err != nil {
+		return err
+	}
+	return h.handleGuardrailViolations("policy "+policy.Name, checker.CheckPolicy(af, policy, manifests))
+}
+
+// handleGuardrailViolations records the violations appearing since the last reconcile, and returns the error of the
+// enforced ones
+func (h *AppHandler) handleGuardrailViolations(source string, violations []guardrail.Violation) error {
+	for _, v := range guardrailHistory.Record(client.ObjectKeyFromObject(h.app).String(), source, violations) {
+		metrics.GuardrailViolationCounter.WithLabelValues(h.app.Namespace, v.Guardrail, string(v.Mode)).Inc()
+		klog.InfoS("guardrail violated", "application", h.app.Name, "namespace", h.app.Namespace,
+			"guardrail", v.Guardrail, "mode", v.Mode, "resource", v.Resource, "source", v.Source(), "message", v.Message)
+		if v.Mode == v1beta1.GuardrailWarn && h.recorder != nil {
+			h.recorder.Event(h.app, event.Warning(types.ReasonGuardrailViolated, errors.New(v.String())))
+		}
+	}
+	return guardrail.Enforced(violations)
+}
@@ -0,0 +1,43 @@
+/*
+Copyright 2026 The KubeVela Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package application
+
+import (
+	monitorContext "github.com/kubevela/pkg/monitor/context"
+
+	"github.com/oam-dev/kubevela/apis/core.oam.dev/v1beta1"
+	"github.com/oam-dev/kubevela/pkg/quota"
+)
+
+// recordQuotaMetrics records the limits and the usage of the quotas in the namespace of the application, as the
+// usage changes after the workflow of the application finishes
+func (r *Reconciler) recordQuotaMetrics(ctx monitorContext.Context, app *v1beta1.Application) {
+	quotas, err := quota.ListQuotas(ctx, r.Client, app.Namespace)
+	if err != nil {
+		ctx.Error(err, "failed to list quotas")
+		return
+	}
+	if len(quotas) == 0 {
+		return
+	}
+	usage, err := quota.GetUsage(ctx, r.Client, app.Namespace)
+	if err != nil {
+		ctx.Error(err, "failed to get the usage of quotas")
+		return
+	}
+	quota.RecordMetrics(app.Namespace, quotas, usage)
+}
@@ -159,19 +159,7 @@ func (r *Reconciler) checkWorkflowRestart(ctx monitorContext.Context, app *v1bet
 	}
 
 	// Check for revision-based restart (publishVersion or normal revision change)
-	desiredRev, currentRev := handler.currentAppRev.Name, ""
-	if app.Status.Workflow != nil {
-		currentRev = app.Status.Workflow.AppRevision
-	}
-	if metav1.HasAnnotation(app.ObjectMeta, oam.AnnotationPublishVersion) {
-		desiredRev = app.GetAnnotations()[oam.AnnotationPublishVersion]
-	} else { // nolint
-		// backward compatibility
-		// legacy versions use <rev>:<hash> as currentRev, extract <rev>
-		if idx := strings.LastIndexAny(currentRev, ":"); idx >= 0 {
-			currentRev = currentRev[:idx]
-		}
-	}
+	desiredRev, currentRev := workflowRevisions(app, handler)
 	if currentRev != "" && desiredRev == currentRev {
 		return
 	}
@@ -204,3 +192,21 @@ func (r *Reconciler) checkWorkflowRestart(ctx monitorContext.Context, app *v1bet
 		AppRevision: desiredRev,
 	}
 }
+
+// workflowRevisions returns the revision the workflow should run for and the revision it last ran for
+func workflowRevisions(app *v1beta1.Application, handler *AppHandler) (desiredRev string, currentRev string) {
+	desiredRev = handler.currentAppRev.Name
+	if app.Status.Workflow != nil {
+		currentRev = app.Status.Workflow.AppRevision
+	}
+	if metav1.HasAnnotation(app.ObjectMeta, oam.AnnotationPublishVersion) {
+		desiredRev = app.GetAnnotations()[oam.AnnotationPublishVersion]
+	} else { // nolint
+		// backward compatibility
+		// legacy versions use <rev>:<hash> as currentRev, extract <rev>
+		if idx := strings.LastIndexAny(currentRev, ":"); idx >= 0 {
+			currentRev = currentRev[:idx]
+		}
+	}
+	return desiredRev, currentRev
+}
@@ -0,0 +1,251 @@
+/*
+Copyright 2026 The KubeVela Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package applicationsubscription
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	ctrlrec "github.com/kubevela/pkg/controller/reconciler"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/klog/v2"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/oam-dev/kubevela/apis/core.oam.dev/v1alpha1"
+	"github.com/oam-dev/kubevela/apis/core.oam.dev/v1beta1"
+	oamctrl "github.com/oam-dev/kubevela/pkg/controller/core.oam.dev"
+	"github.com/oam-dev/kubevela/pkg/controller/utils"
+	"github.com/oam-dev/kubevela/pkg/notification"
+)
+
+// Reconciler sends the notifications of the ApplicationSubscriptions when the matched applications change
+type Reconciler struct {
+	client.Client
+	record               event.Recorder
+	concurrentReconciles int
+}
+
+// Reconcile compares the states of the matched applications with the states last notified, and sends the changes
+// to the channels. The application first matched is recorded without notification. The changes of an application
+// are held until the throttle period since its last notification passes. The state of an application only moves
+// forward once the notification is sent to all the channels, otherwise the notification is retried with backoff.
+// The channels already notified are recorded in the status, so that they are not notified again in the retries.
+func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	ctx, cancel := ctrlrec.NewReconcileContext(ctx)
+	defer cancel()
+
+	sub := &v1alpha1.ApplicationSubscription{}
+	if err := r.Get(ctx, req.NamespacedName, sub); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+	if sub.DeletionTimestamp != nil {
+		return ctrl.Result{}, nil
+	}
+	selector := labels.Everything()
+	if sub.Spec.Selector != nil {
+		var err error
+		if selector, err = metav1.LabelSelectorAsSelector(sub.Spec.Selector); err != nil {
+			r.record.Event(sub, event.Warning("InvalidSelector", err))
+			return ctrl.Result{}, nil
+		}
+	}
+	apps := &v1beta1.ApplicationList{}
+	if err := r.List(ctx, apps, client.InNamespace(sub.Namespace), client.MatchingLabelsSelector{Selector: selector}); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	last := map[string]v1alpha1.SubscribedApplication{}
+	for _, state := range sub.Status.Applications {
+		last[state.Name] = state
+	}
+	var throttle time.Duration
+	if sub.Spec.ThrottlePeriod != nil {
+		throttle = sub.Spec.ThrottlePeriod.Duration
+	}
+	now := metav1.Now()
+	status := sub.Status.DeepCopy()
+	status.Applications = nil
+	var requeue time.Duration
+	var failures []string
+	var pending bool
+	for i := range apps.Items {
+		app := &apps.Items[i]
+		if app.DeletionTimestamp != nil {
+			continue
+		}
+		current := notification.Observe(app)
+		prev, found := last[app.Name]
+		if !found {
+			status.Applications = append(status.Applications, current)
+			continue
+		}
+		current.LastNotifyTime = prev.LastNotifyTime
+		changes := notification.Diff(prev, current, sub.Spec.Events)
+		if len(changes) == 0 || sub.Spec.Suspend {
+			status.Applications = append(status.Applications, current)
+			continue
+		}
+		if wait := throttle - now.Sub(lastNotifyTime(prev)); wait > 0 {
+			status.Applications = append(status.Applications, prev)
+			if requeue == 0 || wait < requeue {
+				requeue = wait
+			}
+			continue
+		}
+		n := &notification.Notification{
+			Subscription: sub.Name,
+			Application:  app.Name,
+			Namespace:    app.Namespace,
+			Phase:        current.Phase,
+			Healthy:      current.Healthy,
+			Changes:      changes,
+			Time:         now,
+		}
+		hash, err := utils.ComputeSpecHash(changes)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+		delivery := &v1alpha1.NotificationDelivery{ID: fmt.Sprintf("%s/%s/%s", sub.UID, app.Name, hash)}
+		if prev.Delivery != nil && prev.Delivery.ID == delivery.ID {
+			delivery.Channels = slices.Clone(prev.Delivery.Channels)
+		}
+		sent, errs := r.send(ctx, sub, delivery, n)
+		failures = append(failures, errs...)
+		if !sent {
+			pending = true
+			prev.Delivery = delivery
+			status.Applications = append(status.Applications, prev)
+			continue
+		}
+		current.LastNotifyTime = now.DeepCopy()
+		status.LastNotifyTime = now.DeepCopy()
+		status.Applications = append(status.Applications, current)
+	}
+	sort.Slice(status.Applications, func(i, j int) bool {
+		return status.Applications[i].Name < status.Applications[j].Name
+	})
+	status.ApplicationCount = len(status.Applications)
+	status.Message = strings.Join(failures, "; ")
+	if len(failures) > 0 {
+		r.record.Event(sub, event.Warning("FailedNotify", errors.New(status.Message)))
+	}
+	if !reflect.DeepEqual(*status, sub.Status) {
+		sub.Status = *status
+		if err := r.Status().Update(ctx, sub); err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+	if len(failures) > 0 {
+		return ctrl.Result{}, errors.New(status.Message)
+	}
+	return ctrl.Result{Requeue: pending, RequeueAfter: requeue}, nil
+}
+
+// send sends the notification to the channels not notified yet in the delivery, and records the channels
+// notified. It returns whether all the channels are notified, and the failures of the channels.
+func (r *Reconciler) send(ctx context.Context, sub *v1alpha1.ApplicationSubscription, delivery *v1alpha1.NotificationDelivery,
+	n *notification.Notification) (bool, []string) {
+	sent := true
+	var failures []string
+	for _, channel := range sub.Spec.Channels {
+		if slices.Contains(delivery.Channels, channel.Name) {
+			continue
+		}
+		done, err := notification.Send(ctx, r.Client, sub.Namespace, delivery.ID+"/"+channel.Name, channel, n)
+		if err != nil {
+			klog.ErrorS(err, "failed to send the notification", "subscription", klog.KObj(sub), "channel", channel.Name, "application", n.Application)
+			failures = append(failures, fmt.Sprintf("failed to notify %s of application %s: %s", channel.Name, n.Application, err.Error()))
+		}
+		if done {
+			delivery.Channels = append(delivery.Channels, channel.Name)
+		} else {
+			sent = false
+		}
+	}
+	return sent, failures
+}
+
+func lastNotifyTime(state v1alpha1.SubscribedApplication) time.Time {
+	if state.LastNotifyTime == nil {
+		return time.Time{}
+	}
+	return state.LastNotifyTime.Time
+}
+
+// findSubscriptionsForApplication enqueues the subscriptions in the namespace of the application whose selector
+// matches the application, or which recorded the application before, such as its labels no longer match
+func (r *Reconciler) findSubscriptionsForApplication(ctx context.Context, app client.Object) []reconcile.Request {
+	subs := &v1alpha1.ApplicationSubscriptionList{}
+	if err := r.List(ctx, subs, client.InNamespace(app.GetNamespace())); err != nil {
+		klog.ErrorS(err, "failed to list the application subscriptions", "namespace", app.GetNamespace())
+		return nil
+	}
+	var requests []reconcile.Request
+	for _, sub := range subs.Items {
+		if matches(&sub, app) || slices.ContainsFunc(sub.Status.Applications, func(state v1alpha1.SubscribedApplication) bool {
+			return state.Name == app.GetName()
+		}) {
+			requests = append(requests, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(sub.DeepCopy())})
+		}
+	}
+	return requests
+}
+
+// matches tells whether the selector of the subscription matches the application
+func matches(sub *v1alpha1.ApplicationSubscription, app client.Object) bool {
+	if sub.Spec.Selector == nil {
+		return true
+	}
+	selector, err := metav1.LabelSelectorAsSelector(sub.Spec.Selector)
+	return err == nil && selector.Matches(labels.Set(app.GetLabels()))
+}
+
+// SetupWithManager will setup with event recorder
+func (r *Reconciler) SetupWithManager(mgr ctrl.Manager) error {
+	r.record = event.NewAPIRecorder(mgr.GetEventRecorderFor("ApplicationSubscription")).
+		WithAnnotations("controller", "ApplicationSubscription")
+	return ctrl.NewControllerManagedBy(mgr).
+		WithOptions(controller.Options{
+			MaxConcurrentReconciles: r.concurrentReconciles,
+		}).
+		For(&v1alpha1.ApplicationSubscription{}, builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		Watches(&v1beta1.Application{}, handler.EnqueueRequestsFromMapFunc(r.findSubscriptionsForApplication)).
+		Complete(r)
+}
+
+// Setup adds a controller that sends the notifications of the ApplicationSubscriptions.
+func Setup(mgr ctrl.Manager, args oamctrl.Args) error {
+	r := Reconciler{
+		Client:               mgr.GetClient(),
+		concurrentReconciles: args.ConcurrentReconciles,
+	}
+	return r.SetupWithManager(mgr)
+}
@@ -0,0 +1,184 @@
+/*
+Copyright 2026 The KubeVela Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package applicationsubscription
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	core "github.com/oam-dev/kubevela/apis/core.oam.dev"
+	"github.com/oam-dev/kubevela/apis/core.oam.dev/common"
+	"github.com/oam-dev/kubevela/apis/core.oam.dev/v1alpha1"
+	"github.com/oam-dev/kubevela/apis/core.oam.dev/v1beta1"
+	"github.com/oam-dev/kubevela/pkg/notification"
+)
+
+func TestReconcile(t *testing.T) {
+	r := require.New(t)
+	ctx := context.Background()
+	var received []notification.Notification
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		n := notification.Notification{}
+		r.NoError(json.NewDecoder(req.Body).Decode(&n))
+		received = append(received, n)
+	}))
+	defer server.Close()
+
+	scheme := runtime.NewScheme()
+	r.NoError(clientgoscheme.AddToScheme(scheme))
+	r.NoError(core.AddToScheme(scheme))
+	newApp := func(name string, labels map[string]string) *v1beta1.Application {
+		app := &v1beta1.Application{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "demo", Labels: labels}}
+		app.Status.Phase = common.ApplicationRunning
+		app.Status.Services = []common.ApplicationComponentStatus{{Name: name, Healthy: true}}
+		return app
+	}
+	web, other := newApp("web", map[string]string{"team": "a"}), newApp("other", nil)
+	sub := &v1alpha1.ApplicationSubscription{
+		ObjectMeta: metav1.ObjectMeta{Name: "oncall", Namespace: "demo"},
+		Spec: v1alpha1.ApplicationSubscriptionSpec{
+			Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"team": "a"}},
+			Channels: []v1alpha1.NotificationChannel{{Name: "webhook", Webhook: &v1alpha1.GenericWebhookChannel{
+				URL: v1alpha1.NotificationValue{Value: server.URL},
+			}}},
+			ThrottlePeriod: &metav1.Duration{Duration: time.Hour},
+		},
+	}
+	cli := fake.NewClientBuilder().WithScheme(scheme).WithObjects(web, other, sub).
+		WithStatusSubresource(&v1beta1.Application{}, &v1alpha1.ApplicationSubscription{}).Build()
+	reconciler := &Reconciler{Client: cli, record: event.NewNopRecorder()}
+	req := ctrl.Request{NamespacedName: client.ObjectKeyFromObject(sub)}
+	reconcileAndGet := func() (ctrl.Result, *v1alpha1.ApplicationSubscription) {
+		res, err := reconciler.Reconcile(ctx, req)
+		r.NoError(err)
+		r.NoError(cli.Get(ctx, req.NamespacedName, sub))
+		return res, sub
+	}
+	updateApp := func(update func(app *v1beta1.Application)) {
+		r.NoError(cli.Get(ctx, client.ObjectKeyFromObject(web), web))
+		update(web)
+		r.NoError(cli.Status().Update(ctx, web))
+	}
+
+	// the application first matched is recorded without notification
+	_, sub = reconcileAndGet()
+	r.Empty(received)
+	r.Equal(1, sub.Status.ApplicationCount)
+	r.Equal([]v1alpha1.SubscribedApplication{{Name: "web", Phase: "running", Healthy: true}}, sub.Status.Applications)
+
+	updateApp(func(app *v1beta1.Application) { app.Status.Services[0].Healthy = false })
+	_, sub = reconcileAndGet()
+	r.Len(received, 1)
+	r.Equal("web", received[0].Application)
+	r.Equal([]notification.Change{{Event: v1alpha1.SubscriptionEventHealthChanged, Message: "the application becomes unhealthy"}}, received[0].Changes)
+	r.NotNil(sub.Status.LastNotifyTime)
+	r.False(sub.Status.Applications[0].Healthy)
+
+	// the same state is not notified twice
+	_, _ = reconcileAndGet()
+	r.Len(received, 1)
+
+	// the changes are held during the throttle period
+	updateApp(func(app *v1beta1.Application) { app.Status.Phase = common.ApplicationWorkflowFailed })
+	res, sub := reconcileAndGet()
+	r.Len(received, 1)
+	r.True(res.RequeueAfter > 0 && res.RequeueAfter <= time.Hour)
+	r.Equal("running", sub.Status.Applications[0].Phase)
+
+	// and sent together once the throttle period passes
+	sub.Status.Applications[0].LastNotifyTime = &metav1.Time{Time: time.Now().Add(-2 * time.Hour)}
+	r.NoError(cli.Status().Update(ctx, sub))
+	res, sub = reconcileAndGet()
+	r.Zero(res.RequeueAfter)
+	r.Len(received, 2)
+	r.Equal([]notification.Change{
+		{Event: v1alpha1.SubscriptionEventPhaseChanged, Message: "phase changed from running to workflowFailed"},
+		{Event: v1alpha1.SubscriptionEventWorkflowFailed, Message: "the workflow failed"},
+	}, received[1].Changes)
+	r.Equal("workflowFailed", sub.Status.Applications[0].Phase)
+	r.Empty(sub.Status.Message)
+
+	// the failures of the channels are reported in the status and retried, without moving the state forward, and
+	// the channels notified are recorded to not be notified again
+	sub.Spec.Channels = append(sub.Spec.Channels, v1alpha1.NotificationChannel{Name: "broken", Webhook: &v1alpha1.GenericWebhookChannel{
+		URL: v1alpha1.NotificationValue{Value: "http://127.0.0.1:0"},
+	}})
+	r.NoError(cli.Update(ctx, sub))
+	sub.Status.Applications[0].LastNotifyTime = nil
+	r.NoError(cli.Status().Update(ctx, sub))
+	updateApp(func(app *v1beta1.Application) { app.Status.Phase = common.ApplicationRunning })
+	for i := 0; i < 2; i++ {
+		_, err := reconciler.Reconcile(ctx, req)
+		r.Error(err)
+		r.NoError(cli.Get(ctx, req.NamespacedName, sub))
+		r.Contains(sub.Status.Message, "failed to notify broken of application web")
+		r.Equal("workflowFailed", sub.Status.Applications[0].Phase)
+		r.Equal([]string{"webhook"}, sub.Status.Applications[0].Delivery.Channels)
+		r.Len(received, 3)
+	}
+
+	sub.Spec.Channels[1].Webhook.URL.Value = server.URL
+	r.NoError(cli.Update(ctx, sub))
+	_, sub = reconcileAndGet()
+	r.Len(received, 4)
+	r.Equal("running", sub.Status.Applications[0].Phase)
+	r.Nil(sub.Status.Applications[0].Delivery)
+	r.Empty(sub.Status.Message)
+}
+
+func TestFindSubscriptionsForApplication(t *testing.T) {
+	r := require.New(t)
+	ctx := context.Background()
+	scheme := runtime.NewScheme()
+	r.NoError(core.AddToScheme(scheme))
+	newSub := func(name string, selector *metav1.LabelSelector, apps ...string) *v1alpha1.ApplicationSubscription {
+		sub := &v1alpha1.ApplicationSubscription{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "demo"},
+			Spec:       v1alpha1.ApplicationSubscriptionSpec{Selector: selector},
+		}
+		for _, app := range apps {
+			sub.Status.Applications = append(sub.Status.Applications, v1alpha1.SubscribedApplication{Name: app})
+		}
+		return sub
+	}
+	teamA := &metav1.LabelSelector{MatchLabels: map[string]string{"team": "a"}}
+	teamB := &metav1.LabelSelector{MatchLabels: map[string]string{"team": "b"}}
+	cli := fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+		newSub("all", nil), newSub("team-a", teamA), newSub("team-b", teamB),
+		newSub("team-b-recorded", teamB, "web"),
+	).Build()
+	reconciler := &Reconciler{Client: cli}
+	app := &v1beta1.Application{ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "demo", Labels: map[string]string{"team": "a"}}}
+	var names []string
+	for _, req := range reconciler.findSubscriptionsForApplication(ctx, app) {
+		names = append(names, req.Name)
+	}
+	r.ElementsMatch([]string{"all", "team-a", "team-b-recorded"}, names)
+}
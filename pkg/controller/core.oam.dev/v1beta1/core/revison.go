@@ -336,6 +336,7 @@ func ConstructDefinitionRevisionName(definitionName, revision string) string {
 func CleanUpDefinitionRevision(ctx context.Context, cli client.Client, def runtime.Object, revisionLimit int) error {
 	var listOpts []client.ListOption
 	var usingRevision *common.Revision
+	var defName string
 
 	switch definition := def.(type) {
 	case *v1beta1.ComponentDefinition:
@@ -343,24 +344,24 @@ func CleanUpDefinitionRevision(ctx context.Context, cli client.Client, def runti
 			client.InNamespace(definition.Namespace),
 			client.MatchingLabels{oam.LabelComponentDefinitionName: definition.Name},
 		}
-		usingRevision = definition.Status.LatestRevision
+		usingRevision, defName = definition.Status.LatestRevision, definition.Name
 	case *v1beta1.TraitDefinition:
 		listOpts = []client.ListOption{
 			client.InNamespace(definition.Namespace),
 			client.MatchingLabels{oam.LabelTraitDefinitionName: definition.Name},
 		}
-		usingRevision = definition.Status.LatestRevision
+		usingRevision, defName = definition.Status.LatestRevision, definition.Name
 	case *v1beta1.PolicyDefinition:
 		listOpts = []client.ListOption{
 			client.InNamespace(definition.Namespace),
 			client.MatchingLabels{oam.LabelPolicyDefinitionName: definition.Name},
 		}
-		usingRevision = definition.Status.LatestRevision
+		usingRevision, defName = definition.Status.LatestRevision, definition.Name
 	case *v1beta1.WorkflowStepDefinition:
 		listOpts = []client.ListOption{
 			client.InNamespace(definition.Namespace),
 			client.MatchingLabels{oam.LabelWorkflowStepDefinitionName: definition.Name}}
-		usingRevision = definition.Status.LatestRevision
+		usingRevision, defName = definition.Status.LatestRevision, definition.Name
 	}
 
 	if usingRevision == nil {
@@ -379,12 +380,14 @@ func CleanUpDefinitionRevision(ctx context.Context, cli client.Client, def runti
 
 	sortedRevision := defRevList.Items
 	sort.Sort(historiesByRevision(sortedRevision))
+	// keep the latest version of each major version, so that applications could pin the major versions side by side
+	latestOfMajors := util.LatestDefinitionRevisionOfEachMajor(defName, sortedRevision)
 
 	for _, rev := range sortedRevision {
 		if needKill <= 0 {
 			break
 		}
-		if rev.Name == usingRevision.Name {
+		if rev.Name == usingRevision.Name || latestOfMajors[rev.Name] {
 			continue
 		}
 		if err := cli.Delete(ctx, rev.DeepCopy()); err != nil && !apierrors.IsNotFound(err) {
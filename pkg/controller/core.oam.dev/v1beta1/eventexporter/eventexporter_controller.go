@@ -0,0 +1,172 @@
+/*
+Copyright 2026 The KubeVela Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package eventexporter
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	ctrlrec "github.com/kubevela/pkg/controller/reconciler"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/klog/v2"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	"github.com/oam-dev/kubevela/apis/core.oam.dev/v1beta1"
+	oamctrl "github.com/oam-dev/kubevela/pkg/controller/core.oam.dev"
+	"github.com/oam-dev/kubevela/pkg/eventtimeline"
+)
+
+// Reconciler exports the event timelines of the applications to the webhook periodically
+type Reconciler struct {
+	client.Client
+	sink                 *eventtimeline.WebhookSink
+	interval             time.Duration
+	concurrentReconciles int
+
+	// exported records the last exported events of each application. The events happened before the controller
+	// starts are not exported, to avoid sending the whole history again on restart.
+	mu        sync.Mutex
+	startTime metav1.Time
+	exported  map[types.NamespacedName]exportState
+}
+
+// exportState is the time of the last exported events, with the events exported at the time. The event times are
+// in seconds, so the events at the time are listed again and the ones exported are skipped, instead of dropping the
+// events of the same second listed later.
+type exportState struct {
+	time   metav1.Time
+	events map[string]bool
+}
+
+// eventKey identifies the event, the count is included as the repeated event is exported again
+func eventKey(event eventtimeline.Event) string {
+	return fmt.Sprintf("%s/%d", event.UID, event.Count)
+}
+
+// unexported returns the events not exported yet
+func (s exportState) unexported(events []eventtimeline.Event) []eventtimeline.Event {
+	var res []eventtimeline.Event
+	for _, event := range events {
+		if !event.Time.Equal(&s.time) || !s.events[eventKey(event)] {
+			res = append(res, event)
+		}
+	}
+	return res
+}
+
+// add returns the state after exporting the events sorted by time
+func (s exportState) add(events []eventtimeline.Event) exportState {
+	last := events[len(events)-1].Time
+	next := exportState{time: last, events: map[string]bool{}}
+	if last.Equal(&s.time) {
+		for key := range s.events {
+			next.events[key] = true
+		}
+	}
+	for _, event := range events {
+		if event.Time.Equal(&last) {
+			next.events[eventKey(event)] = true
+		}
+	}
+	return next
+}
+
+// Reconcile sends the new events of the application to the webhook and requeues the application for the next export
+func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	ctx, cancel := ctrlrec.NewReconcileContext(ctx)
+	defer cancel()
+
+	app := &v1beta1.Application{}
+	if err := r.Get(ctx, req.NamespacedName, app); err != nil {
+		if client.IgnoreNotFound(err) == nil {
+			r.forget(req.NamespacedName)
+		}
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+	if app.DeletionTimestamp != nil {
+		r.forget(req.NamespacedName)
+		return ctrl.Result{}, nil
+	}
+	state := r.lastExported(req.NamespacedName)
+	events, err := eventtimeline.Collect(ctx, r.Client, app, eventtimeline.Filter{Since: &state.time})
+	if err != nil {
+		klog.ErrorS(err, "failed to collect the events of the application", "application", klog.KObj(app))
+		return ctrl.Result{}, err
+	}
+	if events = state.unexported(events); len(events) > 0 {
+		if err = r.sink.Send(ctx, app.Name, app.Namespace, events); err != nil {
+			klog.ErrorS(err, "failed to export the events of the application", "application", klog.KObj(app))
+			return ctrl.Result{RequeueAfter: r.interval}, nil
+		}
+		r.markExported(req.NamespacedName, state.add(events))
+		klog.V(4).InfoS("exported the events of the application", "application", klog.KObj(app), "events", len(events))
+	}
+	return ctrl.Result{RequeueAfter: r.interval}, nil
+}
+
+func (r *Reconciler) lastExported(key types.NamespacedName) exportState {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if state, ok := r.exported[key]; ok {
+		return state
+	}
+	return exportState{time: r.startTime}
+}
+
+func (r *Reconciler) markExported(key types.NamespacedName, state exportState) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.exported[key] = state
+}
+
+func (r *Reconciler) forget(key types.NamespacedName) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.exported, key)
+}
+
+// SetupWithManager will setup the controller with the manager
+func (r *Reconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		Named("eventexporter").
+		WithOptions(controller.Options{
+			MaxConcurrentReconciles: r.concurrentReconciles,
+		}).
+		For(&v1beta1.Application{}).
+		Complete(r)
+}
+
+// Setup adds a controller that exports the event timelines of the applications to the event webhook, if configured.
+func Setup(mgr ctrl.Manager, args oamctrl.Args) error {
+	if args.EventWebhookURL == "" {
+		return nil
+	}
+	r := Reconciler{
+		Client:               mgr.GetClient(),
+		sink:                 eventtimeline.NewWebhookSink(args.EventWebhookURL),
+		interval:             args.EventExportInterval,
+		concurrentReconciles: args.ConcurrentReconciles,
+		startTime:            metav1.Now(),
+		exported:             map[types.NamespacedName]exportState{},
+	}
+	return r.SetupWithManager(mgr)
+}
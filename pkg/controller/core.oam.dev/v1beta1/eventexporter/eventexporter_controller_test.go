@@ -0,0 +1,57 @@
+/*
+Copyright 2026 The KubeVela Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package eventexporter
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+
+	"github.com/oam-dev/kubevela/pkg/eventtimeline"
+)
+
+func TestExportState(t *testing.T) {
+	r := require.New(t)
+	now := metav1.NewTime(time.Now().Truncate(time.Second))
+	before := metav1.NewTime(now.Add(-time.Second))
+	newEvent := func(uid string, count int32, at metav1.Time) eventtimeline.Event {
+		return eventtimeline.Event{UID: k8stypes.UID("uid-" + uid), Count: count, Time: at}
+	}
+	state := exportState{time: before}
+	first := []eventtimeline.Event{newEvent("a", 1, before), newEvent("b", 1, now)}
+	r.Equal(first, state.unexported(first))
+	state = state.add(first)
+	r.Equal(now, state.time)
+
+	// the event of the same second listed later is exported, the ones exported are not exported again
+	listed := []eventtimeline.Event{newEvent("b", 1, now), newEvent("c", 1, now)}
+	r.Equal([]eventtimeline.Event{newEvent("c", 1, now)}, state.unexported(listed))
+	state = state.add(state.unexported(listed))
+	r.Empty(state.unexported(listed))
+
+	// the repeated event is exported again
+	repeated := []eventtimeline.Event{newEvent("b", 2, now), newEvent("c", 1, now)}
+	r.Equal([]eventtimeline.Event{newEvent("b", 2, now)}, state.unexported(repeated))
+
+	// the events exported at an earlier time are forgotten once the time moves on
+	later := metav1.NewTime(now.Add(time.Second))
+	state = state.add([]eventtimeline.Event{newEvent("d", 1, later)})
+	r.Equal(map[string]bool{"uid-d/1": true}, state.events)
+}
@@ -0,0 +1,95 @@
+/*
+Copyright 2026 The KubeVela Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package privilegeaudit
+
+import (
+	"context"
+	"io"
+
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	ctrlrec "github.com/kubevela/pkg/controller/reconciler"
+	"k8s.io/klog/v2"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	"github.com/oam-dev/kubevela/apis/core.oam.dev/v1alpha1"
+	"github.com/oam-dev/kubevela/pkg/auth"
+	oamctrl "github.com/oam-dev/kubevela/pkg/controller/core.oam.dev"
+	"github.com/oam-dev/kubevela/pkg/oam"
+)
+
+// Reconciler revokes the privileges granted by the PrivilegeAudits when they expire
+type Reconciler struct {
+	client.Client
+	record               event.Recorder
+	concurrentReconciles int
+}
+
+// Reconcile revokes the expired privileges, or requeues the PrivilegeAudit until the privileges expire
+func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	ctx, cancel := ctrlrec.NewReconcileContext(ctx)
+	defer cancel()
+
+	audit := &v1alpha1.PrivilegeAudit{}
+	if err := r.Get(ctx, req.NamespacedName, audit); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+	if audit.DeletionTimestamp != nil {
+		return ctrl.Result{}, nil
+	}
+	wait, err := auth.RevokeExpiredPrivileges(ctx, r.Client, audit, io.Discard)
+	if err != nil {
+		klog.ErrorS(err, "failed to revoke the expired privileges", "privilegeAudit", klog.KObj(audit))
+		r.record.Event(audit, event.Warning("FailedRevoke", err))
+		return ctrl.Result{}, err
+	}
+	if wait > 0 {
+		return ctrl.Result{RequeueAfter: wait}, nil
+	}
+	if audit.Status.Phase == v1alpha1.PrivilegeAuditExpired && audit.Status.RevokeTime != nil {
+		klog.InfoS("revoked the expired privileges", "privilegeAudit", klog.KObj(audit),
+			"identity", auth.IdentityFromPrivilegeIdentity(audit.Spec.Identity).String())
+	}
+	return ctrl.Result{}, nil
+}
+
+// SetupWithManager will setup with event recorder
+func (r *Reconciler) SetupWithManager(mgr ctrl.Manager) error {
+	r.record = event.NewAPIRecorder(mgr.GetEventRecorderFor("PrivilegeAudit")).
+		WithAnnotations("controller", "PrivilegeAudit")
+	return ctrl.NewControllerManagedBy(mgr).
+		WithOptions(controller.Options{
+			MaxConcurrentReconciles: r.concurrentReconciles,
+		}).
+		// only the audits in the system namespace are trusted to revoke the privileges
+		For(&v1alpha1.PrivilegeAudit{}, builder.WithPredicates(predicate.NewPredicateFuncs(func(obj client.Object) bool {
+			return obj.GetNamespace() == oam.SystemDefinitionNamespace
+		}))).
+		Complete(r)
+}
+
+// Setup adds a controller that revokes the expired privileges of the PrivilegeAudits.
+func Setup(mgr ctrl.Manager, args oamctrl.Args) error {
+	r := Reconciler{
+		Client:               mgr.GetClient(),
+		concurrentReconciles: args.ConcurrentReconciles,
+	}
+	return r.SetupWithManager(mgr)
+}
@@ -20,10 +20,13 @@ import (
 	ctrl "sigs.k8s.io/controller-runtime"
 
 	"github.com/oam-dev/kubevela/pkg/controller/core.oam.dev/v1beta1/application"
+	"github.com/oam-dev/kubevela/pkg/controller/core.oam.dev/v1beta1/applicationsubscription"
 	"github.com/oam-dev/kubevela/pkg/controller/core.oam.dev/v1beta1/core/components/componentdefinition"
 	"github.com/oam-dev/kubevela/pkg/controller/core.oam.dev/v1beta1/core/policies/policydefinition"
 	"github.com/oam-dev/kubevela/pkg/controller/core.oam.dev/v1beta1/core/traits/traitdefinition"
 	"github.com/oam-dev/kubevela/pkg/controller/core.oam.dev/v1beta1/core/workflow/workflowstepdefinition"
+	"github.com/oam-dev/kubevela/pkg/controller/core.oam.dev/v1beta1/eventexporter"
+	"github.com/oam-dev/kubevela/pkg/controller/core.oam.dev/v1beta1/privilegeaudit"
 
 	controller "github.com/oam-dev/kubevela/pkg/controller/core.oam.dev"
 )
@@ -32,6 +35,7 @@ import (
 func Setup(mgr ctrl.Manager, args controller.Args) error {
 	for _, setup := range []func(ctrl.Manager, controller.Args) error{
 		application.Setup, traitdefinition.Setup, componentdefinition.Setup, policydefinition.Setup, workflowstepdefinition.Setup,
+		privilegeaudit.Setup, eventexporter.Setup, applicationsubscription.Setup,
 	} {
 		if err := setup(mgr, args); err != nil {
 			return err
@@ -0,0 +1,330 @@
+/*
+Copyright 2026 The KubeVela Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package definition
+
+import (
+	"container/list"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"strconv"
+	"sync"
+
+	"cuelang.org/go/cue"
+	"cuelang.org/go/cue/cuecontext"
+	"cuelang.org/go/cue/parser"
+	"github.com/kubevela/pkg/cue/cuex"
+	"github.com/kubevela/workflow/pkg/cue/model/sets"
+	"github.com/kubevela/workflow/pkg/cue/model/value"
+	"github.com/kubevela/workflow/pkg/cue/process"
+
+	"github.com/oam-dev/kubevela/pkg/monitor/metrics"
+	"github.com/oam-dev/kubevela/pkg/oam/util"
+)
+
+const (
+	// DefaultTemplateCacheMaxBytes is the default memory bound of the compiled template cache
+	DefaultTemplateCacheMaxBytes int64 = 256 << 20
+	// templateMemoryFactor estimates the memory held by a compiled template from the size of its source
+	templateMemoryFactor = 32
+	// templateMaxIdleInstances bounds the idle compiled instances kept for one template
+	templateMaxIdleInstances = 16
+	// templateMaxInstanceUses bounds the renderings done with one compiled instance, as the cue runtime of
+	// the instance keeps the labels seen in the parameters and contexts
+	templateMaxInstanceUses = 1000
+)
+
+// DefaultTemplateCache is the compiled template cache used for rendering the components and traits
+var DefaultTemplateCache = NewTemplateCache(DefaultTemplateCacheMaxBytes)
+
+// TemplateCache caches the compiled templates of definitions, so that rendering only unifies the parameter and
+// the context on top of the compiled template instead of compiling the whole template again. The values from
+// one cue context are not safe for concurrent use, therefore each compiled instance is used by one rendering at
+// a time and several instances of the same template might be cached. The cache is bounded by the estimated
+// memory of the idle instances and evicts the least recently used templates.
+type TemplateCache struct {
+	mu       sync.Mutex
+	maxBytes int64
+	bytes    int64
+	lru      *list.List
+	entries  map[string]*list.Element
+}
+
+type templateCacheEntry struct {
+	key       string
+	source    string
+	instances []*templateInstance
+}
+
+type templateInstance struct {
+	source string
+	value  cue.Value
+	// resolve indicates whether the template imports the packages of the provider functions
+	resolve bool
+	uses    int
+}
+
+// NewTemplateCache creates a compiled template cache bounded by the given estimated memory in bytes.
+// A non-positive bound disables the cache.
+func NewTemplateCache(maxBytes int64) *TemplateCache {
+	return &TemplateCache{maxBytes: maxBytes, lru: list.New(), entries: map[string]*list.Element{}}
+}
+
+// SetMaxBytes updates the memory bound of the cache, evicting templates if necessary
+func (c *TemplateCache) SetMaxBytes(maxBytes int64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.maxBytes = maxBytes
+	c.evict()
+}
+
+// Enabled checks if the cache is enabled
+func (c *TemplateCache) Enabled() bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.maxBytes > 0
+}
+
+// Size returns the estimated memory of the cached templates in bytes
+func (c *TemplateCache) Size() int64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.bytes
+}
+
+// Len returns the number of the cached templates
+func (c *TemplateCache) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.lru.Len()
+}
+
+// TemplateCacheKey returns the key of the template in the cache. The revision hash of the definition is used
+// if provided, otherwise the hash of the template source.
+func TemplateCacheKey(revisionHash string, source string) string {
+	if revisionHash != "" {
+		return revisionHash
+	}
+	sum := sha256.Sum256([]byte(source))
+	return hex.EncodeToString(sum[:])
+}
+
+func instanceCost(source string) int64 {
+	return int64(len(source)) * templateMemoryFactor
+}
+
+// acquire checks out an idle compiled instance of the template, or compiles a new one. The instance must be
+// given back by release once nothing refers to the values derived from it.
+func (c *TemplateCache) acquire(ctx context.Context, key string, source string) (*templateInstance, error) {
+	c.mu.Lock()
+	if elem, ok := c.entries[key]; ok {
+		entry := elem.Value.(*templateCacheEntry)
+		if entry.source == source && len(entry.instances) > 0 {
+			inst := entry.instances[len(entry.instances)-1]
+			entry.instances = entry.instances[:len(entry.instances)-1]
+			if len(entry.instances) == 0 {
+				c.lru.Remove(elem)
+				delete(c.entries, key)
+			} else {
+				c.lru.MoveToFront(elem)
+			}
+			c.bytes -= instanceCost(source)
+			c.mu.Unlock()
+			metrics.TemplateCacheRequestCounter.WithLabelValues("hit").Inc()
+			metrics.TemplateCacheSizeGauge.Set(float64(c.Size()))
+			return inst, nil
+		}
+	}
+	c.mu.Unlock()
+	metrics.TemplateCacheRequestCounter.WithLabelValues("miss").Inc()
+	// provider functions are resolved after the parameter and context are filled
+	compiler := cuex.DefaultCompiler.Get()
+	val, err := compiler.CompileStringWithOptions(ctx, source, cuex.DisableResolveProviderFunctions{})
+	if err != nil {
+		return nil, err
+	}
+	return &templateInstance{source: source, value: val, resolve: importsProviders(compiler, source)}, nil
+}
+
+// importsProviders checks if the template imports any package of the compiler, which might call provider functions
+func importsProviders(compiler *cuex.Compiler, source string) bool {
+	f, err := parser.ParseFile("-", source, parser.ImportsOnly)
+	if err != nil {
+		return true
+	}
+	packages := map[string]bool{}
+	for _, inst := range compiler.GetImports() {
+		packages[inst.ImportPath] = true
+	}
+	for _, spec := range f.Imports {
+		if path, err := strconv.Unquote(spec.Path.Value); err != nil || packages[path] {
+			return true
+		}
+	}
+	return false
+}
+
+// release gives the instance back to the cache. Instances that are not reusable are dropped.
+func (c *TemplateCache) release(key string, inst *templateInstance, reusable bool) {
+	inst.uses++
+	if !reusable || inst.uses >= templateMaxInstanceUses {
+		return
+	}
+	c.mu.Lock()
+	defer func() {
+		c.mu.Unlock()
+		metrics.TemplateCacheSizeGauge.Set(float64(c.Size()))
+	}()
+	cost := instanceCost(inst.source)
+	if cost > c.maxBytes {
+		return
+	}
+	var entry *templateCacheEntry
+	if elem, ok := c.entries[key]; ok {
+		entry = elem.Value.(*templateCacheEntry)
+		if entry.source != inst.source {
+			// the template of the key changes, drop the outdated instances
+			c.bytes -= instanceCost(entry.source) * int64(len(entry.instances))
+			entry.source, entry.instances = inst.source, nil
+		}
+		c.lru.MoveToFront(elem)
+	} else {
+		entry = &templateCacheEntry{key: key, source: inst.source}
+		c.entries[key] = c.lru.PushFront(entry)
+	}
+	if len(entry.instances) >= templateMaxIdleInstances {
+		return
+	}
+	entry.instances = append(entry.instances, inst)
+	c.bytes += cost
+	c.evict()
+}
+
+// evict drops the least recently used templates until the cache fits the memory bound
+func (c *TemplateCache) evict() {
+	for c.bytes > c.maxBytes && c.lru.Len() > 0 {
+		elem := c.lru.Back()
+		entry := elem.Value.(*templateCacheEntry)
+		c.lru.Remove(elem)
+		delete(c.entries, entry.key)
+		c.bytes -= instanceCost(entry.source) * int64(len(entry.instances))
+		metrics.TemplateCacheEvictionCounter.Inc()
+	}
+}
+
+// templateValue is the template compiled together with the parameter and context
+type templateValue struct {
+	cue.Value
+	cache    *TemplateCache
+	key      string
+	inst     *templateInstance
+	reusable bool
+}
+
+// compile compiles the template together with the parameter and context. If the compiled template cache is
+// enabled, the parameter and context are unified on top of the cached template.
+func (d *def) compile(ctx process.Context, template string, paramAndContext string) (*templateValue, error) {
+	cache := DefaultTemplateCache
+	if !cache.Enabled() {
+		val, err := cuex.DefaultCompiler.Get().CompileString(ctx.GetCtx(), template+"\n"+paramAndContext)
+		if err != nil {
+			return nil, err
+		}
+		return &templateValue{Value: val}, nil
+	}
+	key := TemplateCacheKey(d.revisionHash, template)
+	inst, err := cache.acquire(ctx.GetCtx(), key, template)
+	if err != nil {
+		return nil, err
+	}
+	tv := &templateValue{cache: cache, key: key, inst: inst}
+	pv := inst.value.Context().CompileString(paramAndContext)
+	if err = pv.Err(); err != nil {
+		tv.release()
+		return nil, err
+	}
+	tv.Value = inst.value.Unify(pv)
+	if inst.resolve {
+		if tv.Value, err = cuex.DefaultCompiler.Get().Resolve(ctx.GetCtx(), tv.Value); err != nil {
+			tv.release()
+			return nil, err
+		}
+	}
+	return tv, nil
+}
+
+// release gives the compiled template back to the cache. The compiled template is reused only if the values
+// derived from it are detached.
+func (tv *templateValue) release() {
+	if tv.inst != nil {
+		tv.cache.release(tv.key, tv.inst, tv.reusable)
+		tv.inst = nil
+	}
+}
+
+// detach rebuilds the values in new cue contexts, so that they no longer refer to the cached template and the
+// template can be reused once released. The values are exported in the same way as the base is exported when
+// patched by traits. It returns nil if the template is not cached or any of the values is not concrete, and
+// the values shall be kept as is to report the errors.
+func (tv *templateValue) detach(values []namedValue) []namedValue {
+	if tv.inst == nil {
+		return nil
+	}
+	detached := make([]namedValue, 0, len(values))
+	for _, v := range values {
+		if !v.Exists() || v.Validate(cue.Concrete(true), cue.Final()) != nil {
+			return nil
+		}
+		f, err := sets.ToFile(v.Syntax(cue.Docs(true), cue.ResolveReferences(true)))
+		if err != nil {
+			return nil
+		}
+		val := cuecontext.New().BuildFile(f)
+		if val.Err() != nil {
+			return nil
+		}
+		detached = append(detached, namedValue{Name: v.Name, Value: val})
+	}
+	tv.reusable = true
+	return detached
+}
+
+// namedValue is a value with its field name
+type namedValue struct {
+	cue.Value
+	Name string
+}
+
+// lookupOutputs returns the resources in the outputs of the template
+func lookupOutputs(val cue.Value) ([]namedValue, error) {
+	outputs := val.LookupPath(value.FieldPath(OutputsFieldName))
+	if !outputs.Exists() {
+		return nil, nil
+	}
+	iter, err := outputs.Fields(cue.Definitions(true), cue.Hidden(true), cue.All())
+	if err != nil {
+		return nil, err
+	}
+	var values []namedValue
+	for iter.Next() {
+		if iter.Selector().IsDefinition() || iter.Selector().PkgPath() != "" || iter.IsOptional() {
+			continue
+		}
+		values = append(values, namedValue{Name: util.GetIteratorLabel(*iter), Value: iter.Value()})
+	}
+	return values, nil
+}
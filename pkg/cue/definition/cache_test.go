@@ -0,0 +1,298 @@
+/*
+Copyright 2026 The KubeVela Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package definition
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+
+	"cuelang.org/go/cue"
+	"github.com/kubevela/pkg/cue/cuex"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/yaml"
+
+	"github.com/oam-dev/kubevela/apis/types"
+	"github.com/oam-dev/kubevela/pkg/cue/process"
+)
+
+// loadChartTemplate loads the cue template of the built-in definition from the chart
+func loadChartTemplate(t testing.TB, name string) string {
+	bs, err := os.ReadFile(filepath.Join("../../../charts/vela-core/templates/defwithtemplate", name+".yaml"))
+	require.NoError(t, err)
+	var lines []string
+	for _, line := range strings.Split(string(bs), "\n") {
+		if !strings.Contains(line, "{{") {
+			lines = append(lines, line)
+		}
+	}
+	obj := map[string]interface{}{}
+	require.NoError(t, yaml.Unmarshal([]byte(strings.Join(lines, "\n")), &obj))
+	template, _, err := unstructured.NestedString(obj, "spec", "schematic", "cue", "template")
+	require.NoError(t, err)
+	require.NotEmpty(t, template)
+	return template
+}
+
+type renderTrait struct {
+	name     string
+	template string
+	params   map[string]interface{}
+}
+
+// renderWithTraits renders the component with the traits and returns the resources rendered
+func renderWithTraits(t testing.TB, name string, template string, params map[string]interface{}, traits ...renderTrait) []*unstructured.Unstructured {
+	ctx := process.NewContext(process.ContextData{
+		AppName:         "app",
+		CompName:        name,
+		Namespace:       "default",
+		AppRevisionName: "app-v1",
+		ClusterVersion:  types.ClusterVersion{Minor: "30"},
+	})
+	require.NoError(t, NewWorkloadAbstractEngine(name).Complete(ctx, template, params))
+	for _, trait := range traits {
+		require.NoError(t, NewTraitAbstractEngine(trait.name).Complete(ctx, trait.template, trait.params))
+	}
+	base, auxiliaries := ctx.Output()
+	obj, err := base.Unstructured()
+	require.NoError(t, err)
+	objs := []*unstructured.Unstructured{obj}
+	for _, aux := range auxiliaries {
+		obj, err := aux.Ins.Unstructured()
+		require.NoError(t, err)
+		objs = append(objs, obj)
+	}
+	return objs
+}
+
+func withTemplateCache(cache *TemplateCache, fn func()) {
+	origin := DefaultTemplateCache
+	DefaultTemplateCache = cache
+	defer func() { DefaultTemplateCache = origin }()
+	fn()
+}
+
+func TestTemplateCache(t *testing.T) {
+	r := require.New(t)
+	ctx := context.Background()
+	source := renderTemplate(`output: {kind: "ConfigMap", data: key: parameter.value}`)
+	cost := instanceCost(source)
+	cache := NewTemplateCache(cost * 2)
+	r.True(cache.Enabled())
+
+	// miss, and then reused
+	inst, err := cache.acquire(ctx, "a", source)
+	r.NoError(err)
+	cache.release("a", inst, true)
+	r.Equal(1, cache.Len())
+	r.Equal(cost, cache.Size())
+	hit, err := cache.acquire(ctx, "a", source)
+	r.NoError(err)
+	r.Same(inst, hit)
+	r.Equal(0, cache.Len())
+	r.Equal(int64(0), cache.Size())
+
+	// not reusable instances are dropped
+	cache.release("a", hit, false)
+	r.Equal(0, cache.Len())
+
+	// the outdated instances are dropped if the template of the key changes
+	inst, err = cache.acquire(ctx, "a", source)
+	r.NoError(err)
+	cache.release("a", inst, true)
+	changed := renderTemplate(`output: {kind: "Secret", data: key: parameter.value}`)
+	other, err := cache.acquire(ctx, "a", changed)
+	r.NoError(err)
+	r.NotSame(inst, other)
+	cache.release("a", other, true)
+	r.Equal(1, cache.Len())
+	r.Equal(instanceCost(changed), cache.Size())
+
+	// the least recently used templates are evicted
+	for _, key := range []string{"b", "c"} {
+		inst, err = cache.acquire(ctx, key, source)
+		r.NoError(err)
+		cache.release(key, inst, true)
+	}
+	r.Equal(2, cache.Len())
+	r.LessOrEqual(cache.Size(), cost*2)
+	_, found := cache.entries["a"]
+	r.False(found)
+
+	cache.SetMaxBytes(0)
+	r.False(cache.Enabled())
+	r.Equal(0, cache.Len())
+	r.Equal(int64(0), cache.Size())
+}
+
+func TestTemplateCacheKey(t *testing.T) {
+	r := require.New(t)
+	r.Equal("hash", TemplateCacheKey("hash", "a: 1"))
+	r.Equal(TemplateCacheKey("", "a: 1"), TemplateCacheKey("", "a: 1"))
+	r.NotEqual(TemplateCacheKey("", "a: 1"), TemplateCacheKey("", "a: 2"))
+}
+
+func TestImportsProviders(t *testing.T) {
+	r := require.New(t)
+	compiler := cuex.DefaultCompiler.Get()
+	r.False(importsProviders(compiler, "output: {}"))
+	r.False(importsProviders(compiler, "import \"strings\"\noutput: name: strings.ToLower(\"A\")"))
+	imports := compiler.GetImports()
+	r.NotEmpty(imports)
+	r.True(importsProviders(compiler, fmt.Sprintf("import (\n\t\"strings\"\n\t%q\n)\noutput: {}", imports[0].ImportPath)))
+}
+
+func TestCachedTemplateRendering(t *testing.T) {
+	webservice := loadChartTemplate(t, "webservice")
+	traits := []renderTrait{{
+		name:     "scaler",
+		template: loadChartTemplate(t, "scaler"),
+		params:   map[string]interface{}{"replicas": 3},
+	}, {
+		name:     "labels",
+		template: loadChartTemplate(t, "labels"),
+		params:   map[string]interface{}{"team": "platform"},
+	}, {
+		name:     "sidecar",
+		template: loadChartTemplate(t, "sidecar"),
+		params:   map[string]interface{}{"name": "proxy", "image": "envoy"},
+	}, {
+		name:     "expose",
+		template: loadChartTemplate(t, "expose"),
+		params:   map[string]interface{}{"port": []interface{}{80}},
+	}}
+	params := func(i int) map[string]interface{} {
+		return map[string]interface{}{
+			"image": fmt.Sprintf("nginx:1.%d", i),
+			"ports": []interface{}{map[string]interface{}{"port": 80, "expose": true}},
+			"env":   []interface{}{map[string]interface{}{"name": "INDEX", "value": fmt.Sprint(i)}},
+		}
+	}
+
+	var expected [][]*unstructured.Unstructured
+	withTemplateCache(NewTemplateCache(0), func() {
+		for i := 0; i < 3; i++ {
+			expected = append(expected, renderWithTraits(t, "web", webservice, params(i), traits...))
+		}
+	})
+
+	cache := NewTemplateCache(DefaultTemplateCacheMaxBytes)
+	withTemplateCache(cache, func() {
+		for round := 0; round < 2; round++ {
+			for i := 0; i < 3; i++ {
+				require.Equal(t, expected[i], renderWithTraits(t, "web", webservice, params(i), traits...))
+			}
+		}
+		require.Equal(t, len(traits)+1, cache.Len())
+
+		// renderings of the same template run concurrently with different instances
+		var wg sync.WaitGroup
+		for i := 0; i < 8; i++ {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				objs := renderWithTraits(t, "web", webservice, params(i%3), traits...)
+				require.Equal(t, expected[i%3], objs)
+			}(i)
+		}
+		wg.Wait()
+	})
+}
+
+func TestCachedTemplateRenderingErrors(t *testing.T) {
+	r := require.New(t)
+	template := `
+output: {
+	apiVersion: "v1"
+	kind:       "ConfigMap"
+	metadata: name: context.name
+	data: key: parameter.value
+}
+parameter: value?: string
+`
+	cache := NewTemplateCache(DefaultTemplateCacheMaxBytes)
+	withTemplateCache(cache, func() {
+		ctx := process.NewContext(process.ContextData{AppName: "app", CompName: "comp", Namespace: "default"})
+		r.NoError(NewWorkloadAbstractEngine("comp").Complete(ctx, template, map[string]interface{}{}))
+		// the incomplete output is kept with the template for error reporting, and the template is not reused
+		r.NotNil(ctx.GetData(GetWorkloadTemplateKey("comp")))
+		base, _ := ctx.Output()
+		_, err := base.Unstructured()
+		r.Error(err)
+		r.Equal(0, cache.Len())
+
+		ctx = process.NewContext(process.ContextData{AppName: "app", CompName: "comp", Namespace: "default"})
+		r.NoError(NewWorkloadAbstractEngine("comp").Complete(ctx, template, map[string]interface{}{"value": "v"}))
+		r.Equal(1, cache.Len())
+		// the detached rendering compiles the template again for the error context
+		full, ok := GetWorkloadTemplate(ctx, "comp")
+		r.True(ok)
+		name, err := full.LookupPath(cue.ParsePath("output.metadata.name")).String()
+		r.NoError(err)
+		r.Equal("comp", name)
+
+		ctx = process.NewContext(process.ContextData{AppName: "app", CompName: "comp", Namespace: "default"})
+		// the instance of a failed rendering is dropped
+		r.Error(NewWorkloadAbstractEngine("comp").Complete(ctx, template, map[string]interface{}{"value": 1}))
+		r.Equal(0, cache.Len())
+	})
+}
+
+func benchmarkRender(b *testing.B, cache *TemplateCache, parallel bool) {
+	webservice := loadChartTemplate(b, "webservice")
+	scaler := renderTrait{name: "scaler", template: loadChartTemplate(b, "scaler"), params: map[string]interface{}{"replicas": 3}}
+	params := map[string]interface{}{
+		"image": "nginx",
+		"ports": []interface{}{map[string]interface{}{"port": 80, "expose": true}},
+	}
+	withTemplateCache(cache, func() {
+		b.ReportAllocs()
+		b.ResetTimer()
+		if !parallel {
+			for i := 0; i < b.N; i++ {
+				renderWithTraits(b, "web", webservice, params, scaler)
+			}
+			return
+		}
+		b.RunParallel(func(pb *testing.PB) {
+			for pb.Next() {
+				renderWithTraits(b, "web", webservice, params, scaler)
+			}
+		})
+	})
+}
+
+func BenchmarkRenderWithoutTemplateCache(b *testing.B) {
+	benchmarkRender(b, NewTemplateCache(0), false)
+}
+
+func BenchmarkRenderWithTemplateCache(b *testing.B) {
+	benchmarkRender(b, NewTemplateCache(DefaultTemplateCacheMaxBytes), false)
+}
+
+func BenchmarkRenderParallelWithoutTemplateCache(b *testing.B) {
+	benchmarkRender(b, NewTemplateCache(0), true)
+}
+
+func BenchmarkRenderParallelWithTemplateCache(b *testing.B) {
+	benchmarkRender(b, NewTemplateCache(DefaultTemplateCacheMaxBytes), true)
+}
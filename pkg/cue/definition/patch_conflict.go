@@ -0,0 +1,361 @@
+/*
+Copyright 2026 The KubeVela Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package definition
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"cuelang.org/go/cue"
+	"cuelang.org/go/cue/ast"
+	"cuelang.org/go/cue/cuecontext"
+	"github.com/kubevela/workflow/pkg/cue/model"
+	"github.com/kubevela/workflow/pkg/cue/model/sets"
+	"github.com/kubevela/workflow/pkg/cue/process"
+	"github.com/pkg/errors"
+	"k8s.io/klog/v2"
+
+	"github.com/oam-dev/kubevela/apis/core.oam.dev/v1beta1"
+)
+
+// patchRecordKey is the context key of the fields patched by the traits of the component
+const patchRecordKey = TemplateContextPrefix + "patches"
+
+// WithConflictPolicy sets the policy of the trait for the fields patched with different values by other traits
+func WithConflictPolicy(policy v1beta1.TraitConflictPolicy) EngineOption {
+	return func(d *def) {
+		d.conflictPolicy = policy
+	}
+}
+
+// PatchConflict is a field of the workload patched with different values by two traits of the same component
+type PatchConflict struct {
+	// Path is the path of the field. The list elements merged by a patch key are written as list[key=value].
+	Path string `json:"path"`
+	// Traits are the trait which patched the field first and the trait which patched it then
+	Traits [2]string `json:"traits"`
+	// Policy is the conflict policy applied
+	Policy v1beta1.TraitConflictPolicy `json:"policy"`
+}
+
+// String returns the description of the conflict
+func (c PatchConflict) String() string {
+	return fmt.Sprintf("traits %s and %s patch %s with different values", c.Traits[0], c.Traits[1], c.Path)
+}
+
+type patchOwner struct {
+	trait  string
+	policy v1beta1.TraitConflictPolicy
+}
+
+// patchRecord is kept in the process context through the evaluation of the traits of a component
+type patchRecord struct {
+	owners    map[string]patchOwner
+	Conflicts []PatchConflict `json:"conflicts,omitempty"`
+}
+
+// GetPatchConflicts returns the conflicts found between the patches of the traits evaluated in the context
+func GetPatchConflicts(ctx process.Context) []PatchConflict {
+	if record, ok := ctx.GetData(patchRecordKey).(*patchRecord); ok {
+		return record.Conflicts
+	}
+	return nil
+}
+
+// conflictSeverity orders the policies from the least to the most strict, an empty policy is error
+var conflictSeverity = map[v1beta1.TraitConflictPolicy]int{
+	v1beta1.TraitConflictLastWins: 0,
+	v1beta1.TraitConflictWarn:     1,
+	v1beta1.TraitConflictError:    2,
+	"":                            2,
+}
+
+func stricterPolicy(a, b v1beta1.TraitConflictPolicy) v1beta1.TraitConflictPolicy {
+	if conflictSeverity[b] > conflictSeverity[a] {
+		a = b
+	}
+	if a == "" {
+		return v1beta1.TraitConflictError
+	}
+	return a
+}
+
+// checkPatchConflicts compares the fields patched by the trait with the fields patched by the former traits of
+// the component. A conflicting field fails the evaluation, or is overridden by the patch as the policy says.
+// The fields patched by the jsonPatch, jsonMergePatch, replace and retainKeys strategies override by design.
+func (td *traitDef) checkPatchConflicts(ctx process.Context, patcher cue.Value) error {
+	if sets.IsJSONPatch(patcher) || sets.IsJSONMergePatch(patcher) {
+		return nil
+	}
+	record, ok := ctx.GetData(patchRecordKey).(*patchRecord)
+	if !ok {
+		record = &patchRecord{owners: map[string]patchOwner{}}
+		ctx.PushData(patchRecordKey, record)
+	}
+	base, _ := ctx.Output()
+	obj, err := base.Unstructured()
+	if err != nil {
+		// the workload is not concrete yet, the patch is left to the unification
+		return nil //nolint:nilerr
+	}
+
+	var conflicts []string
+	overridden := false
+	for _, leaf := range patchLeaves(patcher, patchTags(patcher.Doc())[sets.TagPatchKey], nil, nil) {
+		path := leaf.path.String()
+		owner, patched := record.owners[path]
+		record.owners[path] = patchOwner{trait: td.name, policy: td.conflictPolicy}
+		if !patched || owner.trait == td.name {
+			continue
+		}
+		current, found := leaf.path.lookup(obj.Object)
+		if !found || sameValue(current, leaf.value) {
+			continue
+		}
+		conflict := PatchConflict{Path: path, Traits: [2]string{owner.trait, td.name}, Policy: stricterPolicy(owner.policy, td.conflictPolicy)}
+		record.Conflicts = append(record.Conflicts, conflict)
+		switch conflict.Policy {
+		case v1beta1.TraitConflictError:
+			conflicts = append(conflicts, conflict.String())
+			continue
+		case v1beta1.TraitConflictWarn:
+			klog.Warningf("%s, the patch of trait %s is applied", conflict.String(), td.name)
+		default:
+		}
+		overridden = leaf.path.set(obj.Object, leaf.value) || overridden
+	}
+	if len(conflicts) > 0 {
+		return errors.Errorf("conflicting patch of trait %s: %s", td.name, strings.Join(conflicts, "; "))
+	}
+	if !overridden {
+		return nil
+	}
+	bs, err := json.Marshal(obj.Object)
+	if err != nil {
+		return errors.Wrapf(err, "failed to override the fields patched by trait %s", td.name)
+	}
+	v := cuecontext.New().CompileBytes(bs)
+	if err = v.Err(); err != nil {
+		return errors.Wrapf(err, "failed to override the fields patched by trait %s", td.name)
+	}
+	newBase, err := model.NewBase(v)
+	if err != nil {
+		return err
+	}
+	return ctx.SetBase(newBase)
+}
+
+// patchPathElem is a field, or a list element given by the patch key or by the index
+type patchPathElem struct {
+	field    string
+	key, val string
+	index    int
+}
+
+type patchPath []patchPathElem
+
+// String returns the path such as spec.template.spec.containers[name=main].args[0]
+func (p patchPath) String() string {
+	sb := strings.Builder{}
+	for _, e := range p {
+		switch {
+		case e.key != "":
+			sb.WriteString(fmt.Sprintf("[%s=%s]", e.key, e.val))
+		case e.field == "":
+			sb.WriteString(fmt.Sprintf("[%d]", e.index))
+		default:
+			if sb.Len() > 0 {
+				sb.WriteString(".")
+			}
+			if ast.IsValidIdent(e.field) {
+				sb.WriteString(e.field)
+			} else {
+				sb.WriteString(strconv.Quote(e.field))
+			}
+		}
+	}
+	return sb.String()
+}
+
+// locate returns the list or the map holding the last element of the path
+func (p patchPath) locate(obj interface{}) (interface{}, bool) {
+	current := obj
+	for _, e := range p[:len(p)-1] {
+		next, found := e.get(current)
+		if !found {
+			return nil, false
+		}
+		current = next
+	}
+	return current, true
+}
+
+func (e patchPathElem) get(obj interface{}) (interface{}, bool) {
+	if e.field != "" {
+		m, ok := obj.(map[string]interface{})
+		if !ok {
+			return nil, false
+		}
+		v, found := m[e.field]
+		return v, found
+	}
+	list, ok := obj.([]interface{})
+	if !ok {
+		return nil, false
+	}
+	i := e.position(list)
+	if i < 0 {
+		return nil, false
+	}
+	return list[i], true
+}
+
+func (e patchPathElem) position(list []interface{}) int {
+	if e.key == "" {
+		if e.index < len(list) {
+			return e.index
+		}
+		return -1
+	}
+	for i, item := range list {
+		if m, ok := item.(map[string]interface{}); ok && fmt.Sprint(m[e.key]) == e.val {
+			return i
+		}
+	}
+	return -1
+}
+
+func (p patchPath) lookup(obj interface{}) (interface{}, bool) {
+	parent, found := p.locate(obj)
+	if !found {
+		return nil, false
+	}
+	return p[len(p)-1].get(parent)
+}
+
+func (p patchPath) set(obj interface{}, value interface{}) bool {
+	parent, found := p.locate(obj)
+	if !found {
+		return false
+	}
+	last := p[len(p)-1]
+	switch v := parent.(type) {
+	case map[string]interface{}:
+		if last.field == "" {
+			return false
+		}
+		v[last.field] = value
+		return true
+	case []interface{}:
+		if i := last.position(v); i >= 0 && last.field == "" {
+			v[i] = value
+			return true
+		}
+	}
+	return false
+}
+
+type patchLeaf struct {
+	path  patchPath
+	value interface{}
+}
+
+// patchLeaves collects the concrete scalar fields of the patch. The list elements are identified by the patch key
+// inherited from the parent fields, or by their index.
+func patchLeaves(v cue.Value, patchKey string, path patchPath, leaves []patchLeaf) []patchLeaf {
+	switch v.IncompleteKind() {
+	case cue.StructKind:
+		it, err := v.Fields()
+		if err != nil {
+			return leaves
+		}
+		for it.Next() {
+			tags := patchTags(it.Value().Doc())
+			if strategy := tags[sets.TagPatchStrategy]; strategy == sets.StrategyRetainKeys || strategy == sets.StrategyReplace {
+				continue
+			}
+			key := patchKey
+			if k, ok := tags[sets.TagPatchKey]; ok {
+				key = k
+			}
+			leaves = patchLeaves(it.Value(), key, append(path[:len(path):len(path)], patchPathElem{field: it.Selector().Unquoted()}), leaves)
+		}
+	case cue.ListKind:
+		it, err := v.List()
+		if err != nil {
+			return leaves
+		}
+		for i := 0; it.Next(); i++ {
+			elem := patchPathElem{index: i}
+			if patchKey != "" {
+				val, err := it.Value().LookupPath(cue.ParsePath(patchKey)).String()
+				if err != nil {
+					// the elements without the patch key are appended
+					continue
+				}
+				elem = patchPathElem{key: patchKey, val: val}
+			}
+			leaves = patchLeaves(it.Value(), patchKey, append(path[:len(path):len(path)], elem), leaves)
+		}
+	default:
+		if !v.IsConcrete() || len(path) == 0 {
+			return leaves
+		}
+		var value interface{}
+		if err := v.Decode(&value); err != nil {
+			return leaves
+		}
+		leaves = append(leaves, patchLeaf{path: path, value: value})
+	}
+	return leaves
+}
+
+// patchTags reads the +key=value markers of the comments, such as +patchKey=name
+func patchTags(groups []*ast.CommentGroup) map[string]string {
+	tags := map[string]string{}
+	for _, group := range groups {
+		for _, c := range group.List {
+			line := strings.TrimSpace(strings.TrimPrefix(c.Text, "//"))
+			if !strings.HasPrefix(line, "+") {
+				continue
+			}
+			if k, v, found := strings.Cut(line[1:], "="); found && len(strings.Fields(v)) == 1 {
+				tags[strings.TrimSpace(k)] = strings.TrimSpace(v)
+			}
+		}
+	}
+	return tags
+}
+
+// sameValue compares the values in their JSON form, so that the numbers of different types are equal
+func sameValue(a, b interface{}) bool {
+	normalize := func(v interface{}) interface{} {
+		bs, err := json.Marshal(v)
+		if err != nil {
+			return v
+		}
+		var n interface{}
+		if err = json.Unmarshal(bs, &n); err != nil {
+			return v
+		}
+		return n
+	}
+	return reflect.DeepEqual(normalize(a), normalize(b))
+}
@@ -0,0 +1,188 @@
+/*
+Copyright 2026 The KubeVela Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package definition
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/oam-dev/kubevela/apis/core.oam.dev/v1beta1"
+	"github.com/oam-dev/kubevela/pkg/cue/process"
+)
+
+const conflictWorkloadTemplate = `
+output: {
+	apiVersion: "apps/v1"
+	kind:       "Deployment"
+	spec: {
+		replicas: *1 | int
+		template: spec: containers: [{
+			name:  context.name
+			image: "nginx"
+			args: ["a", "b"]
+		}]
+	}
+}
+`
+
+const envTraitTemplate = `
+patch: spec: template: spec: {
+	// +patchKey=name
+	containers: [{
+		name: context.name
+		env: [{name: "MODE", value: parameter.mode}]
+	}]
+}
+parameter: mode: string
+`
+
+func TestTraitPatchConflicts(t *testing.T) {
+	type trait struct {
+		name     string
+		template string
+		params   map[string]interface{}
+		policy   v1beta1.TraitConflictPolicy
+	}
+	testCases := map[string]struct {
+		traits    []trait
+		conflicts []PatchConflict
+		err       string
+		replicas  int64
+		mode      string
+	}{
+		"different fields": {
+			traits: []trait{
+				{name: "scaler", template: `patch: spec: replicas: 2`},
+				{name: "env", template: envTraitTemplate, params: map[string]interface{}{"mode": "dev"}},
+			},
+			replicas: 2,
+			mode:     "dev",
+		},
+		"same value": {
+			traits: []trait{
+				{name: "scaler", template: `patch: spec: replicas: 2`},
+				{name: "hpa", template: `patch: spec: replicas: 2`},
+			},
+			replicas: 2,
+		},
+		"error by default": {
+			traits: []trait{
+				{name: "scaler", template: `patch: spec: replicas: 2`},
+				{name: "hpa", template: `patch: spec: replicas: 3`},
+			},
+			conflicts: []PatchConflict{{Path: "spec.replicas", Traits: [2]string{"scaler", "hpa"}, Policy: v1beta1.TraitConflictError}},
+			err:       "conflicting patch of trait hpa: traits scaler and hpa patch spec.replicas with different values",
+		},
+		"last wins": {
+			traits: []trait{
+				{name: "scaler", template: `patch: spec: replicas: 2`, policy: v1beta1.TraitConflictLastWins},
+				{name: "hpa", template: `patch: spec: replicas: 3`, policy: v1beta1.TraitConflictLastWins},
+			},
+			conflicts: []PatchConflict{{Path: "spec.replicas", Traits: [2]string{"scaler", "hpa"}, Policy: v1beta1.TraitConflictLastWins}},
+			replicas:  3,
+		},
+		"stricter policy": {
+			traits: []trait{
+				{name: "scaler", template: `patch: spec: replicas: 2`, policy: v1beta1.TraitConflictWarn},
+				{name: "hpa", template: `patch: spec: replicas: 3`, policy: v1beta1.TraitConflictLastWins},
+			},
+			conflicts: []PatchConflict{{Path: "spec.replicas", Traits: [2]string{"scaler", "hpa"}, Policy: v1beta1.TraitConflictWarn}},
+			replicas:  3,
+		},
+		"keyed list element": {
+			traits: []trait{
+				{name: "env", template: envTraitTemplate, params: map[string]interface{}{"mode": "dev"}, policy: v1beta1.TraitConflictWarn},
+				{name: "debug-env", template: envTraitTemplate, params: map[string]interface{}{"mode": "debug"}, policy: v1beta1.TraitConflictWarn},
+			},
+			conflicts: []PatchConflict{{Path: "spec.template.spec.containers[name=web].env[name=MODE].value", Traits: [2]string{"env", "debug-env"}, Policy: v1beta1.TraitConflictWarn}},
+			replicas:  1,
+			mode:      "debug",
+		},
+		"overriding strategy": {
+			traits: []trait{
+				{name: "scaler", template: `patch: spec: replicas: 2`},
+				{name: "hpa", template: `
+// +patchStrategy=retainKeys
+patch: spec: replicas: 3`},
+			},
+			replicas: 3,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			r := require.New(t)
+			ctx := process.NewContext(process.ContextData{AppName: "app", CompName: "web", Namespace: "default"})
+			r.NoError(NewWorkloadAbstractEngine("web").Complete(ctx, conflictWorkloadTemplate, nil))
+			var err error
+			for _, tr := range tc.traits {
+				if err = NewTraitAbstractEngine(tr.name, WithConflictPolicy(tr.policy)).Complete(ctx, tr.template, tr.params); err != nil {
+					break
+				}
+			}
+			r.Equal(tc.conflicts, GetPatchConflicts(ctx))
+			if tc.err != "" {
+				r.EqualError(err, tc.err)
+				return
+			}
+			r.NoError(err)
+			base, _ := ctx.Output()
+			obj, err := base.Unstructured()
+			r.NoError(err)
+			replicas, _, _ := unstructuredNestedInt64(obj.Object, "spec", "replicas")
+			r.Equal(tc.replicas, replicas)
+			containers := obj.Object["spec"].(map[string]interface{})["template"].(map[string]interface{})["spec"].(map[string]interface{})["containers"].([]interface{})
+			r.Len(containers, 1)
+			if tc.mode != "" {
+				env := containers[0].(map[string]interface{})["env"].([]interface{})
+				r.Len(env, 1)
+				r.Equal(tc.mode, env[0].(map[string]interface{})["value"])
+			}
+		})
+	}
+}
+
+func unstructuredNestedInt64(obj map[string]interface{}, fields ...string) (int64, bool, error) {
+	var current interface{} = obj
+	for _, f := range fields {
+		m, ok := current.(map[string]interface{})
+		if !ok {
+			return 0, false, nil
+		}
+		current = m[f]
+	}
+	n, ok := current.(int64)
+	return n, ok, nil
+}
+
+func TestPatchPath(t *testing.T) {
+	r := require.New(t)
+	path := patchPath{{field: "spec"}, {field: "containers"}, {key: "name", val: "main"}, {field: "args"}, {index: 1}, {field: "a-b"}}
+	r.Equal(`spec.containers[name=main].args[1]."a-b"`, path.String())
+
+	obj := map[string]interface{}{"spec": map[string]interface{}{"containers": []interface{}{
+		map[string]interface{}{"name": "main", "args": []interface{}{"x", map[string]interface{}{"a-b": "y"}}},
+	}}}
+	v, found := path.lookup(obj)
+	r.True(found)
+	r.Equal("y", v)
+	r.True(path.set(obj, "z"))
+	v, _ = path.lookup(obj)
+	r.Equal("z", v)
+	_, found = patchPath{{field: "spec"}, {field: "containers"}, {key: "name", val: "sidecar"}, {field: "image"}}.lookup(obj)
+	r.False(found)
+}
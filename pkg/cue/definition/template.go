@@ -26,6 +26,7 @@ import (
 	"k8s.io/apiserver/pkg/util/feature"
 	"k8s.io/klog/v2"
 
+	"github.com/oam-dev/kubevela/apis/core.oam.dev/v1beta1"
 	"github.com/oam-dev/kubevela/pkg/cue/definition/health"
 	"github.com/oam-dev/kubevela/pkg/features"
 
@@ -70,6 +71,33 @@ func GetWorkloadTemplateKey(name string) string {
 	return TemplateContextPrefix + "workload-" + name
 }
 
+// detachedTemplate is the workload template rendered with the compiled template cache. As the cached template is
+// reused by other renderings, it keeps the JSON of the template for the context of the traits, and compiles the
+// template again for the error context on demand.
+type detachedTemplate struct {
+	json    []byte
+	err     error
+	compile func() (cue.Value, error)
+}
+
+// MarshalJSON implements json.Marshaler
+func (t *detachedTemplate) MarshalJSON() ([]byte, error) {
+	return t.json, t.err
+}
+
+// GetWorkloadTemplate returns the compiled workload template stored in the context for the error context
+func GetWorkloadTemplate(ctx process.Context, name string) (cue.Value, bool) {
+	switch data := ctx.GetData(GetWorkloadTemplateKey(name)).(type) {
+	case cue.Value:
+		return data, true
+	case *detachedTemplate:
+		val, err := data.compile()
+		return val, err == nil
+	default:
+		return cue.Value{}, false
+	}
+}
+
 // GetTraitTemplateKey returns the context key for storing trait templates
 func GetTraitTemplateKey(name string) string {
 	return TemplateContextPrefix + "trait-" + name
@@ -89,7 +117,27 @@ type AbstractEngine interface {
 }
 
 type def struct {
-	name string
+	name           string
+	revisionHash   string
+	conflictPolicy v1beta1.TraitConflictPolicy
+}
+
+// EngineOption configures the AbstractEngine
+type EngineOption func(*def)
+
+// WithRevisionHash sets the revision hash of the definition, which identifies the compiled template in the cache
+func WithRevisionHash(hash string) EngineOption {
+	return func(d *def) {
+		d.revisionHash = hash
+	}
+}
+
+func newDef(name string, opts ...EngineOption) def {
+	d := def{name: name}
+	for _, opt := range opts {
+		opt(&d)
+	}
+	return d
 }
 
 type workloadDef struct {
@@ -97,11 +145,9 @@ type workloadDef struct {
 }
 
 // NewWorkloadAbstractEngine create Workload Definition AbstractEngine
-func NewWorkloadAbstractEngine(name string) AbstractEngine {
+func NewWorkloadAbstractEngine(name string, opts ...EngineOption) AbstractEngine {
 	return &workloadDef{
-		def: def{
-			name: name,
-		},
+		def: newDef(name, opts...),
 	}
 }
 
@@ -123,13 +169,12 @@ func (wd *workloadDef) Complete(ctx process.Context, abstractTemplate string, pa
 		return err
 	}
 
-	val, err := cuex.DefaultCompiler.Get().CompileString(ctx.GetCtx(), strings.Join([]string{
-		renderTemplate(abstractTemplate), paramFile, c,
-	}, "\n"))
-
+	tv, err := wd.compile(ctx, renderTemplate(abstractTemplate), paramFile+"\n"+c)
 	if err != nil {
 		return errors.WithMessagef(err, "failed to compile workload %s after merge parameter and context", wd.name)
 	}
+	defer tv.release()
+	val := tv.Value
 
 	var userErrors []string
 	if errs := val.LookupPath(value.FieldPath(ErrsFieldName)); errs.Exists() {
@@ -162,6 +207,23 @@ func (wd *workloadDef) Complete(ctx process.Context, abstractTemplate string, pa
 		return errors.New(strings.TrimRight(result.String(), "\n"))
 	}
 	output := val.LookupPath(value.FieldPath(OutputFieldName))
+	// we will support outputs for workload composition, and it will become trait in AppConfig.
+	outputs, err := lookupOutputs(val)
+	if err != nil {
+		return errors.WithMessagef(err, "invalid outputs of workload %s", wd.name)
+	}
+	// Store template for error context (use workload-specific key to avoid pollution)
+	if detached := tv.detach(append([]namedValue{{Value: output}}, outputs...)); detached != nil {
+		output, outputs = detached[0].Value, detached[1:]
+		source, goCtx := strings.Join([]string{renderTemplate(abstractTemplate), paramFile, c}, "\n"), ctx.GetCtx()
+		tmpl := &detachedTemplate{compile: func() (cue.Value, error) {
+			return cuex.DefaultCompiler.Get().CompileString(goCtx, source)
+		}}
+		tmpl.json, tmpl.err = val.MarshalJSON()
+		ctx.PushData(GetWorkloadTemplateKey(wd.name), tmpl)
+	} else {
+		ctx.PushData(GetWorkloadTemplateKey(wd.name), val)
+	}
 
 	base, err := model.NewBase(output)
 	if err != nil {
@@ -171,29 +233,12 @@ func (wd *workloadDef) Complete(ctx process.Context, abstractTemplate string, pa
 		return err
 	}
 
-	// Store template for error context (use workload-specific key to avoid pollution)
-	ctx.PushData(GetWorkloadTemplateKey(wd.name), val)
-
-	// we will support outputs for workload composition, and it will become trait in AppConfig.
-	outputs := val.LookupPath(value.FieldPath(OutputsFieldName))
-	if !outputs.Exists() {
-		return nil
-	}
-
-	iter, err := outputs.Fields(cue.Definitions(true), cue.Hidden(true), cue.All())
-	if err != nil {
-		return errors.WithMessagef(err, "invalid outputs of workload %s", wd.name)
-	}
-	for iter.Next() {
-		if iter.Selector().IsDefinition() || iter.Selector().PkgPath() != "" || iter.IsOptional() {
-			continue
-		}
-		other, err := model.NewOther(iter.Value())
-		name := util.GetIteratorLabel(*iter)
+	for _, o := range outputs {
+		other, err := model.NewOther(o.Value)
 		if err != nil {
-			return errors.WithMessagef(err, "invalid outputs(%s) of workload %s", name, wd.name)
+			return errors.WithMessagef(err, "invalid outputs(%s) of workload %s", o.Name, wd.name)
 		}
-		if err := ctx.AppendAuxiliaries(process.Auxiliary{Ins: other, Type: AuxiliaryWorkload, Name: name}); err != nil {
+		if err := ctx.AppendAuxiliaries(process.Auxiliary{Ins: other, Type: AuxiliaryWorkload, Name: o.Name}); err != nil {
 			return err
 		}
 	}
@@ -268,18 +313,16 @@ type traitDef struct {
 }
 
 // NewTraitAbstractEngine create Trait Definition AbstractEngine
-func NewTraitAbstractEngine(name string) AbstractEngine {
+func NewTraitAbstractEngine(name string, opts ...EngineOption) AbstractEngine {
 	return &traitDef{
-		def: def{
-			name: name,
-		},
+		def: newDef(name, opts...),
 	}
 }
 
 // Complete do trait definition's rendering
 // nolint:gocyclo
 func (td *traitDef) Complete(ctx process.Context, abstractTemplate string, params interface{}) error {
-	buff := abstractTemplate + "\n"
+	var buff string
 	if params != nil {
 		bt, err := json.Marshal(params)
 		if err != nil {
@@ -309,11 +352,17 @@ func (td *traitDef) Complete(ctx process.Context, abstractTemplate string, param
 
 	buff += c
 
-	val, err := cuex.DefaultCompiler.Get().CompileString(ctx.GetCtx(), buff)
-
+	// the template is compiled on its own to be cached, so the context and parameter must be declared in it
+	template := abstractTemplate
+	if DefaultTemplateCache.Enabled() {
+		template = renderTemplate(abstractTemplate)
+	}
+	tv, err := td.compile(ctx, template, buff)
 	if err != nil {
 		return errors.WithMessagef(err, "failed to compile trait %s after merge parameter and context", td.name)
 	}
+	defer tv.release()
+	val := tv.Value
 
 	var userErrors []string
 	if errs := val.LookupPath(value.FieldPath(ErrsFieldName)); errs.Exists() {
@@ -352,25 +401,20 @@ func (td *traitDef) Complete(ctx process.Context, abstractTemplate string, param
 			return errors.WithMessagef(err, "invalid process of trait %s", td.name)
 		}
 	}
-	outputs := val.LookupPath(value.FieldPath(OutputsFieldName))
-	if outputs.Exists() {
-
-		iter, err := outputs.Fields(cue.Definitions(true), cue.Hidden(true), cue.All())
+	outputs, err := lookupOutputs(val)
+	if err != nil {
+		return errors.WithMessagef(err, "invalid outputs of trait %s", td.name)
+	}
+	if detached := tv.detach(outputs); detached != nil {
+		outputs = detached
+	}
+	for _, o := range outputs {
+		other, err := model.NewOther(o.Value)
 		if err != nil {
-			return errors.WithMessagef(err, "invalid outputs of trait %s", td.name)
+			return errors.WithMessagef(err, "invalid outputs(resource=%s) of trait %s", o.Name, td.name)
 		}
-		for iter.Next() {
-			if iter.Selector().IsDefinition() || iter.Selector().PkgPath() != "" || iter.IsOptional() {
-				continue
-			}
-			other, err := model.NewOther(iter.Value())
-			name := util.GetIteratorLabel(*iter)
-			if err != nil {
-				return errors.WithMessagef(err, "invalid outputs(resource=%s) of trait %s", name, td.name)
-			}
-			if err := ctx.AppendAuxiliaries(process.Auxiliary{Ins: other, Type: td.name, Name: name}); err != nil {
-				return err
-			}
+		if err := ctx.AppendAuxiliaries(process.Auxiliary{Ins: other, Type: td.name, Name: o.Name}); err != nil {
+			return err
 		}
 	}
 
@@ -380,6 +424,11 @@ func (td *traitDef) Complete(ctx process.Context, abstractTemplate string, param
 		if base == nil {
 			return fmt.Errorf("patch trait %s into an invalid workload", td.name)
 		}
+		if err := td.checkPatchConflicts(ctx, patcher); err != nil {
+			return err
+		}
+		// the fields overridden by the conflict policy are set in a new base
+		base, _ = ctx.Output()
 		if err := base.Unify(patcher, sets.CreateUnifyOptionsForPatcher(patcher)...); err != nil {
 			return errors.WithMessagef(err, "invalid patch trait %s into workload", td.name)
 		}
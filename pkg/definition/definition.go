@@ -70,6 +70,7 @@ const (
 	policyDefType       = "policy"
 	workflowStepDefType = "workflow-step"
 	workloadDefType     = "workload"
+	guardrailDefType    = "guardrail"
 )
 
 var (
@@ -82,6 +83,7 @@ var (
 		policyDefType:       v1beta1.PolicyDefinitionKind,
 		workloadDefType:     v1beta1.WorkloadDefinitionKind,
 		workflowStepDefType: v1beta1.WorkflowStepDefinitionKind,
+		guardrailDefType:    v1beta1.GuardrailDefinitionKind,
 	}
 	// StringToDefinitionType converts user input to DefinitionType used in DefinitionRevisions
 	StringToDefinitionType = map[string]common.DefinitionType{
@@ -108,6 +110,7 @@ var (
 		v1beta1.PolicyDefinitionKind:       policyDefType,
 		v1beta1.WorkloadDefinitionKind:     workloadDefType,
 		v1beta1.WorkflowStepDefinitionKind: workflowStepDefType,
+		v1beta1.GuardrailDefinitionKind:    guardrailDefType,
 	}
 )
 
@@ -386,6 +389,8 @@ func validateSpec(spec map[string]interface{}, t string) error {
 		tpl = &v1beta1.PolicyDefinitionSpec{}
 	case workflowStepDefType:
 		tpl = &v1beta1.WorkflowStepDefinitionSpec{}
+	case guardrailDefType:
+		tpl = &v1beta1.GuardrailDefinitionSpec{}
 	default:
 	}
 	if tpl != nil {
@@ -656,6 +661,16 @@ func GetDefinitionDefaultSpec(kind string) map[string]interface{} {
 				},
 			},
 		}
+	case v1beta1.GuardrailDefinitionKind:
+		return map[string]interface{}{
+			"mode":  string(v1beta1.GuardrailEnforce),
+			"kinds": []interface{}{},
+			"schematic": map[string]interface{}{
+				"cue": map[string]interface{}{
+					"template": "violations: []\n",
+				},
+			},
+		}
 	}
 	return map[string]interface{}{}
 }
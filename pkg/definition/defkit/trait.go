@@ -42,6 +42,7 @@ type TraitDefinition struct {
 	baseDefinition                       // embedded common fields (name, description, params, template, etc.)
 	appliesToWorkloads []string          // e.g., ["deployments.apps", "statefulsets.apps"]
 	conflictsWith      []string          // traits that conflict with this one
+	conflictPolicy     string            // "error", "warn" or "last-wins" (default: "")
 	podDisruptive      bool              // whether applying this trait causes pod restart
 	stage              string            // "PreDispatch" or "PostDispatch" (default: "")
 	templateBlock      string            // raw CUE for template: block only (uses fluent API for header)
@@ -79,6 +80,13 @@ func (t *TraitDefinition) ConflictsWith(traits ...string) *TraitDefinition {
 	return t
 }
 
+// ConflictPolicy sets how a field patched by this trait and, with a different value,
+// by another trait of the same component is handled: "error", "warn" or "last-wins".
+func (t *TraitDefinition) ConflictPolicy(policy string) *TraitDefinition {
+	t.conflictPolicy = policy
+	return t
+}
+
 // PodDisruptive marks whether applying this trait causes pod restarts.
 func (t *TraitDefinition) PodDisruptive(disruptive bool) *TraitDefinition {
 	t.podDisruptive = disruptive
@@ -269,6 +277,9 @@ func (t *TraitDefinition) GetAppliesToWorkloads() []string { return t.appliesToW
 // GetConflictsWith returns traits that conflict with this one.
 func (t *TraitDefinition) GetConflictsWith() []string { return t.conflictsWith }
 
+// GetConflictPolicy returns the policy of the conflicting patches.
+func (t *TraitDefinition) GetConflictPolicy() string { return t.conflictPolicy }
+
 // IsPodDisruptive returns whether this trait is pod-disruptive.
 func (t *TraitDefinition) IsPodDisruptive() bool { return t.podDisruptive }
 
@@ -369,6 +380,11 @@ func (t *TraitDefinition) ToYAML() ([]byte, error) {
 		cr["spec"].(map[string]any)["stage"] = t.stage
 	}
 
+	// Add conflictPolicy if present
+	if t.conflictPolicy != "" {
+		cr["spec"].(map[string]any)["conflictPolicy"] = t.conflictPolicy
+	}
+
 	return yaml.Marshal(cr)
 }
 
@@ -465,6 +481,11 @@ func (g *TraitCUEGenerator) writeAttributes(sb *strings.Builder, t *TraitDefinit
 		sb.WriteString(fmt.Sprintf("%sconflictsWith: [%s]\n", indent, strings.Join(conflicts, ", ")))
 	}
 
+	// conflictPolicy (if set)
+	if t.GetConflictPolicy() != "" {
+		sb.WriteString(fmt.Sprintf("%sconflictPolicy: %q\n", indent, t.GetConflictPolicy()))
+	}
+
 	// status (customStatus and healthPolicy)
 	if t.GetCustomStatus() != "" || t.GetHealthPolicy() != "" {
 		sb.WriteString(fmt.Sprintf("%sstatus: {\n", indent))
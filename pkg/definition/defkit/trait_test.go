@@ -236,6 +236,15 @@ parameter: #PatchParams
 			Expect(cue).To(ContainSubstring(`conflictsWith: ["other-trait", "incompatible-trait"]`))
 		})
 
+		It("should include conflictPolicy in CUE output", func() {
+			trait := defkit.NewTrait("scaler").
+				Description("Scaler trait").
+				ConflictPolicy("last-wins")
+
+			Expect(trait.GetConflictPolicy()).To(Equal("last-wins"))
+			Expect(trait.ToCue()).To(ContainSubstring(`conflictPolicy: "last-wins"`))
+		})
+
 		It("should include imports in CUE output", func() {
 			trait := defkit.NewTrait("with-imports").
 				Description("Trait with imports").
@@ -0,0 +1,173 @@
+/*
+Copyright 2026 The KubeVela Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package deftest
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestIsTestFile(t *testing.T) {
+	r := require.New(t)
+	r.True(IsTestFile("defs/web_test.yaml"))
+	r.True(IsTestFile("defs/web_test.yml"))
+	r.True(IsTestFile("defs/web_test.cue"))
+	r.False(IsTestFile("defs/web.cue"))
+	r.False(IsTestFile("defs/web_test.go"))
+	r.False(IsTestFile("defs/web_test.json"))
+}
+
+func TestFindTestFiles(t *testing.T) {
+	r := require.New(t)
+	files, err := FindTestFiles("testdata")
+	r.NoError(err)
+	r.Equal([]string{"testdata/replicas_test.cue", "testdata/web_test.yaml"}, files)
+
+	files, err = FindTestFiles("testdata/web.cue")
+	r.NoError(err)
+	r.Equal([]string{"testdata/web_test.yaml"}, files)
+
+	files, err = FindTestFiles("testdata/replicas_test.cue")
+	r.NoError(err)
+	r.Equal([]string{"testdata/replicas_test.cue"}, files)
+
+	_, err = FindTestFiles("testdata/golden/web-service.yaml")
+	r.Error(err)
+	_, err = FindTestFiles("testdata/not-found.cue")
+	r.Error(err)
+}
+
+func TestRunFile(t *testing.T) {
+	for _, file := range []string{"testdata/web_test.yaml", "testdata/replicas_test.cue"} {
+		t.Run(file, func(t *testing.T) {
+			r := require.New(t)
+			result := RunFile(context.Background(), file, Options{})
+			r.NoError(result.Err)
+			r.NotEmpty(result.Cases)
+			for _, c := range result.Cases {
+				r.Empty(c.Failures, c.Name)
+			}
+			r.Equal(0, result.Failed())
+		})
+	}
+}
+
+func TestRunFileFailures(t *testing.T) {
+	r := require.New(t)
+	dir := t.TempDir()
+	def, err := os.ReadFile("testdata/web.cue")
+	r.NoError(err)
+	r.NoError(os.WriteFile(filepath.Join(dir, "web.cue"), def, 0600))
+	r.NoError(os.WriteFile(filepath.Join(dir, "web_test.yaml"), []byte(`
+tests:
+  - name: wrong output
+    parameter:
+      image: nginx
+    expect:
+      output:
+        metadata:
+          name: web
+        spec:
+          replicas: 2
+  - name: wrong status
+    parameter:
+      image: nginx
+    context:
+      outputStatus:
+        readyReplicas: 1
+    expect:
+      status:
+        healthy: false
+        message: "Ready: 0/1"
+  - name: unexpected success
+    parameter:
+      image: nginx
+    expect:
+      error: image
+  - name: golden
+    parameter:
+      image: nginx
+      port: 8080
+    golden: golden/web.yaml
+`), 0600))
+	file := filepath.Join(dir, "web_test.yaml")
+
+	result := RunFile(context.Background(), file, Options{})
+	r.NoError(result.Err)
+	r.Equal(4, result.Failed())
+	r.Equal([]string{
+		`output.metadata.name: expected "web", got "component"`,
+		`output.spec.replicas: expected 2, got 1`,
+	}, result.Cases[0].Failures)
+	r.Equal([]string{
+		`status.healthy: expected false, got true`,
+		`status.message: expected "Ready: 0/1", got "Ready: 1/1"`,
+	}, result.Cases[1].Failures)
+	r.Len(result.Cases[2].Failures, 1)
+	r.Contains(result.Cases[3].Failures[0], "run with --update")
+
+	// the golden file is created in update mode and compared afterwards
+	result = RunFile(context.Background(), file, Options{Update: true, Run: regexp.MustCompile("golden")})
+	r.Len(result.Cases, 1)
+	r.True(result.Cases[0].Updated)
+	r.True(result.Cases[0].Passed())
+	golden, err := os.ReadFile(filepath.Join(dir, "golden/web.yaml"))
+	r.NoError(err)
+	r.Contains(string(golden), "containerPort: 8080")
+	result = RunFile(context.Background(), file, Options{Run: regexp.MustCompile("golden")})
+	r.Equal(0, result.Failed())
+
+	r.NoError(os.WriteFile(filepath.Join(dir, "golden/web.yaml"), bytes.ReplaceAll(golden, []byte("8080"), []byte("9090")), 0600))
+	result = RunFile(context.Background(), file, Options{Run: regexp.MustCompile("golden")})
+	r.Equal(1, result.Failed())
+	r.Contains(result.Cases[0].Failures[0], "differ from golden file")
+
+	// invalid test files fail as a whole
+	r.NoError(os.WriteFile(file, []byte("tests:\n  - name: a\n    unknown: b\n"), 0600))
+	result = RunFile(context.Background(), file, Options{})
+	r.Error(result.Err)
+	r.Equal(1, result.Failed())
+}
+
+func TestWriteJUnit(t *testing.T) {
+	r := require.New(t)
+	results := []*SuiteResult{{
+		File: "web_test.yaml",
+		Cases: []*CaseResult{
+			{Name: "passed"},
+			{Name: "failed", Failures: []string{"output.kind: expected \"Deployment\", got \"Job\""}},
+		},
+	}, {
+		File: "broken_test.yaml",
+		Err:  os.ErrNotExist,
+	}}
+	buf := &bytes.Buffer{}
+	r.NoError(WriteJUnit(buf, results))
+	out := buf.String()
+	r.True(strings.HasPrefix(out, "<?xml"))
+	r.Contains(out, `<testsuites tests="3" failures="1" errors="1"`)
+	r.Contains(out, `<testsuite name="web_test.yaml" tests="2" failures="1" errors="0"`)
+	r.Contains(out, `<failure message="1 assertion(s) failed">output.kind: expected &#34;Deployment&#34;, got &#34;Job&#34;</failure>`)
+	r.Contains(out, `<error message="failed to run test file">file does not exist</error>`)
+}
@@ -0,0 +1,107 @@
+/*
+Copyright 2026 The KubeVela Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package deftest
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
+type junitTestSuites struct {
+	XMLName  xml.Name         `xml:"testsuites"`
+	Tests    int              `xml:"tests,attr"`
+	Failures int              `xml:"failures,attr"`
+	Errors   int              `xml:"errors,attr"`
+	Time     string           `xml:"time,attr"`
+	Suites   []junitTestSuite `xml:"testsuite"`
+}
+
+type junitTestSuite struct {
+	Name      string          `xml:"name,attr"`
+	Tests     int             `xml:"tests,attr"`
+	Failures  int             `xml:"failures,attr"`
+	Errors    int             `xml:"errors,attr"`
+	Time      string          `xml:"time,attr"`
+	Timestamp string          `xml:"timestamp,attr,omitempty"`
+	Cases     []junitTestCase `xml:"testcase"`
+}
+
+type junitTestCase struct {
+	Name      string        `xml:"name,attr"`
+	ClassName string        `xml:"classname,attr"`
+	Time      string        `xml:"time,attr"`
+	Failure   *junitMessage `xml:"failure,omitempty"`
+	Error     *junitMessage `xml:"error,omitempty"`
+}
+
+type junitMessage struct {
+	Message string `xml:"message,attr"`
+	Content string `xml:",chardata"`
+}
+
+func junitSeconds(d time.Duration) string {
+	return fmt.Sprintf("%.3f", d.Seconds())
+}
+
+// WriteJUnit writes the test results in the JUnit XML format, one test suite for each test file
+func WriteJUnit(w io.Writer, results []*SuiteResult) error {
+	report := junitTestSuites{}
+	var total time.Duration
+	for _, r := range results {
+		suite := junitTestSuite{Name: r.File, Time: junitSeconds(r.Duration)}
+		if r.Err != nil {
+			suite.Tests, suite.Errors = 1, 1
+			suite.Cases = append(suite.Cases, junitTestCase{
+				Name:      r.File,
+				ClassName: r.File,
+				Time:      junitSeconds(r.Duration),
+				Error:     &junitMessage{Message: "failed to run test file", Content: r.Err.Error()},
+			})
+		}
+		for _, c := range r.Cases {
+			tc := junitTestCase{Name: c.Name, ClassName: r.File, Time: junitSeconds(c.Duration)}
+			if !c.Passed() {
+				tc.Failure = &junitMessage{
+					Message: fmt.Sprintf("%d assertion(s) failed", len(c.Failures)),
+					Content: strings.Join(c.Failures, "\n"),
+				}
+				suite.Failures++
+			}
+			suite.Tests++
+			suite.Cases = append(suite.Cases, tc)
+		}
+		report.Tests += suite.Tests
+		report.Failures += suite.Failures
+		report.Errors += suite.Errors
+		total += r.Duration
+		report.Suites = append(report.Suites, suite)
+	}
+	report.Time = junitSeconds(total)
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	encoder := xml.NewEncoder(w)
+	encoder.Indent("", "  ")
+	if err := encoder.Encode(report); err != nil {
+		return err
+	}
+	_, err := io.WriteString(w, "\n")
+	return err
+}
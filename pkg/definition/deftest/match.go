@@ -0,0 +1,98 @@
+/*
+Copyright 2026 The KubeVela Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package deftest
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+)
+
+// normalize converts the value into its JSON form, so that numbers of different types are comparable
+func normalize(v interface{}) (interface{}, error) {
+	bs, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	var out interface{}
+	if err = json.Unmarshal(bs, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// matchSubset checks if the actual value contains the expected one. Maps match if the actual map contains all
+// the expected keys with matching values, lists match if they have the same length and the elements match, and
+// other values match if they are equal. It returns the mismatches found.
+func matchSubset(path string, expected, actual interface{}) []string {
+	switch exp := expected.(type) {
+	case map[string]interface{}:
+		act, ok := actual.(map[string]interface{})
+		if !ok {
+			return []string{fmt.Sprintf("%s: expected an object, got %s", path, describe(actual))}
+		}
+		keys := make([]string, 0, len(exp))
+		for k := range exp {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		var mismatches []string
+		for _, k := range keys {
+			v, found := act[k]
+			if !found {
+				mismatches = append(mismatches, fmt.Sprintf("%s: expected to be %s, but not found", joinPath(path, k), describe(exp[k])))
+				continue
+			}
+			mismatches = append(mismatches, matchSubset(joinPath(path, k), exp[k], v)...)
+		}
+		return mismatches
+	case []interface{}:
+		act, ok := actual.([]interface{})
+		if !ok {
+			return []string{fmt.Sprintf("%s: expected a list, got %s", path, describe(actual))}
+		}
+		if len(exp) != len(act) {
+			return []string{fmt.Sprintf("%s: expected %d elements, got %d", path, len(exp), len(act))}
+		}
+		var mismatches []string
+		for i := range exp {
+			mismatches = append(mismatches, matchSubset(fmt.Sprintf("%s[%d]", path, i), exp[i], act[i])...)
+		}
+		return mismatches
+	default:
+		if !reflect.DeepEqual(expected, actual) {
+			return []string{fmt.Sprintf("%s: expected %s, got %s", path, describe(expected), describe(actual))}
+		}
+		return nil
+	}
+}
+
+func joinPath(path, key string) string {
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
+
+func describe(v interface{}) string {
+	bs, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprintf("%v", v)
+	}
+	return string(bs)
+}
@@ -0,0 +1,483 @@
+/*
+Copyright 2026 The KubeVela Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package deftest
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"cuelang.org/go/cue"
+	"cuelang.org/go/cue/cuecontext"
+	"github.com/google/go-cmp/cmp"
+	"github.com/kubevela/workflow/pkg/cue/model"
+	"github.com/kubevela/workflow/pkg/cue/process"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/util/version"
+	"sigs.k8s.io/yaml"
+
+	"github.com/oam-dev/kubevela/apis/core.oam.dev/common"
+	"github.com/oam-dev/kubevela/apis/core.oam.dev/v1beta1"
+	"github.com/oam-dev/kubevela/apis/types"
+	"github.com/oam-dev/kubevela/pkg/cue/definition"
+	"github.com/oam-dev/kubevela/pkg/cue/definition/health"
+	velaprocess "github.com/oam-dev/kubevela/pkg/cue/process"
+	pkgdef "github.com/oam-dev/kubevela/pkg/definition"
+)
+
+const (
+	// TestFileSuffix is the suffix of the test file name before the extension
+	TestFileSuffix = "_test"
+
+	defaultAppName   = "app"
+	defaultCompName  = "component"
+	defaultNamespace = "default"
+)
+
+var testFileExtensions = []string{".yaml", ".yml", ".cue"}
+
+// Options are the options to run the tests
+type Options struct {
+	// Update writes the rendered resources into the golden files instead of comparing with them
+	Update bool
+	// Run selects the test cases to run by name. All test cases run if nil.
+	Run *regexp.Regexp
+}
+
+// IsTestFile checks if the file is a test file of a definition
+func IsTestFile(path string) bool {
+	ext := filepath.Ext(path)
+	for _, e := range testFileExtensions {
+		if ext == e {
+			return strings.HasSuffix(strings.TrimSuffix(filepath.Base(path), ext), TestFileSuffix)
+		}
+	}
+	return false
+}
+
+// FindTestFiles finds the test files of the given path. The path could be a test file, a definition file whose
+// test files are next to it, or a directory to find the test files recursively.
+func FindTestFiles(path string) ([]string, error) {
+	fi, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+	var files []string
+	switch {
+	case fi.IsDir():
+		err = filepath.Walk(path, func(p string, info os.FileInfo, err error) error {
+			if err != nil {
+				return err
+			}
+			if !info.IsDir() && IsTestFile(p) {
+				files = append(files, p)
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	case IsTestFile(path):
+		files = append(files, path)
+	case filepath.Ext(path) == ".cue":
+		prefix := strings.TrimSuffix(path, ".cue") + TestFileSuffix
+		for _, ext := range testFileExtensions {
+			if _, err := os.Stat(prefix + ext); err == nil {
+				files = append(files, prefix+ext)
+			}
+		}
+		if len(files) == 0 {
+			return nil, errors.Errorf("no test file found for %s", path)
+		}
+	default:
+		return nil, errors.Errorf("%s is neither a test file nor a CUE definition", path)
+	}
+	sort.Strings(files)
+	return files, nil
+}
+
+// LoadSuite loads the test file in YAML or CUE
+func LoadSuite(path string) (*Suite, error) {
+	bs, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	suite := &Suite{}
+	if filepath.Ext(path) != ".cue" {
+		if err = yaml.UnmarshalStrict(bs, suite); err != nil {
+			return nil, errors.Wrapf(err, "failed to parse test file %s", path)
+		}
+		return suite, nil
+	}
+	val := cuecontext.New().CompileBytes(bs, cue.Filename(path))
+	if err = val.Validate(cue.Concrete(true)); err != nil {
+		return nil, errors.Wrapf(err, "failed to evaluate test file %s", path)
+	}
+	if bs, err = val.MarshalJSON(); err != nil {
+		return nil, errors.Wrapf(err, "failed to evaluate test file %s", path)
+	}
+	decoder := json.NewDecoder(bytes.NewReader(bs))
+	decoder.DisallowUnknownFields()
+	if err = decoder.Decode(suite); err != nil {
+		return nil, errors.Wrapf(err, "failed to parse test file %s", path)
+	}
+	return suite, nil
+}
+
+// DefinitionPath returns the path of the definition file under test
+func DefinitionPath(testFile string, suite *Suite) string {
+	if suite.Definition != "" {
+		return filepath.Join(filepath.Dir(testFile), suite.Definition)
+	}
+	ext := filepath.Ext(testFile)
+	return strings.TrimSuffix(strings.TrimSuffix(testFile, ext), TestFileSuffix) + ".cue"
+}
+
+// testDefinition is the definition under test
+type testDefinition struct {
+	name     string
+	kind     string
+	template string
+	status   common.Status
+}
+
+func loadDefinition(path string) (*testDefinition, error) {
+	bs, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return parseDefinition(string(bs), path)
+}
+
+func parseDefinition(cueSource string, path string) (*testDefinition, error) {
+	def := pkgdef.Definition{Unstructured: unstructured.Unstructured{}}
+	if err := def.FromCUEString(cueSource, nil); err != nil {
+		return nil, errors.Wrapf(err, "failed to parse definition %s", path)
+	}
+	if kind := def.GetKind(); kind != v1beta1.ComponentDefinitionKind && kind != v1beta1.TraitDefinitionKind {
+		return nil, errors.Errorf("%s is a %s, only ComponentDefinition and TraitDefinition are supported", path, kind)
+	}
+	td := &testDefinition{name: def.GetName(), kind: def.GetKind()}
+	td.template, _, _ = unstructured.NestedString(def.Object, "spec", "schematic", "cue", "template")
+	if td.template == "" {
+		return nil, errors.Errorf("definition %s has no CUE template", path)
+	}
+	td.status.HealthPolicy, _, _ = unstructured.NestedString(def.Object, "spec", "status", "healthPolicy")
+	td.status.CustomStatus, _, _ = unstructured.NestedString(def.Object, "spec", "status", "customStatus")
+	td.status.Details, _, _ = unstructured.NestedString(def.Object, "spec", "status", "details")
+	return td, nil
+}
+
+// RunFile runs the test cases in the test file
+func RunFile(ctx context.Context, path string, opts Options) *SuiteResult {
+	start := time.Now()
+	result := &SuiteResult{File: path}
+	defer func() { result.Duration = time.Since(start) }()
+	suite, err := LoadSuite(path)
+	if err != nil {
+		result.Err = err
+		return result
+	}
+	result.Definition = DefinitionPath(path, suite)
+	def, err := loadDefinition(result.Definition)
+	if err != nil {
+		result.Err = err
+		return result
+	}
+	for _, c := range suite.Tests {
+		if opts.Run != nil && !opts.Run.MatchString(c.Name) {
+			continue
+		}
+		result.Cases = append(result.Cases, runCase(ctx, def, c, filepath.Dir(path), opts))
+	}
+	return result
+}
+
+// Rendering is the resources rendered by the definition
+type Rendering struct {
+	Output  map[string]interface{}            `json:"output,omitempty"`
+	Outputs map[string]map[string]interface{} `json:"outputs,omitempty"`
+}
+
+func runCase(ctx context.Context, def *testDefinition, c Case, dir string, opts Options) *CaseResult {
+	start := time.Now()
+	result := &CaseResult{Name: c.Name}
+	defer func() { result.Duration = time.Since(start) }()
+	fail := func(format string, args ...interface{}) {
+		result.Failures = append(result.Failures, fmt.Sprintf(format, args...))
+	}
+
+	pctx, err := newProcessContext(ctx, c.Context)
+	if err != nil {
+		fail("invalid context: %v", err)
+		return result
+	}
+	engine, rendered, err := render(pctx, def, c.Parameter)
+	switch {
+	case c.Expect.Error != "" && err == nil:
+		fail("expected rendering error containing %q, but rendered successfully", c.Expect.Error)
+		return result
+	case c.Expect.Error != "":
+		if !strings.Contains(err.Error(), c.Expect.Error) {
+			fail("expected rendering error containing %q, got: %v", c.Expect.Error, err)
+		}
+		return result
+	case err != nil:
+		fail("failed to render: %v", err)
+		return result
+	}
+
+	result.Failures = append(result.Failures, matchRendering(rendered, c.Expect)...)
+	if c.Golden != "" {
+		failures, updated := checkGolden(filepath.Join(dir, c.Golden), rendered, opts.Update)
+		result.Failures, result.Updated = append(result.Failures, failures...), updated
+	}
+	if c.Expect.Status != nil {
+		status, err := engine.Status(statusContext(pctx, def, rendered, c.Context), &health.StatusRequest{
+			Health:    def.status.HealthPolicy,
+			Custom:    def.status.CustomStatus,
+			Details:   def.status.Details,
+			Parameter: c.Parameter,
+		})
+		if err != nil {
+			fail("failed to evaluate status: %v", err)
+			return result
+		}
+		result.Failures = append(result.Failures, matchStatus(status, c.Expect.Status)...)
+	}
+	return result
+}
+
+// Render renders the component or trait definition with the parameter in the mock context
+func Render(ctx context.Context, cueSource string, parameter map[string]interface{}, mock Context) (*Rendering, error) {
+	def, err := parseDefinition(cueSource, "-")
+	if err != nil {
+		return nil, err
+	}
+	pctx, err := newProcessContext(ctx, mock)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid context")
+	}
+	_, rendered, err := render(pctx, def, parameter)
+	return rendered, err
+}
+
+func render(pctx process.Context, def *testDefinition, parameter map[string]interface{}) (definition.AbstractEngine, *Rendering, error) {
+	var engine definition.AbstractEngine
+	auxiliaryType := definition.AuxiliaryWorkload
+	if def.kind == v1beta1.ComponentDefinitionKind {
+		engine = definition.NewWorkloadAbstractEngine(pctx.GetData(velaprocess.ContextName).(string))
+	} else {
+		engine = definition.NewTraitAbstractEngine(def.name)
+		auxiliaryType = def.name
+	}
+	// incomplete resources are reported when they are collected rather than when the template is rendered
+	if err := engine.Complete(pctx, def.template, parameter); err != nil {
+		return engine, nil, err
+	}
+	rendered, err := collectRendering(pctx, auxiliaryType)
+	return engine, rendered, err
+}
+
+func newProcessContext(ctx context.Context, mock Context) (process.Context, error) {
+	data := velaprocess.ContextData{
+		Ctx:             ctx,
+		AppName:         mock.AppName,
+		CompName:        mock.Name,
+		Namespace:       mock.Namespace,
+		AppRevisionName: mock.AppRevision,
+		Cluster:         mock.Cluster,
+		AppLabels:       mock.AppLabels,
+		AppAnnotations:  mock.AppAnnotations,
+	}
+	if data.AppName == "" {
+		data.AppName = defaultAppName
+	}
+	if data.CompName == "" {
+		data.CompName = defaultCompName
+	}
+	if data.Namespace == "" {
+		data.Namespace = defaultNamespace
+	}
+	if data.AppRevisionName == "" {
+		data.AppRevisionName = data.AppName + "-v1"
+	}
+	if mock.ClusterVersion != "" {
+		v, err := version.ParseGeneric(mock.ClusterVersion)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid cluster version %s", mock.ClusterVersion)
+		}
+		data.ClusterVersion = types.ClusterVersion{
+			Major:      fmt.Sprint(v.Major()),
+			Minor:      fmt.Sprint(v.Minor()),
+			GitVersion: mock.ClusterVersion,
+		}
+	}
+	pctx := velaprocess.NewContext(data)
+	if mock.Output != nil {
+		base, err := model.NewBase(cuecontext.New().Encode(mock.Output))
+		if err != nil {
+			return nil, err
+		}
+		if err = pctx.SetBase(base); err != nil {
+			return nil, err
+		}
+	}
+	return pctx, nil
+}
+
+func collectRendering(pctx process.Context, auxiliaryType string) (*Rendering, error) {
+	r := &Rendering{Outputs: map[string]map[string]interface{}{}}
+	base, auxiliaries := pctx.Output()
+	if base != nil {
+		obj, err := base.Unstructured()
+		if err != nil {
+			return nil, err
+		}
+		r.Output = obj.Object
+	}
+	for _, aux := range auxiliaries {
+		if aux.Type != auxiliaryType {
+			continue
+		}
+		obj, err := aux.Ins.Unstructured()
+		if err != nil {
+			return nil, errors.WithMessagef(err, "outputs %s", aux.Name)
+		}
+		r.Outputs[aux.Name] = obj.Object
+	}
+	return r, nil
+}
+
+func matchRendering(r *Rendering, expect Expectation) []string {
+	var failures []string
+	if expect.Output != nil {
+		exp, _ := normalize(expect.Output)
+		act, _ := normalize(r.Output)
+		failures = append(failures, matchSubset("output", exp, act)...)
+	}
+	names := make([]string, 0, len(expect.Outputs))
+	for name := range expect.Outputs {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		obj, found := r.Outputs[name]
+		if !found {
+			failures = append(failures, fmt.Sprintf("outputs.%s: not rendered", name))
+			continue
+		}
+		exp, _ := normalize(expect.Outputs[name])
+		act, _ := normalize(obj)
+		failures = append(failures, matchSubset("outputs."+name, exp, act)...)
+	}
+	return failures
+}
+
+// checkGolden compares the rendered resources with the golden file, or updates the golden file
+func checkGolden(path string, r *Rendering, update bool) ([]string, bool) {
+	bs, err := yaml.Marshal(r)
+	if err != nil {
+		return []string{fmt.Sprintf("failed to marshal rendered resources: %v", err)}, false
+	}
+	if update {
+		if err = os.MkdirAll(filepath.Dir(path), 0750); err == nil {
+			err = os.WriteFile(path, bs, 0600)
+		}
+		if err != nil {
+			return []string{fmt.Sprintf("failed to update golden file %s: %v", path, err)}, false
+		}
+		return nil, true
+	}
+	golden, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return []string{fmt.Sprintf("golden file %s not found, run with --update to create it", path)}, false
+	}
+	if err != nil {
+		return []string{fmt.Sprintf("failed to read golden file %s: %v", path, err)}, false
+	}
+	var expected, actual interface{}
+	if err = yaml.Unmarshal(golden, &expected); err != nil {
+		return []string{fmt.Sprintf("failed to parse golden file %s: %v", path, err)}, false
+	}
+	if err = yaml.Unmarshal(bs, &actual); err != nil {
+		return []string{fmt.Sprintf("failed to parse rendered resources: %v", err)}, false
+	}
+	if diff := cmp.Diff(expected, actual); diff != "" {
+		return []string{fmt.Sprintf("rendered resources differ from golden file %s (-golden +rendered):\n%s", path, diff)}, false
+	}
+	return nil, false
+}
+
+// statusContext builds the context to evaluate the status, with the mocked status of the rendered resources
+func statusContext(pctx process.Context, def *testDefinition, r *Rendering, mock Context) map[string]interface{} {
+	root := map[string]interface{}{}
+	for k, v := range definition.GetBaseContextLabels(pctx) {
+		root[k] = v
+	}
+	if def.kind == v1beta1.ComponentDefinitionKind && r.Output != nil {
+		root[definition.OutputFieldName] = withStatus(r.Output, mock.OutputStatus)
+	}
+	outputs := map[string]interface{}{}
+	for name, obj := range r.Outputs {
+		outputs[name] = withStatus(obj, mock.OutputsStatus[name])
+	}
+	if len(outputs) > 0 {
+		root[definition.OutputsFieldName] = outputs
+	}
+	return root
+}
+
+func withStatus(obj map[string]interface{}, status map[string]interface{}) map[string]interface{} {
+	out := make(map[string]interface{}, len(obj)+1)
+	for k, v := range obj {
+		out[k] = v
+	}
+	if status != nil {
+		out["status"] = status
+	}
+	return out
+}
+
+func matchStatus(status *health.StatusResult, expect *StatusExpectation) []string {
+	var failures []string
+	if expect.Healthy != nil && *expect.Healthy != status.Healthy {
+		failures = append(failures, fmt.Sprintf("status.healthy: expected %t, got %t", *expect.Healthy, status.Healthy))
+	}
+	if expect.Message != nil && *expect.Message != status.Message {
+		failures = append(failures, fmt.Sprintf("status.message: expected %q, got %q", *expect.Message, status.Message))
+	}
+	keys := make([]string, 0, len(expect.Details))
+	for k := range expect.Details {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		if v, found := status.Details[k]; !found || v != expect.Details[k] {
+			failures = append(failures, fmt.Sprintf("status.details.%s: expected %q, got %q", k, expect.Details[k], v))
+		}
+	}
+	return failures
+}
@@ -0,0 +1,25 @@
+replicas: {
+	type: "trait"
+	annotations: {}
+	labels: {}
+	description: "Scale the workload and guard it with a pod disruption budget."
+	attributes: {
+		appliesToWorkloads: ["deployments.apps"]
+		status: healthPolicy: #"""
+			isHealth: context.outputs.pdb.status.currentHealthy >= context.outputs.pdb.status.desiredHealthy
+			"""#
+	}
+}
+template: {
+	patch: spec: replicas: parameter.replicas
+	outputs: pdb: {
+		apiVersion: "policy/v1"
+		kind:       "PodDisruptionBudget"
+		metadata: name: context.name
+		spec: {
+			minAvailable: parameter.replicas - 1
+			selector: matchLabels: context.output.spec.selector.matchLabels
+		}
+	}
+	parameter: replicas: *2 | int
+}
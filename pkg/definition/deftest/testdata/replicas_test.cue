@@ -0,0 +1,33 @@
+let deployment = {
+	apiVersion: "apps/v1"
+	kind:       "Deployment"
+	metadata: name: "backend"
+	spec: selector: matchLabels: app: "backend"
+}
+
+tests: [{
+	name: "patches the replicas"
+	parameter: replicas: 3
+	context: {
+		name:   "backend"
+		output: deployment
+	}
+	expect: {
+		output: spec: replicas: 3
+		outputs: pdb: spec: {
+			minAvailable: 2
+			selector: matchLabels: app: "backend"
+		}
+	}
+}, {
+	name: "reports the budget health"
+	context: {
+		name:   "backend"
+		output: deployment
+		outputsStatus: pdb: {
+			currentHealthy: 1
+			desiredHealthy: 1
+		}
+	}
+	expect: status: healthy: true
+}]
@@ -0,0 +1,69 @@
+web: {
+	type: "component"
+	annotations: {}
+	labels: {}
+	description: "A deployment exposed by a service."
+	attributes: {
+		workload: definition: {
+			apiVersion: "apps/v1"
+			kind:       "Deployment"
+		}
+		status: {
+			healthPolicy: #"""
+				isHealth: context.output.status.readyReplicas == context.output.spec.replicas
+				"""#
+			customStatus: #"""
+				message: "Ready: \(context.output.status.readyReplicas)/\(context.output.spec.replicas)"
+				"""#
+			details: #"""
+				serviceType: context.outputs.service.spec.type
+				"""#
+		}
+	}
+}
+template: {
+	output: {
+		apiVersion: "apps/v1"
+		kind:       "Deployment"
+		metadata: {
+			name: context.name
+			labels: "app.oam.dev/component": context.name
+		}
+		spec: {
+			replicas: parameter.replicas
+			selector: matchLabels: "app.oam.dev/component": context.name
+			template: {
+				metadata: labels: "app.oam.dev/component": context.name
+				spec: containers: [{
+					name:  context.name
+					image: parameter.image
+					if parameter.port != _|_ {
+						ports: [{containerPort: parameter.port}]
+					}
+				}]
+			}
+		}
+	}
+	if parameter.port != _|_ {
+		outputs: service: {
+			apiVersion: "v1"
+			kind:       "Service"
+			metadata: name: context.name
+			spec: {
+				if context.clusterVersion.minor < 30 {
+					type: "ClusterIP"
+				}
+				if context.clusterVersion.minor >= 30 {
+					type: "LoadBalancer"
+				}
+				selector: "app.oam.dev/component": context.name
+				ports: [{port: parameter.port}]
+			}
+		}
+	}
+	parameter: {
+		image:    string
+		replicas: *1 | int
+		port?:    int
+	}
+}
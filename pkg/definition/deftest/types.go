@@ -0,0 +1,133 @@
+/*
+Copyright 2026 The KubeVela Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package deftest runs the declarative tests of CUE definitions. A test file sits next to the definition file,
+// named after the definition with the _test suffix, e.g. webservice_test.yaml or webservice_test.cue for
+// webservice.cue. Each test case renders the definition with the given parameter and mocked context, and
+// asserts on the rendered resources and the evaluated health and status.
+package deftest
+
+import (
+	"time"
+)
+
+// Suite is the content of a test file
+type Suite struct {
+	// Definition is the path of the definition file under test, relative to the test file. If empty, it is
+	// inferred from the name of the test file.
+	Definition string `json:"definition,omitempty"`
+	// Tests are the test cases of the definition
+	Tests []Case `json:"tests"`
+}
+
+// Case is a test case of the definition
+type Case struct {
+	// Name is the name of the test case
+	Name string `json:"name"`
+	// Parameter is the parameter to render the definition with
+	Parameter map[string]interface{} `json:"parameter,omitempty"`
+	// Context mocks the context to render the definition with
+	Context Context `json:"context,omitempty"`
+	// Expect is the assertions on the rendering
+	Expect Expectation `json:"expect,omitempty"`
+	// Golden is the path of the golden file of the rendered resources, relative to the test file
+	Golden string `json:"golden,omitempty"`
+}
+
+// Context mocks the context to render the definition with
+type Context struct {
+	// AppName is the name of the application, defaults to app
+	AppName string `json:"appName,omitempty"`
+	// Name is the name of the component, defaults to component
+	Name string `json:"name,omitempty"`
+	// Namespace is the namespace of the application, defaults to default
+	Namespace string `json:"namespace,omitempty"`
+	// AppRevision is the name of the application revision, defaults to <appName>-v1
+	AppRevision string `json:"appRevision,omitempty"`
+	// Cluster is the cluster the component is dispatched to
+	Cluster string `json:"cluster,omitempty"`
+	// ClusterVersion is the kubernetes version of the cluster, such as v1.30.0
+	ClusterVersion string `json:"clusterVersion,omitempty"`
+	// AppLabels are the labels of the application
+	AppLabels map[string]string `json:"appLabels,omitempty"`
+	// AppAnnotations are the annotations of the application
+	AppAnnotations map[string]string `json:"appAnnotations,omitempty"`
+	// Output is the workload rendered by the component, which is patched by the trait under test
+	Output map[string]interface{} `json:"output,omitempty"`
+	// OutputStatus mocks the status of the rendered output in the cluster
+	OutputStatus map[string]interface{} `json:"outputStatus,omitempty"`
+	// OutputsStatus mocks the status of the rendered outputs in the cluster by name
+	OutputsStatus map[string]map[string]interface{} `json:"outputsStatus,omitempty"`
+}
+
+// Expectation is the assertions on the rendering. The rendered resources are required to contain the
+// expected fields, while the fields not specified are ignored.
+type Expectation struct {
+	// Output is the expected fields of the rendered output
+	Output map[string]interface{} `json:"output,omitempty"`
+	// Outputs is the expected fields of the rendered outputs by name
+	Outputs map[string]map[string]interface{} `json:"outputs,omitempty"`
+	// Status is the expected health and status evaluated
+	Status *StatusExpectation `json:"status,omitempty"`
+	// Error is the expected message contained in the rendering error
+	Error string `json:"error,omitempty"`
+}
+
+// StatusExpectation is the expected health and status evaluated by the health policy, custom status and
+// status details of the definition
+type StatusExpectation struct {
+	Healthy *bool             `json:"healthy,omitempty"`
+	Message *string           `json:"message,omitempty"`
+	Details map[string]string `json:"details,omitempty"`
+}
+
+// CaseResult is the result of a test case
+type CaseResult struct {
+	Name     string
+	Failures []string
+	Duration time.Duration
+	// Updated indicates the golden file is updated
+	Updated bool
+}
+
+// Passed checks if the test case passed
+func (r *CaseResult) Passed() bool {
+	return len(r.Failures) == 0
+}
+
+// SuiteResult is the result of a test file
+type SuiteResult struct {
+	File       string
+	Definition string
+	Cases      []*CaseResult
+	Duration   time.Duration
+	// Err is the error that fails the whole test file, such as failing to load the definition
+	Err error
+}
+
+// Failed returns the number of the failed test cases, or 1 if the test file fails as a whole
+func (r *SuiteResult) Failed() int {
+	if r.Err != nil {
+		return 1
+	}
+	failed := 0
+	for _, c := range r.Cases {
+		if !c.Passed() {
+			failed++
+		}
+	}
+	return failed
+}
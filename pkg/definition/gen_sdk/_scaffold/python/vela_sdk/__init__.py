@@ -0,0 +1,12 @@
+"""KubeVela SDK generated from the X-Definitions by vela def gen-api."""
+
+from vela_sdk.application import Application
+from vela_sdk.base import Component, Policy, Trait, ValidationError, WorkflowStep
+
+try:
+    # the generated builders register themselves on import
+    from vela_sdk import apis  # noqa: F401
+except ImportError:
+    pass
+
+__all__ = ["Application", "Component", "Policy", "Trait", "ValidationError", "WorkflowStep"]
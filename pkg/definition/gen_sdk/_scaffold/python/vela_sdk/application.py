@@ -0,0 +1,144 @@
+"""The builder of KubeVela Applications composed of the typed components, traits, policies and workflow steps."""
+
+import copy
+import json
+from typing import Any, Dict, List, Optional, TypeVar
+
+import yaml
+
+from vela_sdk.base import Component, Definition, Policy, ValidationError, WorkflowStep
+
+API_VERSION = "core.oam.dev/v1beta1"
+KIND = "Application"
+
+D = TypeVar("D", bound=Definition)
+
+
+def _upsert(items: List[D], added: List[D]) -> None:
+    """Replaces the items of the same names, and appends the others."""
+    for item in added:
+        names = [i for i, existing in enumerate(items) if getattr(existing, "name") == getattr(item, "name")]
+        if names:
+            items[names[0]] = item
+        else:
+            items.append(item)
+
+
+class Application:
+    """A KubeVela Application."""
+
+    def __init__(self, name: str, namespace: str = "default") -> None:
+        self.name = name
+        self.namespace = namespace
+        self.labels: Dict[str, str] = {}
+        self.annotations: Dict[str, str] = {}
+        self.components: List[Component] = []
+        self.policies: List[Policy] = []
+        self.workflow_steps: List[WorkflowStep] = []
+        self.workflow_mode: Optional[Dict[str, str]] = None
+
+    def set_labels(self, labels: Dict[str, str]) -> "Application":
+        self.labels = dict(labels)
+        return self
+
+    def set_annotations(self, annotations: Dict[str, str]) -> "Application":
+        self.annotations = dict(annotations)
+        return self
+
+    def set_components(self, *components: Component) -> "Application":
+        """Sets the components, the component of the same name is replaced."""
+        _upsert(self.components, list(components))
+        return self
+
+    def set_policies(self, *policies: Policy) -> "Application":
+        """Sets the policies, the policy of the same name is replaced."""
+        _upsert(self.policies, list(policies))
+        return self
+
+    def set_workflow_steps(self, *steps: WorkflowStep) -> "Application":
+        """Sets the workflow steps, the step of the same name is replaced."""
+        _upsert(self.workflow_steps, list(steps))
+        return self
+
+    def set_workflow_mode(self, steps: str = "StepByStep", sub_steps: str = "DAG") -> "Application":
+        """Sets the execution mode of the steps and sub-steps, StepByStep or DAG."""
+        self.workflow_mode = {"steps": steps, "subSteps": sub_steps}
+        return self
+
+    def get_component(self, name: str) -> Optional[Component]:
+        return next((c for c in self.components if c.name == name), None)
+
+    def get_components_by_type(self, typ: str) -> List[Component]:
+        return [c for c in self.components if c.type == typ]
+
+    def get_policy(self, name: str) -> Optional[Policy]:
+        return next((p for p in self.policies if p.name == name), None)
+
+    def get_policies_by_type(self, typ: str) -> List[Policy]:
+        return [p for p in self.policies if p.type == typ]
+
+    def get_workflow_step(self, name: str) -> Optional[WorkflowStep]:
+        return next((s for s in self.workflow_steps if s.name == name), None)
+
+    def get_workflow_steps_by_type(self, typ: str) -> List[WorkflowStep]:
+        return [s for s in self.workflow_steps if s.type == typ]
+
+    def validate(self) -> None:
+        """Validates the properties of all the components, traits, policies and workflow steps."""
+        errors: List[str] = []
+        for items in (self.components, self.policies, self.workflow_steps):
+            for item in items:
+                errors.extend(item.validation_errors())
+        if errors:
+            raise ValidationError(errors)
+
+    def to_dict(self) -> Dict[str, Any]:
+        """Serializes to the Application object."""
+        metadata: Dict[str, Any] = {"name": self.name, "namespace": self.namespace}
+        if self.labels:
+            metadata["labels"] = dict(self.labels)
+        if self.annotations:
+            metadata["annotations"] = dict(self.annotations)
+        spec: Dict[str, Any] = {"components": [c.to_dict() for c in self.components]}
+        if self.policies:
+            spec["policies"] = [p.to_dict() for p in self.policies]
+        if self.workflow_steps or self.workflow_mode:
+            workflow: Dict[str, Any] = {}
+            if self.workflow_steps:
+                workflow["steps"] = [s.to_dict() for s in self.workflow_steps]
+            if self.workflow_mode:
+                workflow["mode"] = dict(self.workflow_mode)
+            spec["workflow"] = workflow
+        return {"apiVersion": API_VERSION, "kind": KIND, "metadata": metadata, "spec": spec}
+
+    def to_json(self, indent: Optional[int] = 2) -> str:
+        return json.dumps(self.to_dict(), indent=indent)
+
+    def to_yaml(self) -> str:
+        return yaml.safe_dump(self.to_dict(), sort_keys=False)
+
+    @classmethod
+    def from_dict(cls, d: Dict[str, Any]) -> "Application":
+        """Loads the Application object, the components, traits, policies and workflow steps of the generated
+        definitions are loaded as the typed builders."""
+        if d.get("kind") != KIND:
+            raise ValueError("expect kind %s, got %s" % (KIND, d.get("kind")))
+        metadata = d.get("metadata", {})
+        spec = d.get("spec", {})
+        app = cls(metadata["name"], metadata.get("namespace", "default"))
+        app.labels = dict(metadata.get("labels", {}))
+        app.annotations = dict(metadata.get("annotations", {}))
+        app.components = [Component.from_dict(c) for c in spec.get("components", [])]
+        app.policies = [Policy.from_dict(p) for p in spec.get("policies", [])]
+        workflow = spec.get("workflow", {})
+        app.workflow_steps = [WorkflowStep.from_dict(s) for s in workflow.get("steps", [])]
+        app.workflow_mode = copy.deepcopy(workflow.get("mode"))
+        return app
+
+    @classmethod
+    def from_json(cls, s: str) -> "Application":
+        return cls.from_dict(json.loads(s))
+
+    @classmethod
+    def from_yaml(cls, s: str) -> "Application":
+        return cls.from_dict(yaml.safe_load(s))
@@ -0,0 +1,274 @@
+"""Base classes of the typed builders generated from the X-Definitions."""
+
+import copy
+from typing import Any, ClassVar, Dict, List, Optional, Tuple, Type, TypeVar
+
+from vela_sdk.schema import validate
+
+T = TypeVar("T", bound="Definition")
+C = TypeVar("C", bound="Component")
+S = TypeVar("S", bound="WorkflowStep")
+
+_REGISTRY: Dict[Tuple[str, str], Type["Definition"]] = {}
+
+
+class ValidationError(ValueError):
+    """Raised when the properties do not match the schema of the definition."""
+
+    def __init__(self, errors: List[str]) -> None:
+        super().__init__("\n".join(errors))
+        self.errors = errors
+
+
+def register(cls: Type[T]) -> Type[T]:
+    """Registers the generated builder, so that it is used when loading the applications."""
+    _REGISTRY[(cls.KIND, cls.TYPE)] = cls
+    return cls
+
+
+def lookup(kind: str, typ: str) -> Optional[Type["Definition"]]:
+    """Returns the registered builder of the definition."""
+    return _REGISTRY.get((kind, typ))
+
+
+class Definition:
+    """The component, trait, policy or workflow step of an application."""
+
+    KIND: ClassVar[str] = ""
+    TYPE: ClassVar[str] = ""
+    SCHEMA: ClassVar[Dict[str, Any]] = {}
+
+    def __init__(self, properties: Optional[Dict[str, Any]] = None, type_: Optional[str] = None) -> None:
+        self._properties: Dict[str, Any] = copy.deepcopy(dict(properties or {}))
+        self._type = type_ or self.TYPE
+
+    @property
+    def type(self) -> str:
+        """The type of the definition."""
+        return self._type
+
+    @property
+    def properties(self) -> Dict[str, Any]:
+        """The properties, i.e. the parameter of the definition."""
+        return self._properties
+
+    def set_property(self: T, key: str, value: Any) -> T:
+        """Sets the property, which is removed if the value is None."""
+        if value is None:
+            self._properties.pop(key, None)
+        else:
+            self._properties[key] = value
+        return self
+
+    def validation_errors(self) -> List[str]:
+        """Returns the errors of the properties against the schema of the definition."""
+        if not self.SCHEMA:
+            return []
+        return ["%s %s: %s" % (self.KIND, self._describe(), e) for e in validate(self._properties, self.SCHEMA)]
+
+    def validate(self) -> None:
+        """Validates the properties against the schema of the definition."""
+        errors = self.validation_errors()
+        if errors:
+            raise ValidationError(errors)
+
+    def to_dict(self) -> Dict[str, Any]:
+        """Serializes to the dict in the application."""
+        raise NotImplementedError
+
+    def _describe(self) -> str:
+        return self._type
+
+    def _base_dict(self) -> Dict[str, Any]:
+        d: Dict[str, Any] = {"type": self._type}
+        if self._properties:
+            d["properties"] = copy.deepcopy(self._properties)
+        return d
+
+
+class Trait(Definition):
+    """The trait attached to a component."""
+
+    KIND = "trait"
+
+    def to_dict(self) -> Dict[str, Any]:
+        return self._base_dict()
+
+    @classmethod
+    def from_dict(cls, d: Dict[str, Any]) -> "Trait":
+        builder = lookup(cls.KIND, d["type"])
+        if builder is not None:
+            return builder(d.get("properties"))  # type: ignore[call-arg,return-value]
+        return Trait(d.get("properties"), type_=d["type"])
+
+
+class _Named(Definition):
+    def __init__(self, name: str, properties: Optional[Dict[str, Any]] = None, type_: Optional[str] = None) -> None:
+        super().__init__(properties, type_)
+        self.name = name
+
+    def _describe(self) -> str:
+        return "%s(%s)" % (self.name, self._type)
+
+    def _base_dict(self) -> Dict[str, Any]:
+        d = {"name": self.name}
+        d.update(super()._base_dict())
+        return d
+
+    @classmethod
+    def _load(cls, d: Dict[str, Any]) -> Any:
+        builder = lookup(cls.KIND, d["type"])
+        if builder is not None:
+            return builder(d["name"], d.get("properties"))  # type: ignore[call-arg]
+        return cls(d["name"], d.get("properties"), type_=d["type"])
+
+
+class Component(_Named):
+    """The component of an application."""
+
+    KIND = "component"
+
+    def __init__(self, name: str, properties: Optional[Dict[str, Any]] = None, type_: Optional[str] = None) -> None:
+        super().__init__(name, properties, type_)
+        self.traits: List[Trait] = []
+        self.depends_on: List[str] = []
+        self.inputs: List[Dict[str, Any]] = []
+        self.outputs: List[Dict[str, Any]] = []
+
+    def add_trait(self: C, *traits: Trait) -> C:
+        """Adds the traits, the trait of the same type is replaced."""
+        for trait in traits:
+            existing = [i for i, t in enumerate(self.traits) if t.type == trait.type]
+            if existing:
+                self.traits[existing[0]] = trait
+            else:
+                self.traits.append(trait)
+        return self
+
+    def get_trait(self, typ: str) -> Optional[Trait]:
+        """Returns the trait of the type."""
+        return next((t for t in self.traits if t.type == typ), None)
+
+    def set_depends_on(self: C, *components: str) -> C:
+        """Sets the names of the components this component depends on."""
+        self.depends_on = list(components)
+        return self
+
+    def validation_errors(self) -> List[str]:
+        errors = super().validation_errors()
+        for trait in self.traits:
+            errors.extend("component %s: %s" % (self.name, e) for e in trait.validation_errors())
+        return errors
+
+    def to_dict(self) -> Dict[str, Any]:
+        d = self._base_dict()
+        if self.traits:
+            d["traits"] = [t.to_dict() for t in self.traits]
+        if self.depends_on:
+            d["dependsOn"] = list(self.depends_on)
+        if self.inputs:
+            d["inputs"] = copy.deepcopy(self.inputs)
+        if self.outputs:
+            d["outputs"] = copy.deepcopy(self.outputs)
+        return d
+
+    @classmethod
+    def from_dict(cls, d: Dict[str, Any]) -> "Component":
+        comp: Component = cls._load(d)
+        comp.traits = [Trait.from_dict(t) for t in d.get("traits", [])]
+        comp.depends_on = list(d.get("dependsOn", []))
+        comp.inputs = copy.deepcopy(d.get("inputs", []))
+        comp.outputs = copy.deepcopy(d.get("outputs", []))
+        return comp
+
+
+class Policy(_Named):
+    """The policy of an application."""
+
+    KIND = "policy"
+
+    def to_dict(self) -> Dict[str, Any]:
+        return self._base_dict()
+
+    @classmethod
+    def from_dict(cls, d: Dict[str, Any]) -> "Policy":
+        policy: Policy = cls._load(d)
+        return policy
+
+
+class WorkflowStep(_Named):
+    """The workflow step of an application, which could be the sub-step of a step group."""
+
+    KIND = "workflow-step"
+
+    def __init__(self, name: str, properties: Optional[Dict[str, Any]] = None, type_: Optional[str] = None) -> None:
+        super().__init__(name, properties, type_)
+        self.depends_on: List[str] = []
+        self.inputs: List[Dict[str, Any]] = []
+        self.outputs: List[Dict[str, Any]] = []
+        self.if_: Optional[str] = None
+        self.timeout: Optional[str] = None
+        self.sub_steps: List["WorkflowStep"] = []
+
+    def set_depends_on(self: S, *steps: str) -> S:
+        """Sets the names of the steps this step depends on."""
+        self.depends_on = list(steps)
+        return self
+
+    def set_if(self: S, condition: str) -> S:
+        """Sets the condition to run the step."""
+        self.if_ = condition
+        return self
+
+    def set_timeout(self: S, timeout: str) -> S:
+        """Sets the timeout of the step, e.g. 5m."""
+        self.timeout = timeout
+        return self
+
+    def add_input(self: S, from_: str, parameter_key: str) -> S:
+        """Adds the input from the output of another step."""
+        self.inputs.append({"from": from_, "parameterKey": parameter_key})
+        return self
+
+    def add_output(self: S, name: str, value_from: str) -> S:
+        """Adds the output for the other steps."""
+        self.outputs.append({"name": name, "valueFrom": value_from})
+        return self
+
+    def add_sub_step(self: S, *steps: "WorkflowStep") -> S:
+        """Adds the sub-steps of the step group."""
+        self.sub_steps.extend(steps)
+        return self
+
+    def validation_errors(self) -> List[str]:
+        errors = super().validation_errors()
+        for step in self.sub_steps:
+            errors.extend(step.validation_errors())
+        return errors
+
+    def to_dict(self) -> Dict[str, Any]:
+        d = self._base_dict()
+        if self.depends_on:
+            d["dependsOn"] = list(self.depends_on)
+        if self.inputs:
+            d["inputs"] = copy.deepcopy(self.inputs)
+        if self.outputs:
+            d["outputs"] = copy.deepcopy(self.outputs)
+        if self.if_:
+            d["if"] = self.if_
+        if self.timeout:
+            d["timeout"] = self.timeout
+        if self.sub_steps:
+            d["subSteps"] = [s.to_dict() for s in self.sub_steps]
+        return d
+
+    @classmethod
+    def from_dict(cls, d: Dict[str, Any]) -> "WorkflowStep":
+        step: WorkflowStep = cls._load(d)
+        step.depends_on = list(d.get("dependsOn", []))
+        step.inputs = copy.deepcopy(d.get("inputs", []))
+        step.outputs = copy.deepcopy(d.get("outputs", []))
+        step.if_ = d.get("if")
+        step.timeout = d.get("timeout")
+        step.sub_steps = [WorkflowStep.from_dict(s) for s in d.get("subSteps", [])]
+        return step
@@ -0,0 +1,97 @@
+"""Validation of the properties against the OpenAPI schemas generated from the CUE parameters of the definitions."""
+
+import re
+from typing import Any, Dict, List
+
+_TYPES = {
+    "string": lambda v: isinstance(v, str),
+    "integer": lambda v: isinstance(v, int) and not isinstance(v, bool),
+    "number": lambda v: isinstance(v, (int, float)) and not isinstance(v, bool),
+    "boolean": lambda v: isinstance(v, bool),
+    "array": lambda v: isinstance(v, list),
+    "object": lambda v: isinstance(v, dict),
+}
+
+
+def validate(value: Any, schema: Dict[str, Any], path: str = "") -> List[str]:
+    """Validates the value against the schema and returns the errors, each prefixed by the path of the field."""
+    where = path or "properties"
+    if value is None:
+        return [] if schema.get("nullable") else ["%s: must not be null" % where]
+
+    for key in ("oneOf", "anyOf"):
+        if key in schema:
+            if any(not validate(value, alt, path) for alt in schema[key]):
+                return []
+            return ["%s: does not match any of the %d options" % (where, len(schema[key]))]
+    errors: List[str] = []
+    for sub in schema.get("allOf", []):
+        errors.extend(validate(value, sub, path))
+
+    if "enum" in schema and value not in schema["enum"]:
+        return errors + ["%s: must be one of %s" % (where, ", ".join(repr(e) for e in schema["enum"]))]
+
+    typ = schema.get("type")
+    if typ in _TYPES and not _TYPES[typ](value):
+        return errors + ["%s: must be %s" % (where, typ)]
+
+    if isinstance(value, str):
+        if "pattern" in schema and not re.search(schema["pattern"], value):
+            errors.append("%s: must match %s" % (where, schema["pattern"]))
+        if "minLength" in schema and len(value) < schema["minLength"]:
+            errors.append("%s: must be at least %d characters" % (where, schema["minLength"]))
+        if "maxLength" in schema and len(value) > schema["maxLength"]:
+            errors.append("%s: must be at most %d characters" % (where, schema["maxLength"]))
+    elif isinstance(value, (int, float)) and not isinstance(value, bool):
+        if "minimum" in schema and value < schema["minimum"]:
+            errors.append("%s: must be >= %s" % (where, schema["minimum"]))
+        if "maximum" in schema and value > schema["maximum"]:
+            errors.append("%s: must be <= %s" % (where, schema["maximum"]))
+    elif isinstance(value, list):
+        items = schema.get("items")
+        for i, item in enumerate(value):
+            if isinstance(items, dict):
+                errors.extend(validate(item, items, "%s[%d]" % (path, i)))
+    elif isinstance(value, dict):
+        props = schema.get("properties", {})
+        for name in schema.get("required", []):
+            if name not in value and "default" not in props.get(name, {}):
+                errors.append("%s: is required" % _join(path, name))
+        extra = schema.get("additionalProperties")
+        for name, item in value.items():
+            if name in props:
+                errors.extend(validate(item, props[name], _join(path, name)))
+            elif isinstance(extra, dict):
+                errors.extend(validate(item, extra, _join(path, name)))
+            elif extra is False:
+                errors.append("%s: is not allowed" % _join(path, name))
+    return errors
+
+
+def sample(schema: Dict[str, Any]) -> Any:
+    """Returns a minimal value of the schema, which sets the required fields only."""
+    if "default" in schema:
+        return schema["default"]
+    if schema.get("enum"):
+        return schema["enum"][0]
+    for key in ("oneOf", "anyOf"):
+        if schema.get(key):
+            return sample(schema[key][0])
+    typ = schema.get("type")
+    if typ == "object":
+        props = schema.get("properties", {})
+        return {name: sample(props.get(name, {})) for name in schema.get("required", [])
+                if "default" not in props.get(name, {})}
+    if typ == "array":
+        return [sample(schema.get("items", {})) for _ in range(schema.get("minItems", 0))]
+    if typ == "string":
+        return "a" * max(schema.get("minLength", 0), 1)
+    if typ in ("integer", "number"):
+        return schema.get("minimum", 1)
+    if typ == "boolean":
+        return False
+    return {}
+
+
+def _join(path: str, name: str) -> str:
+    return "%s.%s" % (path, name) if path else name
@@ -0,0 +1,38 @@
+terraform {
+  required_providers {
+    {{PROVIDER_NAME}} = {
+      source = "{{PROVIDER_SOURCE}}"
+    }
+  }
+}
+
+provider "{{PROVIDER_NAME}}" {
+  namespace = "default"
+}
+
+resource "{{PROVIDER_NAME}}_trait_scaler" "scaler" {
+  properties {
+    replicas = 3
+  }
+}
+
+resource "{{PROVIDER_NAME}}_component_webservice" "frontend" {
+  name = "frontend"
+  properties {
+    image = "nginx:1.25"
+  }
+  traits = [{{PROVIDER_NAME}}_trait_scaler.scaler.manifest]
+}
+
+resource "{{PROVIDER_NAME}}_policy_topology" "local" {
+  name = "local"
+  properties {
+    clusters = ["local"]
+  }
+}
+
+resource "{{PROVIDER_NAME}}_application" "app" {
+  name       = "website"
+  components = [{{PROVIDER_NAME}}_component_webservice.frontend.manifest]
+  policies   = [{{PROVIDER_NAME}}_policy_topology.local.manifest]
+}
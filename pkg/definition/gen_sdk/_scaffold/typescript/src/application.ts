@@ -0,0 +1,175 @@
+/**
+ * The builder of KubeVela Applications composed of the typed components, traits, policies and workflow steps.
+ */
+
+import { parse, stringify } from "yaml";
+
+import { Component, NamedDefinition, Policy, Spec, ValidationError, WorkflowStep } from "./base";
+
+/* eslint-disable @typescript-eslint/no-explicit-any */
+
+export const API_VERSION = "core.oam.dev/v1beta1";
+export const KIND = "Application";
+
+export type WorkflowMode = "StepByStep" | "DAG";
+
+function upsert<T extends NamedDefinition<any>>(items: T[], added: T[]): void {
+  for (const item of added) {
+    const i = items.findIndex((existing) => existing.name === item.name);
+    if (i >= 0) {
+      items[i] = item;
+    } else {
+      items.push(item);
+    }
+  }
+}
+
+/**
+ * A KubeVela Application.
+ */
+export class Application {
+  labels: Record<string, string> = {};
+  annotations: Record<string, string> = {};
+  components: Component<any>[] = [];
+  policies: Policy<any>[] = [];
+  workflowSteps: WorkflowStep<any>[] = [];
+  workflowMode?: { steps: WorkflowMode; subSteps: WorkflowMode };
+
+  constructor(
+    public name: string,
+    public namespace = "default",
+  ) {}
+
+  setLabels(labels: Record<string, string>): this {
+    this.labels = { ...labels };
+    return this;
+  }
+
+  setAnnotations(annotations: Record<string, string>): this {
+    this.annotations = { ...annotations };
+    return this;
+  }
+
+  /** Sets the components, the component of the same name is replaced. */
+  setComponents(...components: Component<any>[]): this {
+    upsert(this.components, components);
+    return this;
+  }
+
+  /** Sets the policies, the policy of the same name is replaced. */
+  setPolicies(...policies: Policy<any>[]): this {
+    upsert(this.policies, policies);
+    return this;
+  }
+
+  /** Sets the workflow steps, the step of the same name is replaced. */
+  setWorkflowSteps(...steps: WorkflowStep<any>[]): this {
+    upsert(this.workflowSteps, steps);
+    return this;
+  }
+
+  /** Sets the execution mode of the steps and sub-steps. */
+  setWorkflowMode(steps: WorkflowMode = "StepByStep", subSteps: WorkflowMode = "DAG"): this {
+    this.workflowMode = { steps, subSteps };
+    return this;
+  }
+
+  getComponent(name: string): Component<any> | undefined {
+    return this.components.find((c) => c.name === name);
+  }
+
+  getComponentsByType(type: string): Component<any>[] {
+    return this.components.filter((c) => c.type === type);
+  }
+
+  getPolicy(name: string): Policy<any> | undefined {
+    return this.policies.find((p) => p.name === name);
+  }
+
+  getPoliciesByType(type: string): Policy<any>[] {
+    return this.policies.filter((p) => p.type === type);
+  }
+
+  getWorkflowStep(name: string): WorkflowStep<any> | undefined {
+    return this.workflowSteps.find((s) => s.name === name);
+  }
+
+  getWorkflowStepsByType(type: string): WorkflowStep<any>[] {
+    return this.workflowSteps.filter((s) => s.type === type);
+  }
+
+  /** Validates the properties of all the components, traits, policies and workflow steps. */
+  validate(): void {
+    const errors = [...this.components, ...this.policies, ...this.workflowSteps].flatMap((item) =>
+      item.validationErrors(),
+    );
+    if (errors.length > 0) {
+      throw new ValidationError(errors);
+    }
+  }
+
+  /** Serializes to the Application object. */
+  toJSON(): Spec {
+    const metadata: Spec = { name: this.name, namespace: this.namespace };
+    if (Object.keys(this.labels).length > 0) {
+      metadata.labels = { ...this.labels };
+    }
+    if (Object.keys(this.annotations).length > 0) {
+      metadata.annotations = { ...this.annotations };
+    }
+    const spec: Spec = { components: this.components.map((c) => c.toJSON()) };
+    if (this.policies.length > 0) {
+      spec.policies = this.policies.map((p) => p.toJSON());
+    }
+    if (this.workflowSteps.length > 0 || this.workflowMode) {
+      const workflow: Spec = {};
+      if (this.workflowSteps.length > 0) {
+        workflow.steps = this.workflowSteps.map((s) => s.toJSON());
+      }
+      if (this.workflowMode) {
+        workflow.mode = { ...this.workflowMode };
+      }
+      spec.workflow = workflow;
+    }
+    return { apiVersion: API_VERSION, kind: KIND, metadata, spec };
+  }
+
+  toJSONString(indent = 2): string {
+    return JSON.stringify(this.toJSON(), null, indent);
+  }
+
+  toYAML(): string {
+    return stringify(this.toJSON());
+  }
+
+  /**
+   * Loads the Application object, the components, traits, policies and workflow steps of the generated
+   * definitions are loaded as the typed builders.
+   */
+  static fromJSON(obj: Spec): Application {
+    if (obj.kind !== KIND) {
+      throw new Error(`expect kind ${KIND}, got ${String(obj.kind)}`);
+    }
+    const metadata = (obj.metadata ?? {}) as Spec;
+    const spec = (obj.spec ?? {}) as Spec;
+    const app = new Application(metadata.name as string, (metadata.namespace as string | undefined) ?? "default");
+    app.labels = { ...((metadata.labels as Record<string, string> | undefined) ?? {}) };
+    app.annotations = { ...((metadata.annotations as Record<string, string> | undefined) ?? {}) };
+    app.components = ((spec.components as Spec[] | undefined) ?? []).map((c) => Component.fromJSON(c));
+    app.policies = ((spec.policies as Spec[] | undefined) ?? []).map((p) => Policy.fromJSON(p));
+    const workflow = (spec.workflow ?? {}) as Spec;
+    app.workflowSteps = ((workflow.steps as Spec[] | undefined) ?? []).map((s) => WorkflowStep.fromJSON(s));
+    if (workflow.mode) {
+      app.workflowMode = { ...(workflow.mode as { steps: WorkflowMode; subSteps: WorkflowMode }) };
+    }
+    return app;
+  }
+
+  static fromJSONString(s: string): Application {
+    return Application.fromJSON(JSON.parse(s));
+  }
+
+  static fromYAML(s: string): Application {
+    return Application.fromJSON(parse(s));
+  }
+}
@@ -0,0 +1,358 @@
+/**
+ * Base classes of the typed builders generated from the X-Definitions.
+ */
+
+import { Schema, validate } from "./schema";
+
+/* eslint-disable @typescript-eslint/no-explicit-any */
+
+export type Properties = Record<string, unknown>;
+export type Spec = Record<string, unknown>;
+
+/**
+ * Raised when the properties do not match the schema of the definition.
+ */
+export class ValidationError extends Error {
+  constructor(readonly errors: string[]) {
+    super(errors.join("\n"));
+    this.name = "ValidationError";
+  }
+}
+
+/**
+ * The class of the generated builder.
+ */
+export interface DefinitionClass {
+  readonly KIND: string;
+  readonly TYPE: string;
+  readonly SCHEMA?: Schema;
+  new (...args: any[]): Definition<any>;
+}
+
+const registry = new Map<string, DefinitionClass>();
+
+/**
+ * Registers the generated builder, so that it is used when loading the applications.
+ */
+export function register(cls: DefinitionClass): void {
+  registry.set(`${cls.KIND}/${cls.TYPE}`, cls);
+}
+
+/**
+ * Returns the registered builder of the definition.
+ */
+export function lookup(kind: string, type: string): DefinitionClass | undefined {
+  return registry.get(`${kind}/${type}`);
+}
+
+/**
+ * Returns all the registered builders.
+ */
+export function registered(): DefinitionClass[] {
+  return [...registry.values()];
+}
+
+function clone<T>(value: T): T {
+  return value === undefined ? value : JSON.parse(JSON.stringify(value));
+}
+
+/**
+ * The component, trait, policy or workflow step of an application.
+ */
+export abstract class Definition<P extends object = Properties> {
+  static readonly KIND: string = "";
+  static readonly TYPE: string = "";
+  static readonly SCHEMA?: Schema;
+
+  protected props: Partial<P>;
+  private readonly typeOverride?: string;
+
+  constructor(properties?: Partial<P>, type?: string) {
+    this.props = clone(properties ?? {}) as Partial<P>;
+    this.typeOverride = type;
+  }
+
+  /** The kind of the definition, i.e. component, trait, policy or workflow-step. */
+  get kind(): string {
+    return (this.constructor as typeof Definition).KIND;
+  }
+
+  /** The type of the definition. */
+  get type(): string {
+    return this.typeOverride ?? (this.constructor as typeof Definition).TYPE;
+  }
+
+  /** The properties, i.e. the parameter of the definition. */
+  get properties(): Partial<P> {
+    return this.props;
+  }
+
+  /** Sets the property, which is removed if the value is undefined. */
+  setProperty<K extends keyof P>(key: K, value: P[K] | undefined): this {
+    if (value === undefined) {
+      delete this.props[key];
+    } else {
+      this.props[key] = value;
+    }
+    return this;
+  }
+
+  /** Returns the errors of the properties against the schema of the definition. */
+  validationErrors(): string[] {
+    const schema = (this.constructor as typeof Definition).SCHEMA;
+    if (!schema) {
+      return [];
+    }
+    return validate(this.props, schema).map((e) => `${this.kind} ${this.describe()}: ${e}`);
+  }
+
+  /** Validates the properties against the schema of the definition. */
+  validate(): void {
+    const errors = this.validationErrors();
+    if (errors.length > 0) {
+      throw new ValidationError(errors);
+    }
+  }
+
+  /** Serializes to the object in the application. */
+  abstract toJSON(): Spec;
+
+  protected describe(): string {
+    return this.type;
+  }
+
+  protected baseJSON(): Spec {
+    const obj: Spec = { type: this.type };
+    if (Object.keys(this.props).length > 0) {
+      obj.properties = clone(this.props);
+    }
+    return obj;
+  }
+}
+
+/**
+ * The trait attached to a component.
+ */
+export class Trait<P extends object = Properties> extends Definition<P> {
+  static readonly KIND: string = "trait";
+
+  toJSON(): Spec {
+    return this.baseJSON();
+  }
+
+  static fromJSON(obj: Spec): Trait<any> {
+    const type = obj.type as string;
+    const cls = lookup(Trait.KIND, type);
+    if (cls) {
+      return new cls(obj.properties) as Trait<any>;
+    }
+    return new Trait(obj.properties as Properties, type);
+  }
+}
+
+/**
+ * The definition with the name in the application.
+ */
+export abstract class NamedDefinition<P extends object = Properties> extends Definition<P> {
+  constructor(
+    public name: string,
+    properties?: Partial<P>,
+    type?: string,
+  ) {
+    super(properties, type);
+  }
+
+  protected describe(): string {
+    return `${this.name}(${this.type})`;
+  }
+
+  protected baseJSON(): Spec {
+    return { name: this.name, ...super.baseJSON() };
+  }
+}
+
+function load<T>(kind: string, obj: Spec, fallback: (name: string, props: Properties, type: string) => T): T {
+  const type = obj.type as string;
+  const cls = lookup(kind, type);
+  if (cls) {
+    return new cls(obj.name, obj.properties) as T;
+  }
+  return fallback(obj.name as string, obj.properties as Properties, type);
+}
+
+/**
+ * The component of an application.
+ */
+export class Component<P extends object = Properties> extends NamedDefinition<P> {
+  static readonly KIND: string = "component";
+
+  traits: Trait<any>[] = [];
+  dependsOn: string[] = [];
+  inputs: Spec[] = [];
+  outputs: Spec[] = [];
+
+  /** Adds the traits, the trait of the same type is replaced. */
+  addTrait(...traits: Trait<any>[]): this {
+    for (const trait of traits) {
+      const i = this.traits.findIndex((t) => t.type === trait.type);
+      if (i >= 0) {
+        this.traits[i] = trait;
+      } else {
+        this.traits.push(trait);
+      }
+    }
+    return this;
+  }
+
+  /** Returns the trait of the type. */
+  getTrait(type: string): Trait<any> | undefined {
+    return this.traits.find((t) => t.type === type);
+  }
+
+  /** Sets the names of the components this component depends on. */
+  setDependsOn(...components: string[]): this {
+    this.dependsOn = components;
+    return this;
+  }
+
+  validationErrors(): string[] {
+    const errors = super.validationErrors();
+    for (const trait of this.traits) {
+      errors.push(...trait.validationErrors().map((e) => `component ${this.name}: ${e}`));
+    }
+    return errors;
+  }
+
+  toJSON(): Spec {
+    const obj = this.baseJSON();
+    if (this.traits.length > 0) {
+      obj.traits = this.traits.map((t) => t.toJSON());
+    }
+    if (this.dependsOn.length > 0) {
+      obj.dependsOn = [...this.dependsOn];
+    }
+    if (this.inputs.length > 0) {
+      obj.inputs = clone(this.inputs);
+    }
+    if (this.outputs.length > 0) {
+      obj.outputs = clone(this.outputs);
+    }
+    return obj;
+  }
+
+  static fromJSON(obj: Spec): Component<any> {
+    const comp = load(Component.KIND, obj, (name, props, type) => new Component(name, props, type));
+    comp.traits = ((obj.traits as Spec[] | undefined) ?? []).map((t) => Trait.fromJSON(t));
+    comp.dependsOn = [...((obj.dependsOn as string[] | undefined) ?? [])];
+    comp.inputs = clone((obj.inputs as Spec[] | undefined) ?? []);
+    comp.outputs = clone((obj.outputs as Spec[] | undefined) ?? []);
+    return comp;
+  }
+}
+
+/**
+ * The policy of an application.
+ */
+export class Policy<P extends object = Properties> extends NamedDefinition<P> {
+  static readonly KIND: string = "policy";
+
+  toJSON(): Spec {
+    return this.baseJSON();
+  }
+
+  static fromJSON(obj: Spec): Policy<any> {
+    return load(Policy.KIND, obj, (name, props, type) => new Policy(name, props, type));
+  }
+}
+
+/**
+ * The workflow step of an application, which could be the sub-step of a step group.
+ */
+export class WorkflowStep<P extends object = Properties> extends NamedDefinition<P> {
+  static readonly KIND: string = "workflow-step";
+
+  dependsOn: string[] = [];
+  inputs: Spec[] = [];
+  outputs: Spec[] = [];
+  if?: string;
+  timeout?: string;
+  subSteps: WorkflowStep<any>[] = [];
+
+  /** Sets the names of the steps this step depends on. */
+  setDependsOn(...steps: string[]): this {
+    this.dependsOn = steps;
+    return this;
+  }
+
+  /** Sets the condition to run the step. */
+  setIf(condition: string): this {
+    this.if = condition;
+    return this;
+  }
+
+  /** Sets the timeout of the step, e.g. 5m. */
+  setTimeout(timeout: string): this {
+    this.timeout = timeout;
+    return this;
+  }
+
+  /** Adds the input from the output of another step. */
+  addInput(from: string, parameterKey: string): this {
+    this.inputs.push({ from, parameterKey });
+    return this;
+  }
+
+  /** Adds the output for the other steps. */
+  addOutput(name: string, valueFrom: string): this {
+    this.outputs.push({ name, valueFrom });
+    return this;
+  }
+
+  /** Adds the sub-steps of the step group. */
+  addSubStep(...steps: WorkflowStep<any>[]): this {
+    this.subSteps.push(...steps);
+    return this;
+  }
+
+  validationErrors(): string[] {
+    const errors = super.validationErrors();
+    for (const step of this.subSteps) {
+      errors.push(...step.validationErrors());
+    }
+    return errors;
+  }
+
+  toJSON(): Spec {
+    const obj = this.baseJSON();
+    if (this.dependsOn.length > 0) {
+      obj.dependsOn = [...this.dependsOn];
+    }
+    if (this.inputs.length > 0) {
+      obj.inputs = clone(this.inputs);
+    }
+    if (this.outputs.length > 0) {
+      obj.outputs = clone(this.outputs);
+    }
+    if (this.if) {
+      obj.if = this.if;
+    }
+    if (this.timeout) {
+      obj.timeout = this.timeout;
+    }
+    if (this.subSteps.length > 0) {
+      obj.subSteps = this.subSteps.map((s) => s.toJSON());
+    }
+    return obj;
+  }
+
+  static fromJSON(obj: Spec): WorkflowStep<any> {
+    const step = load(WorkflowStep.KIND, obj, (name, props, type) => new WorkflowStep(name, props, type));
+    step.dependsOn = [...((obj.dependsOn as string[] | undefined) ?? [])];
+    step.inputs = clone((obj.inputs as Spec[] | undefined) ?? []);
+    step.outputs = clone((obj.outputs as Spec[] | undefined) ?? []);
+    step.if = obj.if as string | undefined;
+    step.timeout = obj.timeout as string | undefined;
+    step.subSteps = ((obj.subSteps as Spec[] | undefined) ?? []).map((s) => WorkflowStep.fromJSON(s));
+    return step;
+  }
+}
@@ -0,0 +1,8 @@
+/**
+ * KubeVela SDK generated from the X-Definitions by vela def gen-api.
+ */
+
+export * from "./application";
+export * from "./base";
+export * from "./schema";
+export * as apis from "./apis";
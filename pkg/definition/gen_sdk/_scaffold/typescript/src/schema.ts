@@ -0,0 +1,139 @@
+/**
+ * Validation of the properties against the OpenAPI schemas generated from the CUE parameters of the definitions.
+ */
+
+export type Schema = { [key: string]: unknown };
+
+const typeChecks: Record<string, (v: unknown) => boolean> = {
+  string: (v) => typeof v === "string",
+  integer: (v) => typeof v === "number" && Number.isInteger(v),
+  number: (v) => typeof v === "number",
+  boolean: (v) => typeof v === "boolean",
+  array: (v) => Array.isArray(v),
+  object: (v) => typeof v === "object" && v !== null && !Array.isArray(v),
+};
+
+function join(path: string, name: string): string {
+  return path ? `${path}.${name}` : name;
+}
+
+function deepEqual(a: unknown, b: unknown): boolean {
+  return JSON.stringify(a) === JSON.stringify(b);
+}
+
+/**
+ * Validates the value against the schema and returns the errors, each prefixed by the path of the field.
+ */
+export function validate(value: unknown, schema: Schema, path = ""): string[] {
+  const where = path || "properties";
+  if (value === null || value === undefined) {
+    return schema.nullable ? [] : [`${where}: must not be null`];
+  }
+  for (const key of ["oneOf", "anyOf"]) {
+    const alts = schema[key] as Schema[] | undefined;
+    if (alts) {
+      return alts.some((alt) => validate(value, alt, path).length === 0)
+        ? []
+        : [`${where}: does not match any of the ${alts.length} options`];
+    }
+  }
+  const errors: string[] = [];
+  for (const sub of (schema.allOf as Schema[] | undefined) ?? []) {
+    errors.push(...validate(value, sub, path));
+  }
+  const enumValues = schema.enum as unknown[] | undefined;
+  if (enumValues && !enumValues.some((e) => deepEqual(e, value))) {
+    return [...errors, `${where}: must be one of ${enumValues.map((e) => JSON.stringify(e)).join(", ")}`];
+  }
+  const type = schema.type as string | undefined;
+  if (type && typeChecks[type] && !typeChecks[type](value)) {
+    return [...errors, `${where}: must be ${type}`];
+  }
+
+  if (typeof value === "string") {
+    if (typeof schema.pattern === "string" && !new RegExp(schema.pattern).test(value)) {
+      errors.push(`${where}: must match ${schema.pattern}`);
+    }
+    if (typeof schema.minLength === "number" && value.length < schema.minLength) {
+      errors.push(`${where}: must be at least ${schema.minLength} characters`);
+    }
+    if (typeof schema.maxLength === "number" && value.length > schema.maxLength) {
+      errors.push(`${where}: must be at most ${schema.maxLength} characters`);
+    }
+  } else if (typeof value === "number") {
+    if (typeof schema.minimum === "number" && value < schema.minimum) {
+      errors.push(`${where}: must be >= ${schema.minimum}`);
+    }
+    if (typeof schema.maximum === "number" && value > schema.maximum) {
+      errors.push(`${where}: must be <= ${schema.maximum}`);
+    }
+  } else if (Array.isArray(value)) {
+    const items = schema.items as Schema | undefined;
+    if (items) {
+      value.forEach((item, i) => errors.push(...validate(item, items, `${path}[${i}]`)));
+    }
+  } else if (typeof value === "object") {
+    const obj = value as Record<string, unknown>;
+    const props = (schema.properties as Record<string, Schema> | undefined) ?? {};
+    for (const name of (schema.required as string[] | undefined) ?? []) {
+      if (!(name in obj) && !(props[name] && "default" in props[name])) {
+        errors.push(`${join(path, name)}: is required`);
+      }
+    }
+    const extra = schema.additionalProperties;
+    for (const [name, item] of Object.entries(obj)) {
+      if (name in props) {
+        errors.push(...validate(item, props[name], join(path, name)));
+      } else if (typeof extra === "object" && extra !== null) {
+        errors.push(...validate(item, extra as Schema, join(path, name)));
+      } else if (extra === false) {
+        errors.push(`${join(path, name)}: is not allowed`);
+      }
+    }
+  }
+  return errors;
+}
+
+/**
+ * Returns a minimal value of the schema, which sets the required fields only.
+ */
+export function sample(schema: Schema): unknown {
+  if ("default" in schema) {
+    return schema.default;
+  }
+  const enumValues = schema.enum as unknown[] | undefined;
+  if (enumValues && enumValues.length > 0) {
+    return enumValues[0];
+  }
+  for (const key of ["oneOf", "anyOf"]) {
+    const alts = schema[key] as Schema[] | undefined;
+    if (alts && alts.length > 0) {
+      return sample(alts[0]);
+    }
+  }
+  switch (schema.type) {
+    case "object": {
+      const props = (schema.properties as Record<string, Schema> | undefined) ?? {};
+      const obj: Record<string, unknown> = {};
+      for (const name of (schema.required as string[] | undefined) ?? []) {
+        if (!(props[name] && "default" in props[name])) {
+          obj[name] = sample(props[name] ?? {});
+        }
+      }
+      return obj;
+    }
+    case "array":
+      return Array.from({ length: (schema.minItems as number | undefined) ?? 0 }, () =>
+        sample((schema.items as Schema | undefined) ?? {}),
+      );
+    case "string":
+      return "a".repeat(Math.max((schema.minLength as number | undefined) ?? 0, 1));
+    case "integer":
+    case "number":
+      return (schema.minimum as number | undefined) ?? 1;
+    case "boolean":
+      return false;
+    default:
+      return {};
+  }
+}
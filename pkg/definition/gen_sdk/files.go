@@ -23,8 +23,10 @@ var (
 	// Templates contains different template files for different languages
 	Templates embed.FS
 	// SupportedLangs is supported languages
-	SupportedLangs = map[string]bool{"go": true}
-	//go:embed _scaffold
+	SupportedLangs = map[string]bool{"go": true, "terraform": true, "python": true, "typescript": true}
+	// NativeLangs are the languages generated without openapi-generator
+	NativeLangs = map[string]bool{"terraform": true, "python": true, "typescript": true}
+	//go:embed all:_scaffold
 	// Scaffold is scaffold files for different languages
 	Scaffold embed.FS
 	// ScaffoldDir is scaffold dir name
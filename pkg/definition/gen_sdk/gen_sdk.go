@@ -29,6 +29,7 @@ import (
 	"reflect"
 	"runtime"
 	"runtime/debug"
+	"sort"
 	"strings"
 
 	"cuelang.org/go/cue"
@@ -40,6 +41,7 @@ import (
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/client-go/rest"
 	"k8s.io/klog/v2"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/kubevela/pkg/util/singleton"
 
@@ -54,7 +56,10 @@ type byteHandler func([]byte) []byte
 
 var (
 	defaultAPIDir = map[string]string{
-		"go": "pkg/apis",
+		"go":        "pkg/apis",
+		"terraform": "definitions",
+		// the python api directory is in the package, see GenMeta.Init
+		"typescript": "src/apis",
 	}
 	// LangArgsRegistry is used to store the argument info
 	LangArgsRegistry = map[string]map[langArgKey]LangArg{}
@@ -73,12 +78,18 @@ type GenMeta struct {
 	Package      string
 	Template     string
 	File         []string
-	InitSDK      bool
-	Verbose      bool
+	// Installed generates the API of the definitions installed in the cluster besides the files
+	Installed bool
+	// Namespace is the namespace of the installed definitions, all namespaces if empty
+	Namespace string
+	InitSDK   bool
+	Verbose   bool
 
 	LangArgs LanguageArgs
 
-	cuePaths     []string
+	cuePaths []string
+	// cueSources are the CUE of the installed definitions, keyed by the pseudo paths in cuePaths
+	cueSources   map[string][]byte
 	templatePath string
 	packageFunc  byteHandler
 }
@@ -183,8 +194,22 @@ func (meta *GenMeta) Init(c common.Args, langArgs []string) (err error) {
 	}
 
 	// Init arguments
+	switch {
+	case meta.Lang == "terraform" && (meta.Package == "" || meta.Package == PackagePlaceHolder):
+		meta.Package = TerraformProviderPlaceHolder
+	case meta.Lang == "python":
+		if meta.Package, err = pythonPackage(meta.Package); err != nil {
+			return err
+		}
+	case meta.Lang == "typescript" && (meta.Package == "" || meta.Package == PackagePlaceHolder):
+		meta.Package = TypeScriptPackagePlaceHolder
+	}
+
 	if meta.APIDirectory == "" {
 		meta.APIDirectory = defaultAPIDir[meta.Lang]
+		if meta.Lang == "python" {
+			meta.APIDirectory = path.Join(meta.Package, "apis")
+		}
 	}
 
 	meta.LangArgs, err = NewLanguageArgs(meta.Lang, langArgs)
@@ -195,6 +220,17 @@ func (meta *GenMeta) Init(c common.Args, langArgs []string) (err error) {
 		"go": func(b []byte) []byte {
 			return bytes.ReplaceAll(b, []byte(PackagePlaceHolder), []byte(meta.Package))
 		},
+		"terraform": func(b []byte) []byte {
+			// the resource types are prefixed with the local name of the provider, which is the last part of the address
+			b = bytes.ReplaceAll(b, []byte(terraformSourcePlaceHolder), []byte(meta.Package))
+			return bytes.ReplaceAll(b, []byte(terraformNamePlaceHolder), []byte(terraformProviderName(meta.Package)))
+		},
+		"python": func(b []byte) []byte {
+			return bytes.ReplaceAll(b, []byte(PythonPackagePlaceHolder), []byte(meta.Package))
+		},
+		"typescript": func(b []byte) []byte {
+			return bytes.ReplaceAll(b, []byte(TypeScriptPackagePlaceHolder), []byte(meta.Package))
+		},
 	}
 
 	meta.packageFunc = packageFuncs[meta.Lang]
@@ -224,9 +260,43 @@ func (meta *GenMeta) Init(c common.Args, langArgs []string) (err error) {
 		}
 
 	}
+	if meta.Installed {
+		if err = meta.loadInstalledDefinitions(clt); err != nil {
+			return err
+		}
+	}
 	return os.MkdirAll(meta.Output, 0750)
 }
 
+// loadInstalledDefinitions reads the CUE of the definitions installed in the cluster
+func (meta *GenMeta) loadInstalledDefinitions(c client.Client) error {
+	objs, err := definition.SearchDefinition(c, "", meta.Namespace)
+	if err != nil {
+		return errors.Wrap(err, "failed to list the installed definitions")
+	}
+	sort.Slice(objs, func(i, j int) bool {
+		if objs[i].GetKind() != objs[j].GetKind() {
+			return objs[i].GetKind() < objs[j].GetKind()
+		}
+		return objs[i].GetName() < objs[j].GetName()
+	})
+	if meta.cueSources == nil {
+		meta.cueSources = map[string][]byte{}
+	}
+	for _, obj := range objs {
+		def := definition.Definition{Unstructured: obj}
+		src, err := def.ToCUEString()
+		if err != nil {
+			klog.Warningf("Skip the installed %s %s/%s: %s", obj.GetKind(), obj.GetNamespace(), obj.GetName(), err.Error())
+			continue
+		}
+		key := fmt.Sprintf("%s/%s/%s", obj.GetKind(), obj.GetNamespace(), obj.GetName())
+		meta.cuePaths = append(meta.cuePaths, key)
+		meta.cueSources[key] = []byte(src)
+	}
+	return nil
+}
+
 // CreateScaffold will create a scaffold for the given language.
 // It will copy all files from embedded scaffold/{meta.Lang} to meta.Output.
 func (meta *GenMeta) CreateScaffold() error {
@@ -253,6 +323,10 @@ func (meta *GenMeta) CreateScaffold() error {
 		fileName := path.Join(meta.Output, strings.TrimPrefix(_path, langDirPrefix))
 		// go.mod_ is a special file name, it will be renamed to go.mod. Go will ignore directory containing go.mod during the build process.
 		fileName = strings.ReplaceAll(fileName, "go.mod_", "go.mod")
+		// the python package directory is named after the package
+		if meta.Lang == "python" {
+			fileName = strings.Replace(fileName, "/"+PythonPackagePlaceHolder+"/", "/"+meta.Package+"/", 1)
+		}
 		fileDir := path.Dir(fileName)
 		if err = os.MkdirAll(fileDir, 0750); err != nil {
 			return err
@@ -264,6 +338,9 @@ func (meta *GenMeta) CreateScaffold() error {
 
 // PrepareGeneratorAndTemplate will make a copy of the embedded openapi-generator-cli and templates/{meta.Lang} to local
 func (meta *GenMeta) PrepareGeneratorAndTemplate() error {
+	if NativeLangs[meta.Lang] {
+		return nil
+	}
 	var err error
 	ogImageName := "openapitools/openapi-generator-cli"
 	ogImageTag := "v6.3.0"
@@ -339,10 +416,9 @@ func (meta *GenMeta) Run(ctx context.Context) error {
 	APIGenerated := false
 	for _, cuePath := range meta.cuePaths {
 		klog.Infof("Generating API for %s", cuePath)
-		// nolint:gosec
-		cueBytes, err := os.ReadFile(cuePath)
+		cueBytes, err := meta.readCUE(cuePath)
 		if err != nil {
-			return errors.Wrapf(err, "failed to read %s", cuePath)
+			return err
 		}
 		template, defName, defKind, err := g.GetDefinitionValue(ctx, cueBytes)
 		if err != nil {
@@ -379,6 +455,19 @@ func (meta *GenMeta) Run(ctx context.Context) error {
 	return nil
 }
 
+// readCUE returns the CUE of the installed definition or the file
+func (meta *GenMeta) readCUE(cuePath string) ([]byte, error) {
+	if src, ok := meta.cueSources[cuePath]; ok {
+		return src, nil
+	}
+	// nolint:gosec
+	b, err := os.ReadFile(cuePath)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to read %s", cuePath)
+	}
+	return b, nil
+}
+
 // SetDefinition sets definition name and kind
 func (meta *GenMeta) SetDefinition(defName, defKind string) {
 	meta.name = defName
@@ -488,6 +577,9 @@ func (g *Generator) completeOpenAPISchema(doc *openapi3.T) error {
 
 // GenerateCode will call openapi-generator to generate code and modify it
 func (g *Generator) GenerateCode() (err error) {
+	if NativeLangs[g.meta.Lang] {
+		return g.modifyDef()
+	}
 	tmpFile, err := os.CreateTemp("", g.meta.name+"-*.json")
 	if err != nil {
 		return err
@@ -543,6 +635,11 @@ func (g *Generator) GenerateCode() (err error) {
 	}
 
 	// Adjust the generated files and code
+	return g.modifyDef()
+}
+
+// modifyDef runs the modifiers for the definition
+func (g *Generator) modifyDef() error {
 	for _, m := range g.defModifiers {
 		err := m.Modify()
 		if err != nil {
@@ -704,6 +801,15 @@ func appendModifiersByLanguage(g *Generator, meta *GenMeta) {
 	case "go":
 		g.defModifiers = append(g.defModifiers, &GoDefModifier{GenMeta: meta})
 		g.moduleModifiers = append(g.moduleModifiers, &GoModuleModifier{GenMeta: meta})
+	case "terraform":
+		g.defModifiers = append(g.defModifiers, &TerraformDefModifier{Generator: g})
+		g.moduleModifiers = append(g.moduleModifiers, &TerraformModuleModifier{GenMeta: meta})
+	case "python":
+		g.defModifiers = append(g.defModifiers, newPythonDefModifier(g))
+		g.moduleModifiers = append(g.moduleModifiers, &PythonModuleModifier{GenMeta: meta})
+	case "typescript":
+		g.defModifiers = append(g.defModifiers, newTypeScriptDefModifier(g))
+		g.moduleModifiers = append(g.moduleModifiers, &TypeScriptModuleModifier{GenMeta: meta})
 	default:
 		panic(fmt.Sprintf("unsupported language: %s", meta.Lang))
 	}
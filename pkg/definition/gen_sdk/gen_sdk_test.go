@@ -18,6 +18,7 @@ package gen_sdk
 
 import (
 	"context"
+	"encoding/json"
 	"os"
 	"path/filepath"
 
@@ -132,11 +133,167 @@ var _ = Describe("Test Generating SDK", func() {
 
 })
 
+var _ = Describe("Test Generating Terraform provider schema", func() {
+	It("Test generating schema and init the scaffold", func() {
+		outputDir := filepath.Join("testdata", "output-terraform")
+		DeferCleanup(func() { _ = os.RemoveAll(outputDir) })
+		meta := GenMeta{
+			Output:  outputDir,
+			Lang:    "terraform",
+			Package: "registry.terraform.io/acme/kubevela",
+			InitSDK: true,
+			File:    []string{filepath.Join("testdata", "cron-task.cue"), filepath.Join("testdata", "json-merge-patch.cue")},
+		}
+		Expect(meta.Init(common.Args{}, nil)).Should(Succeed())
+		Expect(meta.APIDirectory).Should(Equal("definitions"))
+		Expect(meta.CreateScaffold()).Should(Succeed())
+		Expect(meta.PrepareGeneratorAndTemplate()).Should(Succeed())
+		Expect(meta.Run(context.Background())).Should(Succeed())
+
+		example, err := os.ReadFile(filepath.Join(outputDir, "examples", "main.tf"))
+		Expect(err).Should(BeNil())
+		Expect(string(example)).Should(ContainSubstring(`resource "kubevela_component_webservice"`))
+		Expect(string(example)).Should(ContainSubstring(`source = "registry.terraform.io/acme/kubevela"`))
+		Expect(string(example)).Should(ContainSubstring(`provider "kubevela" {`))
+		readme, err := os.ReadFile(filepath.Join(outputDir, "README.md"))
+		Expect(err).Should(BeNil())
+		// only the placeholders are replaced, the other text mentioning vela is kept
+		Expect(string(readme)).Should(ContainSubstring("generated via vela CLI"))
+		Expect(string(readme)).Should(ContainSubstring("`kubevela_component_webservice`"))
+		Expect(string(readme)).ShouldNot(ContainSubstring("{{"))
+
+		b, err := os.ReadFile(filepath.Join(outputDir, terraformSchemaFile))
+		Expect(err).Should(BeNil())
+		schema := &TerraformSchema{}
+		Expect(json.Unmarshal(b, schema)).Should(Succeed())
+		provider := schema.ProviderSchemas["registry.terraform.io/acme/kubevela"]
+		Expect(provider).ShouldNot(BeNil())
+		Expect(provider.ResourceSchemas).Should(HaveKey("kubevela_application"))
+		Expect(provider.ResourceSchemas).Should(HaveKey("kubevela_trait_json_merge_patch"))
+		Expect(provider.ResourceSchemas["kubevela_trait_json_merge_patch"].Block.Attributes[terraformPropertiesBlock].Type).Should(Equal("dynamic"))
+
+		cronTask := provider.ResourceSchemas["kubevela_component_cron_task"]
+		Expect(cronTask).ShouldNot(BeNil())
+		Expect(cronTask.Block.Attributes["name"].Required).Should(BeTrue())
+		Expect(cronTask.Block.Attributes[terraformManifestAttribute].Computed).Should(BeTrue())
+		props := cronTask.Block.BlockTypes[terraformPropertiesBlock]
+		Expect(props.MinItems).Should(Equal(1))
+		Expect(props.Block.Attributes["schedule"].Required).Should(BeTrue())
+		Expect(props.Block.Attributes["image_pull_secrets"].Type).Should(Equal([]interface{}{"list", "string"}))
+		Expect(props.Block.BlockTypes["env"].NestingMode).Should(Equal("list"))
+		Expect(props.Block.BlockTypes["liveness_probe"].NestingMode).Should(Equal("single"))
+
+		b, err = os.ReadFile(filepath.Join(outputDir, terraformDefinitionsFile))
+		Expect(err).Should(BeNil())
+		var defs []TerraformDefinition
+		Expect(json.Unmarshal(b, &defs)).Should(Succeed())
+		Expect(defs).Should(HaveLen(2))
+		Expect(defs[0].Resource).Should(Equal("kubevela_component_cron_task"))
+		Expect(defs[0].FieldNames).Should(HaveKeyWithValue("env.value_from.secret_key_ref", "env.valueFrom.secretKeyRef"))
+	})
+})
+
 var _ = AfterSuite(func() {
 	By("Cleaning up generated files")
 	_ = os.RemoveAll(_outputDir)
 })
 
+var _ = Describe("Test Generating Python and TypeScript SDK", func() {
+	files := []string{filepath.Join("testdata", "cron-task.cue"), filepath.Join("testdata", "json-merge-patch.cue"), filepath.Join("testdata", "deploy.cue")}
+
+	It("Test generating python SDK and init the scaffold", func() {
+		outputDir := filepath.Join("testdata", "output-python")
+		DeferCleanup(func() { _ = os.RemoveAll(outputDir) })
+		meta := GenMeta{
+			Output:  outputDir,
+			Lang:    "python",
+			Package: "acme_vela",
+			InitSDK: true,
+			File:    files,
+		}
+		Expect(meta.Init(common.Args{}, nil)).Should(Succeed())
+		Expect(meta.APIDirectory).Should(Equal("acme_vela/apis"))
+		Expect(meta.CreateScaffold()).Should(Succeed())
+		Expect(meta.PrepareGeneratorAndTemplate()).Should(Succeed())
+		Expect(meta.Run(context.Background())).Should(Succeed())
+
+		base, err := os.ReadFile(filepath.Join(outputDir, "acme_vela", "base.py"))
+		Expect(err).Should(BeNil())
+		Expect(string(base)).Should(ContainSubstring("class Component("))
+		Expect(filepath.Join(outputDir, "acme_vela", "__init__.py")).Should(BeAnExistingFile())
+		Expect(filepath.Join(outputDir, "vela_sdk")).ShouldNot(BeAnExistingFile())
+
+		cronTask, err := os.ReadFile(filepath.Join(outputDir, "acme_vela", "apis", "components", "cron_task.py"))
+		Expect(err).Should(BeNil())
+		Expect(string(cronTask)).Should(ContainSubstring("from acme_vela.base import Component, register"))
+		Expect(string(cronTask)).Should(ContainSubstring("class CronTask(Component):"))
+		Expect(string(cronTask)).Should(ContainSubstring(`TYPE = "cron-task"`))
+		Expect(string(cronTask)).Should(ContainSubstring(`"CronTaskPropertiesEnv",`))
+		Expect(string(cronTask)).Should(ContainSubstring(`def set_schedule(self, schedule: str) -> "CronTask":`))
+
+		patch, err := os.ReadFile(filepath.Join(outputDir, "acme_vela", "apis", "traits", "json_merge_patch.py"))
+		Expect(err).Should(BeNil())
+		Expect(string(patch)).Should(ContainSubstring("class JsonMergePatch(Trait):"))
+		Expect(string(patch)).Should(ContainSubstring("def __init__(self, properties: Optional[Dict[str, Any]] = None)"))
+
+		deploy, err := os.ReadFile(filepath.Join(outputDir, "acme_vela", "apis", "workflow_steps", "deploy.py"))
+		Expect(err).Should(BeNil())
+		Expect(string(deploy)).Should(ContainSubstring("class Deploy(WorkflowStep):"))
+
+		index, err := os.ReadFile(filepath.Join(outputDir, "acme_vela", "apis", "components", "__init__.py"))
+		Expect(err).Should(BeNil())
+		Expect(string(index)).Should(ContainSubstring("from .cron_task import CronTask"))
+		index, err = os.ReadFile(filepath.Join(outputDir, "acme_vela", "apis", "__init__.py"))
+		Expect(err).Should(BeNil())
+		Expect(string(index)).Should(ContainSubstring("from . import components, traits, workflow_steps"))
+	})
+
+	It("Test generating typescript SDK and init the scaffold", func() {
+		outputDir := filepath.Join("testdata", "output-typescript")
+		DeferCleanup(func() { _ = os.RemoveAll(outputDir) })
+		meta := GenMeta{
+			Output:  outputDir,
+			Lang:    "typescript",
+			Package: PackagePlaceHolder,
+			InitSDK: true,
+			File:    files,
+		}
+		Expect(meta.Init(common.Args{}, nil)).Should(Succeed())
+		Expect(meta.Package).Should(Equal(TypeScriptPackagePlaceHolder))
+		Expect(meta.APIDirectory).Should(Equal("src/apis"))
+		Expect(meta.CreateScaffold()).Should(Succeed())
+		Expect(meta.PrepareGeneratorAndTemplate()).Should(Succeed())
+		Expect(meta.Run(context.Background())).Should(Succeed())
+
+		pkg, err := os.ReadFile(filepath.Join(outputDir, "package.json"))
+		Expect(err).Should(BeNil())
+		Expect(string(pkg)).Should(ContainSubstring(`"name": "@kubevela/vela-sdk"`))
+
+		cronTask, err := os.ReadFile(filepath.Join(outputDir, "src", "apis", "components", "cron-task.ts"))
+		Expect(err).Should(BeNil())
+		Expect(string(cronTask)).Should(ContainSubstring(`import { Component, register } from "../../base";`))
+		Expect(string(cronTask)).Should(ContainSubstring("export interface CronTaskProperties {"))
+		Expect(string(cronTask)).Should(ContainSubstring(`  "schedule": string;`))
+		Expect(string(cronTask)).Should(ContainSubstring("export class CronTask extends Component<CronTaskProperties> {"))
+		Expect(string(cronTask)).Should(ContainSubstring("setSchedule(schedule: string): this {"))
+		Expect(string(cronTask)).Should(ContainSubstring("register(CronTask);"))
+
+		patch, err := os.ReadFile(filepath.Join(outputDir, "src", "apis", "traits", "json-merge-patch.ts"))
+		Expect(err).Should(BeNil())
+		Expect(string(patch)).Should(ContainSubstring("export class JsonMergePatch extends Trait<Record<string, unknown>> {"))
+		Expect(string(patch)).Should(ContainSubstring("constructor(properties?: Partial<Record<string, unknown>>)"))
+
+		index, err := os.ReadFile(filepath.Join(outputDir, "src", "apis", "index.ts"))
+		Expect(err).Should(BeNil())
+		Expect(string(index)).Should(ContainSubstring(`export * as components from "./components";`))
+		Expect(string(index)).Should(ContainSubstring(`export * as workflowSteps from "./workflowSteps";`))
+		Expect(string(index)).ShouldNot(ContainSubstring("policies"))
+		index, err = os.ReadFile(filepath.Join(outputDir, "src", "apis", "traits", "index.ts"))
+		Expect(err).Should(BeNil())
+		Expect(string(index)).Should(ContainSubstring(`export * from "./json-merge-patch";`))
+	})
+})
+
 var _ = Describe("FixSchemaWithOneAnyAllOf", func() {
 	var (
 		schema *openapi3.SchemaRef
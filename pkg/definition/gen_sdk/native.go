@@ -0,0 +1,261 @@
+/*
+Copyright 2026 The KubeVela Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gen_sdk
+
+import (
+	"encoding/json"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/ettle/strcase"
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/kubevela/pkg/util/slices"
+	"github.com/pkg/errors"
+
+	"github.com/oam-dev/kubevela/apis/types"
+	pkgdef "github.com/oam-dev/kubevela/pkg/definition"
+)
+
+// sdkKind is the kind of type in the language-neutral model of the native generators
+type sdkKind string
+
+const (
+	sdkString  sdkKind = "string"
+	sdkInteger sdkKind = "integer"
+	sdkNumber  sdkKind = "number"
+	sdkBoolean sdkKind = "boolean"
+	sdkArray   sdkKind = "array"
+	sdkMap     sdkKind = "map"
+	sdkObject  sdkKind = "object"
+	sdkEnum    sdkKind = "enum"
+	sdkUnion   sdkKind = "union"
+	sdkAny     sdkKind = "any"
+
+	// sdkMaxDepth limits the depth of nested types to break the recursive schemas
+	sdkMaxDepth = 16
+)
+
+// sdkType is a type of the parameter. Objects are named and declared once, the other types are inlined.
+type sdkType struct {
+	Kind sdkKind
+	// Name is the name of the object type
+	Name string
+	// Elem is the element type of arrays and maps
+	Elem *sdkType
+	// Alts are the alternatives of unions
+	Alts   []*sdkType
+	Enum   []interface{}
+	Fields []*sdkField
+}
+
+// sdkField is a field of the object type
+type sdkField struct {
+	Name        string
+	Description string
+	Required    bool
+	Default     interface{}
+	Type        *sdkType
+}
+
+// sdkDefinition is the language-neutral model of a definition generated by the native generators of Python and
+// TypeScript. The generated code of each language renders it with the language-specific naming and types.
+type sdkDefinition struct {
+	Name        string
+	Kind        string
+	Type        string
+	Description string
+	// Properties is the type of the parameter, which is an object unless the parameter is free-form or oneOf
+	Properties *sdkType
+	// Objects are the object types in the order of declaration, the nested types are declared first
+	Objects []*sdkType
+	// Schema is the OpenAPI schema of the parameter in JSON for validation
+	Schema string
+}
+
+// sdkConverter converts the OpenAPI schema of the parameter to the sdkDefinition
+type sdkConverter struct {
+	prefix  string
+	objects []*sdkType
+	named   map[string]*sdkType
+	names   map[string]bool
+}
+
+// newSDKDefinition builds the language-neutral model of the definition from its OpenAPI schema
+func newSDKDefinition(g *Generator) (*sdkDefinition, error) {
+	doc, err := openapi3.NewLoader().LoadFromData(g.openapiSchema)
+	if err != nil {
+		return nil, errors.Wrap(err, "load OpenAPI schema")
+	}
+	spec, ok := doc.Components.Schemas[g.meta.name+"-spec"]
+	if !ok || spec.Value == nil {
+		return nil, errors.Errorf("OpenAPI schema of %s not found", g.meta.name)
+	}
+	schema, err := json.Marshal(spec.Value)
+	if err != nil {
+		return nil, err
+	}
+	c := &sdkConverter{prefix: strcase.ToPascal(g.meta.name), named: map[string]*sdkType{}, names: map[string]bool{}}
+	def := &sdkDefinition{
+		Name:        g.meta.name,
+		Kind:        g.meta.kind,
+		Type:        pkgdef.DefinitionKindToType[g.meta.kind],
+		Description: g.def.GetAnnotations()[types.AnnoDefinitionDescription],
+		Properties:  c.convert("Properties", &openapi3.SchemaRef{Value: spec.Value}, 0),
+		Schema:      string(schema),
+	}
+	def.Objects = c.objects
+	return def, nil
+}
+
+func (c *sdkConverter) convert(name string, ref *openapi3.SchemaRef, depth int) *sdkType {
+	if ref == nil || ref.Value == nil || depth >= sdkMaxDepth {
+		return &sdkType{Kind: sdkAny}
+	}
+	if ref.Ref != "" {
+		refName := path.Base(ref.Ref)
+		if t, ok := c.named[refName]; ok {
+			return t
+		}
+		name = strcase.ToPascal(refName)
+	}
+	schema := ref.Value
+	switch {
+	case len(schema.OneOf) > 0 || len(schema.AnyOf) > 0:
+		alts := schema.OneOf
+		if len(alts) == 0 {
+			alts = schema.AnyOf
+		}
+		t := &sdkType{Kind: sdkUnion}
+		for i, alt := range alts {
+			t.Alts = append(t.Alts, c.convert(name+"Option"+strconv.Itoa(i+1), alt, depth+1))
+		}
+		return t
+	case len(schema.Enum) > 0:
+		return &sdkType{Kind: sdkEnum, Enum: schema.Enum}
+	case schema.Type.Is(openapi3.TypeString):
+		return &sdkType{Kind: sdkString}
+	case schema.Type.Is(openapi3.TypeInteger):
+		return &sdkType{Kind: sdkInteger}
+	case schema.Type.Is(openapi3.TypeNumber):
+		return &sdkType{Kind: sdkNumber}
+	case schema.Type.Is(openapi3.TypeBoolean):
+		return &sdkType{Kind: sdkBoolean}
+	case schema.Type.Is(openapi3.TypeArray):
+		return &sdkType{Kind: sdkArray, Elem: c.convert(name, schema.Items, depth+1)}
+	case schema.Type.Is(openapi3.TypeObject) && len(schema.Properties) > 0:
+		t := &sdkType{Kind: sdkObject, Name: c.uniqueName(name)}
+		if ref.Ref != "" {
+			c.named[path.Base(ref.Ref)] = t
+		}
+		for _, key := range sortedPropertyNames(schema.Properties) {
+			prop := schema.Properties[key]
+			f := &sdkField{Name: key, Type: c.convert(name+strcase.ToPascal(key), prop, depth+1)}
+			if prop.Value != nil {
+				f.Description, f.Default = prop.Value.Description, prop.Value.Default
+			}
+			f.Required = f.Default == nil && slices.Contains(schema.Required, key)
+			t.Fields = append(t.Fields, f)
+		}
+		c.objects = append(c.objects, t)
+		return t
+	case schema.Type.Is(openapi3.TypeObject):
+		if ap := schema.AdditionalProperties.Schema; ap != nil && ap.Value != nil && !ap.Value.Nullable {
+			return &sdkType{Kind: sdkMap, Elem: c.convert(name+"Value", ap, depth+1)}
+		}
+		return &sdkType{Kind: sdkMap, Elem: &sdkType{Kind: sdkAny}}
+	default:
+		return &sdkType{Kind: sdkAny}
+	}
+}
+
+// uniqueName prefixes the type name with the definition name and makes it unique in the definition
+func (c *sdkConverter) uniqueName(name string) string {
+	name = c.prefix + name
+	unique := name
+	for i := 2; c.names[unique]; i++ {
+		unique = name + strconv.Itoa(i)
+	}
+	c.names[unique] = true
+	return unique
+}
+
+func sortedPropertyNames(props openapi3.Schemas) []string {
+	keys := make([]string, 0, len(props))
+	for k := range props {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func writeFile(file string, content []byte) error {
+	if err := os.MkdirAll(filepath.Dir(file), 0750); err != nil {
+		return err
+	}
+	return os.WriteFile(file, content, 0600)
+}
+
+// sdkIdentifier converts the name to the identifier with the case function, and escapes the reserved words and the
+// names starting with digits
+func sdkIdentifier(name string, caseFunc func(string) string, reserved map[string]bool) string {
+	id := caseFunc(name)
+	if id == "" || (id[0] >= '0' && id[0] <= '9') {
+		id = "_" + id
+	}
+	if reserved[id] {
+		id += "_"
+	}
+	return id
+}
+
+// sdkComment makes the text safe to be put in the doc comments
+func sdkComment(text string, escapes ...string) string {
+	text = strings.TrimSpace(text)
+	for i := 0; i+1 < len(escapes); i += 2 {
+		text = strings.ReplaceAll(text, escapes[i], escapes[i+1])
+	}
+	return text
+}
+
+// sdkModifier writes the generated code of a definition in a native language
+type sdkModifier struct {
+	*Generator
+	lang   string
+	render func(def *sdkDefinition) (file string, content []byte, err error)
+}
+
+// Name the name of modifier
+func (m *sdkModifier) Name() string {
+	return strcase.ToPascal(m.lang) + "DefModifier"
+}
+
+// Modify implements Modifier
+func (m *sdkModifier) Modify() error {
+	def, err := newSDKDefinition(m.Generator)
+	if err != nil {
+		return err
+	}
+	file, content, err := m.render(def)
+	if err != nil {
+		return errors.Wrapf(err, "render %s code of %s", m.lang, def.Name)
+	}
+	return writeFile(file, content)
+}
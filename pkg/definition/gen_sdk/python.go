@@ -0,0 +1,363 @@
+/*
+Copyright 2026 The KubeVela Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gen_sdk
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"text/template"
+
+	"github.com/ettle/strcase"
+	"github.com/pkg/errors"
+
+	"github.com/oam-dev/kubevela/apis/core.oam.dev/v1beta1"
+)
+
+const (
+	// PythonPackagePlaceHolder is the default package name of the generated Python SDK
+	PythonPackagePlaceHolder = "vela_sdk"
+	// generatedHeader marks the files generated from the definitions, which are overwritten by the next generation
+	generatedHeader = "Code generated by vela def gen-api. DO NOT EDIT."
+)
+
+var (
+	pythonPackageRegexp = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+	pythonClassRegexp   = regexp.MustCompile(`(?m)^@register\nclass (\w+)\(`)
+
+	// pythonKindDirs are the sub-packages of the definitions of each kind
+	pythonKindDirs = map[string]string{
+		v1beta1.ComponentDefinitionKind:    "components",
+		v1beta1.TraitDefinitionKind:        "traits",
+		v1beta1.PolicyDefinitionKind:       "policies",
+		v1beta1.WorkflowStepDefinitionKind: "workflow_steps",
+	}
+	pythonBaseClasses = map[string]string{
+		v1beta1.ComponentDefinitionKind:    "Component",
+		v1beta1.TraitDefinitionKind:        "Trait",
+		v1beta1.PolicyDefinitionKind:       "Policy",
+		v1beta1.WorkflowStepDefinitionKind: "WorkflowStep",
+	}
+	pythonReserved = toSet("False", "None", "True", "and", "as", "assert", "async", "await", "break", "class",
+		"continue", "def", "del", "elif", "else", "except", "finally", "for", "from", "global", "if", "import", "in",
+		"is", "lambda", "nonlocal", "not", "or", "pass", "raise", "return", "try", "while", "with", "yield", "self")
+	// pythonBaseMethods are the methods of the base classes, the setters of the same names are suffixed
+	pythonBaseMethods = toSet("set_property", "set_depends_on", "set_if", "set_timeout")
+)
+
+// PythonDefModifier is the Modifier for python, it generates the typed builder of the definition
+type PythonDefModifier struct {
+	sdkModifier
+}
+
+// PythonModuleModifier is the Modifier for python, it exports the builders of all the definitions
+type PythonModuleModifier struct {
+	*GenMeta
+}
+
+func newPythonDefModifier(g *Generator) *PythonDefModifier {
+	m := &PythonDefModifier{sdkModifier{Generator: g, lang: "python"}}
+	m.render = m.renderDefinition
+	return m
+}
+
+// pythonPackage returns the name of the Python package of the SDK
+func pythonPackage(pkg string) (string, error) {
+	if pkg == "" || pkg == PackagePlaceHolder {
+		return PythonPackagePlaceHolder, nil
+	}
+	if !pythonPackageRegexp.MatchString(pkg) {
+		return "", errors.Errorf("invalid python package name %s", pkg)
+	}
+	return pkg, nil
+}
+
+// pythonModule returns the module name of the definition, e.g. cron_task for cron-task
+func pythonModule(name string) string {
+	return sdkIdentifier(name, strcase.ToSnake, pythonReserved)
+}
+
+var pythonDefinitionTemplate = template.Must(template.New("python").Funcs(template.FuncMap{
+	"type":    pythonType,
+	"doc":     pythonDoc,
+	"comment": pythonComment,
+	"quote":   jsonQuote,
+}).Parse(`# {{ .Header }}
+"""{{ doc .Def.Description "" }}"""
+
+import json
+from typing import Any, Dict, List, Literal, Optional, TypedDict, Union  # noqa: F401
+
+from {{ .Package }}.base import {{ .Base }}, register
+{{ range .Def.Objects }}
+{{ .Name }} = TypedDict(
+    "{{ .Name }}",
+    {
+{{- range .Fields }}
+{{- if .Description }}
+        # {{ comment .Description "        " }}
+{{- end }}
+        {{ quote .Name }}: {{ type .Type }},
+{{- end }}
+    },
+    total=False,
+)
+{{ end }}
+_SCHEMA: Dict[str, Any] = json.loads({{ quote .Def.Schema }})
+
+
+@register
+class {{ .Class }}({{ .Base }}):
+    """{{ doc .Def.Description "    " }}"""
+
+    TYPE = {{ quote .Def.Name }}
+    SCHEMA = _SCHEMA
+
+    def __init__(self, {{ if .Named }}name: str, {{ end }}properties: Optional[{{ type .Def.Properties }}] = None) -> None:
+        super().__init__({{ if .Named }}name, {{ end }}properties)  # type: ignore[arg-type]
+{{- range .Setters }}
+
+    def {{ .Method }}(self, {{ .Arg }}: {{ type .Field.Type }}) -> "{{ $.Class }}":
+        """{{ doc .Doc "        " }}"""
+        return self.set_property({{ quote .Field.Name }}, {{ .Arg }})
+{{- end }}
+`))
+
+type pythonSetter struct {
+	Method string
+	Arg    string
+	Doc    string
+	Field  *sdkField
+}
+
+// renderDefinition renders the module of the definition
+func (m *PythonDefModifier) renderDefinition(def *sdkDefinition) (string, []byte, error) {
+	data := map[string]interface{}{
+		"Header":  generatedHeader,
+		"Package": m.meta.Package,
+		"Def":     def,
+		"Class":   sdkIdentifier(def.Name, strcase.ToPascal, pythonReserved),
+		"Base":    pythonBaseClasses[def.Kind],
+		"Named":   def.Kind != v1beta1.TraitDefinitionKind,
+	}
+	var setters []pythonSetter
+	if def.Properties.Kind == sdkObject {
+		for _, f := range def.Properties.Fields {
+			method := "set_" + strcase.ToSnake(f.Name)
+			if pythonBaseMethods[method] {
+				method += "_property"
+			}
+			setters = append(setters, pythonSetter{
+				Method: method,
+				Arg:    sdkIdentifier(f.Name, strcase.ToSnake, pythonReserved),
+				Doc:    fieldDoc(f),
+				Field:  f,
+			})
+		}
+	}
+	data["Setters"] = setters
+	buf := &bytes.Buffer{}
+	if err := pythonDefinitionTemplate.Execute(buf, data); err != nil {
+		return "", nil, err
+	}
+	file := path.Join(m.meta.Output, m.meta.APIDirectory, pythonKindDirs[def.Kind], pythonModule(def.Name)+".py")
+	return file, buf.Bytes(), nil
+}
+
+// fieldDoc returns the doc of the field with whether it is required or its default value
+func fieldDoc(f *sdkField) string {
+	doc := f.Description
+	if doc == "" {
+		doc = "Sets " + f.Name + "."
+	}
+	switch {
+	case f.Required:
+		doc += "\n\nRequired."
+	case f.Default != nil:
+		b, _ := json.Marshal(f.Default)
+		doc += fmt.Sprintf("\n\nDefaults to %s.", b)
+	}
+	return doc
+}
+
+// pythonType returns the type annotation of the type, the objects are quoted as forward references
+func pythonType(t *sdkType) string {
+	switch t.Kind {
+	case sdkString:
+		return "str"
+	case sdkInteger:
+		return "int"
+	case sdkNumber:
+		return "float"
+	case sdkBoolean:
+		return "bool"
+	case sdkArray:
+		return "List[" + pythonType(t.Elem) + "]"
+	case sdkMap:
+		return "Dict[str, " + pythonType(t.Elem) + "]"
+	case sdkObject:
+		return `"` + t.Name + `"`
+	case sdkEnum:
+		values := make([]string, 0, len(t.Enum))
+		for _, v := range t.Enum {
+			values = append(values, pythonLiteral(v))
+		}
+		return "Literal[" + strings.Join(values, ", ") + "]"
+	case sdkUnion:
+		return unionType(t.Alts, pythonType, func(alts []string) string { return "Union[" + strings.Join(alts, ", ") + "]" })
+	default:
+		return "Any"
+	}
+}
+
+// unionType returns the union of the distinct alternatives, or the only alternative
+func unionType(alts []*sdkType, typeFunc func(*sdkType) string, union func([]string) string) string {
+	var types []string
+	seen := map[string]bool{}
+	for _, alt := range alts {
+		if typ := typeFunc(alt); !seen[typ] {
+			seen[typ] = true
+			types = append(types, typ)
+		}
+	}
+	if len(types) == 1 {
+		return types[0]
+	}
+	return union(types)
+}
+
+func pythonLiteral(v interface{}) string {
+	switch v := v.(type) {
+	case nil:
+		return "None"
+	case bool:
+		if v {
+			return "True"
+		}
+		return "False"
+	default:
+		b, _ := json.Marshal(v)
+		return string(b)
+	}
+}
+
+// pythonDoc makes the text safe in the docstring, the following lines are indented
+func pythonDoc(text, indent string) string {
+	text = sdkComment(text, `\`, `\\`, `"""`, `\"\"\"`)
+	if text == "" {
+		return "Generated from the X-Definition."
+	}
+	// the quote at the end would be merged into the closing quotes of the docstring
+	if strings.HasSuffix(text, `"`) {
+		text += " "
+	}
+	if !strings.Contains(text, "\n") {
+		return text
+	}
+	lines := strings.Split(text, "\n")
+	for i := 1; i < len(lines); i++ {
+		if strings.TrimSpace(lines[i]) != "" {
+			lines[i] = indent + lines[i]
+		} else {
+			lines[i] = ""
+		}
+	}
+	return strings.Join(lines, "\n") + "\n" + indent
+}
+
+// pythonComment makes the multi-line text comments
+func pythonComment(text, indent string) string {
+	return strings.ReplaceAll(sdkComment(text), "\n", "\n"+indent+"# ")
+}
+
+// jsonQuote quotes the string in JSON, which is also a valid string literal in Python and TypeScript
+func jsonQuote(s string) string {
+	b, _ := json.Marshal(s)
+	return string(b)
+}
+
+func toSet(items ...string) map[string]bool {
+	set := make(map[string]bool, len(items))
+	for _, item := range items {
+		set[item] = true
+	}
+	return set
+}
+
+// Name the name of modifier
+func (m *PythonModuleModifier) Name() string {
+	return "PythonModuleModifier"
+}
+
+// Modify implements Modifier
+func (m *PythonModuleModifier) Modify() error {
+	apiDir := path.Join(m.Output, m.APIDirectory)
+	var kindDirs []string
+	for _, dir := range pythonKindDirs {
+		kindDirs = append(kindDirs, dir)
+	}
+	sort.Strings(kindDirs)
+	var packages []string
+	for _, dir := range kindDirs {
+		files, err := filepath.Glob(filepath.Join(apiDir, dir, "*.py"))
+		if err != nil {
+			return err
+		}
+		sort.Strings(files)
+		imports, classes := &bytes.Buffer{}, []string{}
+		for _, file := range files {
+			if filepath.Base(file) == "__init__.py" {
+				continue
+			}
+			// nolint:gosec
+			content, err := os.ReadFile(file)
+			if err != nil {
+				return err
+			}
+			match := pythonClassRegexp.FindSubmatch(content)
+			if match == nil {
+				continue
+			}
+			fmt.Fprintf(imports, "from .%s import %s\n", strings.TrimSuffix(filepath.Base(file), ".py"), match[1])
+			classes = append(classes, jsonQuote(string(match[1])))
+		}
+		if len(classes) == 0 {
+			continue
+		}
+		packages = append(packages, dir)
+		content := fmt.Sprintf("# %s\n%s\n__all__ = [%s]\n", generatedHeader, imports, strings.Join(classes, ", "))
+		if err = writeFile(filepath.Join(apiDir, dir, "__init__.py"), []byte(content)); err != nil {
+			return err
+		}
+	}
+	content := fmt.Sprintf("# %s\nfrom . import %s\n\n__all__ = [%s]\n", generatedHeader, strings.Join(packages, ", "), quoteAll(packages))
+	return writeFile(filepath.Join(apiDir, "__init__.py"), []byte(content))
+}
+
+func quoteAll(items []string) string {
+	quoted := make([]string, 0, len(items))
+	for _, item := range items {
+		quoted = append(quoted, jsonQuote(item))
+	}
+	return strings.Join(quoted, ", ")
+}
@@ -0,0 +1,379 @@
+/*
+Copyright 2026 The KubeVela Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gen_sdk
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/ettle/strcase"
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/kubevela/pkg/util/slices"
+	"github.com/pkg/errors"
+
+	"github.com/oam-dev/kubevela/apis/core.oam.dev/v1beta1"
+	"github.com/oam-dev/kubevela/apis/types"
+	pkgdef "github.com/oam-dev/kubevela/pkg/definition"
+)
+
+const (
+	// TerraformProviderPlaceHolder is the default source address of the generated terraform provider
+	TerraformProviderPlaceHolder = "registry.terraform.io/kubevela/vela"
+
+	// terraformSourcePlaceHolder is the placeholder of the provider source address in the scaffold files
+	terraformSourcePlaceHolder = "{{PROVIDER_SOURCE}}"
+	// terraformNamePlaceHolder is the placeholder of the provider local name in the scaffold files
+	terraformNamePlaceHolder = "{{PROVIDER_NAME}}"
+
+	// terraformSchemaFile is the file name of the merged provider schema
+	terraformSchemaFile = "schema.json"
+	// terraformDefinitionsFile is the file name of the merged definition metadata
+	terraformDefinitionsFile = "definitions.json"
+	// terraformManifestAttribute is the computed attribute holding the rendered JSON of the definition resource
+	terraformManifestAttribute = "manifest"
+	// terraformPropertiesBlock is the block holding the parameters of the definition
+	terraformPropertiesBlock = "properties"
+	// terraformMaxDepth limits the depth of nested blocks to break the recursive schemas
+	terraformMaxDepth = 16
+)
+
+// TerraformSchema is the provider schema in the format of `terraform providers schema -json`
+type TerraformSchema struct {
+	FormatVersion   string                              `json:"format_version"`
+	ProviderSchemas map[string]*TerraformProviderSchema `json:"provider_schemas"`
+}
+
+// TerraformProviderSchema is the schema of one provider
+type TerraformProviderSchema struct {
+	Provider        *TerraformSchemaRepr            `json:"provider"`
+	ResourceSchemas map[string]*TerraformSchemaRepr `json:"resource_schemas"`
+}
+
+// TerraformSchemaRepr is the schema of the provider configuration or a resource
+type TerraformSchemaRepr struct {
+	Version int64           `json:"version"`
+	Block   *TerraformBlock `json:"block"`
+}
+
+// TerraformBlock is a configuration block
+type TerraformBlock struct {
+	Attributes      map[string]*TerraformAttribute   `json:"attributes,omitempty"`
+	BlockTypes      map[string]*TerraformNestedBlock `json:"block_types,omitempty"`
+	Description     string                           `json:"description,omitempty"`
+	DescriptionKind string                           `json:"description_kind,omitempty"`
+}
+
+// TerraformAttribute is an attribute of the block. Type is the JSON form of the cty type,
+// e.g. "string", ["list","string"], ["object",{"name":"string"}].
+type TerraformAttribute struct {
+	Type        interface{} `json:"type"`
+	Description string      `json:"description,omitempty"`
+	Required    bool        `json:"required,omitempty"`
+	Optional    bool        `json:"optional,omitempty"`
+	Computed    bool        `json:"computed,omitempty"`
+}
+
+// TerraformNestedBlock is a nested block of the block
+type TerraformNestedBlock struct {
+	NestingMode string          `json:"nesting_mode"`
+	Block       *TerraformBlock `json:"block"`
+	MinItems    int             `json:"min_items,omitempty"`
+	MaxItems    int             `json:"max_items,omitempty"`
+}
+
+// TerraformDefinition records how a terraform resource maps to the X-Definition, so that the provider can
+// render the resource back to the component, trait, policy or workflow step of the application
+type TerraformDefinition struct {
+	Resource string `json:"resource"`
+	Kind     string `json:"kind"`
+	Type     string `json:"type"`
+	// FieldNames maps the dot-separated attribute paths in the properties block to the parameter paths
+	FieldNames map[string]string    `json:"fieldNames,omitempty"`
+	Schema     *TerraformSchemaRepr `json:"schema"`
+}
+
+// TerraformDefModifier is the Modifier for terraform, it converts the OpenAPI schema of the definition to the
+// terraform resource schema
+type TerraformDefModifier struct {
+	*Generator
+}
+
+// TerraformModuleModifier is the Modifier for terraform, it merges the resource schemas of all the definitions
+// together with the application resource into the provider schema
+type TerraformModuleModifier struct {
+	*GenMeta
+}
+
+// Name the name of modifier
+func (m *TerraformDefModifier) Name() string {
+	return "TerraformDefModifier"
+}
+
+// Name the name of modifier
+func (m *TerraformModuleModifier) Name() string {
+	return "TerraformModuleModifier"
+}
+
+// terraformProviderName returns the local name of the provider, which is the prefix of all the resources
+func terraformProviderName(address string) string {
+	return path.Base(address)
+}
+
+// TerraformResourceName returns the name of the terraform resource of the definition, e.g. vela_component_webservice
+func TerraformResourceName(provider, kind, name string) string {
+	return strcase.ToSnake(strings.Join([]string{provider, pkgdef.DefinitionKindToType[kind], name}, "_"))
+}
+
+// Modify implements Modifier
+func (m *TerraformDefModifier) Modify() error {
+	doc, err := openapi3.NewLoader().LoadFromData(m.openapiSchema)
+	if err != nil {
+		return errors.Wrap(err, "load OpenAPI schema")
+	}
+	spec, ok := doc.Components.Schemas[m.meta.name+"-spec"]
+	if !ok || spec.Value == nil {
+		return errors.Errorf("OpenAPI schema of %s not found", m.meta.name)
+	}
+	res := TerraformResourceName(terraformProviderName(m.meta.Package), m.meta.kind, m.meta.name)
+	def := &TerraformDefinition{
+		Resource:   res,
+		Kind:       m.meta.kind,
+		Type:       m.meta.name,
+		FieldNames: map[string]string{},
+	}
+	block := &TerraformBlock{
+		Attributes: map[string]*TerraformAttribute{
+			terraformManifestAttribute: {Type: "string", Computed: true, Description: fmt.Sprintf("The rendered %s in JSON.", pkgdef.DefinitionKindToType[m.meta.kind])},
+		},
+		Description:     m.def.GetAnnotations()[types.AnnoDefinitionDescription],
+		DescriptionKind: "plain",
+	}
+	if m.meta.kind != v1beta1.TraitDefinitionKind {
+		block.Attributes["name"] = &TerraformAttribute{Type: "string", Required: true, Description: "The name in the application."}
+	}
+	if m.meta.kind == v1beta1.ComponentDefinitionKind {
+		block.Attributes["traits"] = &TerraformAttribute{Type: []interface{}{"list", "string"}, Optional: true, Description: "The manifests of the traits attached to the component."}
+		block.Attributes["depends_on_components"] = &TerraformAttribute{Type: []interface{}{"list", "string"}, Optional: true, Description: "The names of the components this component depends on."}
+	}
+	if len(spec.Value.Properties) > 0 && len(spec.Value.OneOf) == 0 {
+		props := convertTerraformBlock(spec.Value, "", "", def.FieldNames, 0)
+		block.BlockTypes = map[string]*TerraformNestedBlock{terraformPropertiesBlock: {NestingMode: "single", Block: props}}
+		if len(spec.Value.Required) > 0 {
+			block.BlockTypes[terraformPropertiesBlock].MinItems = 1
+		}
+	} else {
+		block.Attributes[terraformPropertiesBlock] = &TerraformAttribute{Type: "dynamic", Optional: true, Description: "The parameters of the definition."}
+	}
+	def.Schema = &TerraformSchemaRepr{Block: block}
+
+	dir := path.Join(m.meta.Output, m.meta.APIDirectory, pkgdef.DefinitionKindToType[m.meta.kind])
+	if err = os.MkdirAll(dir, 0750); err != nil {
+		return err
+	}
+	return writeJSON(path.Join(dir, m.meta.name+".json"), def)
+}
+
+// convertTerraformBlock converts the object schema to the terraform block. The attribute paths and parameter paths
+// are recorded in fieldNames.
+func convertTerraformBlock(schema *openapi3.Schema, tfPrefix, paramPrefix string, fieldNames map[string]string, depth int) *TerraformBlock {
+	block := &TerraformBlock{Attributes: map[string]*TerraformAttribute{}, BlockTypes: map[string]*TerraformNestedBlock{}}
+	for name, ref := range schema.Properties {
+		if ref == nil || ref.Value == nil {
+			continue
+		}
+		prop := ref.Value
+		tfName := strcase.ToSnake(name)
+		tfPath, paramPath := tfPrefix+tfName, paramPrefix+name
+		fieldNames[tfPath] = paramPath
+		required := prop.Default == nil && slices.Contains(schema.Required, name)
+
+		if depth < terraformMaxDepth && isTerraformBlock(prop) {
+			nested := &TerraformNestedBlock{NestingMode: "single", Block: convertTerraformBlock(prop, tfPath+".", paramPath+".", fieldNames, depth+1)}
+			nested.Block.Description = prop.Description
+			if required {
+				nested.MinItems = 1
+			}
+			block.BlockTypes[tfName] = nested
+			continue
+		}
+		if depth < terraformMaxDepth && prop.Type.Is(openapi3.TypeArray) && prop.Items != nil && prop.Items.Value != nil && isTerraformBlock(prop.Items.Value) {
+			nested := &TerraformNestedBlock{NestingMode: "list", Block: convertTerraformBlock(prop.Items.Value, tfPath+".", paramPath+".", fieldNames, depth+1)}
+			nested.Block.Description = prop.Description
+			if required {
+				nested.MinItems = 1
+			}
+			block.BlockTypes[tfName] = nested
+			continue
+		}
+		attr := &TerraformAttribute{Type: terraformType(prop, depth), Description: prop.Description, Required: required, Optional: !required}
+		if prop.Default != nil {
+			b, _ := json.Marshal(prop.Default)
+			attr.Description = strings.TrimSpace(fmt.Sprintf("%s (default: %s)", attr.Description, b))
+		}
+		block.Attributes[tfName] = attr
+	}
+	if len(block.Attributes) == 0 {
+		block.Attributes = nil
+	}
+	if len(block.BlockTypes) == 0 {
+		block.BlockTypes = nil
+	}
+	return block
+}
+
+// isTerraformBlock checks if the schema is a structured object which can be represented as a nested block
+func isTerraformBlock(schema *openapi3.Schema) bool {
+	return schema.Type.Is(openapi3.TypeObject) && len(schema.Properties) > 0 &&
+		len(schema.OneOf) == 0 && len(schema.AnyOf) == 0 && len(schema.AllOf) == 0
+}
+
+// terraformType returns the JSON form of the cty type of the schema. The schemas that cannot be typed,
+// such as the free-form objects and oneOf, are dynamic.
+func terraformType(schema *openapi3.Schema, depth int) interface{} {
+	if depth >= terraformMaxDepth || len(schema.OneOf) > 0 || len(schema.AnyOf) > 0 || len(schema.AllOf) > 0 {
+		return "dynamic"
+	}
+	switch {
+	case schema.Type.Is(openapi3.TypeString):
+		return "string"
+	case schema.Type.Is(openapi3.TypeInteger), schema.Type.Is(openapi3.TypeNumber):
+		return "number"
+	case schema.Type.Is(openapi3.TypeBoolean):
+		return "bool"
+	case schema.Type.Is(openapi3.TypeArray):
+		if schema.Items == nil || schema.Items.Value == nil {
+			return "dynamic"
+		}
+		if elem := terraformType(schema.Items.Value, depth+1); elem != "dynamic" {
+			return []interface{}{"list", elem}
+		}
+	case schema.Type.Is(openapi3.TypeObject):
+		if len(schema.Properties) > 0 {
+			attrs := map[string]interface{}{}
+			for name, ref := range schema.Properties {
+				if ref == nil || ref.Value == nil {
+					continue
+				}
+				attrs[strcase.ToSnake(name)] = terraformType(ref.Value, depth+1)
+			}
+			return []interface{}{"object", attrs}
+		}
+		if ap := schema.AdditionalProperties.Schema; ap != nil && ap.Value != nil {
+			if elem := terraformType(ap.Value, depth+1); elem != "dynamic" {
+				return []interface{}{"map", elem}
+			}
+		}
+	}
+	return "dynamic"
+}
+
+func writeJSON(file string, v interface{}) error {
+	b, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(file, append(b, '\n'), 0600)
+}
+
+// Modify implements Modifier
+func (m *TerraformModuleModifier) Modify() error {
+	apiDir := path.Join(m.Output, m.APIDirectory)
+	var defs []*TerraformDefinition
+	err := filepath.Walk(apiDir, func(p string, info os.FileInfo, err error) error {
+		if err != nil || info.IsDir() || !strings.HasSuffix(p, ".json") {
+			return err
+		}
+		if name := filepath.Base(p); name == terraformSchemaFile || name == terraformDefinitionsFile {
+			return nil
+		}
+		// nolint:gosec
+		b, err := os.ReadFile(p)
+		if err != nil {
+			return err
+		}
+		def := &TerraformDefinition{}
+		if err = json.Unmarshal(b, def); err != nil {
+			return errors.Wrapf(err, "parse %s", p)
+		}
+		defs = append(defs, def)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	sort.Slice(defs, func(i, j int) bool { return defs[i].Resource < defs[j].Resource })
+
+	provider := terraformProviderName(m.Package)
+	resources := map[string]*TerraformSchemaRepr{provider + "_application": terraformApplicationSchema()}
+	for _, def := range defs {
+		resources[def.Resource] = def.Schema
+	}
+	schema := &TerraformSchema{
+		FormatVersion: "1.0",
+		ProviderSchemas: map[string]*TerraformProviderSchema{m.Package: {
+			Provider:        terraformProviderConfigSchema(),
+			ResourceSchemas: resources,
+		}},
+	}
+	if err = writeJSON(path.Join(m.Output, terraformSchemaFile), schema); err != nil {
+		return err
+	}
+	for _, def := range defs {
+		def.Schema = nil
+	}
+	return writeJSON(path.Join(m.Output, terraformDefinitionsFile), defs)
+}
+
+// terraformProviderConfigSchema returns the schema of the provider configuration
+func terraformProviderConfigSchema() *TerraformSchemaRepr {
+	return &TerraformSchemaRepr{Block: &TerraformBlock{
+		Attributes: map[string]*TerraformAttribute{
+			"kubeconfig":     {Type: "string", Optional: true, Description: "The path of the kubeconfig file, defaults to $KUBECONFIG or ~/.kube/config."},
+			"context":        {Type: "string", Optional: true, Description: "The context in the kubeconfig to use."},
+			"namespace":      {Type: "string", Optional: true, Description: "The default namespace of the applications."},
+			"wait_for_ready": {Type: "bool", Optional: true, Description: "Whether to wait for the applications to be running after apply."},
+		},
+	}}
+}
+
+// terraformApplicationSchema returns the schema of the application resource, which composes the manifests of
+// the definition resources into a KubeVela Application
+func terraformApplicationSchema() *TerraformSchemaRepr {
+	stringList := []interface{}{"list", "string"}
+	stringMap := []interface{}{"map", "string"}
+	return &TerraformSchemaRepr{Block: &TerraformBlock{
+		Attributes: map[string]*TerraformAttribute{
+			"name":           {Type: "string", Required: true, Description: "The name of the application."},
+			"namespace":      {Type: "string", Optional: true, Computed: true, Description: "The namespace of the application."},
+			"labels":         {Type: stringMap, Optional: true, Description: "The labels of the application."},
+			"annotations":    {Type: stringMap, Optional: true, Description: "The annotations of the application."},
+			"components":     {Type: stringList, Required: true, Description: "The manifests of the components."},
+			"policies":       {Type: stringList, Optional: true, Description: "The manifests of the policies."},
+			"workflow_steps": {Type: stringList, Optional: true, Description: "The manifests of the workflow steps."},
+			"workflow_mode":  {Type: "string", Optional: true, Description: "The execution mode of the workflow steps, StepByStep or DAG."},
+			"phase":          {Type: "string", Computed: true, Description: "The phase of the application."},
+			"revision":       {Type: "string", Computed: true, Description: "The latest revision of the application."},
+		},
+		Description:     "A KubeVela Application composed of the components, policies and workflow steps.",
+		DescriptionKind: "plain",
+	}}
+}
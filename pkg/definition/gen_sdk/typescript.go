@@ -0,0 +1,263 @@
+/*
+Copyright 2026 The KubeVela Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gen_sdk
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"text/template"
+
+	"github.com/ettle/strcase"
+	"github.com/pkg/errors"
+
+	"github.com/oam-dev/kubevela/apis/core.oam.dev/v1beta1"
+)
+
+const (
+	// TypeScriptPackagePlaceHolder is the default package name of the generated TypeScript SDK
+	TypeScriptPackagePlaceHolder = "@kubevela/vela-sdk"
+	// typeScriptSourceDir is the directory of the sources of the TypeScript SDK
+	typeScriptSourceDir = "src"
+)
+
+var (
+	typeScriptClassRegexp = regexp.MustCompile(`(?m)^register\((\w+)\);$`)
+
+	// typeScriptKindDirs are the directories of the definitions of each kind
+	typeScriptKindDirs = map[string]string{
+		v1beta1.ComponentDefinitionKind:    "components",
+		v1beta1.TraitDefinitionKind:        "traits",
+		v1beta1.PolicyDefinitionKind:       "policies",
+		v1beta1.WorkflowStepDefinitionKind: "workflowSteps",
+	}
+	typeScriptReserved = toSet("break", "case", "catch", "class", "const", "continue", "debugger", "default", "delete",
+		"do", "else", "enum", "export", "extends", "false", "finally", "for", "function", "if", "import", "in",
+		"instanceof", "new", "null", "return", "super", "switch", "this", "throw", "true", "try", "typeof", "var",
+		"void", "while", "with", "as", "implements", "interface", "let", "package", "private", "protected", "public",
+		"static", "yield", "await")
+	// typeScriptBaseMethods are the methods of the base classes, the setters of the same names are suffixed
+	typeScriptBaseMethods = toSet("setProperty", "setDependsOn", "setIf", "setTimeout")
+)
+
+// TypeScriptDefModifier is the Modifier for typescript, it generates the typed builder of the definition
+type TypeScriptDefModifier struct {
+	sdkModifier
+}
+
+// TypeScriptModuleModifier is the Modifier for typescript, it exports the builders of all the definitions
+type TypeScriptModuleModifier struct {
+	*GenMeta
+}
+
+func newTypeScriptDefModifier(g *Generator) *TypeScriptDefModifier {
+	m := &TypeScriptDefModifier{sdkModifier{Generator: g, lang: "typescript"}}
+	m.render = m.renderDefinition
+	return m
+}
+
+var typeScriptDefinitionTemplate = template.Must(template.New("typescript").Funcs(template.FuncMap{
+	"type":  typeScriptType,
+	"doc":   typeScriptDoc,
+	"quote": jsonQuote,
+}).Parse(`// {{ .Header }}
+import { {{ .Base }}, register } from "{{ .Import }}";
+{{ range .Def.Objects }}
+{{ doc "" "" }}export interface {{ .Name }} {
+{{- range .Fields }}
+  {{ doc .Description "  " }}{{ quote .Name }}{{ if not .Required }}?{{ end }}: {{ type .Type }};
+{{- end }}
+}
+{{ end }}
+export const {{ .Class }}Schema = {{ .Def.Schema }};
+
+{{ doc .Def.Description "" }}export class {{ .Class }} extends {{ .Base }}<{{ .Properties }}> {
+  static readonly TYPE = {{ quote .Def.Name }};
+  static readonly SCHEMA = {{ .Class }}Schema;
+
+  constructor({{ if .Named }}name: string, {{ end }}properties?: Partial<{{ .Properties }}>) {
+    super({{ if .Named }}name, {{ end }}properties);
+  }
+{{- range .Setters }}
+
+  {{ doc .Doc "  " }}{{ .Method }}({{ .Arg }}: {{ type .Field.Type }}): this {
+    return this.setProperty({{ quote .Field.Name }}, {{ .Arg }});
+  }
+{{- end }}
+}
+
+register({{ .Class }});
+`))
+
+type typeScriptSetter struct {
+	Method string
+	Arg    string
+	Doc    string
+	Field  *sdkField
+}
+
+// renderDefinition renders the module of the definition
+func (m *TypeScriptDefModifier) renderDefinition(def *sdkDefinition) (string, []byte, error) {
+	kindDir := path.Join(m.meta.Output, m.meta.APIDirectory, typeScriptKindDirs[def.Kind])
+	base, err := filepath.Rel(kindDir, path.Join(m.meta.Output, typeScriptSourceDir, "base"))
+	if err != nil {
+		return "", nil, errors.Wrap(err, "the api directory must be in the output directory")
+	}
+	base = filepath.ToSlash(base)
+	if !strings.HasPrefix(base, ".") {
+		base = "./" + base
+	}
+	class := sdkIdentifier(def.Name, strcase.ToPascal, typeScriptReserved)
+	data := map[string]interface{}{
+		"Header":     generatedHeader,
+		"Import":     base,
+		"Def":        def,
+		"Class":      class,
+		"Base":       pythonBaseClasses[def.Kind],
+		"Named":      def.Kind != v1beta1.TraitDefinitionKind,
+		"Properties": "Record<string, unknown>",
+	}
+	var setters []typeScriptSetter
+	if def.Properties.Kind == sdkObject {
+		data["Properties"] = def.Properties.Name
+		for _, f := range def.Properties.Fields {
+			method := "set" + strcase.ToPascal(f.Name)
+			if typeScriptBaseMethods[method] {
+				method += "Property"
+			}
+			setters = append(setters, typeScriptSetter{
+				Method: method,
+				Arg:    sdkIdentifier(f.Name, strcase.ToCamel, typeScriptReserved),
+				Doc:    fieldDoc(f),
+				Field:  f,
+			})
+		}
+	}
+	data["Setters"] = setters
+	buf := &bytes.Buffer{}
+	if err = typeScriptDefinitionTemplate.Execute(buf, data); err != nil {
+		return "", nil, err
+	}
+	return path.Join(kindDir, typeScriptModule(def.Name)+".ts"), buf.Bytes(), nil
+}
+
+// typeScriptModule returns the module name of the definition
+func typeScriptModule(name string) string {
+	return strcase.ToKebab(name)
+}
+
+// typeScriptType returns the type of the type
+func typeScriptType(t *sdkType) string {
+	switch t.Kind {
+	case sdkString:
+		return "string"
+	case sdkInteger, sdkNumber:
+		return "number"
+	case sdkBoolean:
+		return "boolean"
+	case sdkArray:
+		return "Array<" + typeScriptType(t.Elem) + ">"
+	case sdkMap:
+		return "Record<string, " + typeScriptType(t.Elem) + ">"
+	case sdkObject:
+		return t.Name
+	case sdkEnum:
+		values := make([]string, 0, len(t.Enum))
+		for _, v := range t.Enum {
+			b, _ := json.Marshal(v)
+			values = append(values, string(b))
+		}
+		return strings.Join(values, " | ")
+	case sdkUnion:
+		return unionType(t.Alts, typeScriptType, func(alts []string) string { return strings.Join(alts, " | ") })
+	default:
+		return "unknown"
+	}
+}
+
+// typeScriptDoc returns the JSDoc of the text followed by the indent, or nothing if the text is empty
+func typeScriptDoc(text, indent string) string {
+	text = sdkComment(text, "*/", `*\/`)
+	if text == "" {
+		return ""
+	}
+	if !strings.Contains(text, "\n") {
+		return "/** " + text + " */\n" + indent
+	}
+	lines := strings.Split(text, "\n")
+	buf := &strings.Builder{}
+	buf.WriteString("/**\n")
+	for _, line := range lines {
+		buf.WriteString(strings.TrimRight(indent+" * "+line, " ") + "\n")
+	}
+	buf.WriteString(indent + " */\n" + indent)
+	return buf.String()
+}
+
+// Name the name of modifier
+func (m *TypeScriptModuleModifier) Name() string {
+	return "TypeScriptModuleModifier"
+}
+
+// Modify implements Modifier
+func (m *TypeScriptModuleModifier) Modify() error {
+	apiDir := path.Join(m.Output, m.APIDirectory)
+	var kindDirs []string
+	for _, dir := range typeScriptKindDirs {
+		kindDirs = append(kindDirs, dir)
+	}
+	sort.Strings(kindDirs)
+	index := &bytes.Buffer{}
+	fmt.Fprintf(index, "// %s\n", generatedHeader)
+	for _, dir := range kindDirs {
+		files, err := filepath.Glob(filepath.Join(apiDir, dir, "*.ts"))
+		if err != nil {
+			return err
+		}
+		sort.Strings(files)
+		exports := &bytes.Buffer{}
+		for _, file := range files {
+			if filepath.Base(file) == "index.ts" {
+				continue
+			}
+			// nolint:gosec
+			content, err := os.ReadFile(file)
+			if err != nil {
+				return err
+			}
+			if !typeScriptClassRegexp.Match(content) {
+				continue
+			}
+			fmt.Fprintf(exports, "export * from \"./%s\";\n", strings.TrimSuffix(filepath.Base(file), ".ts"))
+		}
+		if exports.Len() == 0 {
+			continue
+		}
+		fmt.Fprintf(index, "export * as %s from \"./%s\";\n", dir, dir)
+		content := fmt.Sprintf("// %s\n%s", generatedHeader, exports)
+		if err = writeFile(filepath.Join(apiDir, dir, "index.ts"), []byte(content)); err != nil {
+			return err
+		}
+	}
+	return writeFile(filepath.Join(apiDir, "index.ts"), index.Bytes())
+}
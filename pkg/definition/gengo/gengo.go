@@ -0,0 +1,477 @@
+/*
+Copyright 2026 The KubeVela Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package gengo converts the CUE X-Definitions to the Go definitions written with defkit.
+// The parts of the definition that have no equivalent in the defkit builders are kept as raw CUE.
+package gengo
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"cuelang.org/go/cue/ast"
+	cueformat "cuelang.org/go/cue/format"
+	"cuelang.org/go/cue/literal"
+	"cuelang.org/go/cue/parser"
+	"cuelang.org/go/cue/token"
+	"github.com/ettle/strcase"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/oam-dev/kubevela/apis/core.oam.dev/v1beta1"
+	pkgdef "github.com/oam-dev/kubevela/pkg/definition"
+)
+
+const (
+	// DefaultPackage is the package name of the generated Go file by default
+	DefaultPackage = "definitions"
+
+	defkitImport = "github.com/oam-dev/kubevela/pkg/definition/defkit"
+
+	descriptionAnnotation = "definition.oam.dev/description"
+	customPrefix          = "custom.definition.oam.dev/"
+	autodetectWorkload    = "autodetects.core.oam.dev"
+)
+
+// Options is the options of generating the Go definition
+type Options struct {
+	// Package is the package name of the generated Go file, defaults to DefaultPackage
+	Package string
+}
+
+// Result is the Go definition generated from the CUE definition
+type Result struct {
+	// Name is the name of the definition
+	Name string
+	// Kind is the kind of the definition, such as ComponentDefinition
+	Kind string
+	// Function is the name of the Go function returning the definition
+	Function string
+	// Source is the formatted Go source file
+	Source []byte
+	// Raw is true if the whole definition is kept as raw CUE
+	Raw bool
+	// Notes are the parts of the definition that are kept as raw CUE or dropped, which are worth a review
+	Notes []string
+}
+
+// kindInfo is how a kind of definition is built in defkit
+type kindInfo struct {
+	constructor string
+	typeName    string
+	suffix      string
+}
+
+var kinds = map[string]kindInfo{
+	v1beta1.ComponentDefinitionKind:    {constructor: "NewComponent", typeName: "ComponentDefinition", suffix: "Component"},
+	v1beta1.TraitDefinitionKind:        {constructor: "NewTrait", typeName: "TraitDefinition", suffix: "Trait"},
+	v1beta1.PolicyDefinitionKind:       {constructor: "NewPolicy", typeName: "PolicyDefinition", suffix: "Policy"},
+	v1beta1.WorkflowStepDefinitionKind: {constructor: "NewWorkflowStep", typeName: "WorkflowStepDefinition", suffix: "WorkflowStep"},
+}
+
+// generator converts a CUE definition to the Go definition
+type generator struct {
+	def      *pkgdef.Definition
+	kind     kindInfo
+	file     *ast.File
+	meta     *ast.Field
+	imports  []string
+	template []ast.Decl
+	// params are the go variables of the top-level parameters by name
+	params map[string]*paramVar
+	// helperDecls are the helper definitions built with the Helper calls
+	helperDecls map[ast.Decl]bool
+	usesVela    bool
+	notes       []string
+}
+
+// unsupported is the reason why a part of the definition cannot be built with defkit
+type unsupported struct {
+	reason string
+}
+
+func (u *unsupported) Error() string { return u.reason }
+
+func unsupportedf(format string, args ...interface{}) error {
+	return &unsupported{reason: fmt.Sprintf(format, args...)}
+}
+
+// Generate converts the CUE definition to the Go definition written with defkit
+func Generate(cueSource string, opts Options) (*Result, error) {
+	if opts.Package == "" {
+		opts.Package = DefaultPackage
+	}
+	def := &pkgdef.Definition{Unstructured: unstructured.Unstructured{}}
+	if err := def.FromCUEString(cueSource, nil); err != nil {
+		return nil, errors.Wrap(err, "invalid CUE definition")
+	}
+	kind, ok := kinds[def.GetKind()]
+	if !ok {
+		return nil, errors.Errorf("%s is not supported", def.GetKind())
+	}
+	f, err := parser.ParseFile("-", cueSource, parser.ParseComments)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid CUE definition")
+	}
+	g := &generator{def: def, kind: kind, file: f, params: map[string]*paramVar{}, helperDecls: map[ast.Decl]bool{}}
+	if err = g.split(); err != nil {
+		return nil, err
+	}
+	result := &Result{Name: def.GetName(), Kind: def.GetKind(), Function: functionName(def.GetName(), kind.suffix)}
+	body, err := g.build()
+	if u := (&unsupported{}); errors.As(err, &u) {
+		g.notes = append(g.notes, fmt.Sprintf("the definition is kept as raw CUE: %s", u.reason))
+		body, err = g.buildRaw()
+		result.Raw = true
+	}
+	if err != nil {
+		return nil, err
+	}
+	result.Notes = g.notes
+	src := &bytes.Buffer{}
+	fmt.Fprintf(src, "package %s\n\nimport (\n\t%q\n)\n\n", opts.Package, defkitImport)
+	fmt.Fprintf(src, "func init() {\n\tdefkit.Register(%s())\n}\n\n", result.Function)
+	fmt.Fprintf(src, "// %s creates the %s %s definition\n", result.Function, result.Name, strings.ToLower(strcase.ToCase(kind.suffix, strcase.LowerCase, ' ')))
+	fmt.Fprintf(src, "func %s() *defkit.%s {\n%s}\n", result.Function, kind.typeName, body)
+	if result.Source, err = format.Source(src.Bytes()); err != nil {
+		return nil, errors.Wrapf(err, "failed to format the generated Go source")
+	}
+	return result, nil
+}
+
+// split splits the CUE file into the imports, the metadata and the template
+func (g *generator) split() error {
+	for _, decl := range g.file.Decls {
+		switch d := decl.(type) {
+		case *ast.ImportDecl:
+			for _, spec := range d.Specs {
+				path, err := literal.Unquote(spec.Path.Value)
+				if err != nil {
+					return errors.Wrapf(err, "invalid import %s", spec.Path.Value)
+				}
+				g.imports = append(g.imports, path)
+			}
+		case *ast.Field:
+			if name, _, _ := ast.LabelName(d.Label); name == "template" {
+				st, ok := d.Value.(*ast.StructLit)
+				if !ok {
+					return errors.New("the template of the definition must be a struct")
+				}
+				g.template = st.Elts
+				continue
+			}
+			g.meta = d
+		}
+	}
+	if g.meta == nil {
+		return errors.New("no metadata found in the definition")
+	}
+	return nil
+}
+
+// build builds the body of the Go function with the defkit builders
+func (g *generator) build() (string, error) {
+	for _, decl := range g.file.Decls {
+		if d, ok := decl.(*ast.ImportDecl); ok {
+			for _, spec := range d.Specs {
+				if spec.Name != nil {
+					return "", unsupportedf("the import %s is aliased", spec.Path.Value)
+				}
+			}
+		}
+	}
+	chain, err := g.metadata()
+	if err != nil {
+		return "", err
+	}
+	fields, extras := g.templateFields()
+	helpers, extras := g.helpers(extras)
+	paramField := fields["parameter"]
+	delete(fields, "parameter")
+	paramDecls, paramChain, err := g.parameter(paramField)
+	// the trait keeps the parameter as a raw CUE block if it has no builder
+	rawParameter := false
+	if u := (&unsupported{}); errors.As(err, &u) && g.def.GetKind() == v1beta1.TraitDefinitionKind {
+		rawParameter, err = true, nil
+		g.params = map[string]*paramVar{}
+	}
+	if err != nil {
+		return "", err
+	}
+
+	var tpl string
+	switch g.def.GetKind() {
+	case v1beta1.ComponentDefinitionKind:
+		tpl, err = g.componentTemplate(fields, extras)
+	case v1beta1.TraitDefinitionKind:
+		tpl, err = g.traitTemplate(fields, extras, paramField, rawParameter)
+	case v1beta1.PolicyDefinitionKind:
+		tpl, err = g.policyTemplate(fields, extras)
+	default:
+		if len(fields) > 0 || len(extras) > 0 {
+			err = unsupportedf("the workflow step has actions in the template")
+		}
+	}
+	if err != nil {
+		return "", err
+	}
+
+	body := &strings.Builder{}
+	body.WriteString(paramDecls)
+	if paramDecls != "" {
+		body.WriteString("\n")
+	}
+	fmt.Fprintf(body, "return defkit.%s(%s)", g.kind.constructor, strconv.Quote(g.def.GetName()))
+	for _, c := range chain.head {
+		body.WriteString(".\n" + c)
+	}
+	if len(g.imports) > 0 {
+		quoted := make([]string, len(g.imports))
+		for i, imp := range g.imports {
+			quoted[i] = strconv.Quote(imp)
+		}
+		fmt.Fprintf(body, ".\nWithImports(%s)", strings.Join(quoted, ", "))
+	}
+	if paramChain != "" {
+		body.WriteString(".\n" + paramChain)
+	}
+	for _, c := range helpers {
+		body.WriteString(".\n" + c)
+	}
+	if tpl != "" {
+		body.WriteString(".\n" + tpl)
+	}
+	for _, c := range chain.tail {
+		body.WriteString(".\n" + c)
+	}
+	body.WriteString("\n")
+	return body.String(), nil
+}
+
+// buildRaw builds the body of the Go function keeping the whole definition as raw CUE
+func (g *generator) buildRaw() (string, error) {
+	// the name of the definition is rewritten by defkit, which requires it to be quoted
+	g.meta.Label = ast.NewString(g.def.GetName())
+	bs, err := cueformat.Node(g.file)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to format the CUE definition")
+	}
+	return fmt.Sprintf("return defkit.%s(%s).RawCUE(%s)\n", g.kind.constructor, strconv.Quote(g.def.GetName()), goString(string(bs))), nil
+}
+
+// templateFields returns the top-level fields of the template by name, and the other declarations
+func (g *generator) templateFields() (map[string]*ast.Field, []ast.Decl) {
+	fields := map[string]*ast.Field{}
+	var extras []ast.Decl
+	for _, decl := range g.template {
+		f, ok := decl.(*ast.Field)
+		if !ok || f.Constraint != token.ILLEGAL {
+			extras = append(extras, decl)
+			continue
+		}
+		name, ok := identLabel(f.Label)
+		if !ok || fields[name] != nil {
+			extras = append(extras, decl)
+			continue
+		}
+		fields[name] = f
+	}
+	return fields, extras
+}
+
+// builderChain is the builder calls of the metadata before and after the template
+type builderChain struct {
+	head []string
+	tail []string
+}
+
+// metadata builds the builder calls of the metadata of the definition
+func (g *generator) metadata() (*builderChain, error) {
+	chain := &builderChain{}
+	kind := g.def.GetKind()
+	annotations := g.def.GetAnnotations()
+	labels := g.def.GetLabels()
+	if desc := annotations[descriptionAnnotation]; desc != "" {
+		chain.head = append(chain.head, fmt.Sprintf("Description(%s)", strconv.Quote(desc)))
+	}
+	delete(annotations, descriptionAnnotation)
+	if kind == v1beta1.WorkflowStepDefinitionKind {
+		if category, ok := annotations[customPrefix+"category"]; ok {
+			chain.head = append(chain.head, fmt.Sprintf("Category(%s)", strconv.Quote(category)))
+			delete(annotations, customPrefix+"category")
+		}
+		if scope, ok := labels[customPrefix+"scope"]; ok {
+			chain.head = append(chain.head, fmt.Sprintf("Scope(%s)", strconv.Quote(scope)))
+			delete(labels, customPrefix+"scope")
+		}
+	}
+	if len(annotations) > 0 {
+		return nil, unsupportedf("the annotations %s have no builder", strings.Join(sortedKeys(annotations), ", "))
+	}
+	if len(labels) > 0 {
+		if kind != v1beta1.TraitDefinitionKind {
+			return nil, unsupportedf("the labels %s have no builder", strings.Join(sortedKeys(labels), ", "))
+		}
+		var entries []string
+		for _, k := range sortedKeys(labels) {
+			if !strings.HasPrefix(k, customPrefix) {
+				return nil, unsupportedf("the label %s has no builder", k)
+			}
+			entries = append(entries, fmt.Sprintf("%s: %s,\n", strconv.Quote(strings.TrimPrefix(k, customPrefix)), strconv.Quote(labels[k])))
+		}
+		chain.head = append(chain.head, fmt.Sprintf("Labels(map[string]string{\n%s})", strings.Join(entries, "")))
+	}
+
+	spec, _, _ := unstructured.NestedMap(g.def.Object, "spec")
+	delete(spec, "schematic")
+	if status, ok := spec["status"].(map[string]interface{}); ok && kind != v1beta1.WorkflowStepDefinitionKind && kind != v1beta1.PolicyDefinitionKind {
+		for _, key := range []string{"customStatus", "healthPolicy"} {
+			if s, ok := status[key].(string); ok && strings.TrimSpace(s) != "" {
+				chain.tail = append(chain.tail, fmt.Sprintf("%s(%s)", strcase.ToPascal(key), goString(strings.Trim(s, "\n"))))
+			}
+			delete(status, key)
+		}
+		if len(status) > 0 {
+			return nil, unsupportedf("the status %s has no builder", strings.Join(sortedKeys(status), ", "))
+		}
+		delete(spec, "status")
+	}
+	switch kind {
+	case v1beta1.ComponentDefinitionKind:
+		if workload, ok := spec["workload"].(map[string]interface{}); ok {
+			apiVersion, _, _ := unstructured.NestedString(workload, "definition", "apiVersion")
+			workloadKind, _, _ := unstructured.NestedString(workload, "definition", "kind")
+			workloadType, _, _ := unstructured.NestedString(workload, "type")
+			switch {
+			case workloadType == autodetectWorkload:
+				chain.head = append(chain.head, "AutodetectWorkload()")
+			case apiVersion != "" && workloadKind != "":
+				chain.head = append(chain.head, fmt.Sprintf("Workload(%s, %s)", strconv.Quote(apiVersion), strconv.Quote(workloadKind)))
+			default:
+				return nil, unsupportedf("the workload type %s has no builder", workloadType)
+			}
+			delete(spec, "workload")
+		}
+	case v1beta1.TraitDefinitionKind:
+		for _, key := range []string{"appliesToWorkloads", "conflictsWith"} {
+			values, ok, err := unstructured.NestedStringSlice(spec, key)
+			if err != nil {
+				return nil, unsupportedf("the %s is not a list of strings", key)
+			}
+			if ok && len(values) > 0 {
+				quoted := make([]string, len(values))
+				for i, v := range values {
+					quoted[i] = strconv.Quote(v)
+				}
+				method := map[string]string{"appliesToWorkloads": "AppliesTo", "conflictsWith": "ConflictsWith"}[key]
+				chain.head = append(chain.head, fmt.Sprintf("%s(%s)", method, strings.Join(quoted, ", ")))
+			}
+			delete(spec, key)
+		}
+		if disruptive, ok := spec["podDisruptive"].(bool); ok {
+			if disruptive {
+				chain.head = append(chain.head, "PodDisruptive(true)")
+			}
+			delete(spec, "podDisruptive")
+		}
+		if stage, ok := spec["stage"].(string); ok {
+			chain.head = append(chain.head, fmt.Sprintf("Stage(%s)", strconv.Quote(stage)))
+			delete(spec, "stage")
+		}
+	}
+	if len(spec) > 0 {
+		return nil, unsupportedf("the attributes %s have no builder", strings.Join(sortedKeys(spec), ", "))
+	}
+	return chain, nil
+}
+
+// note records a part of the definition worth a review
+func (g *generator) note(format string, args ...interface{}) {
+	g.notes = append(g.notes, fmt.Sprintf(format, args...))
+}
+
+// cueText returns the formatted CUE source of the node
+func cueText(n ast.Node) string {
+	// the declarations such as the comprehensions can only be formatted in a file
+	if _, isComprehension := n.(*ast.Comprehension); isComprehension {
+		n = &ast.File{Decls: []ast.Decl{n.(ast.Decl)}}
+	}
+	bs, err := cueformat.Node(n)
+	if err != nil {
+		return "_|_"
+	}
+	return strings.TrimSpace(string(bs))
+}
+
+// goString returns the Go string literal of the text, preferring the raw string literal for multiple lines
+func goString(s string) string {
+	if !strings.Contains(s, "\n") {
+		return strconv.Quote(s)
+	}
+	parts := strings.Split(s, "`")
+	for i, part := range parts {
+		parts[i] = "`" + part + "`"
+	}
+	return strings.Join(parts, " + \"`\" + ")
+}
+
+// functionName returns the name of the Go function returning the definition
+func functionName(name, suffix string) string {
+	fn := strcase.ToPascal(name)
+	if fn == "" || !unicode.IsLetter(rune(fn[0])) {
+		fn = "Def" + fn
+	}
+	return fn + suffix
+}
+
+// identLabel returns the name of the label if it is a regular identifier
+func identLabel(l ast.Label) (string, bool) {
+	ident, ok := l.(*ast.Ident)
+	if !ok || !ast.IsValidIdent(ident.Name) || strings.HasPrefix(ident.Name, "#") || strings.HasPrefix(ident.Name, "_") {
+		return "", false
+	}
+	return ident.Name, true
+}
+
+// fieldKey returns the key of the field label which is a regular identifier or a quoted string
+func fieldKey(l ast.Label) (key string, ident bool, ok bool) {
+	if name, ok := identLabel(l); ok {
+		return name, true, true
+	}
+	lit, isLit := l.(*ast.BasicLit)
+	if !isLit || lit.Kind != token.STRING {
+		return "", false, false
+	}
+	key, err := literal.Unquote(lit.Value)
+	if err != nil {
+		return "", false, false
+	}
+	return key, ast.IsValidIdent(key) && !strings.HasPrefix(key, "#") && !strings.HasPrefix(key, "_"), true
+}
+
+func sortedKeys[T any](m map[string]T) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,185 @@
+/*
+Copyright 2026 The KubeVela Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gengo
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerate(t *testing.T) {
+	for _, name := range []string{"webserver", "annotator"} {
+		t.Run(name, func(t *testing.T) {
+			r := require.New(t)
+			src, err := os.ReadFile(filepath.Join("testdata", name+".cue"))
+			r.NoError(err)
+			result, err := Generate(string(src), Options{})
+			r.NoError(err)
+			r.False(result.Raw)
+			r.Empty(result.Notes)
+			expected, err := os.ReadFile(filepath.Join("testdata", name+".go.golden"))
+			r.NoError(err)
+			r.Equal(string(expected), string(result.Source))
+		})
+	}
+}
+
+func TestGenerateRaw(t *testing.T) {
+	r := require.New(t)
+	src, err := os.ReadFile(filepath.Join("testdata", "mounts.cue"))
+	r.NoError(err)
+	result, err := Generate(string(src), Options{Package: "components"})
+	r.NoError(err)
+	r.True(result.Raw)
+	r.Equal("MountsComponent", result.Function)
+	r.Equal([]string{"the definition is kept as raw CUE: the template has the declaration let vols = [for v in parameter.volumes {name: v.name, emptyDir: {}}]"}, result.Notes)
+	r.Contains(string(result.Source), "package components\n")
+	r.Contains(string(result.Source), "return defkit.NewComponent(\"mounts\").RawCUE(`\"mounts\": {\n")
+
+	_, err = Generate("invalid: {", Options{})
+	r.Error(err)
+}
+
+func TestGenerateTraitRawBlocks(t *testing.T) {
+	r := require.New(t)
+	result, err := Generate(`
+labeler: {
+	type: "trait"
+	annotations: {}
+	labels: {}
+	description: "Add the labels."
+	attributes: appliesToWorkloads: ["*"]
+}
+template: {
+	// +patchStrategy=jsonMergePatch
+	patch: metadata: labels: {
+		for k, v in parameter {
+			(k): v
+		}
+	}
+	parameter: [string]: string | null
+}
+`, Options{})
+	r.NoError(err)
+	r.False(result.Raw)
+	r.Equal([]string{"the template is kept as raw CUE blocks: the parameter has no builder"}, result.Notes)
+	r.Contains(string(result.Source), "tpl.SetRawPatchBlock(`// +patchStrategy=jsonMergePatch\npatch: metadata: labels: {")
+	r.Contains(string(result.Source), "tpl.SetRawParameterBlock(`parameter: [string]: string | null\n`)")
+	r.NotContains(string(result.Source), "Params(")
+}
+
+func TestGenerateConditions(t *testing.T) {
+	testCases := map[string]struct {
+		condition string
+		expected  string
+	}{
+		"bool":          {condition: "parameter.debug", expected: "debug.IsTrue()"},
+		"not bool":      {condition: "!parameter.debug", expected: "debug.IsFalse()"},
+		"set":           {condition: "parameter.image != _|_", expected: "image.IsSet()"},
+		"not set":       {condition: "parameter.image == _|_", expected: "image.NotSet()"},
+		"nested set":    {condition: "parameter.probe.path != _|_", expected: `defkit.ParamPath("probe.path").IsSet()`},
+		"context":       {condition: `context.output.spec != _|_`, expected: `defkit.PathExists("context.output.spec")`},
+		"comparison":    {condition: `parameter.replicas > 1`, expected: `defkit.Gt(replicas, defkit.Lit(1))`},
+		"equal context": {condition: `parameter.image == context.name`, expected: `defkit.Eq(image, vela.Name())`},
+		"and":           {condition: `parameter.debug && parameter.replicas >= 2`, expected: `defkit.And(debug.IsTrue(), defkit.Ge(replicas, defkit.Lit(2)))`},
+		"not":           {condition: `!(parameter.image == "nginx")`, expected: `defkit.Not(defkit.Eq(image, defkit.Lit("nginx")))`},
+		"mixed":         {condition: `parameter.debug && (parameter.replicas > 1 || parameter.image == "nginx")`, expected: `Set("spec", defkit.Reference(`},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			r := require.New(t)
+			result, err := Generate(fmt.Sprintf(`
+conditional: {
+	type: "component"
+	attributes: workload: definition: {
+		apiVersion: "apps/v1"
+		kind:       "Deployment"
+	}
+}
+template: {
+	output: {
+		apiVersion: "apps/v1"
+		kind:       "Deployment"
+		spec: {
+			if %s {
+				replicas: parameter.replicas
+			}
+		}
+	}
+	parameter: {
+		image:    string
+		replicas: *1 | int
+		debug:    *false | bool
+		probe?: path: string
+	}
+}
+`, tc.condition), Options{})
+			r.NoError(err)
+			r.False(result.Raw)
+			r.Contains(string(result.Source), tc.expected)
+		})
+	}
+}
+
+func TestGoIdent(t *testing.T) {
+	r := require.New(t)
+	used := map[string]bool{}
+	r.Equal("targetAPIVersion", goIdent("targetAPIVersion", used))
+	r.Equal("typeParam", goIdent("type", used))
+	r.Equal("maxParam", goIdent("max", used))
+	r.Equal("velaParam", goIdent("vela", used))
+	r.Equal("image", goIdent("image", used))
+	r.Equal("image2", goIdent("image", used))
+}
+
+func TestVerify(t *testing.T) {
+	if _, err := exec.LookPath("go"); err != nil {
+		t.Skip("the go toolchain is required to verify the Go definition")
+	}
+	r := require.New(t)
+	src, err := os.ReadFile(filepath.Join("testdata", "webserver.cue"))
+	r.NoError(err)
+	result, err := Generate(string(src), Options{})
+	r.NoError(err)
+	report, err := Verify(context.Background(), string(src), result)
+	r.NoError(err)
+	r.True(report.Equivalent(), "%v", report.Mismatches)
+	r.Empty(report.Skipped)
+	r.Contains(report.CUE, "webserver: {")
+
+	traitSrc, err := os.ReadFile(filepath.Join("testdata", "annotator.cue"))
+	r.NoError(err)
+	traitResult, err := Generate(string(traitSrc), Options{})
+	r.NoError(err)
+	report, err = Verify(context.Background(), string(traitSrc), traitResult)
+	r.NoError(err)
+	r.True(report.Equivalent(), "%v", report.Mismatches)
+
+	result.Source = bytes.ReplaceAll(result.Source, []byte(`Default(80)`), []byte(`Default(8080)`))
+	report, err = Verify(context.Background(), string(src), result)
+	r.NoError(err)
+	r.False(report.Equivalent())
+	r.Contains(report.Mismatches[0], "the parameter defaults differ")
+	r.Contains(report.Mismatches[0], "int64(8080)")
+}
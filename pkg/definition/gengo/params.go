@@ -0,0 +1,437 @@
+/*
+Copyright 2026 The KubeVela Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gengo
+
+import (
+	"fmt"
+	"go/token"
+	"go/types"
+	"math"
+	"strconv"
+	"strings"
+
+	"cuelang.org/go/cue/ast"
+	"cuelang.org/go/cue/literal"
+	cuetoken "cuelang.org/go/cue/token"
+	"github.com/ettle/strcase"
+)
+
+// paramVar is the Go variable of a top-level parameter
+type paramVar struct {
+	goName string
+	// base is the CUE type of the parameter, such as bool
+	base string
+}
+
+// reservedNames are the names used by the generated code besides the parameters
+var reservedNames = map[string]bool{"defkit": true, "tpl": true, "vela": true}
+
+// paramTypes are the defkit param types of the CUE types
+var paramTypes = map[string]string{
+	"string": "defkit.ParamTypeString",
+	"int":    "defkit.ParamTypeInt",
+	"bool":   "defkit.ParamTypeBool",
+	"float":  "defkit.ParamTypeFloat",
+}
+
+// parameter builds the variables of the top-level parameters and the Params call of the definition
+func (g *generator) parameter(f *ast.Field) (decls string, chain string, err error) {
+	if f == nil {
+		return "", "", nil
+	}
+	st, ok := f.Value.(*ast.StructLit)
+	if !ok {
+		return "", "", unsupportedf("the parameter is not a struct")
+	}
+	used := map[string]bool{}
+	var names []string
+	sb := &strings.Builder{}
+	for _, elt := range st.Elts {
+		field, ok := elt.(*ast.Field)
+		if !ok {
+			return "", "", unsupportedf("the parameter has the declaration %s", cueText(elt))
+		}
+		name, ok := identLabel(field.Label)
+		if !ok {
+			return "", "", unsupportedf("the parameter has the field %s", cueText(field.Label))
+		}
+		expr, base := g.param("parameter."+name, name, field)
+		goName := goIdent(name, used)
+		g.params[name] = &paramVar{goName: goName, base: base}
+		names = append(names, goName)
+		fmt.Fprintf(sb, "%s := %s\n", goName, expr)
+	}
+	if len(names) == 0 {
+		return "", "", nil
+	}
+	if len(names) > 3 {
+		return sb.String(), fmt.Sprintf("Params(\n%s,\n)", strings.Join(names, ",\n")), nil
+	}
+	return sb.String(), fmt.Sprintf("Params(%s)", strings.Join(names, ", ")), nil
+}
+
+// helpers builds the Helper calls of the helper definitions such as #HealthProbe, and returns the other declarations
+func (g *generator) helpers(decls []ast.Decl) ([]string, []ast.Decl) {
+	var calls []string
+	var rest []ast.Decl
+	for _, decl := range decls {
+		f, isField := decl.(*ast.Field)
+		if !isField || f.Constraint != cuetoken.ILLEGAL {
+			rest = append(rest, decl)
+			continue
+		}
+		ident, isIdent := f.Label.(*ast.Ident)
+		st, isStruct := f.Value.(*ast.StructLit)
+		if !isIdent || !isStruct || !strings.HasPrefix(ident.Name, "#") || !ast.IsValidIdent(ident.Name) {
+			rest = append(rest, decl)
+			continue
+		}
+		fields, ok := g.structFields(ident.Name, st)
+		if !ok || fields == "" {
+			rest = append(rest, decl)
+			continue
+		}
+		name := strings.TrimPrefix(ident.Name, "#")
+		calls = append(calls, fmt.Sprintf("Helper(%s, defkit.Object(%s).WithFields(%s))", strconv.Quote(name), strconv.Quote(strcase.ToGoCamel(name)), fields))
+		g.helperDecls[decl] = true
+	}
+	return calls, rest
+}
+
+// param builds the defkit param of the field, the parameter schema is kept as raw CUE if it has no builder
+func (g *generator) param(path, name string, f *ast.Field) (expr string, base string) {
+	usage, markers := fieldDoc(f)
+	for _, marker := range markers {
+		g.note("%s: the %s marker is dropped", path, marker)
+	}
+	optional := f.Constraint == cuetoken.OPTION
+	expr, base, hasDefault, ok := g.typedParam(path, name, f.Value)
+	switch {
+	case !ok || (optional && hasDefault):
+		constructor := "Object"
+		if _, isList := stripDefaults(f.Value).(*ast.ListLit); isList {
+			constructor = "Array"
+		}
+		expr, base = fmt.Sprintf("defkit.%s(%s).WithSchema(%s)", constructor, strconv.Quote(name), goString(cueText(f.Value))), ""
+		if !optional {
+			expr += ".Required()"
+		}
+	case !optional && !hasDefault:
+		expr += ".Required()"
+	}
+	if usage != "" {
+		expr += fmt.Sprintf(".Description(%s)", strconv.Quote(usage))
+	}
+	return expr, base
+}
+
+// typedParam builds the typed defkit param of the parameter schema
+func (g *generator) typedParam(path, name string, v ast.Expr) (expr string, base string, hasDefault bool, ok bool) {
+	var defaults, alts []ast.Expr
+	for _, alt := range disjuncts(v) {
+		if u, isDefault := alt.(*ast.UnaryExpr); isDefault && u.Op == cuetoken.MUL {
+			defaults = append(defaults, u.X)
+			continue
+		}
+		alts = append(alts, alt)
+	}
+	if len(defaults) > 1 || len(alts) == 0 {
+		return "", "", false, false
+	}
+	quotedName := strconv.Quote(name)
+
+	// the enum of strings, such as *"a" | "b"
+	if _, isEnum := stringValues(alts); isEnum && len(alts) > 1 {
+		var all []string
+		for _, alt := range disjuncts(v) {
+			if u, isDefault := alt.(*ast.UnaryExpr); isDefault {
+				alt = u.X
+			}
+			s, _ := stringValue(alt)
+			all = append(all, strconv.Quote(s))
+		}
+		expr = fmt.Sprintf("defkit.String(%s).Enum(%s)", quotedName, strings.Join(all, ", "))
+		if len(defaults) == 0 {
+			return expr, "string", false, true
+		}
+		d, isString := stringValue(defaults[0])
+		if !isString || d == "" {
+			return "", "", false, false
+		}
+		return expr + fmt.Sprintf(".Default(%s)", strconv.Quote(d)), "string", true, true
+	}
+	if len(alts) != 1 {
+		return "", "", false, false
+	}
+
+	switch t := alts[0].(type) {
+	case *ast.Ident, *ast.BinaryExpr:
+		base, constraints, isScalar := scalarType(t)
+		if !isScalar {
+			return "", "", false, false
+		}
+		expr = fmt.Sprintf("defkit.%s(%s)%s", strcase.ToPascal(base), quotedName, constraints)
+		if len(defaults) == 0 {
+			return expr, base, false, true
+		}
+		d, isDefault := defaultValue(base, defaults[0])
+		if !isDefault {
+			return "", "", false, false
+		}
+		return expr + fmt.Sprintf(".Default(%s)", d), base, true, true
+	case *ast.ListLit:
+		if len(defaults) > 0 || len(t.Elts) != 1 {
+			return "", "", false, false
+		}
+		ellipsis, isEllipsis := t.Elts[0].(*ast.Ellipsis)
+		if !isEllipsis {
+			return "", "", false, false
+		}
+		switch elem := ellipsis.Type.(type) {
+		case nil:
+			return fmt.Sprintf("defkit.List(%s)", quotedName), "", false, true
+		case *ast.Ident:
+			switch elem.Name {
+			case "string":
+				return fmt.Sprintf("defkit.StringList(%s)", quotedName), "", false, true
+			case "int":
+				return fmt.Sprintf("defkit.IntList(%s)", quotedName), "", false, true
+			}
+		case *ast.StructLit:
+			fields, isStruct := g.structFields(path+"[]", elem)
+			if isStruct && fields != "" {
+				return fmt.Sprintf("defkit.List(%s).WithFields(%s)", quotedName, fields), "", false, true
+			}
+		}
+		return "", "", false, false
+	case *ast.StructLit:
+		if len(defaults) > 0 {
+			return "", "", false, false
+		}
+		if len(t.Elts) == 1 {
+			switch elt := t.Elts[0].(type) {
+			case *ast.Ellipsis:
+				if elt.Type == nil {
+					return fmt.Sprintf("defkit.Object(%s)", quotedName), "", false, true
+				}
+			case *ast.Field:
+				// the map with typed values, such as [string]: string
+				if l, isPattern := elt.Label.(*ast.ListLit); isPattern && len(l.Elts) == 1 && isIdent(l.Elts[0], "string") {
+					if ident, isType := elt.Value.(*ast.Ident); isType && paramTypes[ident.Name] != "" {
+						return fmt.Sprintf("defkit.Map(%s).Of(%s)", quotedName, paramTypes[ident.Name]), "", false, true
+					}
+				}
+			}
+		}
+		fields, isStruct := g.structFields(path, t)
+		if isStruct && fields != "" {
+			return fmt.Sprintf("defkit.Object(%s).WithFields(%s)", quotedName, fields), "", false, true
+		}
+	}
+	return "", "", false, false
+}
+
+// structFields builds the defkit params of the fields of the struct, which must be regular fields
+func (g *generator) structFields(path string, st *ast.StructLit) (string, bool) {
+	for _, elt := range st.Elts {
+		f, ok := elt.(*ast.Field)
+		if !ok {
+			return "", false
+		}
+		if _, ok = identLabel(f.Label); !ok {
+			return "", false
+		}
+	}
+	var fields []string
+	for _, elt := range st.Elts {
+		f := elt.(*ast.Field)
+		name, _ := identLabel(f.Label)
+		expr, _ := g.param(path+"."+name, name, f)
+		fields = append(fields, expr)
+	}
+	return "\n" + strings.Join(fields, ",\n") + ",\n", len(fields) > 0
+}
+
+// scalarType returns the scalar type and the constraints of the type expression, such as int & >=1
+func scalarType(t ast.Expr) (base string, constraints string, ok bool) {
+	parts := conjuncts(t)
+	ident, ok := parts[0].(*ast.Ident)
+	if !ok || paramTypes[ident.Name] == "" {
+		return "", "", false
+	}
+	base = ident.Name
+	for _, part := range parts[1:] {
+		u, isUnary := part.(*ast.UnaryExpr)
+		if !isUnary {
+			return "", "", false
+		}
+		switch {
+		case base == "int" && (u.Op == cuetoken.GEQ || u.Op == cuetoken.LEQ):
+			n, isInt := intValue(u.X)
+			if !isInt {
+				return "", "", false
+			}
+			method := map[cuetoken.Token]string{cuetoken.GEQ: "Min", cuetoken.LEQ: "Max"}[u.Op]
+			constraints += fmt.Sprintf(".%s(%d)", method, n)
+		case base == "string" && u.Op == cuetoken.MAT:
+			s, isString := stringValue(u.X)
+			if !isString {
+				return "", "", false
+			}
+			constraints += fmt.Sprintf(".Pattern(%s)", strconv.Quote(s))
+		default:
+			return "", "", false
+		}
+	}
+	return base, constraints, true
+}
+
+// defaultValue returns the Go literal of the default value of the scalar type
+func defaultValue(base string, v ast.Expr) (string, bool) {
+	switch base {
+	case "string":
+		s, ok := stringValue(v)
+		return strconv.Quote(s), ok
+	case "int":
+		n, ok := intValue(v)
+		return strconv.FormatInt(n, 10), ok
+	case "bool":
+		lit, ok := v.(*ast.BasicLit)
+		if !ok || (lit.Kind != cuetoken.TRUE && lit.Kind != cuetoken.FALSE) {
+			return "", false
+		}
+		return lit.Value, true
+	case "float":
+		lit, ok := v.(*ast.BasicLit)
+		if !ok || lit.Kind != cuetoken.FLOAT {
+			return "", false
+		}
+		f, err := strconv.ParseFloat(lit.Value, 64)
+		// defkit writes the integral floats as integers, which are not floats in CUE
+		if err != nil || f == math.Trunc(f) {
+			return "", false
+		}
+		return strconv.FormatFloat(f, 'g', -1, 64), true
+	}
+	return "", false
+}
+
+// fieldDoc returns the usage and the other markers in the doc comments of the field
+func fieldDoc(f *ast.Field) (usage string, markers []string) {
+	for _, cg := range ast.Comments(f) {
+		for _, c := range cg.List {
+			text := strings.TrimSpace(strings.TrimPrefix(c.Text, "//"))
+			switch {
+			case strings.HasPrefix(text, "+usage="):
+				usage = strings.TrimPrefix(text, "+usage=")
+			case strings.HasPrefix(text, "+"):
+				markers = append(markers, text)
+			}
+		}
+	}
+	return usage, markers
+}
+
+// goIdent returns an unused Go identifier of the parameter name
+func goIdent(name string, used map[string]bool) string {
+	id := strcase.ToGoCamel(name)
+	if id == "" {
+		id = "param"
+	}
+	if token.IsKeyword(id) || reservedNames[id] || types.Universe.Lookup(id) != nil || !token.IsIdentifier(id) {
+		id += "Param"
+	}
+	for base, i := id, 2; used[id]; i++ {
+		id = base + strconv.Itoa(i)
+	}
+	used[id] = true
+	return id
+}
+
+// disjuncts flattens the disjunction into the alternatives
+func disjuncts(v ast.Expr) []ast.Expr {
+	if b, ok := unparen(v).(*ast.BinaryExpr); ok && b.Op == cuetoken.OR {
+		return append(disjuncts(b.X), disjuncts(b.Y)...)
+	}
+	return []ast.Expr{v}
+}
+
+// conjuncts flattens the conjunction into the parts
+func conjuncts(v ast.Expr) []ast.Expr {
+	if b, ok := unparen(v).(*ast.BinaryExpr); ok && b.Op == cuetoken.AND {
+		return append(conjuncts(b.X), conjuncts(b.Y)...)
+	}
+	return []ast.Expr{v}
+}
+
+// stripDefaults returns the expression without the default values
+func stripDefaults(v ast.Expr) ast.Expr {
+	for _, alt := range disjuncts(v) {
+		if u, ok := alt.(*ast.UnaryExpr); !ok || u.Op != cuetoken.MUL {
+			return alt
+		}
+	}
+	return v
+}
+
+func unparen(v ast.Expr) ast.Expr {
+	for {
+		p, ok := v.(*ast.ParenExpr)
+		if !ok {
+			return v
+		}
+		v = p.X
+	}
+}
+
+// stringValues returns the values if all the expressions are string literals
+func stringValues(exprs []ast.Expr) ([]string, bool) {
+	var values []string
+	for _, e := range exprs {
+		s, ok := stringValue(e)
+		if !ok {
+			return nil, false
+		}
+		values = append(values, s)
+	}
+	return values, true
+}
+
+// stringValue returns the value of the single-line string literal
+func stringValue(e ast.Expr) (string, bool) {
+	lit, ok := e.(*ast.BasicLit)
+	if !ok || lit.Kind != cuetoken.STRING || !strings.HasPrefix(lit.Value, `"`) || strings.HasPrefix(lit.Value, `"""`) {
+		return "", false
+	}
+	s, err := literal.Unquote(lit.Value)
+	return s, err == nil
+}
+
+// intValue returns the value of the integer literal
+func intValue(e ast.Expr) (int64, bool) {
+	lit, ok := e.(*ast.BasicLit)
+	if !ok || lit.Kind != cuetoken.INT {
+		return 0, false
+	}
+	n, err := strconv.ParseInt(lit.Value, 0, 64)
+	return n, err == nil
+}
+
+func isIdent(e ast.Expr, name string) bool {
+	ident, ok := e.(*ast.Ident)
+	return ok && ident.Name == name
+}
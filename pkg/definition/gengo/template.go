@@ -0,0 +1,807 @@
+/*
+Copyright 2026 The KubeVela Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gengo
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"cuelang.org/go/cue/ast"
+	cuetoken "cuelang.org/go/cue/token"
+	"github.com/pkg/errors"
+)
+
+// setOp is a field set on a resource or a patch
+type setOp struct {
+	cond  *condition
+	path  string
+	value string
+}
+
+// condition is the Go expression of a defkit condition
+type condition struct {
+	code string
+	// op is the logical operator at the top of the condition, defkit does not parenthesize the operands of them
+	op cuetoken.Token
+}
+
+// comparisons are the defkit functions of the comparison operators
+var comparisons = map[cuetoken.Token]string{
+	cuetoken.EQL: "Eq",
+	cuetoken.NEQ: "Ne",
+	cuetoken.LSS: "Lt",
+	cuetoken.LEQ: "Le",
+	cuetoken.GTR: "Gt",
+	cuetoken.GEQ: "Ge",
+}
+
+// contextFields are the methods of defkit.VelaContext of the context fields
+var contextFields = map[string]string{
+	"name":           "Name()",
+	"namespace":      "Namespace()",
+	"appName":        "AppName()",
+	"appRevision":    "AppRevision()",
+	"appRevisionNum": "AppRevisionNum()",
+	"revision":       "Revision()",
+	"output":         "Output()",
+}
+
+// componentTemplate builds the Template call of the component
+func (g *generator) componentTemplate(fields map[string]*ast.Field, extras []ast.Decl) (string, error) {
+	if len(extras) > 0 {
+		return "", unsupportedf("the template has the declaration %s", summary(extras[0]))
+	}
+	for _, name := range sortedKeys(fields) {
+		if name != "output" && name != "outputs" {
+			return "", unsupportedf("the template has the field %s", name)
+		}
+	}
+	g.usesVela = false
+	var stmts []string
+	if f := fields["output"]; f != nil {
+		st, ok := f.Value.(*ast.StructLit)
+		if !ok {
+			return "", unsupportedf("the output is not a struct")
+		}
+		res, err := g.resource("output", st)
+		if err != nil {
+			return "", err
+		}
+		stmts = append(stmts, fmt.Sprintf("tpl.Output(%s)", res))
+	}
+	if f := fields["outputs"]; f != nil {
+		outputs, err := g.outputs(f)
+		if err != nil {
+			return "", err
+		}
+		stmts = append(stmts, outputs...)
+	}
+	return g.templateFunc("Template", stmts), nil
+}
+
+// traitTemplate builds the Template call of the trait. The template is kept as raw CUE blocks
+// if the patch or the outputs has no builder.
+func (g *generator) traitTemplate(fields map[string]*ast.Field, extras []ast.Decl, paramField *ast.Field, rawParameter bool) (string, error) {
+	g.usesVela = false
+	stmts, err := g.traitBuilders(fields, extras, rawParameter)
+	if err == nil {
+		return g.templateFunc("Template", stmts), nil
+	}
+	if u := (&unsupported{}); !errors.As(err, &u) {
+		return "", err
+	}
+	g.note("the template is kept as raw CUE blocks: %s", err.Error())
+
+	var header, patch, outputs, parameter []string
+	for _, decl := range g.template {
+		text := cueText(decl)
+		switch decl {
+		case ast.Decl(fields["patch"]):
+			patch = append(patch, text)
+		case ast.Decl(fields["outputs"]):
+			outputs = append(outputs, text)
+		case ast.Decl(paramField):
+			// the parameter is written by Params unless it has no builder
+			if rawParameter {
+				parameter = append(parameter, text)
+			}
+		default:
+			if g.helperDecls[decl] {
+				continue
+			}
+			header = append(header, text)
+		}
+	}
+	stmts = nil
+	for _, block := range []struct {
+		method string
+		decls  []string
+	}{{"SetRawHeaderBlock", header}, {"SetRawPatchBlock", patch}, {"SetRawOutputsBlock", outputs}, {"SetRawParameterBlock", parameter}} {
+		if len(block.decls) > 0 {
+			stmts = append(stmts, fmt.Sprintf("tpl.%s(%s)", block.method, goString(strings.Join(block.decls, "\n")+"\n")))
+		}
+	}
+	g.usesVela = false
+	return g.templateFunc("Template", stmts), nil
+}
+
+// traitBuilders builds the statements of the patch and the outputs of the trait with the builders
+func (g *generator) traitBuilders(fields map[string]*ast.Field, extras []ast.Decl, rawParameter bool) ([]string, error) {
+	if len(extras) > 0 {
+		return nil, unsupportedf("the template has the declaration %s", summary(extras[0]))
+	}
+	if rawParameter {
+		return nil, unsupportedf("the parameter has no builder")
+	}
+	for _, name := range sortedKeys(fields) {
+		if name != "patch" && name != "outputs" {
+			return nil, unsupportedf("the template has the field %s", name)
+		}
+	}
+	var stmts []string
+	if f := fields["patch"]; f != nil {
+		patch, err := g.patch(f)
+		if err != nil {
+			return nil, err
+		}
+		stmts = append(stmts, patch...)
+	}
+	if f := fields["outputs"]; f != nil {
+		outputs, err := g.outputs(f)
+		if err != nil {
+			return nil, err
+		}
+		stmts = append(stmts, outputs...)
+	}
+	return stmts, nil
+}
+
+// policyTemplate builds the Template call of the policy
+func (g *generator) policyTemplate(fields map[string]*ast.Field, extras []ast.Decl) (string, error) {
+	if len(extras) > 0 {
+		return "", unsupportedf("the template has the declaration %s", summary(extras[0]))
+	}
+	g.usesVela = false
+	var stmts []string
+	for _, decl := range g.template {
+		f, ok := decl.(*ast.Field)
+		if !ok {
+			continue
+		}
+		name, _ := identLabel(f.Label)
+		if name == "parameter" || fields[name] != f {
+			continue
+		}
+		stmts = append(stmts, fmt.Sprintf("tpl.SetField(%s, %s)", strconv.Quote(name), g.value(f.Value)))
+	}
+	if len(stmts) == 0 {
+		return "", nil
+	}
+	return g.templateFunc("Template", stmts), nil
+}
+
+// templateFunc builds the call of the template function with the statements
+func (g *generator) templateFunc(method string, stmts []string) string {
+	if len(stmts) == 0 {
+		return ""
+	}
+	templateType := "defkit.Template"
+	if g.kind.typeName == "PolicyDefinition" {
+		templateType = "defkit.PolicyTemplate"
+	}
+	sb := &strings.Builder{}
+	fmt.Fprintf(sb, "%s(func(tpl *%s) {\n", method, templateType)
+	if g.usesVela {
+		sb.WriteString("vela := defkit.VelaCtx()\n\n")
+	}
+	sb.WriteString(strings.Join(stmts, "\n"))
+	sb.WriteString("\n})")
+	return sb.String()
+}
+
+// outputs builds the statements of the auxiliary resources
+func (g *generator) outputs(f *ast.Field) ([]string, error) {
+	st, ok := f.Value.(*ast.StructLit)
+	if !ok {
+		return nil, unsupportedf("the outputs is not a struct")
+	}
+	var stmts []string
+	seen := map[string]bool{}
+	var collect func(decls []ast.Decl, cond *condition) error
+	collect = func(decls []ast.Decl, cond *condition) error {
+		for _, decl := range decls {
+			switch d := decl.(type) {
+			case *ast.Field:
+				name, _, ok := fieldKey(d.Label)
+				if !ok || d.Constraint != cuetoken.ILLEGAL {
+					return unsupportedf("the outputs has the field %s", cueText(d.Label))
+				}
+				if seen[name] {
+					return unsupportedf("the output %s is set more than once", name)
+				}
+				seen[name] = true
+				res, isStruct := d.Value.(*ast.StructLit)
+				if !isStruct {
+					return unsupportedf("the output %s is not a struct", name)
+				}
+				code, err := g.resource("outputs."+name, res)
+				if err != nil {
+					return err
+				}
+				if cond == nil {
+					stmts = append(stmts, fmt.Sprintf("tpl.Outputs(%s, %s)", strconv.Quote(name), code))
+				} else {
+					stmts = append(stmts, fmt.Sprintf("tpl.OutputsIf(%s, %s, %s)", cond.code, strconv.Quote(name), code))
+				}
+			case *ast.Comprehension:
+				c, body, err := g.comprehension(d)
+				if err != nil {
+					return err
+				}
+				if c, err = and(cond, c); err != nil {
+					return err
+				}
+				if err = collect(body.Elts, c); err != nil {
+					return err
+				}
+			case *ast.CommentGroup:
+			default:
+				return unsupportedf("the outputs has the declaration %s", summary(decl))
+			}
+		}
+		return nil
+	}
+	if err := collect(st.Elts, nil); err != nil {
+		return nil, err
+	}
+	return stmts, nil
+}
+
+// patch builds the statements of the patch of the trait
+func (g *generator) patch(f *ast.Field) ([]string, error) {
+	var stmts []string
+	_, markers := fieldDoc(f)
+	for _, marker := range markers {
+		strategy, isStrategy := strings.CutPrefix(marker, "+patchStrategy=")
+		if !isStrategy {
+			return nil, unsupportedf("the patch has the %s marker", marker)
+		}
+		stmts = append(stmts, fmt.Sprintf("tpl.PatchStrategy(%s)", strconv.Quote(strategy)))
+	}
+	if isIdent(f.Value, "parameter") {
+		return append(stmts, "tpl.Patch().Passthrough()"), nil
+	}
+	st, ok := f.Value.(*ast.StructLit)
+	if !ok {
+		return nil, unsupportedf("the patch is not a struct")
+	}
+	ops, err := g.fields("patch", "", st.Elts, true)
+	if err != nil {
+		return nil, err
+	}
+	return append(stmts, "tpl.Patch()"+chainOps(ops)), nil
+}
+
+// resource builds the defkit resource of the struct
+func (g *generator) resource(where string, st *ast.StructLit) (string, error) {
+	var apiVersion, kind string
+	var rest []ast.Decl
+	for _, decl := range st.Elts {
+		if f, ok := decl.(*ast.Field); ok && f.Constraint == cuetoken.ILLEGAL {
+			if name, _ := identLabel(f.Label); name == "apiVersion" || name == "kind" {
+				s, isString := stringValue(f.Value)
+				if !isString {
+					return "", unsupportedf("the %s of %s is not a string literal", name, where)
+				}
+				if name == "apiVersion" {
+					apiVersion = s
+				} else {
+					kind = s
+				}
+				continue
+			}
+		}
+		rest = append(rest, decl)
+	}
+	if apiVersion == "" || kind == "" {
+		return "", unsupportedf("the %s has no apiVersion or kind", where)
+	}
+	ops, err := g.fields(where, "", rest, false)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("defkit.NewResource(%s, %s)", strconv.Quote(apiVersion), strconv.Quote(kind)) + chainOps(ops), nil
+}
+
+// fields builds the set operations of the struct fields under the path. A nested struct that has no builder
+// is kept as a raw CUE value, while the error is returned if the fields at this level have no builder.
+func (g *generator) fields(where, prefix string, decls []ast.Decl, patch bool) ([]setOp, error) {
+	var ops []setOp
+	// seen records the fields at this level and whether they are set with nested structs unconditionally
+	seen := map[string]bool{}
+	var collect func(decls []ast.Decl, cond *condition) error
+	collect = func(decls []ast.Decl, cond *condition) error {
+		for _, decl := range decls {
+			switch d := decl.(type) {
+			case *ast.Field:
+				key, ident, ok := fieldKey(d.Label)
+				if !ok || d.Constraint != cuetoken.ILLEGAL || len(d.Attrs) > 0 || (patch && !ident) {
+					return unsupportedf("the field %s has no builder", cueText(d.Label))
+				}
+				if patch && hasPatchDirective(d) {
+					return unsupportedf("the field %s has the patch directives", key)
+				}
+				path, err := joinPath(prefix, key, ident)
+				if err != nil {
+					return err
+				}
+				nested, seenBefore := seen[key]
+				st, isStruct := d.Value.(*ast.StructLit)
+				if isStruct && len(st.Elts) > 0 {
+					if seenBefore && !nested {
+						return unsupportedf("the field %s is set more than once", path)
+					}
+					sub, err := g.fields(where, path, st.Elts, patch)
+					if u := (&unsupported{}); errors.As(err, &u) {
+						if seenBefore {
+							return unsupportedf("the field %s is set more than once", path)
+						}
+						value, typed := g.typedValue(d.Value)
+						if !typed {
+							g.note("%s.%s: %s, kept as a raw CUE value", where, path, err.Error())
+						}
+						ops, seen[key] = append(ops, setOp{cond: cond, path: path, value: value}), false
+						continue
+					}
+					if err != nil {
+						return err
+					}
+					// the nested fields are set under the condition of the struct, the fields sharing the same
+					// condition are still grouped by chainOps
+					conds := map[*condition]*condition{nil: cond}
+					for i := range sub {
+						c, ok := conds[sub[i].cond]
+						if !ok {
+							if c, err = and(cond, sub[i].cond); err != nil {
+								return err
+							}
+							conds[sub[i].cond] = c
+						}
+						sub[i].cond = c
+					}
+					ops, seen[key] = append(ops, sub...), true
+					continue
+				}
+				if seenBefore {
+					return unsupportedf("the field %s is set more than once", path)
+				}
+				ops, seen[key] = append(ops, setOp{cond: cond, path: path, value: g.value(d.Value)}), false
+			case *ast.Comprehension:
+				c, body, err := g.comprehension(d)
+				if err != nil {
+					return err
+				}
+				if c, err = and(cond, c); err != nil {
+					return err
+				}
+				if err = collect(body.Elts, c); err != nil {
+					return err
+				}
+			case *ast.CommentGroup:
+			default:
+				return unsupportedf("the declaration %s has no builder", summary(decl))
+			}
+		}
+		return nil
+	}
+	if err := collect(decls, nil); err != nil {
+		return nil, err
+	}
+	return ops, nil
+}
+
+// comprehension returns the condition and the body of the if comprehension
+func (g *generator) comprehension(c *ast.Comprehension) (*condition, *ast.StructLit, error) {
+	ifc, ok := c.Clauses[0].(*ast.IfClause)
+	if !ok || len(c.Clauses) != 1 {
+		return nil, nil, unsupportedf("the comprehension %s has no builder", summary(c))
+	}
+	body, ok := c.Value.(*ast.StructLit)
+	if !ok {
+		return nil, nil, unsupportedf("the comprehension %s has no builder", summary(c))
+	}
+	cond, err := g.condition(ifc.Condition)
+	return cond, body, err
+}
+
+// condition builds the defkit condition of the CUE expression
+func (g *generator) condition(e ast.Expr) (*condition, error) {
+	e = unparen(e)
+	switch v := e.(type) {
+	case *ast.UnaryExpr:
+		if v.Op != cuetoken.NOT {
+			break
+		}
+		if p := g.boolParam(v.X); p != nil {
+			return &condition{code: p.goName + ".IsFalse()"}, nil
+		}
+		inner, err := g.condition(v.X)
+		if err != nil {
+			return nil, err
+		}
+		return &condition{code: fmt.Sprintf("defkit.Not(%s)", inner.code)}, nil
+	case *ast.BinaryExpr:
+		switch v.Op {
+		case cuetoken.LAND, cuetoken.LOR:
+			left, err := g.condition(v.X)
+			if err != nil {
+				return nil, err
+			}
+			right, err := g.condition(v.Y)
+			if err != nil {
+				return nil, err
+			}
+			if (left.op != 0 && left.op != v.Op) || (right.op != 0 && right.op != v.Op) {
+				return nil, unsupportedf("the condition %s mixes && and ||", cueText(e))
+			}
+			fn := map[cuetoken.Token]string{cuetoken.LAND: "And", cuetoken.LOR: "Or"}[v.Op]
+			return &condition{code: fmt.Sprintf("defkit.%s(%s, %s)", fn, left.code, right.code), op: v.Op}, nil
+		case cuetoken.EQL, cuetoken.NEQ:
+			if _, isBottom := v.Y.(*ast.BottomLit); isBottom {
+				return g.exists(v.X, v.Op == cuetoken.NEQ), nil
+			}
+		}
+		if fn, ok := comparisons[v.Op]; ok {
+			return &condition{code: fmt.Sprintf("defkit.%s(%s, %s)", fn, g.value(v.X), g.value(v.Y))}, nil
+		}
+	case *ast.Ident, *ast.SelectorExpr:
+		if p := g.boolParam(e); p != nil {
+			return &condition{code: p.goName + ".IsTrue()"}, nil
+		}
+	}
+	return nil, unsupportedf("the condition %s has no builder", cueText(e))
+}
+
+// exists builds the condition checking whether the value exists
+func (g *generator) exists(e ast.Expr, set bool) *condition {
+	var code string
+	path, isPath := selectorPath(e)
+	switch {
+	case isPath && len(path) == 2 && path[0] == "parameter" && g.params[path[1]] != nil:
+		if set {
+			return &condition{code: g.params[path[1]].goName + ".IsSet()"}
+		}
+		return &condition{code: g.params[path[1]].goName + ".NotSet()"}
+	case isPath && len(path) > 2 && path[0] == "parameter":
+		code = fmt.Sprintf("defkit.ParamPath(%s).IsSet()", strconv.Quote(strings.Join(path[1:], ".")))
+	default:
+		code = fmt.Sprintf("defkit.PathExists(%s)", goString(cueText(e)))
+	}
+	if !set {
+		code = fmt.Sprintf("defkit.Not(%s)", code)
+	}
+	return &condition{code: code}
+}
+
+// boolParam returns the top-level bool parameter referenced by the expression
+func (g *generator) boolParam(e ast.Expr) *paramVar {
+	path, ok := selectorPath(e)
+	if !ok || len(path) != 2 || path[0] != "parameter" {
+		return nil
+	}
+	if p := g.params[path[1]]; p != nil && p.base == "bool" {
+		return p
+	}
+	return nil
+}
+
+// value builds the defkit value of the CUE expression, which is kept as raw CUE if it has no builder
+func (g *generator) value(e ast.Expr) string {
+	code, _ := g.typedValue(e)
+	return code
+}
+
+// typedValue builds the defkit value of the CUE expression and returns whether it has a builder,
+// the raw CUE value is returned otherwise
+func (g *generator) typedValue(e ast.Expr) (string, bool) {
+	switch v := e.(type) {
+	case *ast.BasicLit:
+		switch v.Kind {
+		case cuetoken.STRING:
+			if s, ok := stringValue(v); ok {
+				return fmt.Sprintf("defkit.Lit(%s)", strconv.Quote(s)), true
+			}
+		case cuetoken.INT:
+			if n, ok := intValue(v); ok {
+				return fmt.Sprintf("defkit.Lit(%d)", n), true
+			}
+		case cuetoken.TRUE, cuetoken.FALSE:
+			return fmt.Sprintf("defkit.Lit(%s)", v.Value), true
+		}
+	case *ast.Interpolation:
+		if code, ok := g.interpolation(v); ok {
+			return code, true
+		}
+	case *ast.Ident, *ast.SelectorExpr:
+		if code, ok := g.reference(e); ok {
+			return code, true
+		}
+	case *ast.StructLit:
+		if code, ok := g.element(v); ok {
+			return code, true
+		}
+	case *ast.ListLit:
+		if code, ok := g.array(v); ok {
+			return code, true
+		}
+	}
+	return rawValue(e), false
+}
+
+// array builds the defkit array of the list of structs, the conditional structs are added with ItemIf.
+// The lists of other values, such as strings, have no builder.
+func (g *generator) array(l *ast.ListLit) (string, bool) {
+	if len(l.Elts) == 0 {
+		return "", false
+	}
+	sb := &strings.Builder{}
+	sb.WriteString("defkit.NewArray()")
+	for _, elt := range l.Elts {
+		switch v := elt.(type) {
+		case *ast.StructLit:
+			elem, ok := g.element(v)
+			if !ok {
+				return "", false
+			}
+			fmt.Fprintf(sb, ".\nItem(%s)", elem)
+		case *ast.Comprehension:
+			cond, body, err := g.comprehension(v)
+			if err != nil {
+				return "", false
+			}
+			elem, ok := g.element(body)
+			if !ok {
+				return "", false
+			}
+			fmt.Fprintf(sb, ".\nItemIf(%s, %s)", cond.code, elem)
+		default:
+			return "", false
+		}
+	}
+	return sb.String(), true
+}
+
+// element builds the defkit array element of the struct, which is also used for the struct values.
+// The fields of the if comprehensions are set with SetIf.
+func (g *generator) element(st *ast.StructLit) (string, bool) {
+	sb := &strings.Builder{}
+	sb.WriteString("defkit.NewArrayElement()")
+	seen := map[string]bool{}
+	for _, decl := range st.Elts {
+		switch d := decl.(type) {
+		case *ast.Field:
+			key, ident, ok := fieldKey(d.Label)
+			if !ok || d.Constraint != cuetoken.ILLEGAL || len(d.Attrs) > 0 || seen[key] {
+				return "", false
+			}
+			seen[key] = true
+			keyCode := strconv.Quote(key)
+			if q := strconv.Quote(key); !ident {
+				// the keys of the element are written to CUE as they are, so the key is quoted for CUE
+				keyCode = strconv.Quote(q)
+				if !strings.Contains(q, "`") {
+					keyCode = "`" + q + "`"
+				}
+			}
+			fmt.Fprintf(sb, ".\nSet(%s, %s)", keyCode, g.value(d.Value))
+		case *ast.Comprehension:
+			cond, body, err := g.comprehension(d)
+			if err != nil {
+				return "", false
+			}
+			for _, bodyDecl := range body.Elts {
+				f, isField := bodyDecl.(*ast.Field)
+				if !isField {
+					if _, isComment := bodyDecl.(*ast.CommentGroup); isComment {
+						continue
+					}
+					return "", false
+				}
+				// the paths of SetIf are split by dots, so only the identifiers are supported
+				key, ident, ok := fieldKey(f.Label)
+				if !ok || !ident || f.Constraint != cuetoken.ILLEGAL || len(f.Attrs) > 0 {
+					return "", false
+				}
+				fmt.Fprintf(sb, ".\nSetIf(%s, %s, %s)", cond.code, strconv.Quote(key), g.value(f.Value))
+			}
+		case *ast.CommentGroup:
+		default:
+			return "", false
+		}
+	}
+	return sb.String(), true
+}
+
+// reference builds the defkit value of the reference to the parameter or the context
+func (g *generator) reference(e ast.Expr) (string, bool) {
+	path, ok := selectorPath(e)
+	if !ok {
+		return "", false
+	}
+	switch path[0] {
+	case "parameter":
+		switch {
+		case len(path) == 1:
+			return "defkit.Parameter()", true
+		case len(path) == 2 && g.params[path[1]] != nil:
+			return g.params[path[1]].goName, true
+		default:
+			return fmt.Sprintf("defkit.ParamPath(%s)", strconv.Quote(strings.Join(path[1:], "."))), true
+		}
+	case "context":
+		var method string
+		switch {
+		case len(path) == 2 && contextFields[path[1]] != "":
+			method = contextFields[path[1]]
+		case len(path) == 3 && path[1] == "outputs":
+			method = fmt.Sprintf("Outputs(%s)", strconv.Quote(path[2]))
+		case len(path) == 3 && path[1] == "clusterVersion":
+			switch path[2] {
+			case "major", "minor", "patch", "gitVersion":
+				method = fmt.Sprintf("ClusterVersion().%s()", strings.ToUpper(path[2][:1])+path[2][1:])
+			}
+		}
+		if method != "" {
+			g.usesVela = true
+			return "vela." + method, true
+		}
+	}
+	return "", false
+}
+
+// interpolation builds the defkit interpolation of the interpolated string
+func (g *generator) interpolation(v *ast.Interpolation) (string, bool) {
+	var parts []string
+	last := len(v.Elts) - 1
+	for i, elt := range v.Elts {
+		if i%2 == 1 {
+			parts = append(parts, g.value(elt))
+			continue
+		}
+		lit, ok := elt.(*ast.BasicLit)
+		if !ok {
+			return "", false
+		}
+		s := lit.Value
+		switch {
+		case i == 0 && strings.HasPrefix(s, `"`) && !strings.HasPrefix(s, `"""`):
+			s = strings.TrimPrefix(s, `"`)
+		case i > 0 && strings.HasPrefix(s, ")"):
+			s = strings.TrimPrefix(s, ")")
+		default:
+			return "", false
+		}
+		switch {
+		case i == last && strings.HasSuffix(s, `"`):
+			s = strings.TrimSuffix(s, `"`)
+		case i < last && strings.HasSuffix(s, `\(`):
+			s = strings.TrimSuffix(s, `\(`)
+		default:
+			return "", false
+		}
+		if s != "" {
+			parts = append(parts, fmt.Sprintf("defkit.Lit(%s)", strconv.Quote(s)))
+		}
+	}
+	return fmt.Sprintf("defkit.Interpolation(%s)", strings.Join(parts, ", ")), true
+}
+
+// and combines the conditions with &&
+func and(left, right *condition) (*condition, error) {
+	if left == nil {
+		return right, nil
+	}
+	if left.op == cuetoken.LOR || right.op == cuetoken.LOR {
+		return nil, unsupportedf("the nested conditions mix && and ||")
+	}
+	return &condition{code: fmt.Sprintf("defkit.And(%s, %s)", left.code, right.code), op: cuetoken.LAND}, nil
+}
+
+// chainOps builds the chained Set calls of the operations, the consecutive operations
+// with the same condition are grouped in an If block
+func chainOps(ops []setOp) string {
+	sb := &strings.Builder{}
+	for i := 0; i < len(ops); {
+		op := ops[i]
+		j := i + 1
+		for op.cond != nil && j < len(ops) && ops[j].cond == op.cond {
+			j++
+		}
+		switch {
+		case op.cond == nil:
+			fmt.Fprintf(sb, ".\nSet(%s, %s)", strconv.Quote(op.path), op.value)
+		case j-i == 1:
+			fmt.Fprintf(sb, ".\nSetIf(%s, %s, %s)", op.cond.code, strconv.Quote(op.path), op.value)
+		default:
+			fmt.Fprintf(sb, ".\nIf(%s)", op.cond.code)
+			for _, grouped := range ops[i:j] {
+				fmt.Fprintf(sb, ".\nSet(%s, %s)", strconv.Quote(grouped.path), grouped.value)
+			}
+			sb.WriteString(".\nEndIf()")
+		}
+		i = j
+	}
+	return sb.String()
+}
+
+// joinPath joins the field key to the defkit path, the keys that are not identifiers are written in brackets
+func joinPath(prefix, key string, ident bool) (string, error) {
+	switch {
+	case ident && prefix == "":
+		return key, nil
+	case ident:
+		return prefix + "." + key, nil
+	case prefix == "" || strings.HasSuffix(prefix, "]") || strings.ContainsAny(key, "[]\""):
+		return "", unsupportedf("the field %s has no builder", strconv.Quote(key))
+	}
+	return prefix + "[" + key + "]", nil
+}
+
+// selectorPath returns the path of the selector expression, such as parameter.image
+func selectorPath(e ast.Expr) ([]string, bool) {
+	switch v := e.(type) {
+	case *ast.Ident:
+		return []string{v.Name}, true
+	case *ast.SelectorExpr:
+		path, ok := selectorPath(v.X)
+		if !ok {
+			return nil, false
+		}
+		name, ok := identLabel(v.Sel)
+		if !ok {
+			return nil, false
+		}
+		return append(path, name), true
+	}
+	return nil, false
+}
+
+// hasPatchDirective returns true if the field has the patchKey or patchStrategy markers
+func hasPatchDirective(f *ast.Field) bool {
+	_, markers := fieldDoc(f)
+	for _, marker := range markers {
+		if strings.HasPrefix(marker, "+patchKey") || strings.HasPrefix(marker, "+patchStrategy") {
+			return true
+		}
+	}
+	return false
+}
+
+// rawValue builds the defkit value of the raw CUE expression
+func rawValue(e ast.Expr) string {
+	return fmt.Sprintf("defkit.Reference(%s)", goString(cueText(e)))
+}
+
+// summary returns the first line of the declaration for the notes
+func summary(n ast.Node) string {
+	text := cueText(n)
+	if i := strings.IndexByte(text, '\n'); i >= 0 {
+		text = text[:i] + " ..."
+	}
+	return text
+}
@@ -0,0 +1,23 @@
+annotator: {
+	type: "trait"
+	annotations: {}
+	labels: {}
+	description: "Add the annotations to the workload."
+	attributes: {
+		appliesToWorkloads: ["deployments.apps"]
+		podDisruptive: true
+	}
+}
+template: {
+	patch: {
+		metadata: annotations: parameter.annotations
+		if parameter.owner != _|_ {
+			spec: template: metadata: annotations: "example.com/owner": parameter.owner
+		}
+	}
+	parameter: {
+		// +usage=The annotations to add
+		annotations: [string]: string
+		owner?: string
+	}
+}
@@ -0,0 +1,19 @@
+mounts: {
+	type: "component"
+	annotations: {}
+	labels: {}
+	description: "Run a container with the volumes."
+	attributes: workload: definition: {
+		apiVersion: "apps/v1"
+		kind:       "Deployment"
+	}
+}
+template: {
+	let vols = [for v in parameter.volumes {name: v.name, emptyDir: {}}]
+	output: {
+		apiVersion: "apps/v1"
+		kind:       "Deployment"
+		spec: template: spec: volumes: vols
+	}
+	parameter: volumes: [...{name: string}]
+}
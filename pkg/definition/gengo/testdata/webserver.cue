@@ -0,0 +1,68 @@
+webserver: {
+	type: "component"
+	annotations: {}
+	labels: {}
+	description: "Run a web server."
+	attributes: workload: definition: {
+		apiVersion: "apps/v1"
+		kind:       "Deployment"
+	}
+}
+template: {
+	output: {
+		apiVersion: "apps/v1"
+		kind:       "Deployment"
+		metadata: {
+			name: context.name
+			labels: "app.oam.dev/component": context.name
+		}
+		spec: {
+			replicas: parameter.replicas
+			selector: matchLabels: "app.oam.dev/component": context.name
+			template: {
+				metadata: labels: "app.oam.dev/component": context.name
+				spec: containers: [{
+					name:  context.name
+					image: parameter.image
+					ports: [{containerPort: parameter.port}]
+					if parameter.env != _|_ {
+						env: parameter.env
+					}
+					if parameter.debug {
+						args: ["--log-level", "debug"]
+					}
+				}]
+			}
+		}
+	}
+	outputs: {
+		if parameter.expose {
+			service: {
+				apiVersion: "v1"
+				kind:       "Service"
+				metadata: name: "\(context.name)-svc"
+				spec: {
+					selector: "app.oam.dev/component": context.name
+					ports: [{port: parameter.port}]
+					type: parameter.serviceType
+				}
+			}
+		}
+	}
+	parameter: {
+		// +usage=Which image would you like to use for your service
+		image: string
+		// +usage=Number of replicas
+		replicas: *1 | int
+		// +usage=Which port do you want customer traffic sent to
+		port: *80 | int
+		// +usage=Define arguments by using environment variables
+		env?: [...{
+			name:   string
+			value?: string
+		}]
+		debug: *false | bool
+		expose: *false | bool
+		serviceType: *"ClusterIP" | "NodePort" | "LoadBalancer"
+	}
+}
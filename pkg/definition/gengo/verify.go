@@ -0,0 +1,301 @@
+/*
+Copyright 2026 The KubeVela Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gengo
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"cuelang.org/go/cue"
+	"github.com/google/go-cmp/cmp"
+	"github.com/kubevela/pkg/cue/cuex"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/oam-dev/kubevela/apis/core.oam.dev/v1beta1"
+	velacue "github.com/oam-dev/kubevela/pkg/cue"
+	pkgdef "github.com/oam-dev/kubevela/pkg/definition"
+	"github.com/oam-dev/kubevela/pkg/definition/deftest"
+	"github.com/oam-dev/kubevela/pkg/definition/goloader"
+	"github.com/oam-dev/kubevela/pkg/workflow/providers"
+)
+
+// Report is the result of verifying the generated Go definition against the CUE definition
+type Report struct {
+	// CUE is the CUE definition regenerated from the Go definition
+	CUE string
+	// Mismatches are the differences between the regenerated and the original definition
+	Mismatches []string
+	// Skipped are the checks which cannot be run on the definition
+	Skipped []string
+}
+
+// Equivalent returns true if no difference is found
+func (r *Report) Equivalent() bool {
+	return len(r.Mismatches) == 0
+}
+
+// sampleOutput is the workload patched by the traits when their renders are compared
+var sampleOutput = map[string]interface{}{
+	"apiVersion": "apps/v1",
+	"kind":       "Deployment",
+	"metadata":   map[string]interface{}{"name": "component"},
+	"spec": map[string]interface{}{
+		"selector": map[string]interface{}{"matchLabels": map[string]interface{}{"app": "component"}},
+		"template": map[string]interface{}{
+			"metadata": map[string]interface{}{"labels": map[string]interface{}{"app": "component"}},
+			"spec": map[string]interface{}{
+				"containers": []interface{}{map[string]interface{}{"name": "main", "image": "busybox"}},
+			},
+		},
+	},
+}
+
+// Verify regenerates the CUE definition from the generated Go definition and compares it with the
+// original one, including the metadata, the parameter schema and the resources rendered with the
+// sample parameters. It requires the go toolchain to run the Go definition.
+func Verify(ctx context.Context, cueSource string, result *Result) (*Report, error) {
+	regenerated, err := regenerate(result)
+	if err != nil {
+		return nil, err
+	}
+	report := &Report{CUE: regenerated}
+	original, err := loadDefinition(cueSource)
+	if err != nil {
+		return nil, err
+	}
+	generated, err := loadDefinition(regenerated)
+	if err != nil {
+		return nil, errors.WithMessage(err, "the regenerated definition is invalid")
+	}
+	if diff := cmp.Diff(metadataOf(original), metadataOf(generated)); diff != "" {
+		report.Mismatches = append(report.Mismatches, fmt.Sprintf("the metadata differs (-original +generated):\n%s", diff))
+	}
+
+	originalParam, err := parameterOf(ctx, original)
+	if err != nil {
+		return nil, err
+	}
+	generatedParam, err := parameterOf(ctx, generated)
+	if err != nil {
+		return nil, errors.WithMessage(err, "the regenerated definition is invalid")
+	}
+	switch {
+	case !originalParam.Exists() && !generatedParam.Exists():
+	case !originalParam.Exists() || !generatedParam.Exists():
+		report.Mismatches = append(report.Mismatches, "the parameter is declared in only one of the definitions")
+	case originalParam.Subsume(generatedParam, cue.Schema()) != nil || generatedParam.Subsume(originalParam, cue.Schema()) != nil:
+		report.Mismatches = append(report.Mismatches, "the parameter schema differs")
+	default:
+		// the defaults are not taken into account by the subsumption of schemas
+		if diff := cmp.Diff(defaultsOf(originalParam, "parameter", nil), defaultsOf(generatedParam, "parameter", nil)); diff != "" {
+			report.Mismatches = append(report.Mismatches, fmt.Sprintf("the parameter defaults differ (-original +generated):\n%s", diff))
+		}
+	}
+
+	if kind := original.GetKind(); kind != v1beta1.ComponentDefinitionKind && kind != v1beta1.TraitDefinitionKind {
+		report.Skipped = append(report.Skipped, fmt.Sprintf("the renders of %s are not compared", kind))
+		return report, nil
+	}
+	mock := deftest.Context{}
+	if original.GetKind() == v1beta1.TraitDefinitionKind {
+		mock.Output = sampleOutput
+	}
+	for _, sample := range []struct {
+		name string
+		full bool
+	}{{"minimal", false}, {"full", true}} {
+		var parameter map[string]interface{}
+		if originalParam.Exists() {
+			parameter, _ = sampleValue(originalParam, sample.full).(map[string]interface{})
+		}
+		want, err := deftest.Render(ctx, cueSource, parameter, mock)
+		if err != nil {
+			report.Skipped = append(report.Skipped, fmt.Sprintf("the %s sample parameter cannot be rendered by the original definition: %v", sample.name, err))
+			continue
+		}
+		got, err := deftest.Render(ctx, regenerated, parameter, mock)
+		if err != nil {
+			report.Mismatches = append(report.Mismatches, fmt.Sprintf("the %s sample parameter cannot be rendered by the generated definition: %v", sample.name, err))
+			continue
+		}
+		if diff := cmp.Diff(want, got); diff != "" {
+			report.Mismatches = append(report.Mismatches, fmt.Sprintf("the resources rendered with the %s sample parameter differ (-original +generated):\n%s", sample.name, diff))
+		}
+	}
+	return report, nil
+}
+
+// regenerate runs the generated Go definition in a temporary module and returns the CUE definition
+func regenerate(result *Result) (string, error) {
+	dir, err := os.MkdirTemp("", "vela-gengo-verify-*")
+	if err != nil {
+		return "", errors.Wrap(err, "failed to create temp directory")
+	}
+	defer func() { _ = os.RemoveAll(dir) }()
+	if err = os.WriteFile(filepath.Join(dir, "go.mod"), []byte("module vela-gengo-verify\n\ngo 1.21\n"), 0600); err != nil {
+		return "", errors.Wrap(err, "failed to write go.mod")
+	}
+	path := filepath.Join(dir, "definition.go")
+	if err = os.WriteFile(path, result.Source, 0600); err != nil {
+		return "", errors.Wrap(err, "failed to write the Go definition")
+	}
+	results, err := goloader.LoadFromFile(path)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to load the Go definition")
+	}
+	for _, r := range results {
+		if r.Definition.FunctionName != result.Function {
+			continue
+		}
+		if r.Error != nil {
+			return "", errors.Wrap(r.Error, "failed to run the Go definition")
+		}
+		return r.CUE, nil
+	}
+	return "", errors.Errorf("function %s not found in the Go definition", result.Function)
+}
+
+func loadDefinition(cueSource string) (*pkgdef.Definition, error) {
+	def := &pkgdef.Definition{Unstructured: unstructured.Unstructured{}}
+	if err := def.FromCUEString(cueSource, nil); err != nil {
+		return nil, err
+	}
+	return def, nil
+}
+
+// metadataOf returns the metadata of the definition to compare, the template is compared separately
+func metadataOf(def *pkgdef.Definition) map[string]interface{} {
+	spec, _, _ := unstructured.NestedMap(def.Object, "spec")
+	delete(spec, "schematic")
+	// the workload type is inferred from the workload definition unless it is autodetected
+	if workloadType, _, _ := unstructured.NestedString(spec, "workload", "type"); workloadType != autodetectWorkload {
+		unstructured.RemoveNestedField(spec, "workload", "type")
+	}
+	// the traits are not pod disruptive by default
+	if disruptive, ok := spec["podDisruptive"].(bool); ok && !disruptive {
+		delete(spec, "podDisruptive")
+	}
+	if status, ok := spec["status"].(map[string]interface{}); ok {
+		for k, v := range status {
+			if s, isString := v.(string); isString {
+				status[k] = strings.TrimSpace(s)
+			}
+		}
+	}
+	return map[string]interface{}{
+		"kind":        def.GetKind(),
+		"name":        def.GetName(),
+		"labels":      def.GetLabels(),
+		"annotations": def.GetAnnotations(),
+		"spec":        spec,
+	}
+}
+
+// parameterOf compiles the template of the definition and returns the parameter
+func parameterOf(ctx context.Context, def *pkgdef.Definition) (cue.Value, error) {
+	template, _, _ := unstructured.NestedString(def.Object, "spec", "schematic", "cue", "template")
+	v, err := providers.DefaultCompiler.Get().CompileStringWithOptions(ctx, template+"\n"+velacue.BaseTemplate, cuex.DisableResolveProviderFunctions{})
+	if err != nil {
+		return cue.Value{}, errors.Wrap(err, "failed to compile the template")
+	}
+	return v.LookupPath(cue.ParsePath("parameter")), nil
+}
+
+// defaultsOf collects the default values of the scalar fields in the schema by path
+func defaultsOf(v cue.Value, path string, defaults map[string]interface{}) map[string]interface{} {
+	if defaults == nil {
+		defaults = map[string]interface{}{}
+	}
+	if d, ok := v.Default(); ok && d.IsConcrete() && d.IncompleteKind()&(cue.StructKind|cue.ListKind) == 0 {
+		var value interface{}
+		if err := d.Decode(&value); err == nil {
+			defaults[path] = value
+		}
+		return defaults
+	}
+	if v.IncompleteKind() != cue.StructKind {
+		return defaults
+	}
+	it, err := v.Fields(cue.Optional(true))
+	if err != nil {
+		return defaults
+	}
+	for it.Next() {
+		defaultsOf(it.Value(), path+"."+it.Selector().String(), defaults)
+	}
+	return defaults
+}
+
+// sampleValue returns a sample value of the schema. The minimal sample only has the required fields
+// without defaults, while the full sample has all the fields.
+func sampleValue(v cue.Value, full bool) interface{} {
+	if d, ok := v.Default(); ok && d.IsConcrete() && (!full || d.IncompleteKind()&(cue.StructKind|cue.ListKind) == 0) {
+		var value interface{}
+		if err := d.Decode(&value); err == nil {
+			return value
+		}
+	}
+	if op, args := v.Expr(); op == cue.OrOp && len(args) > 0 {
+		if full {
+			return sampleValue(args[len(args)-1], full)
+		}
+		return sampleValue(args[0], full)
+	}
+	if v.IsConcrete() && v.IncompleteKind()&(cue.StructKind|cue.ListKind) == 0 {
+		var value interface{}
+		if err := v.Decode(&value); err == nil {
+			return value
+		}
+	}
+	switch kind := v.IncompleteKind(); {
+	case kind&cue.StructKind != 0:
+		obj := map[string]interface{}{}
+		it, err := v.Fields(cue.Optional(true))
+		if err != nil {
+			return obj
+		}
+		for it.Next() {
+			_, hasDefault := it.Value().Default()
+			if !full && (it.IsOptional() || hasDefault) {
+				continue
+			}
+			obj[it.Selector().Unquoted()] = sampleValue(it.Value(), full)
+		}
+		if elem := v.LookupPath(cue.MakePath(cue.AnyString)); full && len(obj) == 0 && elem.Exists() {
+			obj["key1"] = sampleValue(elem, full)
+		}
+		return obj
+	case kind&cue.ListKind != 0:
+		if elem := v.LookupPath(cue.MakePath(cue.AnyIndex)); full && elem.Exists() {
+			return []interface{}{sampleValue(elem, full)}
+		}
+		return []interface{}{}
+	case kind&cue.StringKind != 0:
+		return "sample"
+	case kind&cue.IntKind != 0:
+		return 1
+	case kind&cue.FloatKind != 0:
+		return 1.5
+	case kind&cue.BoolKind != 0:
+		return true
+	}
+	return "sample"
+}
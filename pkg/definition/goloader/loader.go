@@ -50,6 +50,8 @@ type DefinitionInfo struct {
 	FunctionName string
 	// FilePath is the path to the Go file containing the definition
 	FilePath string
+	// Line is the line of the function declaration in the Go file
+	Line int
 	// PackageName is the Go package name
 	PackageName string
 	// Placement contains the definition-level placement constraints (if any)
@@ -465,6 +467,7 @@ func AnalyzeGoFile(filePath string) ([]DefinitionInfo, error) {
 			Type:         defType,
 			FunctionName: fn.Name.Name,
 			FilePath:     filePath,
+			Line:         fset.Position(fn.Pos()).Line,
 			PackageName:  packageName,
 		})
 	}
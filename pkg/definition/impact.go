@@ -0,0 +1,333 @@
+/*
+Copyright 2026 The KubeVela Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package definition
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/oam-dev/kubevela/apis/core.oam.dev/v1beta1"
+	"github.com/oam-dev/kubevela/apis/types"
+	"github.com/oam-dev/kubevela/pkg/appfile/dryrun"
+)
+
+const (
+	// ImpactApplication is the kind of the impact on an application
+	ImpactApplication = "Application"
+	// ImpactApplicationRevision is the kind of the impact on a previous revision of an application, which a
+	// rollback would render with the new definition
+	ImpactApplicationRevision = "ApplicationRevision"
+)
+
+// ResourceChange is a resource of a component rendered differently by the new definition
+type ResourceChange struct {
+	Component string
+	Kind      string
+	Name      string
+	Change    dryrun.DiffType
+}
+
+// String returns the change such as +Service/api, ~Deployment/api or -Ingress/api
+func (c ResourceChange) String() string {
+	sign := map[dryrun.DiffType]string{dryrun.AddDiff: "+", dryrun.ModifyDiff: "~", dryrun.RemoveDiff: "-"}[c.Change]
+	return fmt.Sprintf("%s%s/%s", sign, c.Kind, c.Name)
+}
+
+// ApplicationImpact is the impact of the new definition on an application or an application revision using it
+type ApplicationImpact struct {
+	// Kind is ImpactApplication or ImpactApplicationRevision
+	Kind      string
+	Namespace string
+	Name      string
+	// Changes are the resources rendered differently by the new definition
+	Changes []ResourceChange
+	// Err is the error rendering with the new definition
+	Err error
+	// Skipped is the reason the impact is unknown, such as the application failing to render with the current definition
+	Skipped string
+}
+
+// Affected tells if the rendering is changed or broken by the new definition
+func (i ApplicationImpact) Affected() bool {
+	return len(i.Changes) > 0 || i.Err != nil
+}
+
+// ImpactReport is the impact of a new version of a definition on the applications and revisions using it
+type ImpactReport struct {
+	Kind    string
+	Name    string
+	Impacts []ApplicationImpact
+}
+
+// Risky tells if the new definition would remove a resource or break the rendering of an application
+func (r *ImpactReport) Risky() bool {
+	for _, i := range r.Impacts {
+		if i.Err != nil {
+			return true
+		}
+		for _, c := range i.Changes {
+			if c.Change == dryrun.RemoveDiff {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// Summary returns the summary such as "43 of 50 apps affected, 3 would lose a Service"
+func (r *ImpactReport) Summary() string {
+	count := func(kind string) (total, affected, failed int, lost map[string]int) {
+		lost = map[string]int{}
+		for _, i := range r.Impacts {
+			if i.Kind != kind {
+				continue
+			}
+			total++
+			if !i.Affected() {
+				continue
+			}
+			affected++
+			if i.Err != nil {
+				failed++
+			}
+			kinds := map[string]bool{}
+			for _, c := range i.Changes {
+				if c.Change == dryrun.RemoveDiff {
+					kinds[c.Kind] = true
+				}
+			}
+			for k := range kinds {
+				lost[k]++
+			}
+		}
+		return
+	}
+	total, affected, failed, lost := count(ImpactApplication)
+	parts := []string{fmt.Sprintf("%d of %d apps affected", affected, total)}
+	kinds := make([]string, 0, len(lost))
+	for k := range lost {
+		kinds = append(kinds, k)
+	}
+	sort.Strings(kinds)
+	for _, k := range kinds {
+		article := "a"
+		if strings.ContainsAny(k[:1], "AEIOU") {
+			article = "an"
+		}
+		parts = append(parts, fmt.Sprintf("%d would lose %s %s", lost[k], article, k))
+	}
+	if failed > 0 {
+		parts = append(parts, fmt.Sprintf("%d would fail to render", failed))
+	}
+	summary := strings.Join(parts, ", ")
+	if total, affected, _, _ = count(ImpactApplicationRevision); total > 0 {
+		summary += fmt.Sprintf("; %d of %d previous revisions affected", affected, total)
+	}
+	return summary
+}
+
+// ImpactOptions are the options of the impact analysis
+type ImpactOptions struct {
+	// Namespace of the applications, all the namespaces if empty
+	Namespace string
+	// Revisions includes the previous revisions of the applications, to which the applications could roll back
+	Revisions bool
+}
+
+// AnalyzeImpact finds the applications and application revisions using the definition, and renders each with the
+// current and the new definition to compare the resources. The components and traits pinned to a version of the
+// definition, e.g. webservice@v1, keep rendering with the pinned version and are not affected.
+func AnalyzeImpact(ctx context.Context, cli client.Client, def *unstructured.Unstructured, opts ImpactOptions) (*ImpactReport, error) {
+	kind := def.GetKind()
+	if kind != v1beta1.ComponentDefinitionKind && kind != v1beta1.TraitDefinitionKind {
+		return nil, errors.Errorf("the impact of %s cannot be analyzed, only ComponentDefinition and TraitDefinition are supported", kind)
+	}
+	report := &ImpactReport{Kind: kind, Name: def.GetName()}
+
+	apps := v1beta1.ApplicationList{}
+	if err := cli.List(ctx, &apps, client.InNamespace(opts.Namespace)); err != nil {
+		return nil, errors.Wrapf(err, "failed to list applications")
+	}
+	latest := map[string]bool{}
+	for i := range apps.Items {
+		app := &apps.Items[i]
+		if app.Status.LatestRevision != nil {
+			latest[app.Namespace+"/"+app.Status.LatestRevision.Name] = true
+		}
+		if !usesDefinition(app, kind, def.GetName()) {
+			continue
+		}
+		impact := ApplicationImpact{Kind: ImpactApplication, Namespace: app.Namespace, Name: app.Name}
+		report.Impacts = append(report.Impacts, renderImpact(ctx, cli, app, nil, def, impact))
+	}
+	if !opts.Revisions {
+		return report, nil
+	}
+
+	revisions := v1beta1.ApplicationRevisionList{}
+	if err := cli.List(ctx, &revisions, client.InNamespace(opts.Namespace)); err != nil {
+		return nil, errors.Wrapf(err, "failed to list application revisions")
+	}
+	for i := range revisions.Items {
+		rev := &revisions.Items[i]
+		// the latest revision is rendered as the application
+		if latest[rev.Namespace+"/"+rev.Name] {
+			continue
+		}
+		app := rev.Spec.Application.DeepCopy()
+		app.Namespace = rev.Namespace
+		if !usesDefinition(app, kind, def.GetName()) {
+			continue
+		}
+		impact := ApplicationImpact{Kind: ImpactApplicationRevision, Namespace: rev.Namespace, Name: rev.Name}
+		defs, err := revisionDefinitions(rev)
+		if err != nil {
+			impact.Skipped = err.Error()
+			report.Impacts = append(report.Impacts, impact)
+			continue
+		}
+		report.Impacts = append(report.Impacts, renderImpact(ctx, cli, app, defs, def, impact))
+	}
+	return report, nil
+}
+
+func usesDefinition(app *v1beta1.Application, kind, name string) bool {
+	for _, comp := range app.Spec.Components {
+		if kind == v1beta1.ComponentDefinitionKind && comp.Type == name {
+			return true
+		}
+		for _, trait := range comp.Traits {
+			if kind == v1beta1.TraitDefinitionKind && trait.Type == name {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// revisionDefinitions returns the snapshot of the definitions recorded in the revision, so that the revision is
+// rendered as a rollback would
+func revisionDefinitions(rev *v1beta1.ApplicationRevision) ([]*unstructured.Unstructured, error) {
+	var defs []*unstructured.Unstructured
+	add := func(name, kind string, obj interface{}) error {
+		m, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+		if err != nil {
+			return errors.Wrapf(err, "invalid %s %s in revision %s", kind, name, rev.Name)
+		}
+		u := &unstructured.Unstructured{Object: m}
+		u.SetAPIVersion(v1beta1.SchemeGroupVersion.String())
+		u.SetKind(kind)
+		u.SetName(name)
+		defs = append(defs, u)
+		return nil
+	}
+	for name, def := range rev.Spec.ComponentDefinitions {
+		if err := add(name, v1beta1.ComponentDefinitionKind, def); err != nil {
+			return nil, err
+		}
+	}
+	for name, def := range rev.Spec.TraitDefinitions {
+		if err := add(name, v1beta1.TraitDefinitionKind, def); err != nil {
+			return nil, err
+		}
+	}
+	return defs, nil
+}
+
+func renderImpact(ctx context.Context, cli client.Client, app *v1beta1.Application, defs []*unstructured.Unstructured, def *unstructured.Unstructured, impact ApplicationImpact) ApplicationImpact {
+	current, _, err := dryrun.NewDryRunOption(cli, nil, defs, false).ExecuteDryRun(ctx, app)
+	if err != nil {
+		impact.Skipped = fmt.Sprintf("cannot render with the current definition: %v", err)
+		return impact
+	}
+	newDefs := []*unstructured.Unstructured{def}
+	for _, d := range defs {
+		if d.GetKind() != def.GetKind() || d.GetName() != def.GetName() {
+			newDefs = append(newDefs, d)
+		}
+	}
+	rendered, _, err := dryrun.NewDryRunOption(cli, nil, newDefs, false).ExecuteDryRun(ctx, app)
+	if err != nil {
+		impact.Err = err
+		return impact
+	}
+	impact.Changes = diffResources(current, rendered)
+	return impact
+}
+
+type renderedResource struct {
+	component, kind, name string
+	object                map[string]interface{}
+}
+
+func resourcesOf(comps []*types.ComponentManifest) (map[string]renderedResource, []string) {
+	resources := map[string]renderedResource{}
+	var keys []string
+	add := func(comp string, obj *unstructured.Unstructured) {
+		if obj == nil {
+			return
+		}
+		key := strings.Join([]string{comp, obj.GetAPIVersion(), obj.GetKind(), obj.GetNamespace(), obj.GetName()}, "/")
+		if _, found := resources[key]; !found {
+			keys = append(keys, key)
+		}
+		resources[key] = renderedResource{component: comp, kind: obj.GetKind(), name: obj.GetName(), object: obj.Object}
+	}
+	for _, comp := range comps {
+		add(comp.Name, comp.ComponentOutput)
+		for _, obj := range comp.ComponentOutputsAndTraits {
+			add(comp.Name, obj)
+		}
+	}
+	return resources, keys
+}
+
+// diffResources compares the resources rendered with the current and the new definition, in the order rendered
+func diffResources(current, rendered []*types.ComponentManifest) []ResourceChange {
+	before, beforeKeys := resourcesOf(current)
+	after, afterKeys := resourcesOf(rendered)
+	var changes []ResourceChange
+	for _, key := range beforeKeys {
+		r := before[key]
+		change := ResourceChange{Component: r.component, Kind: r.kind, Name: r.name}
+		n, found := after[key]
+		switch {
+		case !found:
+			change.Change = dryrun.RemoveDiff
+		case !reflect.DeepEqual(r.object, n.object):
+			change.Change = dryrun.ModifyDiff
+		default:
+			continue
+		}
+		changes = append(changes, change)
+	}
+	for _, key := range afterKeys {
+		if _, found := before[key]; !found {
+			r := after[key]
+			changes = append(changes, ResourceChange{Component: r.component, Kind: r.kind, Name: r.name, Change: dryrun.AddDiff})
+		}
+	}
+	return changes
+}
@@ -0,0 +1,132 @@
+/*
+Copyright 2026 The KubeVela Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package definition
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/oam-dev/kubevela/apis/core.oam.dev/common"
+	"github.com/oam-dev/kubevela/apis/core.oam.dev/v1beta1"
+	"github.com/oam-dev/kubevela/pkg/appfile/dryrun"
+	"github.com/oam-dev/kubevela/pkg/oam"
+)
+
+const exposedWorkerTemplate = `
+output: {
+	apiVersion: "apps/v1"
+	kind:       "Deployment"
+	spec: replicas: parameter.replicas
+}
+outputs: service: {
+	apiVersion: "v1"
+	kind:       "Service"
+	metadata: name: context.name
+}
+parameter: replicas: *1 | int
+`
+
+const workerTemplate = `
+output: {
+	apiVersion: "apps/v1"
+	kind:       "Deployment"
+	spec: replicas: parameter.replicas
+}
+parameter: replicas: *1 | int
+`
+
+func TestAnalyzeImpact(t *testing.T) {
+	r := require.New(t)
+	scheme := runtime.NewScheme()
+	r.NoError(v1beta1.AddToScheme(scheme))
+	worker := func(template string) *v1beta1.ComponentDefinition {
+		return &v1beta1.ComponentDefinition{
+			TypeMeta:   metav1.TypeMeta{APIVersion: v1beta1.SchemeGroupVersion.String(), Kind: v1beta1.ComponentDefinitionKind},
+			ObjectMeta: metav1.ObjectMeta{Name: "worker", Namespace: oam.SystemDefinitionNamespace},
+			Spec: v1beta1.ComponentDefinitionSpec{
+				Workload:  common.WorkloadTypeDescriptor{Definition: common.WorkloadGVK{APIVersion: "apps/v1", Kind: "Deployment"}},
+				Schematic: &common.Schematic{CUE: &common.CUE{Template: template}},
+			},
+		}
+	}
+	app := func(namespace, name, typ string, properties string) *v1beta1.Application {
+		return &v1beta1.Application{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+			Spec: v1beta1.ApplicationSpec{Components: []common.ApplicationComponent{{
+				Name:       name,
+				Type:       typ,
+				Properties: &runtime.RawExtension{Raw: []byte(properties)},
+			}}},
+		}
+	}
+	current := app("default", "current", "worker", `{}`)
+	current.Status.LatestRevision = &common.Revision{Name: "current-v2"}
+	previous := &v1beta1.ApplicationRevision{
+		ObjectMeta: metav1.ObjectMeta{Name: "current-v1", Namespace: "default"},
+		Spec: v1beta1.ApplicationRevisionSpec{ApplicationRevisionCompressibleFields: v1beta1.ApplicationRevisionCompressibleFields{
+			Application:          *app("default", "current", "worker", `{"replicas":2}`),
+			ComponentDefinitions: map[string]*v1beta1.ComponentDefinition{"worker": worker(workerTemplate)},
+		}},
+	}
+	latest := previous.DeepCopy()
+	latest.Name = "current-v2"
+	cli := fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+		worker(exposedWorkerTemplate),
+		current,
+		app("prod", "scaled", "worker", `{"replicas":3}`),
+		app("prod", "broken", "worker", `{"replicas":"many"}`),
+		app("prod", "other", "webservice", `{}`),
+		app("prod", "pinned", "worker@v1", `{}`),
+		previous, latest,
+	).Build()
+
+	obj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(worker(workerTemplate))
+	r.NoError(err)
+	newDef := &unstructured.Unstructured{Object: obj}
+	report, err := AnalyzeImpact(context.Background(), cli, newDef, ImpactOptions{Revisions: true})
+	r.NoError(err)
+
+	impacts := map[string]ApplicationImpact{}
+	for _, i := range report.Impacts {
+		impacts[i.Namespace+"/"+i.Name] = i
+	}
+	r.Len(impacts, 4)
+	r.Equal([]ResourceChange{{Component: "current", Kind: "Service", Name: "current", Change: dryrun.RemoveDiff}}, impacts["default/current"].Changes)
+	r.Equal("-Service/current", impacts["default/current"].Changes[0].String())
+	r.True(impacts["prod/scaled"].Affected())
+	r.NotEmpty(impacts["prod/broken"].Skipped)
+	r.False(impacts["prod/broken"].Affected())
+	// the previous revision already rendered without the Service
+	r.Equal(ImpactApplicationRevision, impacts["default/current-v1"].Kind)
+	r.False(impacts["default/current-v1"].Affected())
+	r.True(report.Risky())
+	r.Equal("2 of 3 apps affected, 2 would lose a Service; 0 of 1 previous revisions affected", report.Summary())
+
+	report, err = AnalyzeImpact(context.Background(), cli, newDef, ImpactOptions{Namespace: "default"})
+	r.NoError(err)
+	r.Len(report.Impacts, 1)
+
+	newDef.SetKind(v1beta1.PolicyDefinitionKind)
+	_, err = AnalyzeImpact(context.Background(), cli, newDef, ImpactOptions{})
+	r.EqualError(err, "the impact of PolicyDefinition cannot be analyzed, only ComponentDefinition and TraitDefinition are supported")
+}
@@ -0,0 +1,123 @@
+/*
+Copyright 2026 The KubeVela Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package lint
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/pkg/errors"
+	"sigs.k8s.io/yaml"
+)
+
+// ConfigFileName is the name of the lint config file searched from the linted path up to the root
+const ConfigFileName = ".vela-lint.yaml"
+
+// Config configures the rules of the linter, e.g.
+//
+//	rules:
+//	  output-labels: off
+//	  hardcoded-namespace: error
+//	deprecatedContextFields:
+//	  appRevisionNum: use context.appRevision instead
+type Config struct {
+	// Rules overrides the severities of the rules by ID, a rule is disabled by the severity off
+	Rules map[string]Severity `json:"rules,omitempty"`
+	// DeprecatedContextFields are the deprecated context fields with the suggestions, which are added to the
+	// default deprecated context fields. An empty suggestion removes the default one.
+	DeprecatedContextFields map[string]string `json:"deprecatedContextFields,omitempty"`
+}
+
+// defaultDeprecatedContextFields are the context fields that are no longer provided in rendering
+var defaultDeprecatedContextFields = map[string]string{
+	"outputSecretName": "it is no longer provided by the context",
+}
+
+// DefaultConfig returns the config with the default severities of the rules
+func DefaultConfig() *Config {
+	return &Config{}
+}
+
+// LoadConfig loads the config file
+func LoadConfig(path string) (*Config, error) {
+	bs, err := os.ReadFile(filepath.Clean(path))
+	if err != nil {
+		return nil, err
+	}
+	config := &Config{}
+	if err = yaml.UnmarshalStrict(bs, config); err != nil {
+		return nil, errors.Wrapf(err, "invalid lint config %s", path)
+	}
+	for id, severity := range config.Rules {
+		// the unquoted off is decoded as the boolean false in YAML
+		if severity == "false" {
+			severity, config.Rules[id] = SeverityOff, SeverityOff
+		}
+		if findRule(id) == nil {
+			return nil, errors.Errorf("invalid lint config %s: unknown rule %s", path, id)
+		}
+		switch severity {
+		case SeverityError, SeverityWarning, SeverityOff:
+		default:
+			return nil, errors.Errorf("invalid lint config %s: unknown severity %s of rule %s", path, severity, id)
+		}
+	}
+	return config, nil
+}
+
+// FindConfig finds the config file from the directory of the path up to the root. It returns the default config if
+// no config file is found.
+func FindConfig(path string) (*Config, error) {
+	abs, err := filepath.Abs(path)
+	if err != nil {
+		return nil, err
+	}
+	if fi, err := os.Stat(abs); err == nil && !fi.IsDir() {
+		abs = filepath.Dir(abs)
+	}
+	for dir := abs; ; dir = filepath.Dir(dir) {
+		file := filepath.Join(dir, ConfigFileName)
+		if _, err := os.Stat(file); err == nil {
+			return LoadConfig(file)
+		}
+		if filepath.Dir(dir) == dir {
+			return DefaultConfig(), nil
+		}
+	}
+}
+
+func (c *Config) severity(rule *Rule) Severity {
+	if severity, ok := c.Rules[rule.ID]; ok {
+		return severity
+	}
+	return rule.Severity
+}
+
+func (c *Config) deprecatedContextFields() map[string]string {
+	fields := map[string]string{}
+	for k, v := range defaultDeprecatedContextFields {
+		fields[k] = v
+	}
+	for k, v := range c.DeprecatedContextFields {
+		if v == "" {
+			delete(fields, k)
+			continue
+		}
+		fields[k] = v
+	}
+	return fields
+}
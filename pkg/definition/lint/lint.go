@@ -0,0 +1,168 @@
+/*
+Copyright 2026 The KubeVela Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package lint checks CUE definitions against the best-practice rules, such as documenting the parameters and
+// labeling the outputs. The rules work on the AST of the definition file and can be configured per repository.
+package lint
+
+import (
+	"sort"
+
+	"cuelang.org/go/cue/ast"
+	"cuelang.org/go/cue/parser"
+	"cuelang.org/go/cue/token"
+	"github.com/pkg/errors"
+
+	defast "github.com/oam-dev/kubevela/pkg/definition/ast"
+)
+
+// Severity is the severity of a finding
+type Severity string
+
+const (
+	// SeverityError fails the lint
+	SeverityError Severity = "error"
+	// SeverityWarning reports the problem without failing the lint
+	SeverityWarning Severity = "warning"
+	// SeverityOff disables the rule
+	SeverityOff Severity = "off"
+)
+
+// Finding is a problem found by a rule
+type Finding struct {
+	Rule       string   `json:"rule"`
+	Severity   Severity `json:"severity"`
+	Message    string   `json:"message"`
+	File       string   `json:"file"`
+	Definition string   `json:"definition,omitempty"`
+	Line       int      `json:"line,omitempty"`
+	Column     int      `json:"column,omitempty"`
+}
+
+// Definition is the parsed CUE definition to lint
+type Definition struct {
+	File string
+	Name string
+	// Type is the type of the definition, such as component and trait
+	Type       string
+	Header     *ast.StructLit
+	Template   *ast.StructLit
+	Parameter  *ast.StructLit
+	Attributes *ast.StructLit
+}
+
+// ParseDefinition parses the CUE definition file
+func ParseDefinition(file string, src []byte) (*Definition, error) {
+	f, err := parser.ParseFile(file, src, parser.ParseComments)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to parse %s", file)
+	}
+	def := &Definition{File: file}
+	for _, decl := range f.Decls {
+		field, ok := decl.(*ast.Field)
+		if !ok {
+			continue
+		}
+		label := defast.GetFieldLabel(field.Label)
+		st, _ := field.Value.(*ast.StructLit)
+		if label == "template" {
+			def.Template = st
+			continue
+		}
+		if def.Header == nil && st != nil {
+			def.Name, def.Header = label, st
+		}
+	}
+	if def.Header == nil {
+		return nil, errors.Errorf("no definition found in %s", file)
+	}
+	if typ, ok := lookupField(def.Header, "type"); ok {
+		if lit, ok := typ.Value.(*ast.BasicLit); ok && lit.Kind == token.STRING {
+			def.Type = unquote(lit.Value)
+		}
+	}
+	if attrs, ok := lookupField(def.Header, "attributes"); ok {
+		def.Attributes, _ = attrs.Value.(*ast.StructLit)
+	}
+	if def.Template != nil {
+		if param, ok := lookupField(def.Template, "parameter"); ok {
+			def.Parameter, _ = param.Value.(*ast.StructLit)
+		}
+	}
+	return def, nil
+}
+
+// Lint checks the definition with the rules enabled by the config
+func Lint(def *Definition, config *Config) []Finding {
+	if config == nil {
+		config = DefaultConfig()
+	}
+	var findings []Finding
+	for _, rule := range Rules {
+		severity := config.severity(rule)
+		if severity == SeverityOff {
+			continue
+		}
+		for _, p := range rule.Check(def, config) {
+			f := Finding{Rule: rule.ID, Severity: severity, Message: p.message, File: def.File, Definition: def.Name}
+			if p.node != nil {
+				pos := p.node.Pos()
+				f.Line, f.Column = pos.Line(), pos.Column()
+			}
+			findings = append(findings, f)
+		}
+	}
+	sort.SliceStable(findings, func(i, j int) bool {
+		if findings[i].Line != findings[j].Line {
+			return findings[i].Line < findings[j].Line
+		}
+		return findings[i].Column < findings[j].Column
+	})
+	return findings
+}
+
+// LintFile parses and checks the CUE definition file
+func LintFile(file string, src []byte, config *Config) ([]Finding, error) {
+	def, err := ParseDefinition(file, src)
+	if err != nil {
+		return nil, err
+	}
+	return Lint(def, config), nil
+}
+
+// LintGeneratedFile parses and checks the CUE definition generated from the source file, such as a Go definition.
+// The positions in the generated CUE don't exist in the source file, so the findings are reported at the given line
+// of the source file where the definition is declared.
+func LintGeneratedFile(file string, line int, src []byte, config *Config) ([]Finding, error) {
+	findings, err := LintFile(file, src, config)
+	if err != nil {
+		return nil, err
+	}
+	for i := range findings {
+		findings[i].Line, findings[i].Column = line, 0
+	}
+	return findings, nil
+}
+
+// HasErrors checks if any finding is an error
+func HasErrors(findings []Finding) bool {
+	for _, f := range findings {
+		if f.Severity == SeverityError {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,224 @@
+/*
+Copyright 2026 The KubeVela Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package lint
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const badComponent = `worker: {
+	type: "component"
+	description: "A worker"
+	attributes: workload: type: "deployments.apps"
+}
+template: {
+	output: {
+		apiVersion: "apps/v1"
+		kind:       "Deployment"
+		metadata: {
+			name:      parameter.name + "-worker"
+			namespace: "production"
+		}
+		spec: replicas: parameter.replicas
+	}
+	outputs: secret: {
+		apiVersion: "v1"
+		kind:       "Secret"
+		metadata: {
+			name: context.outputSecretName
+			labels: app: context.name
+		}
+	}
+	parameter: {
+		name: string
+		// +usage=The replicas of the worker
+		replicas: *1 | int
+		// The environments
+		env?: [...{
+			name:   string
+			// +usage=
+			value?: string
+		}]
+	}
+}
+`
+
+const goodComponent = `worker: {
+	type: "component"
+	description: "A worker"
+	attributes: {
+		workload: type: "deployments.apps"
+		status: healthPolicy: "isHealth: context.output.status.readyReplicas == context.output.spec.replicas"
+	}
+}
+template: {
+	output: {
+		apiVersion: "apps/v1"
+		kind:       "Deployment"
+		metadata: {
+			name:      parameter.name
+			namespace: context.namespace
+			labels: "app.oam.dev/component": context.name
+		}
+	}
+	parameter: {
+		// +usage=The name of the worker
+		name: =~"^[a-z0-9-]{1,63}$" & string
+	}
+}
+`
+
+func TestLint(t *testing.T) {
+	r := require.New(t)
+	findings, err := LintFile("worker.cue", []byte(badComponent), nil)
+	r.NoError(err)
+	type brief struct {
+		rule    string
+		line    int
+		message string
+	}
+	var got []brief
+	for _, f := range findings {
+		r.Equal(SeverityWarning, f.Severity)
+		r.Equal("worker.cue", f.File)
+		r.Equal("worker", f.Definition)
+		got = append(got, brief{f.Rule, f.Line, f.Message})
+	}
+	r.Equal([]brief{
+		{"missing-health-policy", 1, "component worker declares no attributes.status.healthPolicy, it is always considered healthy"},
+		{"output-labels", 7, "output does not set metadata.labels"},
+		{"unbounded-resource-name", 11, `output uses parameter.name in metadata.name, which accepts any string; bound it with a pattern like =~"^[a-z0-9-]{1,63}$"`},
+		{"hardcoded-namespace", 12, `output hard-codes metadata.namespace "production", use context.namespace or a parameter instead`},
+		{"deprecated-context-field", 20, "context.outputSecretName is deprecated: it is no longer provided by the context"},
+		{"parameter-description", 25, "parameter name has no description or +usage tag"},
+		{"parameter-description", 30, "parameter env.name has no description or +usage tag"},
+		{"parameter-description", 32, "parameter env.value has no description or +usage tag"},
+	}, got)
+	r.False(HasErrors(findings))
+
+	findings, err = LintFile("worker.cue", []byte(goodComponent), nil)
+	r.NoError(err)
+	r.Empty(findings)
+
+	_, err = LintFile("worker.cue", []byte("worker: {"), nil)
+	r.Error(err)
+	_, err = LintFile("worker.cue", []byte(`template: {}`), nil)
+	r.ErrorContains(err, "no definition found")
+}
+
+func TestLintConfig(t *testing.T) {
+	r := require.New(t)
+	dir := t.TempDir()
+	sub := filepath.Join(dir, "components")
+	r.NoError(os.MkdirAll(sub, 0750))
+	r.NoError(os.WriteFile(filepath.Join(dir, ConfigFileName), []byte(`
+rules:
+  parameter-description: off
+  hardcoded-namespace: error
+  missing-health-policy: "off"
+deprecatedContextFields:
+  outputSecretName: ""
+  name: use context.appName instead
+`), 0600))
+	defFile := filepath.Join(sub, "worker.cue")
+	r.NoError(os.WriteFile(defFile, []byte(badComponent), 0600))
+
+	config, err := FindConfig(defFile)
+	r.NoError(err)
+	findings, err := LintFile(defFile, []byte(badComponent), config)
+	r.NoError(err)
+	rules := map[string]Severity{}
+	for _, f := range findings {
+		rules[f.Rule] = f.Severity
+	}
+	r.Equal(map[string]Severity{
+		"output-labels":            SeverityWarning,
+		"unbounded-resource-name":  SeverityWarning,
+		"hardcoded-namespace":      SeverityError,
+		"deprecated-context-field": SeverityWarning,
+	}, rules)
+	r.True(HasErrors(findings))
+	for _, f := range findings {
+		if f.Rule == "deprecated-context-field" {
+			r.Equal("context.name is deprecated: use context.appName instead", f.Message)
+		}
+	}
+
+	config, err = FindConfig(t.TempDir())
+	r.NoError(err)
+	r.Equal(DefaultConfig(), config)
+
+	invalid := filepath.Join(dir, "invalid.yaml")
+	r.NoError(os.WriteFile(invalid, []byte("rules:\n  unknown-rule: error\n"), 0600))
+	_, err = LoadConfig(invalid)
+	r.ErrorContains(err, "unknown rule unknown-rule")
+	r.NoError(os.WriteFile(invalid, []byte("rules:\n  output-labels: fatal\n"), 0600))
+	_, err = LoadConfig(invalid)
+	r.ErrorContains(err, "unknown severity fatal")
+	r.NoError(os.WriteFile(invalid, []byte("severities: {}\n"), 0600))
+	_, err = LoadConfig(invalid)
+	r.Error(err)
+}
+
+func TestWriteSARIF(t *testing.T) {
+	r := require.New(t)
+	findings, err := LintFile("defs/worker.cue", []byte(badComponent), nil)
+	r.NoError(err)
+	buf := &bytes.Buffer{}
+	r.NoError(WriteSARIF(buf, findings))
+
+	log := sarifLog{}
+	r.NoError(json.Unmarshal(buf.Bytes(), &log))
+	r.Equal("2.1.0", log.Version)
+	r.Len(log.Runs, 1)
+	r.Equal(toolName, log.Runs[0].Tool.Driver.Name)
+	r.Len(log.Runs[0].Tool.Driver.Rules, len(Rules))
+	r.Len(log.Runs[0].Results, len(findings))
+	result := log.Runs[0].Results[1]
+	r.Equal("output-labels", result.RuleID)
+	r.Equal("output-labels", log.Runs[0].Tool.Driver.Rules[result.RuleIndex].ID)
+	r.Equal("warning", result.Level)
+	r.Equal("defs/worker.cue", result.Locations[0].PhysicalLocation.ArtifactLocation.URI)
+	r.Equal(&sarifRegion{StartLine: 7, StartColumn: 2}, result.Locations[0].PhysicalLocation.Region)
+
+	buf.Reset()
+	r.NoError(WriteSARIF(buf, nil))
+	r.Contains(buf.String(), `"results": []`)
+}
+
+func TestLintGeneratedFile(t *testing.T) {
+	r := require.New(t)
+	findings, err := LintGeneratedFile("defs/worker.go", 21, []byte(badComponent), nil)
+	r.NoError(err)
+	r.NotEmpty(findings)
+	for _, f := range findings {
+		r.Equal("defs/worker.go", f.File)
+		r.Equal(21, f.Line)
+		r.Zero(f.Column)
+	}
+	buf := &bytes.Buffer{}
+	r.NoError(WriteSARIF(buf, findings))
+	log := sarifLog{}
+	r.NoError(json.Unmarshal(buf.Bytes(), &log))
+	r.Equal(&sarifRegion{StartLine: 21}, log.Runs[0].Results[0].Locations[0].PhysicalLocation.Region)
+}
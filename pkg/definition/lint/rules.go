@@ -0,0 +1,389 @@
+/*
+Copyright 2026 The KubeVela Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package lint
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"cuelang.org/go/cue/ast"
+	"cuelang.org/go/cue/format"
+	"cuelang.org/go/cue/token"
+
+	defast "github.com/oam-dev/kubevela/pkg/definition/ast"
+)
+
+// Rule is a best-practice rule of definitions
+type Rule struct {
+	ID          string
+	Description string
+	// Severity is the default severity of the rule
+	Severity Severity
+	Check    func(def *Definition, config *Config) []problem
+}
+
+type problem struct {
+	node    ast.Node
+	message string
+}
+
+// Rules are the rules of the linter
+var Rules = []*Rule{{
+	ID:          "parameter-description",
+	Description: "Parameters should be documented by comments or +usage tags.",
+	Severity:    SeverityWarning,
+	Check:       checkParameterDescription,
+}, {
+	ID:          "output-labels",
+	Description: "Output resources should set metadata.labels.",
+	Severity:    SeverityWarning,
+	Check:       checkOutputLabels,
+}, {
+	ID:          "hardcoded-namespace",
+	Description: "Output resources should not hard-code metadata.namespace.",
+	Severity:    SeverityWarning,
+	Check:       checkHardcodedNamespace,
+}, {
+	ID:          "missing-health-policy",
+	Description: "Component definitions should declare a health policy.",
+	Severity:    SeverityWarning,
+	Check:       checkHealthPolicy,
+}, {
+	ID:          "unbounded-resource-name",
+	Description: "Parameters used in resource names should be bounded by patterns or lengths.",
+	Severity:    SeverityWarning,
+	Check:       checkUnboundedResourceName,
+}, {
+	ID:          "deprecated-context-field",
+	Description: "Deprecated context fields should not be used.",
+	Severity:    SeverityWarning,
+	Check:       checkDeprecatedContextField,
+}}
+
+func findRule(id string) *Rule {
+	for _, rule := range Rules {
+		if rule.ID == id {
+			return rule
+		}
+	}
+	return nil
+}
+
+func lookupField(st *ast.StructLit, label string) (*ast.Field, bool) {
+	if st == nil {
+		return nil, false
+	}
+	for _, elt := range st.Elts {
+		if field, ok := elt.(*ast.Field); ok && defast.GetFieldLabel(field.Label) == label {
+			return field, true
+		}
+	}
+	return nil, false
+}
+
+func lookupPath(st *ast.StructLit, path ...string) (*ast.Field, bool) {
+	var field *ast.Field
+	for i, label := range path {
+		f, ok := lookupField(st, label)
+		if !ok {
+			return nil, false
+		}
+		field = f
+		if i < len(path)-1 {
+			if st, ok = f.Value.(*ast.StructLit); !ok {
+				return nil, false
+			}
+		}
+	}
+	return field, field != nil
+}
+
+func unquote(s string) string {
+	if u, err := strconv.Unquote(s); err == nil {
+		return u
+	}
+	return strings.Trim(s, `"`)
+}
+
+// outputs returns the output resources of the template with their names
+func outputs(def *Definition) ([]string, []*ast.Field) {
+	var names []string
+	var fields []*ast.Field
+	if def.Template == nil {
+		return nil, nil
+	}
+	if output, ok := lookupField(def.Template, "output"); ok {
+		names, fields = append(names, "output"), append(fields, output)
+	}
+	if outs, ok := lookupField(def.Template, "outputs"); ok {
+		if st, ok := outs.Value.(*ast.StructLit); ok {
+			for _, elt := range st.Elts {
+				if field, ok := elt.(*ast.Field); ok {
+					names, fields = append(names, "outputs."+defast.GetFieldLabel(field.Label)), append(fields, field)
+				}
+			}
+		}
+	}
+	return names, fields
+}
+
+func hasDescription(field *ast.Field) bool {
+	for _, group := range ast.Comments(field) {
+		if group.Position > 0 && !group.Doc {
+			continue
+		}
+		for _, c := range group.List {
+			text := strings.TrimSpace(strings.TrimPrefix(c.Text, "//"))
+			if strings.HasPrefix(text, "+usage=") && strings.TrimPrefix(text, "+usage=") != "" {
+				return true
+			}
+			if text != "" && !strings.HasPrefix(text, "+") {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func checkParameterDescription(def *Definition, _ *Config) []problem {
+	var problems []problem
+	var walk func(path string, st *ast.StructLit)
+	walk = func(path string, st *ast.StructLit) {
+		for _, elt := range st.Elts {
+			field, ok := elt.(*ast.Field)
+			if !ok {
+				continue
+			}
+			label := defast.GetFieldLabel(field.Label)
+			if label == "" || strings.HasPrefix(label, "#") || strings.HasPrefix(label, "_") {
+				continue
+			}
+			name := strings.TrimPrefix(path+"."+label, ".")
+			if !hasDescription(field) {
+				problems = append(problems, problem{node: field, message: fmt.Sprintf("parameter %s has no description or +usage tag", name)})
+			}
+			for _, st := range structsOf(field.Value) {
+				walk(name, st)
+			}
+		}
+	}
+	if def.Parameter != nil {
+		walk("", def.Parameter)
+	}
+	return problems
+}
+
+// structsOf returns the struct literals of the parameter type, including the ones in disjunctions and list elements
+func structsOf(expr ast.Expr) []*ast.StructLit {
+	switch e := expr.(type) {
+	case *ast.StructLit:
+		return []*ast.StructLit{e}
+	case *ast.ListLit:
+		var sts []*ast.StructLit
+		for _, elt := range e.Elts {
+			if ellipsis, ok := elt.(*ast.Ellipsis); ok {
+				sts = append(sts, structsOf(ellipsis.Type)...)
+			}
+		}
+		return sts
+	case *ast.BinaryExpr:
+		return append(structsOf(e.X), structsOf(e.Y)...)
+	case *ast.UnaryExpr:
+		return structsOf(e.X)
+	case *ast.ParenExpr:
+		return structsOf(e.X)
+	default:
+		return nil
+	}
+}
+
+func checkOutputLabels(def *Definition, _ *Config) []problem {
+	var problems []problem
+	names, fields := outputs(def)
+	for i, field := range fields {
+		st, ok := field.Value.(*ast.StructLit)
+		if !ok {
+			continue
+		}
+		if _, ok := lookupPath(st, "metadata", "labels"); !ok {
+			problems = append(problems, problem{node: field, message: fmt.Sprintf("%s does not set metadata.labels", names[i])})
+		}
+	}
+	return problems
+}
+
+func checkHardcodedNamespace(def *Definition, _ *Config) []problem {
+	var problems []problem
+	names, fields := outputs(def)
+	for i, field := range fields {
+		st, ok := field.Value.(*ast.StructLit)
+		if !ok {
+			continue
+		}
+		ns, ok := lookupPath(st, "metadata", "namespace")
+		if !ok {
+			continue
+		}
+		if lit, ok := ns.Value.(*ast.BasicLit); ok && lit.Kind == token.STRING {
+			problems = append(problems, problem{node: ns, message: fmt.Sprintf("%s hard-codes metadata.namespace %s, use context.namespace or a parameter instead", names[i], lit.Value)})
+		}
+	}
+	return problems
+}
+
+func checkHealthPolicy(def *Definition, _ *Config) []problem {
+	if def.Type != "component" {
+		return nil
+	}
+	if _, ok := lookupPath(def.Attributes, "status", "healthPolicy"); ok {
+		return nil
+	}
+	return []problem{{node: def.Header, message: fmt.Sprintf("component %s declares no attributes.status.healthPolicy, it is always considered healthy", def.Name)}}
+}
+
+// parameterRefs returns the top-level parameters referred in the expression, such as name of parameter.name
+func parameterRefs(expr ast.Expr) []*ast.SelectorExpr {
+	var refs []*ast.SelectorExpr
+	ast.Walk(expr, func(node ast.Node) bool {
+		sel, ok := node.(*ast.SelectorExpr)
+		if !ok {
+			return true
+		}
+		if ident, ok := sel.X.(*ast.Ident); ok && ident.Name == "parameter" {
+			refs = append(refs, sel)
+			return false
+		}
+		return true
+	}, nil)
+	return refs
+}
+
+// isUnboundedString checks if the parameter accepts any string, i.e. it is a string without patterns or lengths
+func isUnboundedString(expr ast.Expr) bool {
+	bs, err := format.Node(expr)
+	if err != nil {
+		return false
+	}
+	src := string(bs)
+	if !strings.Contains(src, "string") {
+		return false
+	}
+	for _, bound := range []string{"=~", "!~", "strings.MaxRunes", "strings.MinRunes", "&", "#"} {
+		if strings.Contains(src, bound) {
+			return false
+		}
+	}
+	return true
+}
+
+func checkUnboundedResourceName(def *Definition, _ *Config) []problem {
+	var problems []problem
+	reported := map[string]bool{}
+	names, fields := outputs(def)
+	for i, field := range fields {
+		st, ok := field.Value.(*ast.StructLit)
+		if !ok {
+			continue
+		}
+		name, ok := lookupPath(st, "metadata", "name")
+		if !ok {
+			continue
+		}
+		for _, ref := range parameterRefs(name.Value) {
+			label := defast.GetFieldLabel(ref.Sel)
+			param, ok := lookupField(def.Parameter, label)
+			if !ok || reported[names[i]+"/"+label] || !isUnboundedString(param.Value) {
+				continue
+			}
+			reported[names[i]+"/"+label] = true
+			problems = append(problems, problem{node: ref, message: fmt.Sprintf(
+				"%s uses parameter.%s in metadata.name, which accepts any string; bound it with a pattern like =~\"^[a-z0-9-]{1,63}$\"", names[i], label)})
+		}
+	}
+	return problems
+}
+
+func checkDeprecatedContextField(def *Definition, config *Config) []problem {
+	deprecated := config.deprecatedContextFields()
+	if len(deprecated) == 0 {
+		return nil
+	}
+	var problems []problem
+	check := func(node ast.Node) {
+		ast.Walk(node, func(node ast.Node) bool {
+			sel, ok := node.(*ast.SelectorExpr)
+			if !ok {
+				return true
+			}
+			if ident, ok := sel.X.(*ast.Ident); ok && ident.Name == "context" {
+				field := defast.GetFieldLabel(sel.Sel)
+				if suggestion, found := deprecated[field]; found {
+					problems = append(problems, problem{node: sel, message: fmt.Sprintf("context.%s is deprecated: %s", field, suggestion)})
+				}
+				return false
+			}
+			return true
+		}, nil)
+	}
+	if def.Template != nil {
+		check(def.Template)
+	}
+	// the status of the definition is written in CUE strings
+	for _, path := range [][]string{{"status", "healthPolicy"}, {"status", "customStatus"}, {"status", "details"}} {
+		if field, ok := lookupPath(def.Attributes, path...); ok {
+			problems = append(problems, checkDeprecatedContextInString(field, deprecated)...)
+		}
+	}
+	sort.SliceStable(problems, func(i, j int) bool { return problems[i].node.Pos().Before(problems[j].node.Pos()) })
+	return problems
+}
+
+func checkDeprecatedContextInString(field *ast.Field, deprecated map[string]string) []problem {
+	lit, ok := field.Value.(*ast.BasicLit)
+	if !ok {
+		return nil
+	}
+	src := lit.Value
+	var problems []problem
+	keys := make([]string, 0, len(deprecated))
+	for k := range deprecated {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		ref := "context." + k
+		for idx := strings.Index(src, ref); idx >= 0; {
+			end := idx + len(ref)
+			if end >= len(src) || !isIdentChar(src[end]) {
+				problems = append(problems, problem{node: lit, message: fmt.Sprintf("context.%s is deprecated: %s", k, deprecated[k])})
+				break
+			}
+			next := strings.Index(src[end:], ref)
+			if next < 0 {
+				break
+			}
+			idx = end + next
+		}
+	}
+	return problems
+}
+
+func isIdentChar(c byte) bool {
+	return c == '_' || c == '$' || c >= '0' && c <= '9' || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z'
+}
@@ -0,0 +1,206 @@
+/*
+Copyright 2026 The KubeVela Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package definition
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"cuelang.org/go/cue"
+	"cuelang.org/go/cue/cuecontext"
+	"github.com/Masterminds/semver/v3"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/oam-dev/kubevela/apis/core.oam.dev/v1beta1"
+	"github.com/oam-dev/kubevela/pkg/oam"
+	"github.com/oam-dev/kubevela/pkg/oam/util"
+)
+
+// Migration migrates the properties of the previous major version of a definition to the version declaring it. It is
+// declared in CUE by the definition.oam.dev/migration annotation, which fills output with the new properties from the
+// old properties in parameter, e.g.
+//
+//	from: "1.x"
+//	output: {
+//		image: parameter.image
+//		ports: [{port: parameter.port}]
+//	}
+//
+// The optional from is the version range to migrate from, defaults to the previous major version. The context
+// provides name, fromVersion and toVersion.
+type Migration struct {
+	// From is the version range of the definition to migrate from
+	From     string
+	template string
+}
+
+// MigrationContext is the context of the migration
+type MigrationContext struct {
+	// Name is the name of the component
+	Name        string `json:"name"`
+	FromVersion string `json:"fromVersion"`
+	ToVersion   string `json:"toVersion"`
+}
+
+// ParseMigration parses the migration CUE
+func ParseMigration(template string) (*Migration, error) {
+	val := cuecontext.New().CompileString(template + "\nparameter: _\ncontext: _\n")
+	if err := val.Err(); err != nil {
+		return nil, errors.Wrap(err, "invalid migration")
+	}
+	m := &Migration{template: template}
+	if from := val.LookupPath(cue.ParsePath("from")); from.Exists() {
+		s, err := from.String()
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid migration from")
+		}
+		if _, err = semver.NewConstraint(s); err != nil {
+			return nil, errors.Wrapf(err, "invalid migration from %s", s)
+		}
+		m.From = s
+	}
+	if !val.LookupPath(cue.ParsePath("output")).Exists() {
+		return nil, errors.New("migration has no output")
+	}
+	return m, nil
+}
+
+// Accepts checks if the migration could migrate from the version, which defaults to the previous major version of
+// the target one if the migration does not declare the range
+func (m *Migration) Accepts(from, to *semver.Version) bool {
+	if m.From == "" {
+		return from.Major()+1 == to.Major()
+	}
+	constraint, err := semver.NewConstraint(m.From)
+	return err == nil && constraint.Check(from)
+}
+
+// Migrate migrates the properties
+func (m *Migration) Migrate(properties map[string]interface{}, mctx MigrationContext) (map[string]interface{}, error) {
+	if properties == nil {
+		properties = map[string]interface{}{}
+	}
+	params, err := json.Marshal(properties)
+	if err != nil {
+		return nil, err
+	}
+	c, err := json.Marshal(mctx)
+	if err != nil {
+		return nil, err
+	}
+	val := cuecontext.New().CompileString(fmt.Sprintf("%s\nparameter: %s\ncontext: %s\n", m.template, params, c))
+	if err = val.Err(); err != nil {
+		return nil, errors.Wrap(err, "failed to migrate")
+	}
+	output := val.LookupPath(cue.ParsePath("output"))
+	if err = output.Validate(cue.Concrete(true)); err != nil {
+		return nil, errors.Wrap(err, "failed to migrate")
+	}
+	result := map[string]interface{}{}
+	if err = output.Decode(&result); err != nil {
+		return nil, errors.Wrap(err, "failed to migrate")
+	}
+	return result, nil
+}
+
+// ApplicationUpgrade is the upgrade of a component or trait of an application to another major version of the definition
+type ApplicationUpgrade struct {
+	Component   string
+	Trait       string
+	FromType    string
+	ToType      string
+	FromVersion string
+	ToVersion   string
+}
+
+// UpgradeApplication rewrites the components and traits of the application pinned to the previous major version of
+// the definition to the target major version, and migrates their properties by the migration declared in the target
+// version. Components and traits using the latest definition or other major versions are left as is.
+func UpgradeApplication(ctx context.Context, cli client.Reader, app *v1beta1.Application, definitionName string, targetMajor uint64) ([]ApplicationUpgrade, error) {
+	ctx = util.SetNamespaceInCtx(ctx, app.Namespace)
+	targetType := fmt.Sprintf("%s@v%d", definitionName, targetMajor)
+	var upgrades []ApplicationUpgrade
+
+	upgrade := func(typ string, props *runtime.RawExtension, newDef func() client.Object, u ApplicationUpgrade) (*runtime.RawExtension, bool, error) {
+		name, version := util.SplitDefinitionType(typ)
+		if name != definitionName || version == "" {
+			return nil, false, nil
+		}
+		current := newDef()
+		if err := util.GetCapabilityDefinition(ctx, cli, current, typ, app.Annotations); err != nil {
+			return nil, false, errors.Wrapf(err, "failed to get definition %s", typ)
+		}
+		from, err := semver.NewVersion(util.GetDefinitionVersion(current))
+		if err != nil || from.Major()+1 != targetMajor {
+			return nil, false, nil
+		}
+		target := newDef()
+		if err = util.GetCapabilityDefinition(ctx, cli, target, targetType, nil); err != nil {
+			return nil, false, errors.Wrapf(err, "failed to get definition %s", targetType)
+		}
+		to, err := semver.NewVersion(util.GetDefinitionVersion(target))
+		if err != nil {
+			return nil, false, errors.Wrapf(err, "definition %s has no semantic version", targetType)
+		}
+		template, ok := target.GetAnnotations()[oam.AnnotationDefinitionMigration]
+		if !ok {
+			return nil, false, errors.Errorf("definition %s version %s declares no migration", definitionName, to)
+		}
+		migration, err := ParseMigration(template)
+		if err != nil {
+			return nil, false, errors.Wrapf(err, "definition %s version %s", definitionName, to)
+		}
+		if !migration.Accepts(from, to) {
+			return nil, false, errors.Errorf("definition %s version %s cannot migrate from version %s", definitionName, to, from)
+		}
+		properties, err := util.RawExtension2Map(props)
+		if err != nil {
+			return nil, false, err
+		}
+		migrated, err := migration.Migrate(properties, MigrationContext{Name: u.Component, FromVersion: from.String(), ToVersion: to.String()})
+		if err != nil {
+			return nil, false, errors.Wrapf(err, "failed to migrate %s", strings.TrimSuffix(u.Component+"/"+u.Trait, "/"))
+		}
+		u.FromType, u.ToType, u.FromVersion, u.ToVersion = typ, targetType, from.String(), to.String()
+		upgrades = append(upgrades, u)
+		return util.Object2RawExtension(migrated), true, nil
+	}
+
+	for i, comp := range app.Spec.Components {
+		props, ok, err := upgrade(comp.Type, comp.Properties, func() client.Object { return &v1beta1.ComponentDefinition{} }, ApplicationUpgrade{Component: comp.Name})
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			app.Spec.Components[i].Type, app.Spec.Components[i].Properties = targetType, props
+		}
+		for j, trait := range comp.Traits {
+			props, ok, err := upgrade(trait.Type, trait.Properties, func() client.Object { return &v1beta1.TraitDefinition{} }, ApplicationUpgrade{Component: comp.Name, Trait: trait.Type})
+			if err != nil {
+				return nil, err
+			}
+			if ok {
+				app.Spec.Components[i].Traits[j].Type, app.Spec.Components[i].Traits[j].Properties = targetType, props
+			}
+		}
+	}
+	return upgrades, nil
+}
@@ -0,0 +1,138 @@
+/*
+Copyright 2026 The KubeVela Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package definition
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Masterminds/semver/v3"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/oam-dev/kubevela/apis/core.oam.dev/common"
+	"github.com/oam-dev/kubevela/apis/core.oam.dev/v1beta1"
+	"github.com/oam-dev/kubevela/pkg/oam"
+	"github.com/oam-dev/kubevela/pkg/oam/util"
+)
+
+const webserviceMigration = `
+output: {
+	image: parameter.image
+	if parameter.port != _|_ {
+		ports: [{port: parameter.port, expose: true}]
+	}
+	labels: "migrated-from": context.fromVersion
+}
+`
+
+func TestMigration(t *testing.T) {
+	r := require.New(t)
+	m, err := ParseMigration(webserviceMigration)
+	r.NoError(err)
+	r.Equal("", m.From)
+	r.True(m.Accepts(semver.MustParse("1.4.0"), semver.MustParse("2.0.0")))
+	r.False(m.Accepts(semver.MustParse("0.9.0"), semver.MustParse("2.0.0")))
+
+	out, err := m.Migrate(map[string]interface{}{"image": "nginx", "port": 80}, MigrationContext{Name: "web", FromVersion: "1.4.0", ToVersion: "2.0.0"})
+	r.NoError(err)
+	r.Equal(map[string]interface{}{
+		"image":  "nginx",
+		"ports":  []interface{}{map[string]interface{}{"port": int64(80), "expose": true}},
+		"labels": map[string]interface{}{"migrated-from": "1.4.0"},
+	}, out)
+
+	_, err = m.Migrate(nil, MigrationContext{})
+	r.Error(err)
+
+	m, err = ParseMigration(`from: ">=0.5.0 <2.0.0"` + "\n" + webserviceMigration)
+	r.NoError(err)
+	r.True(m.Accepts(semver.MustParse("0.9.0"), semver.MustParse("2.0.0")))
+
+	_, err = ParseMigration(`from: "not a range"` + "\n" + webserviceMigration)
+	r.Error(err)
+	_, err = ParseMigration(`value: parameter.image`)
+	r.Error(err)
+	_, err = ParseMigration(`output: {`)
+	r.Error(err)
+}
+
+func TestUpgradeApplication(t *testing.T) {
+	r := require.New(t)
+	scheme := runtime.NewScheme()
+	r.NoError(v1beta1.AddToScheme(scheme))
+	revision := func(version string, annotations map[string]string) *v1beta1.DefinitionRevision {
+		return &v1beta1.DefinitionRevision{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "webservice-v" + version,
+				Namespace: oam.SystemDefinitionNamespace,
+				Labels:    map[string]string{oam.LabelComponentDefinitionName: "webservice"},
+			},
+			Spec: v1beta1.DefinitionRevisionSpec{
+				DefinitionType: common.ComponentType,
+				ComponentDefinition: v1beta1.ComponentDefinition{
+					ObjectMeta: metav1.ObjectMeta{Name: "webservice", Annotations: annotations},
+					Spec:       v1beta1.ComponentDefinitionSpec{Version: version},
+				},
+			},
+		}
+	}
+	cli := fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+		revision("1.4.0", nil),
+		revision("2.0.0", map[string]string{oam.AnnotationDefinitionMigration: webserviceMigration}),
+		revision("3.0.0", nil),
+	).Build()
+
+	app := &v1beta1.Application{
+		ObjectMeta: metav1.ObjectMeta{Name: "app", Namespace: "default"},
+		Spec: v1beta1.ApplicationSpec{Components: []common.ApplicationComponent{{
+			Name:       "old",
+			Type:       "webservice@v1",
+			Properties: util.Object2RawExtension(map[string]interface{}{"image": "nginx", "port": 80}),
+		}, {
+			Name:       "latest",
+			Type:       "webservice",
+			Properties: util.Object2RawExtension(map[string]interface{}{"image": "nginx"}),
+		}, {
+			Name:       "new",
+			Type:       "webservice@v2",
+			Properties: util.Object2RawExtension(map[string]interface{}{"image": "nginx"}),
+		}}},
+	}
+	upgrades, err := UpgradeApplication(context.Background(), cli, app, "webservice", 2)
+	r.NoError(err)
+	r.Equal([]ApplicationUpgrade{{
+		Component:   "old",
+		FromType:    "webservice@v1",
+		ToType:      "webservice@v2",
+		FromVersion: "1.4.0",
+		ToVersion:   "2.0.0",
+	}}, upgrades)
+	r.Equal("webservice@v2", app.Spec.Components[0].Type)
+	props, err := util.RawExtension2Map(app.Spec.Components[0].Properties)
+	r.NoError(err)
+	r.Equal("nginx", props["image"])
+	r.Equal(map[string]interface{}{"migrated-from": "1.4.0"}, props["labels"])
+	r.Equal("webservice", app.Spec.Components[1].Type)
+	r.Equal("webservice@v2", app.Spec.Components[2].Type)
+
+	// the target version declares no migration
+	_, err = UpgradeApplication(context.Background(), cli, app, "webservice", 3)
+	r.ErrorContains(err, "declares no migration")
+}
@@ -0,0 +1,176 @@
+/*
+Copyright 2026 The KubeVela Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package eventtimeline
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/klog/v2"
+	"k8s.io/utils/strings/slices"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/oam-dev/kubevela/apis/core.oam.dev/v1beta1"
+	"github.com/oam-dev/kubevela/pkg/multicluster"
+	"github.com/oam-dev/kubevela/pkg/resourcetracker"
+)
+
+// Event is an event in the timeline of an application
+type Event struct {
+	Time      metav1.Time `json:"time"`
+	Cluster   string      `json:"cluster"`
+	Component string      `json:"component,omitempty"`
+	Kind      string      `json:"kind"`
+	Namespace string      `json:"namespace,omitempty"`
+	Name      string      `json:"name"`
+	Type      string      `json:"type"`
+	Reason    string      `json:"reason"`
+	Message   string      `json:"message"`
+	Count     int32       `json:"count,omitempty"`
+	// UID is the uid of the event, which identifies the event along with the count
+	UID types.UID `json:"-"`
+}
+
+// Filter filters the events in the timeline
+type Filter struct {
+	// Cluster only keeps the events in the cluster if set
+	Cluster string `json:"cluster,omitempty"`
+	// Components only keeps the events of the resources dispatched by the components if set
+	Components []string `json:"components,omitempty"`
+	// Type only keeps the events of the type, Normal or Warning, if set
+	Type string `json:"type,omitempty"`
+	// Since only keeps the events happening at or after the time if set
+	Since *metav1.Time `json:"since,omitempty"`
+}
+
+// EventFieldSelector selects the events involving the object, the uid is matched only if it is known
+func EventFieldSelector(ref corev1.ObjectReference) fields.Selector {
+	field := fields.Set{}
+	field["involvedObject.name"] = ref.Name
+	field["involvedObject.namespace"] = ref.Namespace
+	field["involvedObject.kind"] = ref.Kind
+	if ref.UID != "" {
+		field["involvedObject.uid"] = string(ref.UID)
+	}
+	return field.AsSelector()
+}
+
+// Collect correlates the events of the application and the resources managed by its current ResourceTrackers across
+// clusters into a timeline sorted by time. The clusters failing to list the events are skipped.
+func Collect(ctx context.Context, cli client.Client, app *v1beta1.Application, filter Filter) ([]Event, error) {
+	var timeline []Event
+	if filter.Cluster == "" || filter.Cluster == multicluster.ClusterLocalName {
+		ref := corev1.ObjectReference{Kind: v1beta1.ApplicationKind, Namespace: app.Namespace, Name: app.Name, UID: app.UID}
+		events, err := listEvents(ctx, cli, multicluster.ClusterLocalName, ref)
+		if err != nil {
+			return nil, err
+		}
+		timeline = appendEvents(timeline, events, multicluster.ClusterLocalName, "", filter)
+	}
+	rootRT, currentRT, _, _, err := resourcetracker.ListApplicationResourceTrackers(ctx, cli, app)
+	if err != nil {
+		return nil, err
+	}
+	for _, rt := range []*v1beta1.ResourceTracker{rootRT, currentRT} {
+		if rt == nil {
+			continue
+		}
+		for _, mr := range rt.Spec.ManagedResources {
+			cluster := mr.Cluster
+			if cluster == "" {
+				cluster = multicluster.ClusterLocalName
+			}
+			if mr.Deleted || (filter.Cluster != "" && filter.Cluster != cluster) ||
+				(len(filter.Components) > 0 && !slices.Contains(filter.Components, mr.Component)) {
+				continue
+			}
+			events, err := listEvents(ctx, cli, cluster, mr.ObjectReference)
+			if err != nil {
+				klog.ErrorS(err, "failed to list the events of the managed resource", "resource", mr.DisplayName())
+				continue
+			}
+			timeline = appendEvents(timeline, events, cluster, mr.Component, filter)
+		}
+	}
+	sort.SliceStable(timeline, func(i, j int) bool {
+		return timeline[i].Time.Before(&timeline[j].Time)
+	})
+	return timeline, nil
+}
+
+// listEvents lists the events of the object in the cluster. The events are listed as unstructured to read from the
+// apiserver directly, instead of caching all the events in the controller.
+func listEvents(ctx context.Context, cli client.Client, cluster string, ref corev1.ObjectReference) ([]corev1.Event, error) {
+	list := &unstructured.UnstructuredList{}
+	list.SetAPIVersion("v1")
+	list.SetKind("EventList")
+	if err := cli.List(multicluster.ContextWithClusterName(ctx, cluster), list, client.InNamespace(ref.Namespace),
+		client.MatchingFieldsSelector{Selector: EventFieldSelector(ref)}); err != nil {
+		return nil, fmt.Errorf("failed to list the events of %s %s in cluster %s: %w", ref.Kind, ref.Name, cluster, err)
+	}
+	events := make([]corev1.Event, len(list.Items))
+	for i := range list.Items {
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(list.Items[i].Object, &events[i]); err != nil {
+			return nil, err
+		}
+	}
+	return events, nil
+}
+
+func appendEvents(timeline []Event, events []corev1.Event, cluster, component string, filter Filter) []Event {
+	for _, e := range events {
+		event := Event{
+			Time:      eventTime(e),
+			Cluster:   cluster,
+			Component: component,
+			Kind:      e.InvolvedObject.Kind,
+			Namespace: e.InvolvedObject.Namespace,
+			Name:      e.InvolvedObject.Name,
+			Type:      e.Type,
+			Reason:    e.Reason,
+			Message:   e.Message,
+			Count:     e.Count,
+			UID:       e.UID,
+		}
+		if (filter.Type != "" && filter.Type != event.Type) || (filter.Since != nil && event.Time.Before(filter.Since)) {
+			continue
+		}
+		timeline = append(timeline, event)
+	}
+	return timeline
+}
+
+// eventTime returns the last time the event happened
+func eventTime(e corev1.Event) metav1.Time {
+	switch {
+	case !e.LastTimestamp.IsZero():
+		return e.LastTimestamp
+	case !e.EventTime.IsZero():
+		return metav1.NewTime(e.EventTime.Time)
+	case !e.FirstTimestamp.IsZero():
+		return e.FirstTimestamp
+	default:
+		return e.CreationTimestamp
+	}
+}
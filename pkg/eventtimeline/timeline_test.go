@@ -0,0 +1,146 @@
+/*
+Copyright 2026 The KubeVela Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package eventtimeline
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	core "github.com/oam-dev/kubevela/apis/core.oam.dev"
+	"github.com/oam-dev/kubevela/apis/core.oam.dev/common"
+	"github.com/oam-dev/kubevela/apis/core.oam.dev/v1beta1"
+	"github.com/oam-dev/kubevela/pkg/oam"
+)
+
+func involvedObjectIndexer(extract func(ref corev1.ObjectReference) string) client.IndexerFunc {
+	return func(obj client.Object) []string {
+		event := &corev1.Event{}
+		u, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+		if err != nil || runtime.DefaultUnstructuredConverter.FromUnstructured(u, event) != nil {
+			return nil
+		}
+		return []string{extract(event.InvolvedObject)}
+	}
+}
+
+func TestCollect(t *testing.T) {
+	r := require.New(t)
+	scheme := runtime.NewScheme()
+	r.NoError(clientgoscheme.AddToScheme(scheme))
+	r.NoError(core.AddToScheme(scheme))
+	app := &v1beta1.Application{ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "demo", UID: "app-uid", Generation: 1}}
+	rt := &v1beta1.ResourceTracker{
+		ObjectMeta: metav1.ObjectMeta{Name: "web-v1-demo", Labels: map[string]string{oam.LabelAppName: "web", oam.LabelAppNamespace: "demo"}},
+		Spec: v1beta1.ResourceTrackerSpec{
+			Type:                  v1beta1.ResourceTrackerTypeVersioned,
+			ApplicationGeneration: 1,
+			ManagedResources: []v1beta1.ManagedResource{
+				{ClusterObjectReference: common.ClusterObjectReference{ObjectReference: corev1.ObjectReference{APIVersion: "apps/v1", Kind: "Deployment", Name: "web", Namespace: "demo"}},
+					OAMObjectReference: common.OAMObjectReference{Component: "web"}},
+				{ClusterObjectReference: common.ClusterObjectReference{ObjectReference: corev1.ObjectReference{APIVersion: "v1", Kind: "Service", Name: "web", Namespace: "demo"}},
+					OAMObjectReference: common.OAMObjectReference{Component: "web"}, Deleted: true},
+			},
+		},
+	}
+	now := time.Now()
+	newEvent := func(name, kind, objName string, uid string, typ, reason string, at time.Time) *corev1.Event {
+		return &corev1.Event{
+			ObjectMeta:     metav1.ObjectMeta{Name: name, Namespace: "demo"},
+			InvolvedObject: corev1.ObjectReference{Kind: kind, Name: objName, Namespace: "demo", UID: k8stypes.UID(uid)},
+			Type:           typ,
+			Reason:         reason,
+			LastTimestamp:  metav1.NewTime(at),
+		}
+	}
+	objs := []client.Object{app, rt,
+		newEvent("e1", "Deployment", "web", "", corev1.EventTypeNormal, "ScalingReplicaSet", now.Add(-2*time.Minute)),
+		newEvent("e2", v1beta1.ApplicationKind, "web", "app-uid", corev1.EventTypeWarning, "FailedRender", now.Add(-3*time.Minute)),
+		newEvent("e3", v1beta1.ApplicationKind, "web", "stale-uid", corev1.EventTypeNormal, "Deployed", now.Add(-4*time.Minute)),
+		newEvent("e4", "Service", "web", "", corev1.EventTypeWarning, "Deleted", now.Add(-time.Minute)),
+		newEvent("e5", "Deployment", "other", "", corev1.EventTypeWarning, "Failed", now),
+	}
+	builder := fake.NewClientBuilder().WithScheme(scheme).WithObjects(objs...)
+	for field, extract := range map[string]func(ref corev1.ObjectReference) string{
+		"involvedObject.name":      func(ref corev1.ObjectReference) string { return ref.Name },
+		"involvedObject.namespace": func(ref corev1.ObjectReference) string { return ref.Namespace },
+		"involvedObject.kind":      func(ref corev1.ObjectReference) string { return ref.Kind },
+		"involvedObject.uid":       func(ref corev1.ObjectReference) string { return string(ref.UID) },
+	} {
+		builder = builder.WithIndex(&corev1.Event{}, field, involvedObjectIndexer(extract))
+	}
+	cli := builder.Build()
+
+	reasons := func(events []Event) (res []string) {
+		for _, e := range events {
+			res = append(res, e.Cluster+"/"+e.Component+"/"+e.Kind+"/"+e.Reason)
+		}
+		return res
+	}
+	events, err := Collect(context.Background(), cli, app, Filter{})
+	r.NoError(err)
+	r.Equal([]string{"local//Application/FailedRender", "local/web/Deployment/ScalingReplicaSet"}, reasons(events))
+
+	events, err = Collect(context.Background(), cli, app, Filter{Type: corev1.EventTypeWarning})
+	r.NoError(err)
+	r.Equal([]string{"local//Application/FailedRender"}, reasons(events))
+
+	events, err = Collect(context.Background(), cli, app, Filter{Components: []string{"web"}, Since: &metav1.Time{Time: now.Add(-150 * time.Second)}})
+	r.NoError(err)
+	r.Equal([]string{"local/web/Deployment/ScalingReplicaSet"}, reasons(events))
+
+	// the events at the time are kept
+	events, err = Collect(context.Background(), cli, app, Filter{Components: []string{"web"}, Since: &events[0].Time})
+	r.NoError(err)
+	r.Equal([]string{"local/web/Deployment/ScalingReplicaSet"}, reasons(events))
+
+	events, err = Collect(context.Background(), cli, app, Filter{Cluster: "remote"})
+	r.NoError(err)
+	r.Empty(events)
+}
+
+func TestWebhookSink(t *testing.T) {
+	r := require.New(t)
+	var payload WebhookPayload
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if err := json.NewDecoder(req.Body).Decode(&payload); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+		}
+	}))
+	defer server.Close()
+	sink := NewWebhookSink(server.URL)
+	r.NoError(sink.Send(context.Background(), "web", "demo", []Event{{Cluster: "local", Kind: "Deployment", Name: "web", Reason: "ScalingReplicaSet"}}))
+	r.Equal("web", payload.Application)
+	r.Equal("demo", payload.Namespace)
+	r.Len(payload.Events, 1)
+	r.Equal("ScalingReplicaSet", payload.Events[0].Reason)
+
+	sink.URL = server.URL + "/%zz"
+	r.Error(sink.Send(context.Background(), "web", "demo", nil))
+}
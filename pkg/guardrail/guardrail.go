@@ -0,0 +1,390 @@
+/*
+Copyright 2026 The KubeVela Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package guardrail evaluates the GuardrailDefinitions on the resources rendered for the applications.
+//
+// The CUE template of a guardrail reads the rendered resource as resource, and the application as context, and
+// outputs the messages of the violations as violations, e.g.
+//
+//	violations: [for c in resource.spec.template.spec.containers if c.securityContext.privileged != _|_ && c.securityContext.privileged {
+//		"container \(c.name) is privileged"
+//	}]
+package guardrail
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+
+	"cuelang.org/go/cue"
+	cueerrors "cuelang.org/go/cue/errors"
+	"cuelang.org/go/cue/parser"
+	"github.com/kubevela/pkg/cue/cuex"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/oam-dev/kubevela/apis/core.oam.dev/v1beta1"
+	"github.com/oam-dev/kubevela/apis/types"
+	"github.com/oam-dev/kubevela/pkg/appfile"
+	"github.com/oam-dev/kubevela/pkg/cue/definition"
+	"github.com/oam-dev/kubevela/pkg/oam"
+)
+
+// Violation is a rendered resource violating a guardrail
+type Violation struct {
+	Guardrail string                `json:"guardrail"`
+	Mode      v1beta1.GuardrailMode `json:"mode"`
+	// Component is the component rendering the resource
+	Component string `json:"component,omitempty"`
+	// Trait is the trait rendering the resource, or patching the workload into the violation
+	Trait string `json:"trait,omitempty"`
+	// Policy is the policy rendering the resource
+	Policy string `json:"policy,omitempty"`
+	// Resource is the resource as kind/name
+	Resource string `json:"resource"`
+	Message  string `json:"message"`
+}
+
+// Source returns where the resource comes from, such as component api, trait sidecar
+func (v Violation) Source() string {
+	var source []string
+	if v.Component != "" {
+		source = append(source, "component "+v.Component)
+	}
+	if v.Trait != "" {
+		source = append(source, "trait "+v.Trait)
+	}
+	if v.Policy != "" {
+		source = append(source, "policy "+v.Policy)
+	}
+	return strings.Join(source, ", ")
+}
+
+// String returns the description of the violation
+func (v Violation) String() string {
+	return fmt.Sprintf("guardrail %s: %s of %s: %s", v.Guardrail, v.Resource, v.Source(), v.Message)
+}
+
+// sameAs tells whether the violations are the same violation of the same resource of the component or policy
+func (v Violation) sameAs(o Violation) bool {
+	return v.Guardrail == o.Guardrail && v.Resource == o.Resource && v.Message == o.Message &&
+		v.Component == o.Component && v.Policy == o.Policy
+}
+
+// Enforced returns the error listing the violations of the guardrails in the enforce mode
+func Enforced(violations []Violation) error {
+	var messages []string
+	for _, v := range violations {
+		if v.Mode == v1beta1.GuardrailEnforce {
+			messages = append(messages, v.String())
+		}
+	}
+	if len(messages) == 0 {
+		return nil
+	}
+	return errors.Errorf("the rendered resources violate the enforced guardrails: %s", strings.Join(messages, "; "))
+}
+
+// List lists the guardrails checking the applications of the namespace, which are the guardrails of the vela-system
+// namespace and of the namespace. The guardrails of the namespace can only add rules, see NewChecker.
+func List(ctx context.Context, cli client.Reader, namespace string) ([]v1beta1.GuardrailDefinition, error) {
+	namespaces := []string{oam.SystemDefinitionNamespace}
+	if namespace != "" && namespace != oam.SystemDefinitionNamespace {
+		namespaces = append(namespaces, namespace)
+	}
+	var guardrails []v1beta1.GuardrailDefinition
+	for _, ns := range namespaces {
+		list := &v1beta1.GuardrailDefinitionList{}
+		if err := cli.List(ctx, list, client.InNamespace(ns)); err != nil {
+			return nil, errors.Wrapf(err, "failed to list guardrails in namespace %s", ns)
+		}
+		guardrails = Merge(guardrails, list.Items)
+	}
+	return guardrails, nil
+}
+
+// FromUnstructured returns the guardrail definitions among the objects, such as the definitions given to the dry run.
+// The guardrails without namespace are in the vela-system namespace.
+func FromUnstructured(objs []*unstructured.Unstructured) ([]v1beta1.GuardrailDefinition, error) {
+	var guardrails []v1beta1.GuardrailDefinition
+	for _, obj := range objs {
+		if obj.GetKind() != v1beta1.GuardrailDefinitionKind {
+			continue
+		}
+		g := v1beta1.GuardrailDefinition{}
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.Object, &g); err != nil {
+			return nil, errors.Wrapf(err, "invalid guardrail definition %s", obj.GetName())
+		}
+		if g.Namespace == "" {
+			g.Namespace = oam.SystemDefinitionNamespace
+		}
+		guardrails = append(guardrails, g)
+	}
+	return guardrails, nil
+}
+
+// Merge returns the guardrails, replaced by the overrides of the same namespace and name
+func Merge(guardrails, overrides []v1beta1.GuardrailDefinition) []v1beta1.GuardrailDefinition {
+	merged := slices.DeleteFunc(slices.Clone(guardrails), func(g v1beta1.GuardrailDefinition) bool {
+		return slices.ContainsFunc(overrides, func(o v1beta1.GuardrailDefinition) bool {
+			return o.Namespace == g.Namespace && o.Name == g.Name
+		})
+	})
+	merged = append(merged, overrides...)
+	sort.SliceStable(merged, func(i, j int) bool {
+		if merged[i].Name != merged[j].Name {
+			return merged[i].Name < merged[j].Name
+		}
+		return isSystem(merged[i]) && !isSystem(merged[j])
+	})
+	return merged
+}
+
+func isSystem(g v1beta1.GuardrailDefinition) bool {
+	return g.Namespace == "" || g.Namespace == oam.SystemDefinitionNamespace
+}
+
+// modeOf returns the mode of the guardrail, enforce by default
+func modeOf(g v1beta1.GuardrailDefinition) v1beta1.GuardrailMode {
+	if g.Spec.Mode == "" {
+		return v1beta1.GuardrailEnforce
+	}
+	return g.Spec.Mode
+}
+
+// strictness orders the modes from the least strict
+var strictness = []v1beta1.GuardrailMode{v1beta1.GuardrailAudit, v1beta1.GuardrailWarn, v1beta1.GuardrailEnforce}
+
+// stricter returns the stricter of the modes
+func stricter(a, b v1beta1.GuardrailMode) v1beta1.GuardrailMode {
+	if slices.Index(strictness, b) > slices.Index(strictness, a) {
+		return b
+	}
+	return a
+}
+
+type compiled struct {
+	name  string
+	mode  v1beta1.GuardrailMode
+	kinds []string
+	value cue.Value
+	err   error
+}
+
+// Checker evaluates the guardrails on the resources rendered for an application. The components of an application
+// can be rendered in parallel, so the evaluations are serialized on the shared CUE context.
+type Checker struct {
+	guardrails []compiled
+	mu         sync.Mutex
+}
+
+// NewChecker compiles the templates of the guardrails. The guardrails out of the vela-system namespace can only add
+// rules: a guardrail named as one of vela-system is checked besides it, and can only make its mode stricter.
+func NewChecker(ctx context.Context, guardrails []v1beta1.GuardrailDefinition) *Checker {
+	modes := map[string]v1beta1.GuardrailMode{}
+	for _, g := range guardrails {
+		if isSystem(g) {
+			modes[g.Name] = modeOf(g)
+		}
+	}
+	for _, g := range guardrails {
+		if mode, found := modes[g.Name]; found && !isSystem(g) {
+			modes[g.Name] = stricter(mode, modeOf(g))
+		}
+	}
+	c := &Checker{}
+	for _, g := range guardrails {
+		cg := compiled{name: g.Name, mode: modeOf(g), kinds: g.Spec.Kinds}
+		if isSystem(g) {
+			cg.mode = modes[g.Name]
+		}
+		if g.Spec.Schematic == nil || g.Spec.Schematic.CUE == nil {
+			cg.err = errors.New("the guardrail has no CUE schematic")
+		} else if _, err := parser.ParseFile(g.Name, g.Spec.Schematic.CUE.Template); err != nil {
+			// the other errors can only be told once the resource is filled
+			cg.err = err
+		} else {
+			// the guardrails only read the resources, so the provider functions are not resolved
+			cg.value, cg.err = cuex.DefaultCompiler.Get().CompileStringWithOptions(ctx,
+				g.Spec.Schematic.CUE.Template+"\nresource: _\ncontext: _", cuex.DisableResolveProviderFunctions{})
+		}
+		c.guardrails = append(c.guardrails, cg)
+	}
+	return c
+}
+
+// Empty tells if there is no guardrail to check
+func (c *Checker) Empty() bool {
+	return len(c.guardrails) == 0
+}
+
+// CheckComponent checks the resources rendered for the component. A violation of the workload is attributed to
+// the trait whose patch brings the violation, found by rendering the component with fewer traits. The violations
+// known from the last check of the component keep their attribution, so that the component is not rendered again.
+func (c *Checker) CheckComponent(af *appfile.Appfile, comp *appfile.Component, manifest *types.ComponentManifest, known []Violation) []Violation {
+	if c.Empty() || manifest == nil {
+		return nil
+	}
+	ctxData := contextData(af)
+	ctxData["componentName"], ctxData["componentType"] = comp.Name, comp.Type
+	var violations []Violation
+	if manifest.ComponentOutput != nil {
+		var workloads []*unstructured.Unstructured
+		for _, v := range c.check(manifest.ComponentOutput, ctxData, Violation{Component: comp.Name}) {
+			if i := slices.IndexFunc(known, v.sameAs); i >= 0 {
+				v.Trait = known[i].Trait
+			} else {
+				if workloads == nil {
+					workloads = renderWithFewerTraits(af, comp)
+				}
+				v.Trait = c.attribute(comp, workloads, ctxData, v)
+			}
+			violations = append(violations, v)
+		}
+	}
+	for _, obj := range manifest.ComponentOutputsAndTraits {
+		if obj == nil {
+			continue
+		}
+		v := Violation{Component: comp.Name}
+		if traitType := obj.GetLabels()[oam.TraitTypeLabel]; traitType != definition.AuxiliaryWorkload {
+			v.Trait = traitType
+		}
+		violations = append(violations, c.check(obj, withTrait(ctxData, v.Trait), v)...)
+	}
+	return violations
+}
+
+// CheckPolicy checks the resources rendered for the policy
+func (c *Checker) CheckPolicy(af *appfile.Appfile, policy *appfile.Component, manifests []*unstructured.Unstructured) []Violation {
+	if c.Empty() {
+		return nil
+	}
+	ctxData := contextData(af)
+	ctxData["policyName"], ctxData["policyType"] = policy.Name, policy.Type
+	var violations []Violation
+	for _, obj := range manifests {
+		violations = append(violations, c.check(obj, ctxData, Violation{Policy: policy.Name})...)
+	}
+	return violations
+}
+
+func contextData(af *appfile.Appfile) map[string]interface{} {
+	labels, annotations := map[string]string{}, map[string]string{}
+	for k, v := range af.AppLabels {
+		labels[k] = v
+	}
+	for k, v := range af.AppAnnotations {
+		annotations[k] = v
+	}
+	return map[string]interface{}{
+		"appName":        af.Name,
+		"namespace":      af.Namespace,
+		"appLabels":      labels,
+		"appAnnotations": annotations,
+	}
+}
+
+func withTrait(ctxData map[string]interface{}, trait string) map[string]interface{} {
+	if trait == "" {
+		return ctxData
+	}
+	data := make(map[string]interface{}, len(ctxData)+1)
+	for k, v := range ctxData {
+		data[k] = v
+	}
+	data["traitType"] = trait
+	return data
+}
+
+func (c *Checker) check(obj *unstructured.Unstructured, ctxData map[string]interface{}, source Violation) []Violation {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	var violations []Violation
+	for _, g := range c.guardrails {
+		for _, msg := range g.evaluate(obj, ctxData) {
+			v := source
+			v.Guardrail, v.Mode, v.Resource, v.Message = g.name, g.mode, obj.GetKind()+"/"+obj.GetName(), msg
+			violations = append(violations, v)
+		}
+	}
+	return violations
+}
+
+// evaluate returns the messages of the violations. A guardrail failing to evaluate is violated, so that a broken
+// rule does not let the resources through.
+func (g compiled) evaluate(obj *unstructured.Unstructured, ctxData map[string]interface{}) []string {
+	if len(g.kinds) > 0 && !slices.Contains(g.kinds, obj.GetKind()) {
+		return nil
+	}
+	if g.err != nil {
+		return []string{"the guardrail cannot be compiled: " + strings.TrimSpace(cueerrors.Details(g.err, nil))}
+	}
+	v := g.value.FillPath(cue.ParsePath("resource"), obj.Object).FillPath(cue.ParsePath("context"), ctxData)
+	violations := v.LookupPath(cue.ParsePath("violations"))
+	if !violations.Exists() {
+		return nil
+	}
+	var messages []string
+	if err := violations.Decode(&messages); err != nil {
+		return []string{"the guardrail cannot be evaluated: " + strings.TrimSpace(cueerrors.Details(err, nil))}
+	}
+	return messages
+}
+
+// renderWithFewerTraits renders the workload of the component with the first k traits for each k below the number
+// of the traits, or nil for the renders failing
+func renderWithFewerTraits(af *appfile.Appfile, comp *appfile.Component) []*unstructured.Unstructured {
+	workloads := make([]*unstructured.Unstructured, len(comp.Traits))
+	for k := range workloads {
+		partial := *comp
+		partial.Traits = comp.Traits[:k]
+		if manifest, err := af.GenerateComponentManifest(&partial, nil); err == nil {
+			workloads[k] = manifest.ComponentOutput
+		}
+	}
+	return workloads
+}
+
+// attribute finds the trait whose patch brings the violation of the workload, or returns empty if the component
+// renders the violation without the traits
+func (c *Checker) attribute(comp *appfile.Component, workloads []*unstructured.Unstructured, ctxData map[string]interface{}, v Violation) string {
+	if len(comp.Traits) == 0 {
+		return ""
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for _, g := range c.guardrails {
+		if g.name != v.Guardrail {
+			continue
+		}
+		for k, workload := range workloads {
+			if workload == nil || !slices.Contains(g.evaluate(workload, ctxData), v.Message) {
+				continue
+			}
+			if k == 0 {
+				return ""
+			}
+			return comp.Traits[k-1].Name
+		}
+	}
+	return comp.Traits[len(comp.Traits)-1].Name
+}
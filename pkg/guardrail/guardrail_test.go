@@ -0,0 +1,250 @@
+/*
+Copyright 2026 The KubeVela Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package guardrail
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/yaml"
+
+	"github.com/oam-dev/kubevela/apis/core.oam.dev/common"
+	"github.com/oam-dev/kubevela/apis/core.oam.dev/v1beta1"
+	"github.com/oam-dev/kubevela/pkg/appfile"
+	"github.com/oam-dev/kubevela/pkg/oam"
+)
+
+const definitions = `
+apiVersion: core.oam.dev/v1beta1
+kind: ComponentDefinition
+metadata:
+  name: worker
+  namespace: vela-system
+spec:
+  workload:
+    definition:
+      apiVersion: apps/v1
+      kind: Deployment
+  schematic:
+    cue:
+      template: |
+        output: {
+        	apiVersion: "apps/v1"
+        	kind:       "Deployment"
+        	spec: template: spec: containers: [{name: context.name, image: parameter.image}]
+        }
+        parameter: image: string
+---
+apiVersion: core.oam.dev/v1beta1
+kind: TraitDefinition
+metadata:
+  name: sidecar
+  namespace: vela-system
+spec:
+  schematic:
+    cue:
+      template: |
+        patch: spec: template: spec: {
+        	// +patchKey=name
+        	containers: [{name: "sidecar", image: "registry.example.com/proxy", securityContext: privileged: true}]
+        }
+---
+apiVersion: core.oam.dev/v1beta1
+kind: TraitDefinition
+metadata:
+  name: expose
+  namespace: vela-system
+spec:
+  schematic:
+    cue:
+      template: |
+        outputs: service: {
+        	apiVersion: "v1"
+        	kind:       "Service"
+        	metadata: name: context.name
+        	spec: type: "LoadBalancer"
+        }
+`
+
+const app = `
+apiVersion: core.oam.dev/v1beta1
+kind: Application
+metadata:
+  name: shop
+  namespace: default
+spec:
+  components:
+  - name: api
+    type: worker
+    properties:
+      image: docker.io/nginx
+    traits:
+    - type: expose
+    - type: sidecar
+`
+
+func guardrail(name string, mode v1beta1.GuardrailMode, kinds []string, template string) v1beta1.GuardrailDefinition {
+	return v1beta1.GuardrailDefinition{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: oam.SystemDefinitionNamespace},
+		Spec: v1beta1.GuardrailDefinitionSpec{
+			Mode:      mode,
+			Kinds:     kinds,
+			Schematic: &common.Schematic{CUE: &common.CUE{Template: template}},
+		},
+	}
+}
+
+var guardrails = []v1beta1.GuardrailDefinition{
+	guardrail("no-privileged", "", []string{"Deployment"}, `
+violations: [for c in resource.spec.template.spec.containers if c.securityContext != _|_ if c.securityContext.privileged {
+	"container \(c.name) is privileged"
+}]`),
+	guardrail("approved-registries", v1beta1.GuardrailWarn, []string{"Deployment"}, `
+import "strings"
+violations: [for c in resource.spec.template.spec.containers if !strings.HasPrefix(c.image, "registry.example.com/") {
+	"image \(c.image) of container \(c.name) is not from an approved registry"
+}]`),
+	guardrail("no-load-balancer", v1beta1.GuardrailAudit, []string{"Service"}, `
+if resource.spec.type == "LoadBalancer" {
+	violations: ["service of \(context.appName) is exposed by a load balancer"]
+}`),
+	guardrail("broken", v1beta1.GuardrailAudit, []string{"Service"}, `violations: [resource.spec.missing]`),
+}
+
+func TestCheckComponent(t *testing.T) {
+	r := require.New(t)
+	defs, err := splitYAML(definitions)
+	r.NoError(err)
+	application := &v1beta1.Application{}
+	r.NoError(yaml.Unmarshal([]byte(app), application))
+	af, err := appfile.NewDryRunApplicationParser(fake.NewClientBuilder().Build(), defs).GenerateAppFileFromApp(context.Background(), application)
+	r.NoError(err)
+	comps, err := af.GenerateComponentManifests()
+	r.NoError(err)
+
+	checker := NewChecker(context.Background(), guardrails)
+	violations := checker.CheckComponent(af, af.ParsedComponents[0], comps[0], nil)
+	r.Len(violations, 4)
+	r.Equal(Violation{Guardrail: "no-privileged", Mode: v1beta1.GuardrailEnforce, Component: "api", Trait: "sidecar",
+		Resource: "Deployment/api", Message: "container sidecar is privileged"}, violations[0])
+	r.Equal("guardrail no-privileged: Deployment/api of component api, trait sidecar: container sidecar is privileged", violations[0].String())
+	r.Equal(Violation{Guardrail: "approved-registries", Mode: v1beta1.GuardrailWarn, Component: "api",
+		Resource: "Deployment/api", Message: "image docker.io/nginx of container api is not from an approved registry"}, violations[1])
+	r.Equal(Violation{Guardrail: "no-load-balancer", Mode: v1beta1.GuardrailAudit, Component: "api", Trait: "expose",
+		Resource: "Service/api", Message: "service of shop is exposed by a load balancer"}, violations[2])
+	r.Equal("broken", violations[3].Guardrail)
+	r.Equal("expose", violations[3].Trait)
+	r.Contains(violations[3].Message, "the guardrail cannot be evaluated")
+
+	r.EqualError(Enforced(violations), "the rendered resources violate the enforced guardrails: "+violations[0].String())
+	r.NoError(Enforced(violations[1:]))
+
+	// the known violations keep their attribution
+	known := []Violation{violations[0], violations[1]}
+	known[0].Trait = "expose"
+	r.Equal("expose", checker.CheckComponent(af, af.ParsedComponents[0], comps[0], known)[0].Trait)
+
+	// the guardrails of the namespace add rules, and can only make the guardrails of vela-system stricter
+	relaxed := guardrail("no-privileged", v1beta1.GuardrailAudit, []string{"Deployment"}, `violations: ["relaxed"]`)
+	relaxed.Namespace = "default"
+	strict := guardrail("approved-registries", v1beta1.GuardrailEnforce, []string{"Service"}, "")
+	strict.Namespace = "default"
+	namespaced := NewChecker(context.Background(), Merge(guardrails[:2], []v1beta1.GuardrailDefinition{relaxed, strict}))
+	violations = namespaced.CheckComponent(af, af.ParsedComponents[0], comps[0], nil)
+	r.Len(violations, 3)
+	r.Equal(Violation{Guardrail: "approved-registries", Mode: v1beta1.GuardrailEnforce, Component: "api",
+		Resource: "Deployment/api", Message: "image docker.io/nginx of container api is not from an approved registry"}, violations[0])
+	r.Equal(v1beta1.GuardrailEnforce, violations[1].Mode)
+	r.Equal("container sidecar is privileged", violations[1].Message)
+	r.Equal(v1beta1.GuardrailAudit, violations[2].Mode)
+	r.Equal("relaxed", violations[2].Message)
+
+	broken := NewChecker(context.Background(), []v1beta1.GuardrailDefinition{guardrail("syntax", "", nil, "violations: [")})
+	r.Contains(broken.CheckComponent(af, af.ParsedComponents[0], comps[0], nil)[0].Message, "the guardrail cannot be compiled")
+
+	policy := &appfile.Component{Name: "topology", Type: "topology"}
+	obj := &unstructured.Unstructured{}
+	obj.SetKind("Service")
+	obj.SetName("lb")
+	_ = unstructured.SetNestedField(obj.Object, "LoadBalancer", "spec", "type")
+	violations = NewChecker(context.Background(), guardrails[2:3]).CheckPolicy(af, policy, []*unstructured.Unstructured{obj})
+	r.Equal([]Violation{{Guardrail: "no-load-balancer", Mode: v1beta1.GuardrailAudit, Policy: "topology",
+		Resource: "Service/lb", Message: "service of shop is exposed by a load balancer"}}, violations)
+
+	noSchematic := NewChecker(context.Background(), []v1beta1.GuardrailDefinition{{ObjectMeta: metav1.ObjectMeta{Name: "empty"}}})
+	violations = noSchematic.CheckPolicy(af, policy, []*unstructured.Unstructured{obj})
+	r.Len(violations, 1)
+	r.Equal("the guardrail cannot be compiled: the guardrail has no CUE schematic", violations[0].Message)
+}
+
+func TestList(t *testing.T) {
+	r := require.New(t)
+	scheme := runtime.NewScheme()
+	r.NoError(v1beta1.AddToScheme(scheme))
+	system := guardrail("no-privileged", v1beta1.GuardrailEnforce, nil, "")
+	other := guardrail("approved-registries", v1beta1.GuardrailEnforce, nil, "")
+	override := guardrail("no-privileged", v1beta1.GuardrailAudit, nil, "")
+	override.Namespace = "dev"
+	unrelated := guardrail("no-load-balancer", v1beta1.GuardrailEnforce, nil, "")
+	unrelated.Namespace = "prod"
+	cli := fake.NewClientBuilder().WithScheme(scheme).WithObjects(&system, &other, &override, &unrelated).Build()
+
+	list, err := List(context.Background(), cli, "dev")
+	r.NoError(err)
+	r.Len(list, 3)
+	r.Equal("approved-registries", list[0].Name)
+	r.Equal(oam.SystemDefinitionNamespace, list[1].Namespace)
+	r.Equal(v1beta1.GuardrailEnforce, list[1].Spec.Mode)
+	r.Equal("dev", list[2].Namespace)
+
+	list, err = List(context.Background(), cli, oam.SystemDefinitionNamespace)
+	r.NoError(err)
+	r.Len(list, 2)
+
+	obj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(&override)
+	r.NoError(err)
+	u := &unstructured.Unstructured{Object: obj}
+	u.SetKind(v1beta1.GuardrailDefinitionKind)
+	fromFile, err := FromUnstructured([]*unstructured.Unstructured{u, {Object: map[string]interface{}{"kind": "ConfigMap"}}})
+	r.NoError(err)
+	r.Len(fromFile, 1)
+	r.Equal(v1beta1.GuardrailEnforce, Merge(list, fromFile)[1].Spec.Mode)
+
+	// the guardrails given without namespace replace the ones of vela-system
+	u.SetNamespace("")
+	fromFile, err = FromUnstructured([]*unstructured.Unstructured{u})
+	r.NoError(err)
+	r.Equal(v1beta1.GuardrailAudit, Merge(list, fromFile)[1].Spec.Mode)
+}
+
+func splitYAML(doc string) ([]*unstructured.Unstructured, error) {
+	var objs []*unstructured.Unstructured
+	for _, part := range strings.Split(doc, "\n---\n") {
+		obj := &unstructured.Unstructured{}
+		if err := yaml.Unmarshal([]byte(part), &obj.Object); err != nil {
+			return nil, err
+		}
+		objs = append(objs, obj)
+	}
+	return objs, nil
+}
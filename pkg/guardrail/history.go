@@ -0,0 +1,74 @@
+/*
+Copyright 2026 The KubeVela Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package guardrail
+
+import (
+	"slices"
+	"sync"
+)
+
+// History keeps the violations found by the last check of each component or policy of the applications, so that
+// the violations are reported once when they appear rather than on every reconcile
+type History struct {
+	mu         sync.Mutex
+	violations map[string]map[string][]Violation
+}
+
+// NewHistory creates an empty history
+func NewHistory() *History {
+	return &History{violations: map[string]map[string][]Violation{}}
+}
+
+// Get returns the violations found by the last check of the source of the application
+func (h *History) Get(app, source string) []Violation {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return h.violations[app][source]
+}
+
+// Record records the violations found by the check of the source of the application, and returns the ones not
+// found by the last check
+func (h *History) Record(app, source string, violations []Violation) []Violation {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	last := h.violations[app][source]
+	var appeared []Violation
+	for _, v := range violations {
+		if !slices.Contains(last, v) {
+			appeared = append(appeared, v)
+		}
+	}
+	if len(violations) == 0 {
+		delete(h.violations[app], source)
+		if len(h.violations[app]) == 0 {
+			delete(h.violations, app)
+		}
+		return nil
+	}
+	if h.violations[app] == nil {
+		h.violations[app] = map[string][]Violation{}
+	}
+	h.violations[app][source] = violations
+	return appeared
+}
+
+// Forget drops the violations of the application, such as once it is deleted
+func (h *History) Forget(app string) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	delete(h.violations, app)
+}
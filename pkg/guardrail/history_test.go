@@ -0,0 +1,47 @@
+/*
+Copyright 2026 The KubeVela Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package guardrail
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/oam-dev/kubevela/apis/core.oam.dev/v1beta1"
+)
+
+func TestHistory(t *testing.T) {
+	r := require.New(t)
+	privileged := Violation{Guardrail: "no-privileged", Mode: v1beta1.GuardrailEnforce, Component: "api", Resource: "Deployment/api", Message: "privileged"}
+	registry := Violation{Guardrail: "approved-registries", Mode: v1beta1.GuardrailWarn, Component: "api", Resource: "Deployment/api", Message: "registry"}
+	history := NewHistory()
+
+	r.Equal([]Violation{privileged}, history.Record("default/shop", "component api", []Violation{privileged}))
+	r.Nil(history.Record("default/shop", "component api", []Violation{privileged}))
+	r.Equal([]Violation{registry}, history.Record("default/shop", "component api", []Violation{privileged, registry}))
+	r.Equal([]Violation{privileged, registry}, history.Get("default/shop", "component api"))
+	r.Equal([]Violation{privileged}, history.Record("default/web", "component api", []Violation{privileged}))
+
+	// the violations appear again once fixed
+	r.Nil(history.Record("default/shop", "component api", nil))
+	r.Nil(history.Get("default/shop", "component api"))
+	r.Equal([]Violation{privileged}, history.Record("default/shop", "component api", []Violation{privileged}))
+
+	history.Forget("default/shop")
+	r.Nil(history.Get("default/shop", "component api"))
+	r.Equal([]Violation{privileged}, history.Get("default/web", "component api"))
+}
@@ -78,7 +78,7 @@ var (
 		Name: "kubevela_application_phase",
 		Help: "Application phase as numeric value (0=starting, 1=running, 2=rendering, 3=policy_generating, 4=running_workflow, " +
 			"5=workflow_suspending, 6=workflow_terminated, 7=workflow_failed, 8=unhealthy, 9=deleting, " +
-			"-1=unknown)",
+			"10=pending_deploy_window, -1=unknown)",
 	}, []string{"app_name", "namespace"})
 
 	// WorkflowPhase reports the numeric phase of each workflow
@@ -87,6 +87,74 @@ var (
 		Help: "Workflow phase as numeric value (0=initializing, 1=succeeded, 2=executing, 3=suspending, 4=terminated, " +
 			"5=failed, 6=skipped, -1=unknown)",
 	}, []string{"app_name", "namespace"})
+
+	// ComponentHealthStatus reports the health status of each component of the application in each cluster
+	ComponentHealthStatus = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "kubevela_component_health_status",
+		Help: "Component health status (1 = healthy, 0 = unhealthy)",
+	}, []string{"app_name", "namespace", "component", "cluster"})
+
+	// ApplicationManagedResources reports the number of the resources managed by each application
+	ApplicationManagedResources = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "kubevela_application_managed_resources",
+		Help: "the number of the resources managed by the application.",
+	}, []string{"app_name", "namespace"})
+
+	// ResourceDispatchDurationHistogram reports the time cost of dispatching a resource to each cluster
+	ResourceDispatchDurationHistogram = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "kubevela_resource_dispatch_duration_seconds",
+		Help:    "resource dispatch duration distributions by cluster.",
+		Buckets: velametrics.FineGrainedBuckets,
+	}, []string{"cluster"})
+
+	// GCDeletedResourceCounter reports the resources deleted by the garbage collection in each cluster
+	GCDeletedResourceCounter = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "kubevela_gc_deleted_resources_total",
+		Help: "the resources deleted by the garbage collection by cluster.",
+	}, []string{"cluster"})
+
+	// CUERenderDurationHistogram reports the time cost of rendering the CUE template of each definition
+	CUERenderDurationHistogram = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "kubevela_cue_render_duration_seconds",
+		Help:    "CUE template render duration distributions by definition.",
+		Buckets: velametrics.FineGrainedBuckets,
+	}, []string{"definition_type", "definition"})
+
+	// QuotaLimitGauge reports the limits of the vela quotas
+	QuotaLimitGauge = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "kubevela_quota_limit",
+		Help: "the limit of the resource restricted by the vela quota.",
+	}, []string{"namespace", "quota", "resource"})
+
+	// QuotaUsedGauge reports the usage of the resources restricted by the vela quotas
+	QuotaUsedGauge = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "kubevela_quota_used",
+		Help: "the usage of the resource restricted by the vela quota.",
+	}, []string{"namespace", "quota", "resource"})
+
+	// TemplateCacheRequestCounter reports the requests to the compiled template cache by result, hit or miss
+	TemplateCacheRequestCounter = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "kubevela_template_cache_requests_total",
+		Help: "the requests to the compiled definition template cache by result.",
+	}, []string{"result"})
+
+	// TemplateCacheEvictionCounter reports the templates evicted from the compiled template cache
+	TemplateCacheEvictionCounter = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "kubevela_template_cache_evictions_total",
+		Help: "the templates evicted from the compiled definition template cache.",
+	})
+
+	// TemplateCacheSizeGauge reports the estimated memory of the compiled template cache
+	TemplateCacheSizeGauge = prometheus.NewGauge(prometheus.GaugeOpts{
+		Name: "kubevela_template_cache_size_bytes",
+		Help: "the estimated memory of the compiled definition templates in the cache.",
+	})
+
+	// GuardrailViolationCounter reports the violations of the guardrails by the rendered resources, counted when they appear
+	GuardrailViolationCounter = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "kubevela_guardrail_violations_total",
+		Help: "the violations of the guardrails by the resources rendered for the applications, counted when they appear.",
+	}, []string{"namespace", "guardrail", "mode"})
 )
 
 var (
@@ -0,0 +1,177 @@
+/*
+Copyright 2026 The KubeVela Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	// dashboardPanelWidth is the width of the panels, two panels in a row of the grafana grid
+	dashboardPanelWidth = 12
+	// dashboardPanelHeight is the height of the panels
+	dashboardPanelHeight = 8
+	// dashboardRateInterval is the range of the rates of the counters and the histograms
+	dashboardRateInterval = "5m"
+)
+
+// MetricInfo is the description of a metric registered by the controller
+type MetricInfo struct {
+	Name   string
+	Help   string
+	Type   string
+	Labels []string
+}
+
+// ControllerMetrics returns the descriptions of the metrics registered by the controller, including the
+// application status metrics which are registered when enabled. The descriptions are taken from the metric
+// infos and checked against the descriptions of the collectors, so that they cannot go out of date.
+func ControllerMetrics() ([]MetricInfo, error) {
+	var infos []MetricInfo
+	for _, collector := range append(append([]prometheus.Collector{}, collectorGroup...), applicationStatusMetrics...) {
+		info, found := metricInfos[collector]
+		if !found {
+			return nil, fmt.Errorf("no metric info found for the collector %T", collector)
+		}
+		// the metrics of the controller are single metrics or metric vectors, each described by one description
+		ch := make(chan *prometheus.Desc, 1)
+		collector.Describe(ch)
+		close(ch)
+		desc, expected := <-ch, prometheus.NewDesc(info.Name, info.Help, info.Labels, nil)
+		if desc == nil || desc.String() != expected.String() {
+			return nil, fmt.Errorf("the metric info of %s does not match the description of the collector %v", info.Name, desc)
+		}
+		info.Type = metricType(collector)
+		infos = append(infos, info)
+	}
+	return infos, nil
+}
+
+// metricType returns the type of the collector, the gauges are matched before the counters since they
+// implement the counter interface as well
+func metricType(collector prometheus.Collector) string {
+	switch collector.(type) {
+	case *prometheus.HistogramVec, prometheus.Histogram:
+		return "histogram"
+	case *prometheus.GaugeVec, prometheus.Gauge:
+		return "gauge"
+	case *prometheus.CounterVec, prometheus.Counter:
+		return "counter"
+	default:
+		return "untyped"
+	}
+}
+
+// Query returns the PromQL query to show the metric in the dashboard, the rate of the counters, the sum of
+// the gauges and the 99th percentile of the histograms, all grouped by the labels of the metric
+func (m MetricInfo) Query() string {
+	switch m.Type {
+	case "counter":
+		return groupBy(fmt.Sprintf("sum(rate(%s[%s]))", m.Name, dashboardRateInterval), m.Labels)
+	case "histogram":
+		return fmt.Sprintf("histogram_quantile(0.99, %s)",
+			groupBy(fmt.Sprintf("sum(rate(%s_bucket[%s]))", m.Name, dashboardRateInterval), append([]string{"le"}, m.Labels...)))
+	default:
+		return groupBy(fmt.Sprintf("sum(%s)", m.Name), m.Labels)
+	}
+}
+
+func groupBy(expr string, labels []string) string {
+	if len(labels) == 0 {
+		return expr
+	}
+	return fmt.Sprintf("%s by (%s)", expr, strings.Join(labels, ", "))
+}
+
+func legend(labels []string) string {
+	var items []string
+	for _, label := range labels {
+		items = append(items, "{{"+label+"}}")
+	}
+	return strings.Join(items, " ")
+}
+
+// GenerateDashboard generates the grafana dashboard with a panel for each metric registered by the controller
+func GenerateDashboard() ([]byte, error) {
+	infos, err := ControllerMetrics()
+	if err != nil {
+		return nil, err
+	}
+	datasource := map[string]interface{}{"type": "prometheus", "uid": "${datasource}"}
+	var panels []interface{}
+	for i, info := range infos {
+		unit := "short"
+		switch {
+		case info.Type == "histogram" && strings.HasSuffix(info.Name, "_ms"):
+			unit = "ms"
+		case info.Type == "histogram":
+			unit = "s"
+		case info.Type == "counter":
+			unit = "ops"
+		case strings.HasSuffix(info.Name, "_bytes"):
+			unit = "bytes"
+		}
+		panels = append(panels, map[string]interface{}{
+			"id":          i + 1,
+			"type":        "timeseries",
+			"title":       info.Name,
+			"description": info.Help,
+			"datasource":  datasource,
+			"gridPos": map[string]int{
+				"h": dashboardPanelHeight,
+				"w": dashboardPanelWidth,
+				"x": (i % 2) * dashboardPanelWidth,
+				"y": (i / 2) * dashboardPanelHeight,
+			},
+			"fieldConfig": map[string]interface{}{
+				"defaults":  map[string]interface{}{"unit": unit},
+				"overrides": []interface{}{},
+			},
+			"targets": []interface{}{map[string]interface{}{
+				"datasource":   datasource,
+				"expr":         info.Query(),
+				"legendFormat": legend(info.Labels),
+				"refId":        "A",
+			}},
+		})
+	}
+	dashboard := map[string]interface{}{
+		"title":         "KubeVela Controller",
+		"uid":           "kubevela-controller",
+		"tags":          []string{"kubevela"},
+		"editable":      true,
+		"schemaVersion": 39,
+		"refresh":       "30s",
+		"time":          map[string]string{"from": "now-1h", "to": "now"},
+		"templating": map[string]interface{}{"list": []interface{}{map[string]interface{}{
+			"name":  "datasource",
+			"label": "Data Source",
+			"type":  "datasource",
+			"query": "prometheus",
+		}}},
+		"panels": panels,
+	}
+	bs, err := json.MarshalIndent(dashboard, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append(bs, '\n'), nil
+}
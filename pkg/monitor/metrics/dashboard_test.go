@@ -0,0 +1,58 @@
+/*
+Copyright 2026 The KubeVela Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestControllerMetrics(t *testing.T) {
+	r := require.New(t)
+	infos, err := ControllerMetrics()
+	r.NoError(err)
+	found := map[string]MetricInfo{}
+	for _, info := range infos {
+		found[info.Name] = info
+	}
+	r.Equal(MetricInfo{Name: "kubevela_resource_dispatch_duration_seconds", Help: "resource dispatch duration distributions by cluster.",
+		Type: "histogram", Labels: []string{"cluster"}}, found["kubevela_resource_dispatch_duration_seconds"])
+	r.Equal("gauge", found["kubevela_template_cache_size_bytes"].Type)
+	r.Equal("counter", found["kubevela_template_cache_evictions_total"].Type)
+	r.Equal("histogram_quantile(0.99, sum(rate(kubevela_cue_render_duration_seconds_bucket[5m])) by (le, definition_type, definition))",
+		found["kubevela_cue_render_duration_seconds"].Query())
+	r.Equal("sum(rate(kubevela_gc_deleted_resources_total[5m])) by (cluster)", found["kubevela_gc_deleted_resources_total"].Query())
+	r.Equal("sum(kubevela_component_health_status) by (app_name, namespace, component, cluster)", found["kubevela_component_health_status"].Query())
+
+	// the metric infos going out of date with the collectors are reported
+	info := metricInfos[GCDeletedResourceCounter]
+	defer func() { metricInfos[GCDeletedResourceCounter] = info }()
+	metricInfos[GCDeletedResourceCounter] = MetricInfo{Name: info.Name, Help: info.Help, Labels: []string{"cluster", "kind"}}
+	_, err = ControllerMetrics()
+	r.ErrorContains(err, "the metric info of kubevela_gc_deleted_resources_total does not match")
+}
+
+func TestBundledDashboard(t *testing.T) {
+	r := require.New(t)
+	expected, err := GenerateDashboard()
+	r.NoError(err)
+	bundled, err := os.ReadFile("../../../charts/vela-core/dashboards/kubevela-controller.json")
+	r.NoError(err)
+	r.Equal(string(expected), string(bundled), "the bundled dashboard is outdated, run `go run ./hack/dashboard/gen.go charts/vela-core/dashboards/kubevela-controller.json`")
+}
@@ -54,6 +54,74 @@ var collectorGroup = []prometheus.Collector{
 	ClusterPodAllocatableGauge,
 	ClusterMemoryUsageGauge,
 	ClusterCPUUsageGauge,
+	QuotaLimitGauge,
+	QuotaUsedGauge,
+	TemplateCacheRequestCounter,
+	TemplateCacheEvictionCounter,
+	TemplateCacheSizeGauge,
+	GuardrailViolationCounter,
+	ResourceDispatchDurationHistogram,
+	GCDeletedResourceCounter,
+	CUERenderDurationHistogram,
+}
+
+// applicationStatusMetrics are the metrics labeled by the applications, which are only registered when enabled
+// to keep the label cardinality bounded
+var applicationStatusMetrics = []prometheus.Collector{
+	ApplicationHealthStatus,
+	ApplicationPhase,
+	WorkflowPhase,
+	ComponentHealthStatus,
+	ApplicationManagedResources,
+}
+
+// metricInfos are the descriptions of the metrics registered by the controller to generate the dashboard,
+// which must be updated along with the collectors
+var metricInfos = map[prometheus.Collector]MetricInfo{
+	AppReconcileStageDurationHistogram: {Name: "kubevela_app_reconcile_time_seconds", Help: "application reconcile time costs.", Labels: []string{"stage"}},
+	StepDurationHistogram:              {Name: "step_duration_ms", Help: "step latency distributions.", Labels: []string{"controller", "step_type"}},
+	ListResourceTrackerCounter:         {Name: "list_resourcetracker_num", Help: "list resourceTrackers times.", Labels: []string{"controller"}},
+	ApplicationReconcileTimeHistogram:  {Name: "application_reconcile_time_seconds", Help: "application reconcile duration distributions.", Labels: []string{"begin_phase", "end_phase"}},
+	ApplyComponentTimeHistogram:        {Name: "apply_component_time_seconds", Help: "apply component duration distributions.", Labels: []string{"stage"}},
+	WorkflowFinishedTimeHistogram:      {Name: "workflow_finished_time_seconds", Help: "workflow finished time distributions.", Labels: []string{"phase"}},
+	ApplicationPhaseCounter:            {Name: "application_phase_number", Help: "application phase number", Labels: []string{"phase"}},
+	WorkflowStepPhaseGauge:             {Name: "workflow_step_phase_number", Help: "workflow step phase number", Labels: []string{"step_type", "phase"}},
+	ClusterIsConnectedGauge:            {Name: "cluster_isconnected", Help: "if cluster is connected.", Labels: []string{"cluster"}},
+	ClusterWorkerNumberGauge:           {Name: "cluster_worker_node_number", Help: "cluster worker node number.", Labels: []string{"cluster"}},
+	ClusterMasterNumberGauge:           {Name: "cluster_master_node_number", Help: "cluster master node number.", Labels: []string{"cluster"}},
+	ClusterMemoryCapacityGauge:         {Name: "cluster_memory_capacity", Help: "cluster memory capacity number.", Labels: []string{"cluster"}},
+	ClusterCPUCapacityGauge:            {Name: "cluster_cpu_capacity", Help: "cluster cpu capacity number.", Labels: []string{"cluster"}},
+	ClusterPodCapacityGauge:            {Name: "cluster_pod_capacity", Help: "cluster pod capacity number.", Labels: []string{"cluster"}},
+	ClusterMemoryAllocatableGauge:      {Name: "cluster_memory_allocatable", Help: "cluster memory allocatable number.", Labels: []string{"cluster"}},
+	ClusterCPUAllocatableGauge:         {Name: "cluster_cpu_allocatable", Help: "cluster cpu allocatable number.", Labels: []string{"cluster"}},
+	ClusterPodAllocatableGauge:         {Name: "cluster_pod_allocatable", Help: "cluster pod allocatable number.", Labels: []string{"cluster"}},
+	ClusterMemoryUsageGauge:            {Name: "cluster_memory_usage", Help: "cluster memory usage number.", Labels: []string{"cluster"}},
+	ClusterCPUUsageGauge:               {Name: "cluster_cpu_usage", Help: "cluster cpu usage number.", Labels: []string{"cluster"}},
+	QuotaLimitGauge:                    {Name: "kubevela_quota_limit", Help: "the limit of the resource restricted by the vela quota.", Labels: []string{"namespace", "quota", "resource"}},
+	QuotaUsedGauge:                     {Name: "kubevela_quota_used", Help: "the usage of the resource restricted by the vela quota.", Labels: []string{"namespace", "quota", "resource"}},
+	TemplateCacheRequestCounter:        {Name: "kubevela_template_cache_requests_total", Help: "the requests to the compiled definition template cache by result.", Labels: []string{"result"}},
+	TemplateCacheEvictionCounter:       {Name: "kubevela_template_cache_evictions_total", Help: "the templates evicted from the compiled definition template cache."},
+	TemplateCacheSizeGauge:             {Name: "kubevela_template_cache_size_bytes", Help: "the estimated memory of the compiled definition templates in the cache."},
+	GuardrailViolationCounter: {Name: "kubevela_guardrail_violations_total",
+		Help: "the violations of the guardrails by the resources rendered for the applications, counted when they appear.", Labels: []string{"namespace", "guardrail", "mode"}},
+	ResourceDispatchDurationHistogram: {Name: "kubevela_resource_dispatch_duration_seconds", Help: "resource dispatch duration distributions by cluster.", Labels: []string{"cluster"}},
+	GCDeletedResourceCounter:          {Name: "kubevela_gc_deleted_resources_total", Help: "the resources deleted by the garbage collection by cluster.", Labels: []string{"cluster"}},
+	CUERenderDurationHistogram: {Name: "kubevela_cue_render_duration_seconds", Help: "CUE template render duration distributions by definition.",
+		Labels: []string{"definition_type", "definition"}},
+	ApplicationHealthStatus: {Name: "kubevela_application_health_status", Help: "Application health status (1 = healthy, 0 = unhealthy)", Labels: []string{"app_name", "namespace"}},
+	ApplicationPhase: {Name: "kubevela_application_phase",
+		Help: "Application phase as numeric value (0=starting, 1=running, 2=rendering, 3=policy_generating, 4=running_workflow, " +
+			"5=workflow_suspending, 6=workflow_terminated, 7=workflow_failed, 8=unhealthy, 9=deleting, " +
+			"10=pending_deploy_window, -1=unknown)",
+		Labels: []string{"app_name", "namespace"}},
+	WorkflowPhase: {Name: "kubevela_application_workflow_phase",
+		Help: "Workflow phase as numeric value (0=initializing, 1=succeeded, 2=executing, 3=suspending, 4=terminated, " +
+			"5=failed, 6=skipped, -1=unknown)",
+		Labels: []string{"app_name", "namespace"}},
+	ComponentHealthStatus: {Name: "kubevela_component_health_status", Help: "Component health status (1 = healthy, 0 = unhealthy)",
+		Labels: []string{"app_name", "namespace", "component", "cluster"}},
+	ApplicationManagedResources: {Name: "kubevela_application_managed_resources", Help: "the number of the resources managed by the application.",
+		Labels: []string{"app_name", "namespace"}},
 }
 
 var (
@@ -76,13 +144,7 @@ func RegisterApplicationStatusMetrics() {
 	}
 
 	if feature.DefaultMutableFeatureGate.Enabled(features.EnableApplicationStatusMetrics) {
-		statusMetrics := []prometheus.Collector{
-			ApplicationHealthStatus,
-			ApplicationPhase,
-			WorkflowPhase,
-		}
-
-		for _, metric := range statusMetrics {
+		for _, metric := range applicationStatusMetrics {
 			if err := metrics.Registry.Register(metric); err != nil {
 				klog.Errorf("Failed to register application status metric: %v", err)
 			}
@@ -0,0 +1,153 @@
+/*
+Copyright 2026 The KubeVela Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notification
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"html"
+	"net/http"
+	"strings"
+
+	workflowv1alpha1 "github.com/kubevela/workflow/api/v1alpha1"
+	"github.com/kubevela/workflow/pkg/cue/model"
+	"github.com/kubevela/workflow/pkg/cue/process"
+	"github.com/kubevela/workflow/pkg/providers/email"
+	wfhttp "github.com/kubevela/workflow/pkg/providers/http"
+	providertypes "github.com/kubevela/workflow/pkg/providers/types"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/oam-dev/kubevela/apis/core.oam.dev/v1alpha1"
+)
+
+// defaultEmailPort is the port of the email host if not set, same as the notification workflow step
+const defaultEmailPort = 587
+
+// sendEmail is the email provider of the notification workflow step, replaceable in tests
+var sendEmail = email.Send
+
+// Send sends the notification to the channel through the providers of the notification workflow step, the secrets
+// referred by the channel are read from the namespace. The emails are sent in the background as in the workflow
+// step, so it returns false until the email of the id is sent, and should be called again with the same id later.
+func Send(ctx context.Context, cli client.Client, namespace string, id string, channel v1alpha1.NotificationChannel, n *Notification) (bool, error) {
+	var url v1alpha1.NotificationValue
+	var message interface{}
+	headers := map[string]string{"Content-Type": "application/json"}
+	switch {
+	case channel.Slack != nil:
+		url, message = channel.Slack.URL, map[string]interface{}{"text": n.Text(), "mrkdwn": true}
+	case channel.DingDing != nil:
+		url, message = channel.DingDing.URL, map[string]interface{}{"msgtype": "text", "text": map[string]string{"content": n.Text()}}
+	case channel.Lark != nil:
+		// the content of the lark messages is json encoded
+		content, err := json.Marshal(map[string]string{"text": n.Text()})
+		if err != nil {
+			return false, err
+		}
+		url, message = channel.Lark.URL, map[string]interface{}{"msg_type": "text", "content": string(content)}
+	case channel.Webhook != nil:
+		url, message = channel.Webhook.URL, n
+		for k, v := range channel.Webhook.Headers {
+			headers[k] = v
+		}
+	case channel.Email != nil:
+		password, err := resolveValue(ctx, cli, namespace, channel.Email.From.Password)
+		if err != nil {
+			return false, err
+		}
+		return sendEmailNotification(ctx, id, channel.Email, password, n)
+	default:
+		return false, fmt.Errorf("no channel is set in %s", channel.Name)
+	}
+	address, err := resolveValue(ctx, cli, namespace, url)
+	if err != nil {
+		return false, err
+	}
+	body, err := json.Marshal(message)
+	if err != nil {
+		return false, err
+	}
+	resp, err := wfhttp.Do(ctx, &wfhttp.DoParams{Params: wfhttp.RequestVars{
+		Method:  http.MethodPost,
+		URL:     address,
+		Request: &wfhttp.Request{Body: string(body), Header: headers},
+	}})
+	if err != nil {
+		return false, err
+	}
+	if code := resp.Returns.StatusCode; code < 200 || code >= 300 {
+		return false, fmt.Errorf("unexpected response: %d %s", code, http.StatusText(code))
+	}
+	return true, nil
+}
+
+// sendEmailNotification sends the email through the email provider, keyed by the id as the session of the step
+func sendEmailNotification(ctx context.Context, id string, channel *v1alpha1.EmailNotification, password string, n *Notification) (bool, error) {
+	port := channel.From.Port
+	if port == 0 {
+		port = defaultEmailPort
+	}
+	pCtx := process.NewContext(process.ContextData{})
+	pCtx.PushData(model.ContextStepSessionID, id)
+	act := &emailAction{}
+	_, err := sendEmail(ctx, &email.MailParams{
+		Params: email.MailVars{
+			From: email.Sender{Address: channel.From.Address, Alias: channel.From.Alias, Password: password, Host: channel.From.Host, Port: port},
+			To:   channel.To,
+			// the emails are sent in html
+			Content: email.Content{Subject: n.Title(), Body: strings.ReplaceAll(html.EscapeString(n.Text()), "\n", "<br>")},
+		},
+		RuntimeParams: providertypes.RuntimeParams{ProcessContext: pCtx, Action: act},
+	})
+	if act.waiting {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+// emailAction records whether the email provider waits for the email to be sent
+type emailAction struct {
+	waiting bool
+}
+
+func (a *emailAction) Suspend(string)   {}
+func (a *emailAction) Resume(string)    {}
+func (a *emailAction) Terminate(string) {}
+func (a *emailAction) Wait(string)      { a.waiting = true }
+func (a *emailAction) Fail(string)      {}
+func (a *emailAction) Message(string)   {}
+func (a *emailAction) GetStatus() workflowv1alpha1.StepStatus {
+	return workflowv1alpha1.StepStatus{}
+}
+
+// resolveValue returns the value in plain text or reads it from the secret
+func resolveValue(ctx context.Context, cli client.Client, namespace string, v v1alpha1.NotificationValue) (string, error) {
+	if v.SecretRef == nil {
+		return v.Value, nil
+	}
+	secret := &corev1.Secret{}
+	if err := cli.Get(ctx, client.ObjectKey{Namespace: namespace, Name: v.SecretRef.Name}, secret); err != nil {
+		return "", fmt.Errorf("failed to read secret %s: %w", v.SecretRef.Name, err)
+	}
+	value, ok := secret.Data[v.SecretRef.Key]
+	if !ok {
+		return "", fmt.Errorf("key %s not found in secret %s", v.SecretRef.Key, v.SecretRef.Name)
+	}
+	return string(value), nil
+}
@@ -0,0 +1,176 @@
+/*
+Copyright 2026 The KubeVela Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notification
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	workflowv1alpha1 "github.com/kubevela/workflow/api/v1alpha1"
+	"github.com/kubevela/workflow/pkg/cue/model"
+	"github.com/kubevela/workflow/pkg/providers/email"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/oam-dev/kubevela/apis/core.oam.dev/common"
+	"github.com/oam-dev/kubevela/apis/core.oam.dev/condition"
+	"github.com/oam-dev/kubevela/apis/core.oam.dev/v1alpha1"
+	"github.com/oam-dev/kubevela/apis/core.oam.dev/v1beta1"
+)
+
+func TestObserveAndDiff(t *testing.T) {
+	r := require.New(t)
+	app := &v1beta1.Application{ObjectMeta: metav1.ObjectMeta{Name: "web"}}
+	app.Status.Phase = common.ApplicationRunning
+	app.Status.Services = []common.ApplicationComponentStatus{{Name: "web", Healthy: true}}
+	running := Observe(app)
+	r.Equal(v1alpha1.SubscribedApplication{Name: "web", Phase: "running", Healthy: true}, running)
+
+	app.Status.Phase = common.ApplicationWorkflowFailed
+	app.Status.Services[0].Traits = []common.ApplicationTraitStatus{{Type: "gateway", Healthy: false}}
+	app.Status.Workflow = &common.WorkflowStatus{Steps: []workflowv1alpha1.WorkflowStepStatus{{
+		StepStatus: workflowv1alpha1.StepStatus{Name: "deploy", Phase: workflowv1alpha1.WorkflowStepPhaseFailed},
+	}}}
+	app.Status.SetConditions(condition.ErrorCondition("StateKeep", io.EOF))
+	failed := Observe(app)
+	r.Equal(v1alpha1.SubscribedApplication{Name: "web", Phase: "workflowFailed", WorkflowFailed: true, Drifted: true}, failed)
+
+	events := func(changes []Change) (res []v1alpha1.SubscriptionEvent) {
+		for _, c := range changes {
+			res = append(res, c.Event)
+		}
+		return res
+	}
+	r.Equal([]v1alpha1.SubscriptionEvent{
+		v1alpha1.SubscriptionEventPhaseChanged,
+		v1alpha1.SubscriptionEventHealthChanged,
+		v1alpha1.SubscriptionEventWorkflowFailed,
+		v1alpha1.SubscriptionEventDrifted,
+	}, events(Diff(running, failed, nil)))
+	r.Equal([]v1alpha1.SubscriptionEvent{v1alpha1.SubscriptionEventHealthChanged},
+		events(Diff(running, failed, []v1alpha1.SubscriptionEvent{v1alpha1.SubscriptionEventHealthChanged})))
+	// recovering from the workflow failure and the drift only notifies the phase and health
+	r.Equal([]v1alpha1.SubscriptionEvent{v1alpha1.SubscriptionEventPhaseChanged, v1alpha1.SubscriptionEventHealthChanged},
+		events(Diff(failed, running, nil)))
+	r.Empty(Diff(running, running, nil))
+}
+
+func TestSend(t *testing.T) {
+	r := require.New(t)
+	ctx := context.Background()
+	bodies := map[string]map[string]interface{}{}
+	headers := map[string]http.Header{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.URL.Path == "/broken" {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		body := map[string]interface{}{}
+		r.NoError(json.NewDecoder(req.Body).Decode(&body))
+		bodies[req.URL.Path] = body
+		headers[req.URL.Path] = req.Header
+	}))
+	defer server.Close()
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "hooks", Namespace: "demo"},
+		Data:       map[string][]byte{"slack": []byte(server.URL + "/slack"), "password": []byte("p@ss")},
+	}
+	cli := fake.NewClientBuilder().WithObjects(secret).Build()
+	n := &Notification{
+		Subscription: "oncall", Application: "web", Namespace: "demo", Phase: "running",
+		Changes: []Change{{Event: v1alpha1.SubscriptionEventHealthChanged, Message: "the application becomes unhealthy"}},
+	}
+	value := func(path string) *v1alpha1.WebhookURLChannel {
+		return &v1alpha1.WebhookURLChannel{URL: v1alpha1.NotificationValue{Value: server.URL + path}}
+	}
+
+	sent, err := Send(ctx, cli, "demo", "id/slack", v1alpha1.NotificationChannel{Name: "slack", Slack: &v1alpha1.WebhookURLChannel{
+		URL: v1alpha1.NotificationValue{SecretRef: &v1alpha1.NotificationSecret{Name: "hooks", Key: "slack"}},
+	}}, n)
+	r.NoError(err)
+	r.True(sent)
+	r.Equal(n.Text(), bodies["/slack"]["text"])
+	r.Contains(n.Text(), "- HealthChanged: the application becomes unhealthy")
+
+	sent, err = Send(ctx, cli, "demo", "id/dingding", v1alpha1.NotificationChannel{Name: "dingding", DingDing: value("/dingding")}, n)
+	r.NoError(err)
+	r.True(sent)
+	r.Equal("text", bodies["/dingding"]["msgtype"])
+	r.Equal(map[string]interface{}{"content": n.Text()}, bodies["/dingding"]["text"])
+
+	sent, err = Send(ctx, cli, "demo", "id/lark", v1alpha1.NotificationChannel{Name: "lark", Lark: value("/lark")}, n)
+	r.NoError(err)
+	r.True(sent)
+	r.Equal("text", bodies["/lark"]["msg_type"])
+	content, err := json.Marshal(map[string]string{"text": n.Text()})
+	r.NoError(err)
+	r.Equal(string(content), bodies["/lark"]["content"])
+
+	sent, err = Send(ctx, cli, "demo", "id/webhook", v1alpha1.NotificationChannel{Name: "webhook", Webhook: &v1alpha1.GenericWebhookChannel{
+		URL: v1alpha1.NotificationValue{Value: server.URL + "/webhook"}, Headers: map[string]string{"Authorization": "Bearer token"},
+	}}, n)
+	r.NoError(err)
+	r.True(sent)
+	r.Equal("web", bodies["/webhook"]["application"])
+	r.Equal("Bearer token", headers["/webhook"].Get("Authorization"))
+
+	// the email provider waits for the email sent in the background, and succeeds when called again
+	var mails []string
+	defer func(send func(context.Context, *email.MailParams) (*any, error)) { sendEmail = send }(sendEmail)
+	sendEmail = func(_ context.Context, params *email.MailParams) (*any, error) {
+		mails = append(mails, fmt.Sprint(params.ProcessContext.GetData(model.ContextStepSessionID)))
+		if len(mails) == 1 {
+			r.Equal(email.Sender{Address: "vela@example.com", Password: "p@ss", Host: "smtp.example.com", Port: 587}, params.Params.From)
+			r.Equal([]string{"oncall@example.com"}, params.Params.To)
+			r.Equal("[KubeVela] Application demo/web: the application becomes unhealthy", params.Params.Content.Subject)
+			params.Action.Wait("wait for the email")
+			return nil, errors.New("wait")
+		}
+		return nil, nil
+	}
+	mail := v1alpha1.NotificationChannel{Name: "email", Email: &v1alpha1.EmailNotification{
+		From: v1alpha1.EmailSender{Address: "vela@example.com", Host: "smtp.example.com",
+			Password: v1alpha1.NotificationValue{SecretRef: &v1alpha1.NotificationSecret{Name: "hooks", Key: "password"}}},
+		To: []string{"oncall@example.com"},
+	}}
+	sent, err = Send(ctx, cli, "demo", "id/email", mail, n)
+	r.NoError(err)
+	r.False(sent)
+	sent, err = Send(ctx, cli, "demo", "id/email", mail, n)
+	r.NoError(err)
+	r.True(sent)
+	r.Equal([]string{"id/email", "id/email"}, mails)
+
+	for _, channel := range []v1alpha1.NotificationChannel{
+		{Name: "broken", Webhook: &v1alpha1.GenericWebhookChannel{URL: v1alpha1.NotificationValue{Value: server.URL + "/broken"}}},
+		{Name: "missing", Slack: &v1alpha1.WebhookURLChannel{
+			URL: v1alpha1.NotificationValue{SecretRef: &v1alpha1.NotificationSecret{Name: "hooks", Key: "lark"}}}},
+		{Name: "empty"},
+	} {
+		sent, err = Send(ctx, cli, "demo", "id/"+channel.Name, channel, n)
+		r.Error(err, channel.Name)
+		r.False(sent, channel.Name)
+	}
+}
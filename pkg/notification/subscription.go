@@ -0,0 +1,136 @@
+/*
+Copyright 2026 The KubeVela Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notification
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	workflowv1alpha1 "github.com/kubevela/workflow/api/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/oam-dev/kubevela/apis/core.oam.dev/common"
+	"github.com/oam-dev/kubevela/apis/core.oam.dev/v1alpha1"
+	"github.com/oam-dev/kubevela/apis/core.oam.dev/v1beta1"
+)
+
+// stateKeepCondition is the condition type set on the application when the controller fails to keep its resources
+const stateKeepCondition = "StateKeep"
+
+// Change is a change of an application to notify
+type Change struct {
+	Event   v1alpha1.SubscriptionEvent `json:"event"`
+	Message string                     `json:"message"`
+}
+
+// Notification is the notification of the changes of an application, also the body posted to the generic webhook
+type Notification struct {
+	Subscription string      `json:"subscription"`
+	Application  string      `json:"application"`
+	Namespace    string      `json:"namespace"`
+	Phase        string      `json:"phase"`
+	Healthy      bool        `json:"healthy"`
+	Changes      []Change    `json:"changes"`
+	Time         metav1.Time `json:"time"`
+}
+
+// Title is the summary of the notification, used as the subject of the emails
+func (n *Notification) Title() string {
+	return fmt.Sprintf("[KubeVela] Application %s/%s: %s", n.Namespace, n.Application, n.Changes[0].Message)
+}
+
+// Text is the text of the notification sent to the chat channels
+func (n *Notification) Text() string {
+	sb := strings.Builder{}
+	_, _ = fmt.Fprintf(&sb, "Application %s/%s (phase: %s, healthy: %t)\n", n.Namespace, n.Application, n.Phase, n.Healthy)
+	for _, change := range n.Changes {
+		_, _ = fmt.Fprintf(&sb, "- %s: %s\n", change.Event, change.Message)
+	}
+	_, _ = fmt.Fprintf(&sb, "Subscription: %s, time: %s", n.Subscription, n.Time.Format(time.RFC3339))
+	return sb.String()
+}
+
+// Observe returns the current state of the application to compare with the state last notified
+func Observe(app *v1beta1.Application) v1alpha1.SubscribedApplication {
+	state := v1alpha1.SubscribedApplication{
+		Name:    app.Name,
+		Phase:   string(app.Status.Phase),
+		Healthy: isHealthy(app.Status.Services),
+		Drifted: app.Status.GetCondition(stateKeepCondition).Status == corev1.ConditionFalse,
+	}
+	state.WorkflowFailed = app.Status.Phase == common.ApplicationWorkflowFailed
+	if wf := app.Status.Workflow; wf != nil && !state.WorkflowFailed {
+		for _, step := range wf.Steps {
+			if step.Phase == workflowv1alpha1.WorkflowStepPhaseFailed {
+				state.WorkflowFailed = true
+			}
+		}
+	}
+	return state
+}
+
+// Diff returns the changes between the state last notified and the current state that are subscribed by the events,
+// all the changes are returned if the events are empty
+func Diff(last, current v1alpha1.SubscribedApplication, events []v1alpha1.SubscriptionEvent) []Change {
+	subscribed := func(e v1alpha1.SubscriptionEvent) bool {
+		if len(events) == 0 {
+			return true
+		}
+		for _, event := range events {
+			if event == e {
+				return true
+			}
+		}
+		return false
+	}
+	var changes []Change
+	if current.Phase != last.Phase && subscribed(v1alpha1.SubscriptionEventPhaseChanged) {
+		changes = append(changes, Change{Event: v1alpha1.SubscriptionEventPhaseChanged,
+			Message: fmt.Sprintf("phase changed from %s to %s", last.Phase, current.Phase)})
+	}
+	if current.Healthy != last.Healthy && subscribed(v1alpha1.SubscriptionEventHealthChanged) {
+		msg := "the application becomes healthy"
+		if !current.Healthy {
+			msg = "the application becomes unhealthy"
+		}
+		changes = append(changes, Change{Event: v1alpha1.SubscriptionEventHealthChanged, Message: msg})
+	}
+	if current.WorkflowFailed && !last.WorkflowFailed && subscribed(v1alpha1.SubscriptionEventWorkflowFailed) {
+		changes = append(changes, Change{Event: v1alpha1.SubscriptionEventWorkflowFailed, Message: "the workflow failed"})
+	}
+	if current.Drifted && !last.Drifted && subscribed(v1alpha1.SubscriptionEventDrifted) {
+		changes = append(changes, Change{Event: v1alpha1.SubscriptionEventDrifted,
+			Message: "the resources drifted and the controller failed to keep them"})
+	}
+	return changes
+}
+
+func isHealthy(services []common.ApplicationComponentStatus) bool {
+	for _, service := range services {
+		if !service.Healthy {
+			return false
+		}
+		for _, tr := range service.Traits {
+			if !tr.Pending && !tr.Healthy {
+				return false
+			}
+		}
+	}
+	return true
+}
@@ -147,6 +147,16 @@ const (
 	// AnnotationDefinitionRevisionName is used to specify the name of DefinitionRevision in component/trait definition
 	AnnotationDefinitionRevisionName = "definitionrevision.oam.dev/name"
 
+	// AnnotationDefinitionDeprecated marks the definition as deprecated, the value is the deprecation message
+	AnnotationDefinitionDeprecated = "definition.oam.dev/deprecated"
+
+	// AnnotationDefinitionDeprecatedVersions is the semantic version range of the deprecated versions of the definition,
+	// such as "<2.0.0", which is set on the latest version of the definition
+	AnnotationDefinitionDeprecatedVersions = "definition.oam.dev/deprecated-versions"
+
+	// AnnotationDefinitionMigration is the CUE to migrate the properties of the previous major version of the definition
+	AnnotationDefinitionMigration = "definition.oam.dev/migration"
+
 	// AnnotationLastAppliedConfiguration is kubectl annotations for 3-way merge
 	AnnotationLastAppliedConfiguration = "kubectl.kubernetes.io/last-applied-configuration"
 
@@ -207,6 +217,22 @@ const (
 
 	// AnnotationSkipResume annotation indicates that the resource does not need to be resumed.
 	AnnotationSkipResume = "controller.core.oam.dev/skip-resume"
+
+	// AnnotationPromotedFrom records the source application revision (<namespace>/<revision>) the application is promoted from.
+	AnnotationPromotedFrom = "app.oam.dev/promoted-from"
+
+	// AnnotationPromotedFromEnv records the source env the application is promoted from.
+	AnnotationPromotedFromEnv = "app.oam.dev/promoted-from-env"
+
+	// AnnotationPromotedAt records the time the application is promoted.
+	AnnotationPromotedAt = "app.oam.dev/promoted-at"
+
+	// AnnotationPromotionLineage records all the application revisions the application is promoted through, split by comma.
+	AnnotationPromotionLineage = "app.oam.dev/promotion-lineage"
+
+	// AnnotationDeployWindowOverride if set to true, the new revision of the application is rolled out regardless
+	// of the deploy windows. Only the identities allowed to override applications can set it.
+	AnnotationDeployWindowOverride = "app.oam.dev/deploy-window-override"
 )
 
 const (
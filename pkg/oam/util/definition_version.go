@@ -0,0 +1,183 @@
+/*
+Copyright 2026 The KubeVela Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/Masterminds/semver/v3"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/oam-dev/kubevela/apis/core.oam.dev/common"
+	"github.com/oam-dev/kubevela/apis/core.oam.dev/v1beta1"
+	"github.com/oam-dev/kubevela/pkg/oam"
+)
+
+// SplitDefinitionType splits the definition type used in the application into the definition name and the version,
+// e.g., webservice@v2 will be split into webservice and v2
+func SplitDefinitionType(definitionType string) (string, string) {
+	idx := strings.LastIndex(definitionType, "@")
+	if idx < 0 {
+		return definitionType, ""
+	}
+	return definitionType[:idx], definitionType[idx+1:]
+}
+
+// IsDefinitionVersionRange checks if the version of the definition type selects a range of semantic versions instead
+// of one revision, such as v2, v2.1, ^2.1.0, ~2.1.0, 2.x or ">=2.0.0 <3.0.0". Partial versions like v2 could also be
+// the name of a revision numbered by hash, the reference is rejected as ambiguous if both the revision and the
+// versions in the range exist.
+func IsDefinitionVersionRange(version string) bool {
+	if version == "" || strings.ContainsAny(version, "^~<>=!*xX|, ") {
+		return version != ""
+	}
+	parts := strings.Split(strings.TrimPrefix(version, "v"), ".")
+	if len(parts) >= 3 {
+		return false
+	}
+	for _, part := range parts {
+		if part == "" || strings.Trim(part, "0123456789") != "" {
+			return false
+		}
+	}
+	return true
+}
+
+// ParseDefinitionRevisionVersion returns the semantic version of the DefinitionRevision generated from the definition
+// with spec.version, whose name is <definition>-v<version>. Revisions numbered by the hash of the definition only have
+// the major version.
+func ParseDefinitionRevisionVersion(definitionName, revisionName string) (*semver.Version, bool) {
+	version := strings.TrimPrefix(revisionName, definitionName+"-v")
+	if version == revisionName || !strings.Contains(version, ".") {
+		return nil, false
+	}
+	v, err := semver.NewVersion(version)
+	if err != nil {
+		return nil, false
+	}
+	return v, true
+}
+
+// GetDefinitionRevisionNameInRange returns the name of the DefinitionRevision with the highest semantic version in the
+// version range. The revisions in the namespace of the application take precedence over the ones in vela-system.
+func GetDefinitionRevisionNameInRange(ctx context.Context, cli client.Reader, definitionName, versionRange string, definitionType common.DefinitionType) (string, error) {
+	name, err := findDefinitionRevisionNameInRange(ctx, cli, definitionName, versionRange, definitionType)
+	if err != nil {
+		return "", err
+	}
+	if name == "" {
+		return "", fmt.Errorf("no version of definition %s (type %s) matches %s", definitionName, definitionType, versionRange)
+	}
+	return name, nil
+}
+
+// findDefinitionRevisionNameInRange is like GetDefinitionRevisionNameInRange, but returns an empty name if no revision
+// matches the version range
+func findDefinitionRevisionNameInRange(ctx context.Context, cli client.Reader, definitionName, versionRange string, definitionType common.DefinitionType) (string, error) {
+	constraint, err := semver.NewConstraint(versionRange)
+	if err != nil {
+		return "", fmt.Errorf("invalid version range %s of definition %s: %w", versionRange, definitionName, err)
+	}
+	for _, ns := range []string{GetDefinitionNamespaceWithCtx(ctx), oam.SystemDefinitionNamespace} {
+		revisions := &v1beta1.DefinitionRevisionList{}
+		if err := cli.List(ctx, revisions, client.InNamespace(ns), client.MatchingLabels{DefinitionKindToNameLabel[definitionType]: definitionName}); err != nil {
+			return "", err
+		}
+		var latest *semver.Version
+		var latestName string
+		for _, rev := range revisions.Items {
+			if definitionType != "" && rev.Spec.DefinitionType != definitionType {
+				continue
+			}
+			v, ok := ParseDefinitionRevisionVersion(definitionName, rev.Name)
+			if !ok || !constraint.Check(v) {
+				continue
+			}
+			if latest == nil || v.GreaterThan(latest) {
+				latest, latestName = v, rev.Name
+			}
+		}
+		if latestName != "" {
+			return latestName, nil
+		}
+	}
+	return "", nil
+}
+
+// LatestDefinitionRevisionOfEachMajor returns the names of the revisions with the highest semantic version of each
+// major version, so that the major versions of the definition are installed side by side.
+func LatestDefinitionRevisionOfEachMajor(definitionName string, revisions []v1beta1.DefinitionRevision) map[string]bool {
+	latest := map[uint64]*semver.Version{}
+	names := map[uint64]string{}
+	for _, rev := range revisions {
+		v, ok := ParseDefinitionRevisionVersion(definitionName, rev.Name)
+		if !ok || v.Prerelease() != "" {
+			continue
+		}
+		if cur, found := latest[v.Major()]; !found || v.GreaterThan(cur) {
+			latest[v.Major()], names[v.Major()] = v, rev.Name
+		}
+	}
+	result := map[string]bool{}
+	for _, name := range names {
+		result[name] = true
+	}
+	return result
+}
+
+// GetDefinitionVersion returns the semantic version in the spec of the definition
+func GetDefinitionVersion(definition client.Object) string {
+	switch def := definition.(type) {
+	case *v1beta1.ComponentDefinition:
+		return def.Spec.Version
+	case *v1beta1.TraitDefinition:
+		return def.Spec.Version
+	case *v1beta1.PolicyDefinition:
+		return def.Spec.Version
+	case *v1beta1.WorkflowStepDefinition:
+		return def.Spec.Version
+	default:
+		return ""
+	}
+}
+
+// DefinitionDeprecation returns the deprecation message if the version of the definition is deprecated. A version is
+// deprecated if the definition of that version is annotated as deprecated, or if the version is in the deprecated
+// versions range annotated on the latest definition.
+func DefinitionDeprecation(annotations map[string]string, version string, latestAnnotations map[string]string) (string, bool) {
+	if msg, ok := annotations[oam.AnnotationDefinitionDeprecated]; ok && msg != "false" {
+		if msg == "" || msg == "true" {
+			msg = "deprecated"
+		}
+		return msg, true
+	}
+	versionRange := latestAnnotations[oam.AnnotationDefinitionDeprecatedVersions]
+	if versionRange == "" || version == "" {
+		return "", false
+	}
+	constraint, err := semver.NewConstraint(versionRange)
+	if err != nil {
+		return "", false
+	}
+	v, err := semver.NewVersion(version)
+	if err != nil || !constraint.Check(v) {
+		return "", false
+	}
+	return fmt.Sprintf("version %s is deprecated", strings.TrimPrefix(version, "v")), true
+}
@@ -0,0 +1,176 @@
+/*
+Copyright 2026 The KubeVela Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/oam-dev/kubevela/apis/core.oam.dev/common"
+	"github.com/oam-dev/kubevela/apis/core.oam.dev/v1beta1"
+	"github.com/oam-dev/kubevela/pkg/oam"
+	"github.com/oam-dev/kubevela/pkg/oam/util"
+)
+
+func newWebserviceRevision(name, version string) *v1beta1.DefinitionRevision {
+	return &v1beta1.DefinitionRevision{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: oam.SystemDefinitionNamespace,
+			Labels:    map[string]string{oam.LabelComponentDefinitionName: "webservice"},
+		},
+		Spec: v1beta1.DefinitionRevisionSpec{
+			DefinitionType: common.ComponentType,
+			ComponentDefinition: v1beta1.ComponentDefinition{
+				ObjectMeta: metav1.ObjectMeta{Name: "webservice"},
+				Spec:       v1beta1.ComponentDefinitionSpec{Version: version},
+			},
+		},
+	}
+}
+
+func TestSplitDefinitionType(t *testing.T) {
+	r := require.New(t)
+	name, version := util.SplitDefinitionType("webservice@v2")
+	r.Equal("webservice", name)
+	r.Equal("v2", version)
+	name, version = util.SplitDefinitionType("webservice")
+	r.Equal("webservice", name)
+	r.Equal("", version)
+}
+
+func TestIsDefinitionVersionRange(t *testing.T) {
+	for version, expected := range map[string]bool{
+		"":               false,
+		"v2":             true,
+		"v2.1":           true,
+		"2":              true,
+		"v2.1.0":         false,
+		"v2.1.0-rc.1":    false,
+		"^2.1":           true,
+		"~2.1.0":         true,
+		"2.x":            true,
+		">=2.0.0 <3.0.0": true,
+		"v2a":            false,
+	} {
+		require.Equal(t, expected, util.IsDefinitionVersionRange(version), version)
+	}
+}
+
+func TestParseDefinitionRevisionVersion(t *testing.T) {
+	r := require.New(t)
+	v, ok := util.ParseDefinitionRevisionVersion("webservice", "webservice-v1.2.3")
+	r.True(ok)
+	r.Equal("1.2.3", v.String())
+	_, ok = util.ParseDefinitionRevisionVersion("webservice", "webservice-v3")
+	r.False(ok)
+	_, ok = util.ParseDefinitionRevisionVersion("webservice", "worker-v1.2.3")
+	r.False(ok)
+}
+
+func TestLatestDefinitionRevisionOfEachMajor(t *testing.T) {
+	var revisions []v1beta1.DefinitionRevision
+	for name, version := range map[string]string{
+		"webservice-v1":          "",
+		"webservice-v1.2.0":      "1.2.0",
+		"webservice-v1.10.0":     "1.10.0",
+		"webservice-v2.0.0":      "2.0.0",
+		"webservice-v2.1.0":      "2.1.0",
+		"webservice-v3.0.0-rc.1": "3.0.0-rc.1",
+	} {
+		revisions = append(revisions, *newWebserviceRevision(name, version))
+	}
+	require.Equal(t, map[string]bool{
+		"webservice-v1.10.0": true,
+		"webservice-v2.1.0":  true,
+	}, util.LatestDefinitionRevisionOfEachMajor("webservice", revisions))
+}
+
+func TestGetCapabilityDefinitionWithVersionRange(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, v1beta1.AddToScheme(scheme))
+	objs := []client.Object{
+		&v1beta1.ComponentDefinition{
+			ObjectMeta: metav1.ObjectMeta{Name: "webservice", Namespace: oam.SystemDefinitionNamespace},
+			Spec:       v1beta1.ComponentDefinitionSpec{Version: "3.0.0"},
+		},
+		// the revisions numbered by hash
+		newWebserviceRevision("webservice-v4", ""),
+		newWebserviceRevision("webservice-v2", ""),
+	}
+	for _, version := range []string{"1.2.0", "1.10.1", "2.0.0", "2.1.5", "2.2.0", "3.0.0"} {
+		objs = append(objs, newWebserviceRevision("webservice-v"+version, version))
+	}
+	cli := fake.NewClientBuilder().WithScheme(scheme).WithObjects(objs...).Build()
+
+	for typ, expected := range map[string]string{
+		"webservice":                   "3.0.0",
+		"webservice@v1":                "1.10.1",
+		"webservice@v2":                "error",
+		"webservice@^2":                "2.2.0",
+		"webservice@v2.1":              "2.1.5",
+		"webservice@v2.0.0":            "2.0.0",
+		"webservice@^2.1":              "2.2.0",
+		"webservice@~2.1.0":            "2.1.5",
+		"webservice@>=1.5.0 <2.1.0":    "2.0.0",
+		"webservice@2.x":               "2.2.0",
+		"webservice@v4":                "",
+		"webservice@v3.0.0-nonexisted": "error",
+		"webservice@v5":                "error",
+		"webservice@^6":                "error",
+	} {
+		def := &v1beta1.ComponentDefinition{}
+		err := util.GetCapabilityDefinition(context.Background(), cli, def, typ, nil)
+		if expected == "error" {
+			require.Error(t, err, typ)
+			continue
+		}
+		require.NoError(t, err, typ)
+		require.Equal(t, expected, def.Spec.Version, typ)
+	}
+
+	// the reference matching both the revision and the major version is rejected
+	err := util.GetCapabilityDefinition(context.Background(), cli, &v1beta1.ComponentDefinition{}, "webservice@v2", nil)
+	require.ErrorContains(t, err, "ambiguous version v2 of definition webservice")
+}
+
+func TestDefinitionDeprecation(t *testing.T) {
+	r := require.New(t)
+	msg, deprecated := util.DefinitionDeprecation(map[string]string{oam.AnnotationDefinitionDeprecated: "use worker"}, "1.0.0", nil)
+	r.True(deprecated)
+	r.Equal("use worker", msg)
+	msg, deprecated = util.DefinitionDeprecation(map[string]string{oam.AnnotationDefinitionDeprecated: "true"}, "", nil)
+	r.True(deprecated)
+	r.Equal("deprecated", msg)
+	_, deprecated = util.DefinitionDeprecation(map[string]string{oam.AnnotationDefinitionDeprecated: "false"}, "", nil)
+	r.False(deprecated)
+
+	latest := map[string]string{oam.AnnotationDefinitionDeprecatedVersions: "<2.0.0"}
+	msg, deprecated = util.DefinitionDeprecation(nil, "1.4.0", latest)
+	r.True(deprecated)
+	r.Equal("version 1.4.0 is deprecated", msg)
+	_, deprecated = util.DefinitionDeprecation(nil, "2.0.0", latest)
+	r.False(deprecated)
+	_, deprecated = util.DefinitionDeprecation(nil, "", latest)
+	r.False(deprecated)
+}
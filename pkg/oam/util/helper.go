@@ -278,12 +278,16 @@ func fetchDefinitionRevision(ctx context.Context, cli client.Reader, definitionN
 		return true, nil, nil
 	}
 
+	defName, version := SplitDefinitionType(definitionName)
+	isRange := IsDefinitionVersionRange(version)
 	defRevName, err := ConvertDefinitionRevName(definitionName)
 	if err != nil {
-		return false, nil, err
+		if !isRange {
+			return false, nil, err
+		}
+		return fetchDefinitionRevisionInRange(ctx, cli, defName, version, definitionType)
 	}
 
-	defName := strings.Split(definitionName, "@")[0]
 	autoUpdate, ok := annotations[oam.AnnotationAutoUpdate]
 	if ok && autoUpdate == "true" {
 		latestRevisionName, err := GetLatestDefinitionRevisionName(ctx, cli.(client.Client), defName, defRevName, definitionType)
@@ -294,10 +298,41 @@ func fetchDefinitionRevision(ctx context.Context, cli client.Reader, definitionN
 	}
 
 	defRev := new(v1beta1.DefinitionRevision)
-	if err := GetDefinition(ctx, cli, defRev, defRevName); err != nil {
+	err = GetDefinition(ctx, cli, defRev, defRevName)
+	// the auto update resolves the version to the latest revision already
+	if !isRange || autoUpdate == "true" {
+		if err != nil {
+			return false, nil, err
+		}
+		return false, defRev, nil
+	}
+	if err != nil && !apierrors.IsNotFound(err) {
 		return false, nil, err
 	}
+	// partial versions like webservice@v2 are both the name of the revision numbered by hash and a major version,
+	// they are rejected if both exist so that one reference never resolves to different definitions
+	rangeRevName, rangeErr := findDefinitionRevisionNameInRange(ctx, cli, defName, version, definitionType)
+	switch {
+	case rangeErr != nil:
+		return false, nil, rangeErr
+	case err == nil && rangeRevName != "" && rangeRevName != defRevName:
+		return false, nil, fmt.Errorf("ambiguous version %s of definition %s: it matches both the revision %s and the version range of %s, "+
+			"use a version range like @^%s to select the semantic version", version, defName, defRevName, rangeRevName, strings.TrimPrefix(version, "v"))
+	case err == nil:
+		return false, defRev, nil
+	}
+	return fetchDefinitionRevisionInRange(ctx, cli, defName, version, definitionType)
+}
 
+func fetchDefinitionRevisionInRange(ctx context.Context, cli client.Reader, defName, versionRange string, definitionType common.DefinitionType) (bool, *v1beta1.DefinitionRevision, error) {
+	defRevName, err := GetDefinitionRevisionNameInRange(ctx, cli, defName, versionRange, definitionType)
+	if err != nil {
+		return false, nil, err
+	}
+	defRev := new(v1beta1.DefinitionRevision)
+	if err := GetDefinition(ctx, cli, defRev, defRevName); err != nil {
+		return false, nil, err
+	}
 	return false, defRev, nil
 }
 
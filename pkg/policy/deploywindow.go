@@ -0,0 +1,212 @@
+/*
+Copyright 2026 The KubeVela Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package policy
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/robfig/cron/v3"
+	"k8s.io/utils/strings/slices"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/oam-dev/kubevela/apis/core.oam.dev/v1alpha1"
+	"github.com/oam-dev/kubevela/apis/core.oam.dev/v1beta1"
+	"github.com/oam-dev/kubevela/apis/types"
+	"github.com/oam-dev/kubevela/pkg/multicluster"
+)
+
+const (
+	// deployWindowHorizon is how far the next deploy window is searched
+	deployWindowHorizon = 366 * 24 * time.Hour
+	// deployWindowMaxIterations bounds the steps taken to search the next deploy window
+	deployWindowMaxIterations = 1000
+)
+
+// DeployWindowResult is the result of evaluating the deploy-window policies at a given time
+type DeployWindowResult struct {
+	// Open indicates whether the rollout is allowed
+	Open bool
+	// NextOpen is the time when the rollout is allowed again. It is zero if the rollout is open
+	// or no window opens within the search horizon.
+	NextOpen time.Time
+	// Message describes why the rollout is not allowed
+	Message string
+}
+
+// GetDeployWindowPolicies returns the deploy-window policies of the application, together with the
+// cluster-wide default policies in the system namespace selecting the application
+func GetDeployWindowPolicies(ctx context.Context, cli client.Client, app *v1beta1.Application) ([]*v1alpha1.DeployWindowPolicySpec, error) {
+	var specs []*v1alpha1.DeployWindowPolicySpec
+	spec, err := ParsePolicy[v1alpha1.DeployWindowPolicySpec](app)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to parse deploy-window policy")
+	}
+	if spec != nil {
+		spec.Selector = nil
+		specs = append(specs, spec)
+	}
+	policies := &v1alpha1.PolicyList{}
+	if err = cli.List(multicluster.ContextInLocalCluster(ctx), policies, client.InNamespace(types.DefaultKubeVelaNS)); err != nil {
+		return nil, errors.Wrapf(err, "failed to list default deploy-window policies")
+	}
+	for _, p := range policies.Items {
+		if p.Type != v1alpha1.DeployWindowPolicyType || p.Properties == nil || p.Properties.Raw == nil {
+			continue
+		}
+		def := &v1alpha1.DeployWindowPolicySpec{}
+		if err = json.Unmarshal(p.Properties.Raw, def); err != nil {
+			return nil, errors.Wrapf(err, "failed to parse default deploy-window policy %s", p.Name)
+		}
+		if MatchDeployWindowSelector(def.Selector, app) {
+			specs = append(specs, def)
+		}
+	}
+	return specs, nil
+}
+
+// MatchDeployWindowSelector checks if the application is selected by the selector. A nil selector selects all.
+func MatchDeployWindowSelector(selector *v1alpha1.DeployWindowSelector, app *v1beta1.Application) bool {
+	if selector == nil {
+		return true
+	}
+	if len(selector.Namespaces) > 0 && !slices.Contains(selector.Namespaces, app.Namespace) {
+		return false
+	}
+	for k, v := range selector.ApplicationLabels {
+		if app.GetLabels()[k] != v {
+			return false
+		}
+	}
+	return true
+}
+
+// deployWindowSchedule is the parsed deploy window
+type deployWindowSchedule struct {
+	schedule cron.Schedule
+	duration time.Duration
+}
+
+// parsedDeployWindowPolicy is the parsed deploy-window policy
+type parsedDeployWindowPolicy struct {
+	spec     *v1alpha1.DeployWindowPolicySpec
+	location *time.Location
+	windows  []deployWindowSchedule
+}
+
+func parseDeployWindowPolicy(spec *v1alpha1.DeployWindowPolicySpec) (*parsedDeployWindowPolicy, error) {
+	p := &parsedDeployWindowPolicy{spec: spec, location: time.UTC}
+	if spec.TimeZone != "" {
+		loc, err := time.LoadLocation(spec.TimeZone)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid time zone %s", spec.TimeZone)
+		}
+		p.location = loc
+	}
+	for _, w := range spec.Windows {
+		schedule, err := cron.ParseStandard(w.Schedule)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid schedule %q", w.Schedule)
+		}
+		duration, err := time.ParseDuration(w.Duration)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid duration %q", w.Duration)
+		}
+		if duration <= 0 {
+			return nil, fmt.Errorf("duration of the window %q must be positive", w.Schedule)
+		}
+		p.windows = append(p.windows, deployWindowSchedule{schedule: schedule, duration: duration})
+	}
+	return p, nil
+}
+
+// blockedUntil checks if the rollout is blocked by the policy at time t. If blocked, it returns the earliest
+// time the policy might allow the rollout, which is zero if no window will open, and the reason.
+func (p *parsedDeployWindowPolicy) blockedUntil(t time.Time) (bool, time.Time, string) {
+	for _, b := range p.spec.Blackouts {
+		if !t.Before(b.Start.Time) && t.Before(b.End.Time) {
+			msg := fmt.Sprintf("in blackout until %s", b.End.Time.UTC().Format(time.RFC3339))
+			if b.Reason != "" {
+				msg = fmt.Sprintf("%s (%s)", msg, b.Reason)
+			}
+			return true, b.End.Time, msg
+		}
+	}
+	if len(p.windows) == 0 {
+		return false, time.Time{}, ""
+	}
+	local := t.In(p.location)
+	var next time.Time
+	for _, w := range p.windows {
+		// the window started in (t-duration, t] contains t
+		if start := w.schedule.Next(local.Add(-w.duration)); !start.After(local) {
+			return false, time.Time{}, ""
+		}
+		if start := w.schedule.Next(local); !start.IsZero() && (next.IsZero() || start.Before(next)) {
+			next = start
+		}
+	}
+	return true, next, "outside deploy windows"
+}
+
+// EvaluateDeployWindows evaluates the deploy-window policies at the given time. The rollout is allowed only
+// if all the policies allow it. If not allowed, the next time all of them allow the rollout is searched.
+func EvaluateDeployWindows(specs []*v1alpha1.DeployWindowPolicySpec, now time.Time) (*DeployWindowResult, error) {
+	var policies []*parsedDeployWindowPolicy
+	for _, spec := range specs {
+		p, err := parseDeployWindowPolicy(spec)
+		if err != nil {
+			return nil, err
+		}
+		policies = append(policies, p)
+	}
+	result := &DeployWindowResult{Open: true}
+	t := now
+	for i := 0; i < deployWindowMaxIterations && !t.After(now.Add(deployWindowHorizon)); i++ {
+		var until time.Time
+		blocked, never := false, false
+		for _, p := range policies {
+			b, u, msg := p.blockedUntil(t)
+			if !b {
+				continue
+			}
+			if !blocked && t.Equal(now) {
+				result.Open, result.Message = false, msg
+			}
+			blocked = true
+			if u.IsZero() {
+				never = true
+			} else if u.After(until) {
+				until = u
+			}
+		}
+		if !blocked {
+			if !t.Equal(now) {
+				result.NextOpen = t
+			}
+			return result, nil
+		}
+		if never {
+			break
+		}
+		t = until
+	}
+	return result, nil
+}
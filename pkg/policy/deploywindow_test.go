@@ -0,0 +1,145 @@
+/*
+Copyright 2026 The KubeVela Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package policy
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/oam-dev/kubevela/apis/core.oam.dev/v1alpha1"
+	"github.com/oam-dev/kubevela/apis/core.oam.dev/v1beta1"
+	"github.com/oam-dev/kubevela/apis/types"
+	velacommon "github.com/oam-dev/kubevela/pkg/utils/common"
+)
+
+func TestEvaluateDeployWindows(t *testing.T) {
+	// Monday
+	date := func(day, hour, minute int) time.Time { return time.Date(2026, 10, day, hour, minute, 0, 0, time.UTC) }
+	weeknights := &v1alpha1.DeployWindowPolicySpec{Windows: []v1alpha1.DeployWindow{{Schedule: "0 22 * * 1-5", Duration: "4h"}}}
+	freeze := &v1alpha1.DeployWindowPolicySpec{Blackouts: []v1alpha1.DeployBlackout{{
+		Start: metav1.NewTime(date(20, 0, 0)), End: metav1.NewTime(date(22, 0, 0)), Reason: "release freeze",
+	}}}
+
+	testCases := map[string]struct {
+		specs    []*v1alpha1.DeployWindowPolicySpec
+		now      time.Time
+		open     bool
+		nextOpen time.Time
+		message  string
+	}{
+		"no-policy": {now: date(19, 12, 0), open: true},
+		"inside-window": {
+			specs: []*v1alpha1.DeployWindowPolicySpec{weeknights},
+			now:   date(19, 23, 0),
+			open:  true,
+		},
+		"window-across-midnight": {
+			specs: []*v1alpha1.DeployWindowPolicySpec{weeknights},
+			now:   date(20, 1, 59),
+			open:  true,
+		},
+		"outside-window": {
+			specs:    []*v1alpha1.DeployWindowPolicySpec{weeknights},
+			now:      date(19, 12, 0),
+			nextOpen: date(19, 22, 0),
+			message:  "outside deploy windows",
+		},
+		"weekend": {
+			specs:    []*v1alpha1.DeployWindowPolicySpec{weeknights},
+			now:      date(24, 12, 0),
+			nextOpen: date(26, 22, 0),
+			message:  "outside deploy windows",
+		},
+		"in-blackout": {
+			specs:    []*v1alpha1.DeployWindowPolicySpec{freeze},
+			now:      date(21, 12, 0),
+			nextOpen: date(22, 0, 0),
+			message:  "in blackout until 2026-10-22T00:00:00Z (release freeze)",
+		},
+		"blackout-overrides-window": {
+			specs:    []*v1alpha1.DeployWindowPolicySpec{weeknights, freeze},
+			now:      date(20, 23, 0),
+			nextOpen: date(22, 0, 0),
+			message:  "in blackout until 2026-10-22T00:00:00Z (release freeze)",
+		},
+		"window-after-blackout": {
+			specs:    []*v1alpha1.DeployWindowPolicySpec{weeknights, freeze},
+			now:      date(20, 12, 0),
+			nextOpen: date(22, 0, 0),
+			message:  "outside deploy windows",
+		},
+		"time-zone": {
+			specs: []*v1alpha1.DeployWindowPolicySpec{{
+				TimeZone: "Asia/Shanghai",
+				Windows:  []v1alpha1.DeployWindow{{Schedule: "0 9 * * *", Duration: "1h"}},
+			}},
+			now:      date(19, 2, 0),
+			nextOpen: date(20, 1, 0),
+			message:  "outside deploy windows",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			result, err := EvaluateDeployWindows(tc.specs, tc.now)
+			require.NoError(t, err)
+			require.Equal(t, tc.open, result.Open)
+			require.True(t, tc.nextOpen.Equal(result.NextOpen), "expected %s, got %s", tc.nextOpen, result.NextOpen)
+			require.Equal(t, tc.message, result.Message)
+		})
+	}
+
+	_, err := EvaluateDeployWindows([]*v1alpha1.DeployWindowPolicySpec{{Windows: []v1alpha1.DeployWindow{{Schedule: "bad", Duration: "1h"}}}}, time.Now())
+	require.Error(t, err)
+	_, err = EvaluateDeployWindows([]*v1alpha1.DeployWindowPolicySpec{{Windows: []v1alpha1.DeployWindow{{Schedule: "0 * * * *", Duration: "0s"}}}}, time.Now())
+	require.Error(t, err)
+}
+
+func TestGetDeployWindowPolicies(t *testing.T) {
+	ctx := context.Background()
+	defaultPolicy := func(name, props string) *v1alpha1.Policy {
+		return &v1alpha1.Policy{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: types.DefaultKubeVelaNS},
+			Type:       v1alpha1.DeployWindowPolicyType,
+			Properties: &runtime.RawExtension{Raw: []byte(props)},
+		}
+	}
+	cli := fake.NewClientBuilder().WithScheme(velacommon.Scheme).WithObjects(
+		defaultPolicy("all", `{"timeZone":"UTC"}`),
+		defaultPolicy("prod", `{"timeZone":"Europe/Berlin","selector":{"namespaces":["prod"]}}`),
+		defaultPolicy("critical", `{"timeZone":"Asia/Tokyo","selector":{"applicationLabels":{"tier":"critical"}}}`),
+	).Build()
+	app := &v1beta1.Application{
+		ObjectMeta: metav1.ObjectMeta{Name: "app", Namespace: "prod", Labels: map[string]string{"tier": "normal"}},
+		Spec: v1beta1.ApplicationSpec{Policies: []v1beta1.AppPolicy{{
+			Name: "window", Type: v1alpha1.DeployWindowPolicyType,
+			Properties: &runtime.RawExtension{Raw: []byte(`{"timeZone":"America/New_York"}`)},
+		}}},
+	}
+	specs, err := GetDeployWindowPolicies(ctx, cli, app)
+	require.NoError(t, err)
+	var zones []string
+	for _, spec := range specs {
+		zones = append(zones, spec.TimeZone)
+	}
+	require.ElementsMatch(t, []string{"America/New_York", "UTC", "Europe/Berlin"}, zones)
+}
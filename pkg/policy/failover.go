@@ -0,0 +1,256 @@
+/*
+Copyright 2026 The KubeVela Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package policy
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/strings/slices"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/oam-dev/kubevela/apis/core.oam.dev/common"
+	"github.com/oam-dev/kubevela/apis/core.oam.dev/v1alpha1"
+	"github.com/oam-dev/kubevela/apis/core.oam.dev/v1beta1"
+	"github.com/oam-dev/kubevela/pkg/multicluster"
+)
+
+// ClusterHealth records the observed health of a placement watched by the failover policy
+type ClusterHealth struct {
+	Placement v1alpha1.PlacementDecision
+	Healthy   bool
+	Message   string
+}
+
+// GetFailoverWatchedPolicies returns the topology policies watched by the failover policy
+func GetFailoverWatchedPolicies(spec *v1alpha1.FailoverPolicySpec, policies []v1beta1.AppPolicy) []v1beta1.AppPolicy {
+	var watched []v1beta1.AppPolicy
+	for _, policy := range policies {
+		if policy.Type != v1alpha1.TopologyPolicyType {
+			continue
+		}
+		if len(spec.Topologies) == 0 || slices.Contains(spec.Topologies, policy.Name) {
+			watched = append(watched, policy)
+		}
+	}
+	return watched
+}
+
+// ClusterProbeInterval is the minimum interval between two reachability probes of the same placement. The probe
+// result is shared by all the applications watching the placement within the interval.
+var ClusterProbeInterval = 30 * time.Second
+
+type clusterProbe struct {
+	err  error
+	time time.Time
+}
+
+var (
+	clusterProbesMu sync.Mutex
+	clusterProbes   = map[string]clusterProbe{}
+)
+
+// probeCluster checks whether the namespace of the cluster is reachable, the result is cached for ClusterProbeInterval
+func probeCluster(ctx context.Context, cli client.Client, cluster string, namespace string) error {
+	key := cluster + "/" + namespace
+	clusterProbesMu.Lock()
+	probe, ok := clusterProbes[key]
+	clusterProbesMu.Unlock()
+	if ok && time.Since(probe.time) < ClusterProbeInterval {
+		return probe.err
+	}
+	err := cli.Get(multicluster.ContextWithClusterName(ctx, cluster), client.ObjectKey{Name: namespace}, &corev1.Namespace{})
+	if kerrors.IsNotFound(err) {
+		err = nil
+	}
+	clusterProbesMu.Lock()
+	clusterProbes[key] = clusterProbe{err: err, time: time.Now()}
+	clusterProbesMu.Unlock()
+	return err
+}
+
+// ObserveClusterHealth checks the health of the given placement. A placement is unhealthy if the cluster is
+// unreachable or all the components dispatched to it are unhealthy. For the placement that has already failed
+// over, no component is running there anymore, so only the reachability of the cluster is checked. The reachability
+// probes are rate limited by ClusterProbeInterval.
+func ObserveClusterHealth(ctx context.Context, cli client.Client, placement v1alpha1.PlacementDecision, services []common.ApplicationComponentStatus, failedOver bool) ClusterHealth {
+	observation := ClusterHealth{Placement: placement, Healthy: true}
+	ns := placement.Namespace
+	if ns == "" {
+		ns = metav1.NamespaceDefault
+	}
+	if err := probeCluster(ctx, cli, placement.Cluster, ns); err != nil {
+		observation.Healthy = false
+		observation.Message = fmt.Sprintf("cluster %s is unreachable: %s", placement.Cluster, err.Error())
+		return observation
+	}
+	if failedOver {
+		return observation
+	}
+	var total, unhealthy int
+	for _, svc := range services {
+		cluster := svc.Cluster
+		if cluster == "" {
+			cluster = multicluster.ClusterLocalName
+		}
+		if cluster != placement.Cluster {
+			continue
+		}
+		total++
+		if !svc.Healthy {
+			unhealthy++
+		}
+	}
+	if total > 0 && unhealthy == total {
+		observation.Healthy = false
+		observation.Message = fmt.Sprintf("all %d components in cluster %s are unhealthy", total, placement.Cluster)
+	}
+	return observation
+}
+
+// UpdateFailoverDecisions computes the latest failover decisions from the previous decisions and the observed
+// health of the watched placements. A placement fails over to one of the standby clusters after it stays unhealthy
+// for the grace period, and fails back after it stays healthy again for the grace period.
+// The returned duration is the time after which the decisions should be re-evaluated, 0 means no pending transition.
+func UpdateFailoverDecisions(spec *v1alpha1.FailoverPolicySpec, decisions []common.FailoverDecision, observations []ClusterHealth, standbyClusters []string, now time.Time) ([]common.FailoverDecision, time.Duration) {
+	gracePeriod := spec.GetGracePeriod()
+	var requeue time.Duration
+	wait := func(since metav1.Time) bool {
+		remaining := since.Add(gracePeriod).Sub(now)
+		if remaining <= 0 {
+			return false
+		}
+		if requeue == 0 || remaining < requeue {
+			requeue = remaining
+		}
+		return true
+	}
+
+	used := map[string]bool{}
+	for _, observation := range observations {
+		used[observation.Placement.Cluster] = true
+	}
+	for _, decision := range decisions {
+		if decision.IsFailedOver() {
+			used[decision.StandbyCluster] = true
+		}
+	}
+	standbys := append([]string{}, standbyClusters...)
+	sort.Strings(standbys)
+	pickStandby := func() string {
+		for _, cluster := range standbys {
+			if !used[cluster] {
+				used[cluster] = true
+				return cluster
+			}
+		}
+		return ""
+	}
+
+	var updated []common.FailoverDecision
+	for _, observation := range observations {
+		var decision *common.FailoverDecision
+		for i := range decisions {
+			if decisions[i].Cluster == observation.Placement.Cluster && decisions[i].Namespace == observation.Placement.Namespace {
+				decision = decisions[i].DeepCopy()
+				break
+			}
+		}
+		switch {
+		case !observation.Healthy && decision == nil:
+			decision = &common.FailoverDecision{
+				Cluster:        observation.Placement.Cluster,
+				Namespace:      observation.Placement.Namespace,
+				UnhealthySince: metav1.NewTime(now),
+				Message:        observation.Message,
+			}
+			wait(decision.UnhealthySince)
+		case !observation.Healthy && !decision.IsFailedOver():
+			decision.Message = observation.Message
+			if wait(decision.UnhealthySince) {
+				break
+			}
+			if decision.StandbyCluster = pickStandby(); decision.StandbyCluster == "" {
+				decision.Message = fmt.Sprintf("%s, no standby cluster available", observation.Message)
+			}
+		case !observation.Healthy:
+			decision.RecoveredSince = metav1.Time{}
+			decision.Message = observation.Message
+		case decision == nil || !decision.IsFailedOver():
+			decision = nil
+		case spec.DisableFailback:
+			decision.Message = fmt.Sprintf("cluster %s recovered, failback is disabled", decision.Cluster)
+		default:
+			if decision.RecoveredSince.IsZero() {
+				decision.RecoveredSince = metav1.NewTime(now)
+			}
+			decision.Message = fmt.Sprintf("cluster %s recovered", decision.Cluster)
+			if !wait(decision.RecoveredSince) {
+				decision = nil
+			}
+		}
+		if decision != nil {
+			updated = append(updated, *decision)
+		}
+	}
+	return updated, requeue
+}
+
+// ApplyFailoverDecisions replaces the failed placements with their standby clusters
+func ApplyFailoverDecisions(placements []v1alpha1.PlacementDecision, decisions []common.FailoverDecision) []v1alpha1.PlacementDecision {
+	if len(decisions) == 0 {
+		return placements
+	}
+	var result []v1alpha1.PlacementDecision
+	found := map[string]bool{}
+	for _, placement := range placements {
+		for _, decision := range decisions {
+			if decision.IsFailedOver() && decision.Cluster == placement.Cluster && decision.Namespace == placement.Namespace {
+				placement = v1alpha1.PlacementDecision{Cluster: decision.StandbyCluster, Namespace: placement.Namespace}
+				break
+			}
+		}
+		if !found[placement.String()] {
+			found[placement.String()] = true
+			result = append(result, placement)
+		}
+	}
+	return result
+}
+
+// ListStandbyClusters lists the clusters matching the standby cluster label selector of the failover policy
+func ListStandbyClusters(ctx context.Context, cli client.Client, spec *v1alpha1.FailoverPolicySpec) ([]string, error) {
+	if len(spec.StandbyClusterLabelSelector) == 0 {
+		return nil, nil
+	}
+	clusters, err := multicluster.NewClusterClient(cli).List(ctx, client.MatchingLabels(spec.StandbyClusterLabelSelector))
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to list standby clusters")
+	}
+	var names []string
+	for _, cluster := range clusters.Items {
+		names = append(names, cluster.Name)
+	}
+	return names, nil
+}
@@ -0,0 +1,193 @@
+/*
+Copyright 2026 The KubeVela Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package policy
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	"github.com/oam-dev/kubevela/apis/core.oam.dev/common"
+	"github.com/oam-dev/kubevela/apis/core.oam.dev/v1alpha1"
+	"github.com/oam-dev/kubevela/apis/core.oam.dev/v1beta1"
+	velacommon "github.com/oam-dev/kubevela/pkg/utils/common"
+)
+
+func TestUpdateFailoverDecisions(t *testing.T) {
+	now := time.Now()
+	spec := &v1alpha1.FailoverPolicySpec{GracePeriodSeconds: ptr.To(int64(60))}
+	placementA := v1alpha1.PlacementDecision{Cluster: "cluster-a", Namespace: "default"}
+	placementB := v1alpha1.PlacementDecision{Cluster: "cluster-b", Namespace: "default"}
+	standbys := []string{"cluster-b", "standby-2", "standby-1"}
+
+	// cluster-a becomes unhealthy, wait for the grace period
+	decisions, requeue := UpdateFailoverDecisions(spec, nil, []ClusterHealth{
+		{Placement: placementA, Healthy: false, Message: "unreachable"},
+		{Placement: placementB, Healthy: true},
+	}, standbys, now)
+	require.Len(t, decisions, 1)
+	require.False(t, decisions[0].IsFailedOver())
+	require.Equal(t, "unreachable", decisions[0].Message)
+	require.Equal(t, time.Minute, requeue)
+
+	// still within the grace period
+	decisions, requeue = UpdateFailoverDecisions(spec, decisions, []ClusterHealth{
+		{Placement: placementA, Healthy: false, Message: "unreachable"},
+	}, standbys, now.Add(20*time.Second))
+	require.Len(t, decisions, 1)
+	require.False(t, decisions[0].IsFailedOver())
+	require.Equal(t, 40*time.Second, requeue)
+
+	// grace period passed, fail over to the first unused standby cluster
+	decisions, requeue = UpdateFailoverDecisions(spec, decisions, []ClusterHealth{
+		{Placement: placementA, Healthy: false, Message: "unreachable"},
+		{Placement: placementB, Healthy: true},
+	}, standbys, now.Add(time.Minute))
+	require.Len(t, decisions, 1)
+	require.Equal(t, "standby-1", decisions[0].StandbyCluster)
+	require.Equal(t, time.Duration(0), requeue)
+
+	// cluster-a recovers, wait for the grace period before failback
+	decisions, requeue = UpdateFailoverDecisions(spec, decisions, []ClusterHealth{
+		{Placement: placementA, Healthy: true},
+	}, standbys, now.Add(2*time.Minute))
+	require.Len(t, decisions, 1)
+	require.True(t, decisions[0].IsFailedOver())
+	require.False(t, decisions[0].RecoveredSince.IsZero())
+	require.Equal(t, time.Minute, requeue)
+
+	// cluster-a flaps, the recovery is reset
+	flapped, _ := UpdateFailoverDecisions(spec, decisions, []ClusterHealth{
+		{Placement: placementA, Healthy: false},
+	}, standbys, now.Add(150*time.Second))
+	require.Len(t, flapped, 1)
+	require.True(t, flapped[0].RecoveredSince.IsZero())
+
+	// failback is disabled
+	disabled := spec.DeepCopy()
+	disabled.DisableFailback = true
+	kept, _ := UpdateFailoverDecisions(disabled, decisions, []ClusterHealth{
+		{Placement: placementA, Healthy: true},
+	}, standbys, now.Add(10*time.Minute))
+	require.Len(t, kept, 1)
+	require.Equal(t, "standby-1", kept[0].StandbyCluster)
+
+	// cluster-a stays healthy for the grace period, fail back
+	decisions, _ = UpdateFailoverDecisions(spec, decisions, []ClusterHealth{
+		{Placement: placementA, Healthy: true},
+	}, standbys, now.Add(3*time.Minute))
+	require.Len(t, decisions, 0)
+}
+
+func TestUpdateFailoverDecisionsWithoutStandby(t *testing.T) {
+	now := time.Now()
+	spec := &v1alpha1.FailoverPolicySpec{GracePeriodSeconds: ptr.To(int64(0))}
+	placement := v1alpha1.PlacementDecision{Cluster: "cluster-a"}
+	decisions, _ := UpdateFailoverDecisions(spec, []common.FailoverDecision{{
+		Cluster:        "cluster-a",
+		UnhealthySince: metav1.NewTime(now),
+	}}, []ClusterHealth{{Placement: placement, Healthy: false, Message: "unhealthy"}}, []string{"cluster-a"}, now)
+	require.Len(t, decisions, 1)
+	require.False(t, decisions[0].IsFailedOver())
+	require.Contains(t, decisions[0].Message, "no standby cluster available")
+
+	// recovered within the grace period, the decision is dropped
+	decisions, _ = UpdateFailoverDecisions(spec, decisions, []ClusterHealth{{Placement: placement, Healthy: true}}, nil, now)
+	require.Len(t, decisions, 0)
+}
+
+func TestApplyFailoverDecisions(t *testing.T) {
+	placements := []v1alpha1.PlacementDecision{
+		{Cluster: "cluster-a", Namespace: "default"},
+		{Cluster: "cluster-b", Namespace: "default"},
+	}
+	require.Equal(t, placements, ApplyFailoverDecisions(placements, nil))
+	require.Equal(t, []v1alpha1.PlacementDecision{
+		{Cluster: "standby", Namespace: "default"},
+		{Cluster: "cluster-b", Namespace: "default"},
+	}, ApplyFailoverDecisions(placements, []common.FailoverDecision{
+		{Cluster: "cluster-a", Namespace: "default", StandbyCluster: "standby"},
+		{Cluster: "cluster-b", Namespace: "default"},
+	}))
+	require.Equal(t, []v1alpha1.PlacementDecision{
+		{Cluster: "cluster-b", Namespace: "default"},
+	}, ApplyFailoverDecisions(placements, []common.FailoverDecision{
+		{Cluster: "cluster-a", Namespace: "default", StandbyCluster: "cluster-b"},
+	}))
+}
+
+func TestGetFailoverWatchedPolicies(t *testing.T) {
+	policies := []v1beta1.AppPolicy{
+		{Name: "topology-a", Type: v1alpha1.TopologyPolicyType},
+		{Name: "topology-b", Type: v1alpha1.TopologyPolicyType},
+		{Name: "override", Type: v1alpha1.OverridePolicyType},
+	}
+	require.Len(t, GetFailoverWatchedPolicies(&v1alpha1.FailoverPolicySpec{}, policies), 2)
+	watched := GetFailoverWatchedPolicies(&v1alpha1.FailoverPolicySpec{Topologies: []string{"topology-b"}}, policies)
+	require.Len(t, watched, 1)
+	require.Equal(t, "topology-b", watched[0].Name)
+}
+
+func TestObserveClusterHealth(t *testing.T) {
+	cli := fake.NewClientBuilder().WithScheme(velacommon.Scheme).WithObjects(&corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{Name: "default"},
+	}).Build()
+	placement := v1alpha1.PlacementDecision{Cluster: "local"}
+	services := []common.ApplicationComponentStatus{
+		{Name: "a", Healthy: false},
+		{Name: "b", Cluster: "local", Healthy: true},
+		{Name: "c", Cluster: "remote", Healthy: false},
+	}
+	require.True(t, ObserveClusterHealth(context.Background(), cli, placement, services, false).Healthy)
+	services[1].Healthy = false
+	observation := ObserveClusterHealth(context.Background(), cli, placement, services, false)
+	require.False(t, observation.Healthy)
+	require.Contains(t, observation.Message, "all 2 components")
+	require.True(t, ObserveClusterHealth(context.Background(), cli, placement, services, true).Healthy)
+}
+
+func TestObserveClusterHealthProbeCached(t *testing.T) {
+	probes := 0
+	cli := fake.NewClientBuilder().WithScheme(velacommon.Scheme).WithInterceptorFuncs(interceptor.Funcs{
+		Get: func(_ context.Context, _ client.WithWatch, _ client.ObjectKey, _ client.Object, _ ...client.GetOption) error {
+			probes++
+			return errors.New("connection refused")
+		},
+	}).Build()
+	placement := v1alpha1.PlacementDecision{Cluster: "probe-cached", Namespace: "default"}
+	for i := 0; i < 3; i++ {
+		observation := ObserveClusterHealth(context.Background(), cli, placement, nil, true)
+		require.False(t, observation.Healthy)
+		require.Contains(t, observation.Message, "connection refused")
+	}
+	require.Equal(t, 1, probes)
+
+	interval := ClusterProbeInterval
+	ClusterProbeInterval = 0
+	defer func() { ClusterProbeInterval = interval }()
+	ObserveClusterHealth(context.Background(), cli, placement, nil, true)
+	require.Equal(t, 2, probes)
+}
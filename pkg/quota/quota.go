@@ -0,0 +1,350 @@
+/*
+Copyright 2026 The KubeVela Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package quota
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/oam-dev/kubevela/apis/core.oam.dev/v1alpha1"
+	"github.com/oam-dev/kubevela/apis/core.oam.dev/v1beta1"
+	"github.com/oam-dev/kubevela/pkg/monitor/metrics"
+	"github.com/oam-dev/kubevela/pkg/multicluster"
+	"github.com/oam-dev/kubevela/pkg/oam"
+)
+
+// Resource names of the quota, used in the error messages and metrics
+const (
+	ResourceApplications                   = "applications"
+	ResourceComponentsPerApplication       = "componentsPerApplication"
+	ResourceManagedResourcesPerApplication = "managedResourcesPerApplication"
+	ResourceClustersPerApplication         = "clustersPerApplication"
+	ResourceReplicas                       = "replicas"
+)
+
+// Resources lists all the resources restricted by the quota
+var Resources = []string{
+	ResourceApplications,
+	ResourceComponentsPerApplication,
+	ResourceManagedResourcesPerApplication,
+	ResourceClustersPerApplication,
+	ResourceReplicas,
+}
+
+// Usage records the usage of the resources restricted by the quota in a namespace.
+// For the per-application resources, the usage is the maximal usage of all the applications.
+type Usage map[string]int64
+
+// ErrQuotaExceeded is returned when the quota is exceeded
+type ErrQuotaExceeded struct {
+	Quota    string
+	Resource string
+	Limit    int64
+	Used     int64
+}
+
+// Error implements error
+func (err ErrQuotaExceeded) Error() string {
+	return fmt.Sprintf("exceeded quota %s: %s limited to %d, requested %d", err.Quota, err.Resource, err.Limit, err.Used)
+}
+
+// GetLimit returns the limit of the resource in the quota, nil means unrestricted
+func GetLimit(quota *v1alpha1.VelaQuota, resource string) *int64 {
+	switch resource {
+	case ResourceApplications:
+		return quota.Spec.Applications
+	case ResourceComponentsPerApplication:
+		return quota.Spec.ComponentsPerApplication
+	case ResourceManagedResourcesPerApplication:
+		return quota.Spec.ManagedResourcesPerApplication
+	case ResourceClustersPerApplication:
+		return quota.Spec.ClustersPerApplication
+	case ResourceReplicas:
+		return quota.Spec.Replicas
+	default:
+		return nil
+	}
+}
+
+// ListQuotas lists the quotas in the namespace. No quota is returned if the VelaQuota CRD is not installed.
+func ListQuotas(ctx context.Context, cli client.Client, namespace string) ([]v1alpha1.VelaQuota, error) {
+	quotas := &v1alpha1.VelaQuotaList{}
+	if err := cli.List(multicluster.ContextInLocalCluster(ctx), quotas, client.InNamespace(namespace)); err != nil {
+		if meta.IsNoMatchError(err) || runtime.IsNotRegisteredError(err) {
+			return nil, nil
+		}
+		return nil, errors.Wrapf(err, "failed to list quotas in namespace %s", namespace)
+	}
+	return quotas.Items, nil
+}
+
+// check checks the used amount of the resource against all the quotas
+func check(quotas []v1alpha1.VelaQuota, resource string, used int64) error {
+	for i := range quotas {
+		if limit := GetLimit(&quotas[i], resource); limit != nil && used > *limit {
+			return ErrQuotaExceeded{Quota: quotas[i].Name, Resource: resource, Limit: *limit, Used: used}
+		}
+	}
+	return nil
+}
+
+// ValidateApplication checks the application against the quotas in its namespace. The number of applications is
+// only checked for the application to be created, so that the existing applications can still be updated after a
+// quota is tightened.
+func ValidateApplication(ctx context.Context, cli client.Client, app *v1beta1.Application, create bool) error {
+	quotas, err := ListQuotas(ctx, cli, app.Namespace)
+	if err != nil || len(quotas) == 0 {
+		return err
+	}
+	if err = check(quotas, ResourceComponentsPerApplication, int64(len(app.Spec.Components))); err != nil {
+		return err
+	}
+	if !create {
+		return nil
+	}
+	apps := &v1beta1.ApplicationList{}
+	if err = cli.List(ctx, apps, client.InNamespace(app.Namespace)); err != nil {
+		return errors.Wrapf(err, "failed to list applications in namespace %s", app.Namespace)
+	}
+	count := int64(1)
+	for _, item := range apps.Items {
+		if item.Name != app.Name {
+			count++
+		}
+	}
+	return check(quotas, ResourceApplications, count)
+}
+
+// OtherReplicas is the replicas used by the other applications in the namespace of an application. It is loaded
+// once and shared by the checks of all the dispatches in a reconcile, instead of listing the resourcetrackers of the
+// namespace in every dispatch.
+type OtherReplicas struct {
+	once     sync.Once
+	replicas int64
+	err      error
+}
+
+// Get loads the replicas used by the applications in the namespace of the application other than itself
+func (o *OtherReplicas) Get(ctx context.Context, cli client.Client, app *v1beta1.Application) (int64, error) {
+	o.once.Do(func() {
+		others, err := listApplicationResources(ctx, cli, app.Namespace)
+		if err != nil {
+			o.err = err
+			return
+		}
+		for name, rs := range others {
+			if name != app.Name {
+				o.replicas += usageOf(rs)[ResourceReplicas]
+			}
+		}
+	})
+	return o.replicas, o.err
+}
+
+// ValidateDispatch checks the resources to be dispatched by the application against the quotas in its namespace.
+// The resources already managed by the application are passed in as the current resources, and the replicas used
+// by the other applications are loaded through others if the replicas are limited.
+func ValidateDispatch(ctx context.Context, cli client.Client, app *v1beta1.Application, others *OtherReplicas,
+	current []v1beta1.ManagedResource, manifests []*unstructured.Unstructured) error {
+	quotas, err := ListQuotas(ctx, cli, app.Namespace)
+	if err != nil || len(quotas) == 0 {
+		return err
+	}
+	resources := collectResources(current)
+	for _, manifest := range manifests {
+		r := resource{cluster: oam.GetCluster(manifest), obj: manifest}
+		resources[r.key()] = r
+	}
+	usage := usageOf(resources)
+	if err = check(quotas, ResourceManagedResourcesPerApplication, usage[ResourceManagedResourcesPerApplication]); err != nil {
+		return err
+	}
+	if err = check(quotas, ResourceClustersPerApplication, usage[ResourceClustersPerApplication]); err != nil {
+		return err
+	}
+	if !isLimited(quotas, ResourceReplicas) {
+		return nil
+	}
+	replicas, err := others.Get(ctx, cli, app)
+	if err != nil {
+		return err
+	}
+	return check(quotas, ResourceReplicas, usage[ResourceReplicas]+replicas)
+}
+
+// isLimited checks if the resource is restricted by any of the quotas
+func isLimited(quotas []v1alpha1.VelaQuota, resource string) bool {
+	for i := range quotas {
+		if GetLimit(&quotas[i], resource) != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// GetUsage computes the usage of the resources restricted by the quota in the namespace
+func GetUsage(ctx context.Context, cli client.Client, namespace string) (Usage, error) {
+	apps := &v1beta1.ApplicationList{}
+	if err := cli.List(ctx, apps, client.InNamespace(namespace)); err != nil {
+		return nil, errors.Wrapf(err, "failed to list applications in namespace %s", namespace)
+	}
+	usage := Usage{ResourceApplications: int64(len(apps.Items))}
+	for _, app := range apps.Items {
+		if n := int64(len(app.Spec.Components)); n > usage[ResourceComponentsPerApplication] {
+			usage[ResourceComponentsPerApplication] = n
+		}
+	}
+	resources, err := listApplicationResources(ctx, cli, namespace)
+	if err != nil {
+		return nil, err
+	}
+	for _, rs := range resources {
+		appUsage := usageOf(rs)
+		for _, name := range []string{ResourceManagedResourcesPerApplication, ResourceClustersPerApplication} {
+			if appUsage[name] > usage[name] {
+				usage[name] = appUsage[name]
+			}
+		}
+		usage[ResourceReplicas] += appUsage[ResourceReplicas]
+	}
+	return usage, nil
+}
+
+// RecordMetrics records the limits and the usage of the quotas in the namespace as metrics
+func RecordMetrics(namespace string, quotas []v1alpha1.VelaQuota, usage Usage) {
+	for i := range quotas {
+		for _, resource := range Resources {
+			if limit := GetLimit(&quotas[i], resource); limit != nil {
+				metrics.QuotaLimitGauge.WithLabelValues(namespace, quotas[i].Name, resource).Set(float64(*limit))
+				metrics.QuotaUsedGauge.WithLabelValues(namespace, quotas[i].Name, resource).Set(float64(usage[resource]))
+			}
+		}
+	}
+}
+
+// listApplicationResources lists the resources managed by the applications in the namespace from their root and
+// latest versioned resourcetrackers, grouped by the application name
+func listApplicationResources(ctx context.Context, cli client.Client, namespace string) (map[string]map[string]resource, error) {
+	rts := &v1beta1.ResourceTrackerList{}
+	if err := cli.List(multicluster.ContextInLocalCluster(ctx), rts, client.MatchingLabels{oam.LabelAppNamespace: namespace}); err != nil {
+		return nil, errors.Wrapf(err, "failed to list resourcetrackers of namespace %s", namespace)
+	}
+	roots := map[string]*v1beta1.ResourceTracker{}
+	latest := map[string]*v1beta1.ResourceTracker{}
+	for i := range rts.Items {
+		rt := &rts.Items[i]
+		name := rt.GetLabels()[oam.LabelAppName]
+		switch rt.Spec.Type {
+		case v1beta1.ResourceTrackerTypeRoot:
+			roots[name] = rt
+		case v1beta1.ResourceTrackerTypeVersioned:
+			if cur, found := latest[name]; !found || cur.Spec.ApplicationGeneration < rt.Spec.ApplicationGeneration {
+				latest[name] = rt
+			}
+		}
+	}
+	resources := map[string]map[string]resource{}
+	for _, group := range []map[string]*v1beta1.ResourceTracker{roots, latest} {
+		for name, rt := range group {
+			if resources[name] == nil {
+				resources[name] = map[string]resource{}
+			}
+			for k, v := range collectResources(rt.Spec.ManagedResources) {
+				resources[name][k] = v
+			}
+		}
+	}
+	return resources, nil
+}
+
+// resource is a resource managed by an application in the given cluster
+type resource struct {
+	cluster string
+	obj     *unstructured.Unstructured
+}
+
+func (r resource) getCluster() string {
+	if r.cluster == "" {
+		return multicluster.ClusterLocalName
+	}
+	return r.cluster
+}
+
+func (r resource) key() string {
+	return strings.Join([]string{r.getCluster(), r.obj.GroupVersionKind().GroupKind().String(), r.obj.GetNamespace(), r.obj.GetName()}, "/")
+}
+
+// collectResources collects the managed resources that are not deleted keyed by their identity.
+// The resource recorded without data only contains the metadata.
+func collectResources(mrs []v1beta1.ManagedResource) map[string]resource {
+	resources := map[string]resource{}
+	for _, mr := range mrs {
+		if mr.Deleted {
+			continue
+		}
+		r := resource{cluster: mr.Cluster, obj: mr.ToUnstructured()}
+		if mr.Data != nil {
+			if obj, err := mr.ToUnstructuredWithData(); err == nil {
+				r.obj = obj
+			}
+		}
+		resources[r.key()] = r
+	}
+	return resources
+}
+
+// usageOf computes the usage of the resources managed by one application
+func usageOf(resources map[string]resource) Usage {
+	clusters := map[string]struct{}{}
+	var replicas int64
+	for _, r := range resources {
+		clusters[r.getCluster()] = struct{}{}
+		replicas += GetReplicas(r.obj)
+	}
+	return Usage{
+		ResourceManagedResourcesPerApplication: int64(len(resources)),
+		ResourceClustersPerApplication:         int64(len(clusters)),
+		ResourceReplicas:                       replicas,
+	}
+}
+
+// GetReplicas returns the replicas of the workload. The workloads with replicas field default to 1 replica,
+// other resources have no replica.
+func GetReplicas(obj *unstructured.Unstructured) int64 {
+	if replicas, found, err := unstructured.NestedFieldNoCopy(obj.Object, "spec", "replicas"); err == nil && found {
+		switch v := replicas.(type) {
+		case int64:
+			return v
+		case float64:
+			return int64(v)
+		}
+	}
+	switch obj.GroupVersionKind().GroupKind().String() {
+	case "Deployment.apps", "StatefulSet.apps", "ReplicaSet.apps":
+		return 1
+	default:
+		return 0
+	}
+}
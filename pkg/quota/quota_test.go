@@ -0,0 +1,180 @@
+/*
+Copyright 2026 The KubeVela Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package quota
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	"github.com/oam-dev/kubevela/apis/core.oam.dev/common"
+	"github.com/oam-dev/kubevela/apis/core.oam.dev/v1alpha1"
+	"github.com/oam-dev/kubevela/apis/core.oam.dev/v1beta1"
+	"github.com/oam-dev/kubevela/pkg/oam"
+	"github.com/oam-dev/kubevela/pkg/oam/util"
+	velacommon "github.com/oam-dev/kubevela/pkg/utils/common"
+)
+
+func newDeployment(name string, replicas int32) *unstructured.Unstructured {
+	deploy := &appsv1.Deployment{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "apps/v1", Kind: "Deployment"},
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+		Spec:       appsv1.DeploymentSpec{Replicas: ptr.To(replicas)},
+	}
+	obj, _ := util.Object2Unstructured(deploy)
+	return obj
+}
+
+func newResourceTracker(app string, rtType v1beta1.ResourceTrackerType, gen int64, objs ...*unstructured.Unstructured) *v1beta1.ResourceTracker {
+	rt := &v1beta1.ResourceTracker{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   app + "-" + string(rtType),
+			Labels: map[string]string{oam.LabelAppName: app, oam.LabelAppNamespace: "default"},
+		},
+		Spec: v1beta1.ResourceTrackerSpec{Type: rtType, ApplicationGeneration: gen},
+	}
+	if rtType == v1beta1.ResourceTrackerTypeVersioned {
+		rt.Name = rt.Name + "-" + string(rune('0'+gen))
+	}
+	for _, obj := range objs {
+		rt.AddManagedResource(obj, false, false, "")
+	}
+	return rt
+}
+
+func TestValidateApplication(t *testing.T) {
+	ctx := context.Background()
+	app := func(name string, comps int) *v1beta1.Application {
+		a := &v1beta1.Application{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"}}
+		for i := 0; i < comps; i++ {
+			a.Spec.Components = append(a.Spec.Components, common.ApplicationComponent{Name: string(rune('a' + i))})
+		}
+		return a
+	}
+	cli := fake.NewClientBuilder().WithScheme(velacommon.Scheme).WithObjects(app("existing", 1)).Build()
+	require.NoError(t, ValidateApplication(ctx, cli, app("new", 5), true))
+
+	require.NoError(t, cli.Create(ctx, &v1alpha1.VelaQuota{
+		ObjectMeta: metav1.ObjectMeta{Name: "quota", Namespace: "default"},
+		Spec:       v1alpha1.VelaQuotaSpec{Applications: ptr.To(int64(1)), ComponentsPerApplication: ptr.To(int64(2))},
+	}))
+	err := ValidateApplication(ctx, cli, app("new", 1), true)
+	var exceeded ErrQuotaExceeded
+	require.True(t, errors.As(err, &exceeded))
+	require.Equal(t, ResourceApplications, exceeded.Resource)
+	require.Equal(t, int64(2), exceeded.Used)
+	require.NoError(t, ValidateApplication(ctx, cli, app("existing", 2), false))
+	require.NoError(t, ValidateApplication(ctx, cli, app("existing", 2), true))
+	err = ValidateApplication(ctx, cli, app("existing", 3), false)
+	require.True(t, errors.As(err, &exceeded))
+	require.Equal(t, ResourceComponentsPerApplication, exceeded.Resource)
+}
+
+func TestValidateDispatch(t *testing.T) {
+	ctx := context.Background()
+	app := &v1beta1.Application{ObjectMeta: metav1.ObjectMeta{Name: "app", Namespace: "default"}}
+	other := newResourceTracker("other", v1beta1.ResourceTrackerTypeVersioned, 2, newDeployment("other", 3))
+	outdated := newResourceTracker("other", v1beta1.ResourceTrackerTypeVersioned, 1, newDeployment("outdated", 10))
+	var rtLists int
+	cli := fake.NewClientBuilder().WithScheme(velacommon.Scheme).WithObjects(other, outdated).WithInterceptorFuncs(interceptor.Funcs{
+		List: func(ctx context.Context, cli client.WithWatch, list client.ObjectList, opts ...client.ListOption) error {
+			if _, ok := list.(*v1beta1.ResourceTrackerList); ok {
+				rtLists++
+			}
+			return cli.List(ctx, list, opts...)
+		},
+	}).Build()
+	// the replicas of the other applications are loaded once for all the dispatches
+	others := &OtherReplicas{}
+
+	remote := newDeployment("remote", 1)
+	oam.SetCluster(remote, "cluster-a")
+	current := newResourceTracker("app", v1beta1.ResourceTrackerTypeVersioned, 1, newDeployment("web", 2)).Spec.ManagedResources
+	manifests := []*unstructured.Unstructured{newDeployment("web", 4), remote}
+	require.NoError(t, ValidateDispatch(ctx, cli, app, others, current, manifests))
+
+	quota := &v1alpha1.VelaQuota{ObjectMeta: metav1.ObjectMeta{Name: "quota", Namespace: "default"}}
+	require.NoError(t, cli.Create(ctx, quota))
+	for _, tc := range []struct {
+		spec     v1alpha1.VelaQuotaSpec
+		resource string
+		used     int64
+	}{
+		{spec: v1alpha1.VelaQuotaSpec{ManagedResourcesPerApplication: ptr.To(int64(1))}, resource: ResourceManagedResourcesPerApplication, used: 2},
+		{spec: v1alpha1.VelaQuotaSpec{ClustersPerApplication: ptr.To(int64(1))}, resource: ResourceClustersPerApplication, used: 2},
+		{spec: v1alpha1.VelaQuotaSpec{Replicas: ptr.To(int64(7))}, resource: ResourceReplicas, used: 8},
+	} {
+		quota.Spec = tc.spec
+		require.NoError(t, cli.Update(ctx, quota))
+		err := ValidateDispatch(ctx, cli, app, others, current, manifests)
+		var exceeded ErrQuotaExceeded
+		require.True(t, errors.As(err, &exceeded), tc.resource)
+		require.Equal(t, tc.resource, exceeded.Resource)
+		require.Equal(t, tc.used, exceeded.Used)
+	}
+	quota.Spec = v1alpha1.VelaQuotaSpec{ManagedResourcesPerApplication: ptr.To(int64(2)), ClustersPerApplication: ptr.To(int64(2)), Replicas: ptr.To(int64(8))}
+	require.NoError(t, cli.Update(ctx, quota))
+	require.NoError(t, ValidateDispatch(ctx, cli, app, others, current, manifests))
+	require.Equal(t, 1, rtLists)
+}
+
+func TestGetUsage(t *testing.T) {
+	ctx := context.Background()
+	cm := &unstructured.Unstructured{}
+	cm.SetAPIVersion("v1")
+	cm.SetKind("ConfigMap")
+	cm.SetName("config")
+	remote := newDeployment("remote", 2)
+	oam.SetCluster(remote, "cluster-a")
+	cli := fake.NewClientBuilder().WithScheme(velacommon.Scheme).WithObjects(
+		&v1beta1.Application{ObjectMeta: metav1.ObjectMeta{Name: "a", Namespace: "default"}, Spec: v1beta1.ApplicationSpec{
+			Components: []common.ApplicationComponent{{Name: "x"}, {Name: "y"}},
+		}},
+		&v1beta1.Application{ObjectMeta: metav1.ObjectMeta{Name: "b", Namespace: "default"}},
+		newResourceTracker("a", v1beta1.ResourceTrackerTypeRoot, 0, cm),
+		newResourceTracker("a", v1beta1.ResourceTrackerTypeVersioned, 1, newDeployment("a", 3), remote),
+		newResourceTracker("b", v1beta1.ResourceTrackerTypeVersioned, 1, newDeployment("b", 1)),
+	).Build()
+	usage, err := GetUsage(ctx, cli, "default")
+	require.NoError(t, err)
+	require.Equal(t, Usage{
+		ResourceApplications:                   2,
+		ResourceComponentsPerApplication:       2,
+		ResourceManagedResourcesPerApplication: 3,
+		ResourceClustersPerApplication:         2,
+		ResourceReplicas:                       6,
+	}, usage)
+}
+
+func TestGetReplicas(t *testing.T) {
+	require.Equal(t, int64(3), GetReplicas(newDeployment("a", 3)))
+	deploy := newDeployment("b", 0)
+	unstructured.RemoveNestedField(deploy.Object, "spec", "replicas")
+	require.Equal(t, int64(1), GetReplicas(deploy))
+	cm, _ := util.Object2Unstructured(&corev1.ConfigMap{TypeMeta: metav1.TypeMeta{APIVersion: "v1", Kind: "ConfigMap"}})
+	require.Equal(t, int64(0), GetReplicas(cm))
+}
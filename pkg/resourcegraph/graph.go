@@ -0,0 +1,377 @@
+/*
+Copyright 2026 The KubeVela Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resourcegraph
+
+import (
+	"fmt"
+	"html"
+	"sort"
+	"strings"
+
+	querytypes "github.com/oam-dev/kubevela/pkg/utils/types"
+)
+
+// Format is the format to export the resource graph
+type Format string
+
+const (
+	// FormatDot exports the graph in the Graphviz DOT language
+	FormatDot Format = "dot"
+	// FormatMermaid exports the graph as a Mermaid flowchart
+	FormatMermaid Format = "mermaid"
+	// FormatHTML exports the graph as a standalone HTML page drawing the graph in SVG
+	FormatHTML Format = "html"
+)
+
+// Formats are all the supported formats
+var Formats = []Format{FormatDot, FormatMermaid, FormatHTML}
+
+// The layout of the SVG graph in the HTML page, the nodes are placed in columns by their depth from the root
+const (
+	svgMargin      = 16
+	svgColumnGap   = 64
+	svgRowGap      = 16
+	svgLineHeight  = 16
+	svgCharWidth   = 7
+	svgNodePadding = 8
+	svgMinWidth    = 120
+)
+
+// Node is a resource in the graph
+type Node struct {
+	ID         string                      `json:"id"`
+	Cluster    string                      `json:"cluster,omitempty"`
+	APIVersion string                      `json:"apiVersion,omitempty"`
+	Kind       string                      `json:"kind"`
+	Namespace  string                      `json:"namespace,omitempty"`
+	Name       string                      `json:"name"`
+	Component  string                      `json:"component,omitempty"`
+	Trait      string                      `json:"trait,omitempty"`
+	Health     querytypes.HealthStatusCode `json:"health,omitempty"`
+}
+
+// Edge links the parent resource to the child resource
+type Edge struct {
+	From string `json:"from"`
+	To   string `json:"to"`
+}
+
+// Graph is the resource graph of an application, the root is the application, the applied resources are the
+// children of the application and the sub resources found by the topology rules are the children of the resources
+type Graph struct {
+	Root  Node   `json:"root"`
+	Nodes []Node `json:"nodes"`
+	Edges []Edge `json:"edges"`
+}
+
+// healthColors are the fill and border colors of the health status
+var healthColors = map[querytypes.HealthStatusCode][2]string{
+	querytypes.HealthStatusHealthy:     {"#c8e6c9", "#2e7d32"},
+	querytypes.HealthStatusUnHealthy:   {"#ffcdd2", "#c62828"},
+	querytypes.HealthStatusProgressing: {"#fff9c4", "#f9a825"},
+	querytypes.HealthStatusUnKnown:     {"#eeeeee", "#757575"},
+}
+
+func colorsOf(health querytypes.HealthStatusCode) [2]string {
+	if colors, ok := healthColors[health]; ok {
+		return colors
+	}
+	return healthColors[querytypes.HealthStatusUnKnown]
+}
+
+// Build builds the resource graph of the application from the applied resources with the resource trees. The
+// sub resources inherit the source component and trait of the applied resource. The same object reached more than
+// once is only added once.
+func Build(appName, appNamespace string, resources []querytypes.AppliedResource) *Graph {
+	g := &Graph{Root: Node{ID: "app", Kind: "Application", Namespace: appNamespace, Name: appName}}
+	ids := map[string]string{}
+	addNode := func(node Node) (string, bool) {
+		key := strings.Join([]string{node.Cluster, node.APIVersion, node.Kind, node.Namespace, node.Name}, "/")
+		if id, ok := ids[key]; ok {
+			return id, false
+		}
+		node.ID = fmt.Sprintf("n%d", len(g.Nodes))
+		ids[key] = node.ID
+		g.Nodes = append(g.Nodes, node)
+		return node.ID, true
+	}
+	edges := map[Edge]bool{}
+	addEdge := func(edge Edge) {
+		if !edges[edge] {
+			edges[edge] = true
+			g.Edges = append(g.Edges, edge)
+		}
+	}
+	var addLeafNodes func(parent string, tree *querytypes.ResourceTreeNode, component, trait string)
+	addLeafNodes = func(parent string, tree *querytypes.ResourceTreeNode, component, trait string) {
+		for _, leaf := range tree.LeafNodes {
+			if leaf == nil {
+				continue
+			}
+			id, added := addNode(Node{Cluster: leaf.Cluster, APIVersion: leaf.APIVersion, Kind: leaf.Kind, Namespace: leaf.Namespace,
+				Name: leaf.Name, Component: component, Trait: trait, Health: leaf.HealthStatus.Status})
+			addEdge(Edge{From: parent, To: id})
+			if added {
+				addLeafNodes(id, leaf, component, trait)
+			}
+		}
+	}
+	for _, res := range resources {
+		node := Node{Cluster: res.Cluster, APIVersion: res.APIVersion, Kind: res.Kind, Namespace: res.Namespace, Name: res.Name,
+			Component: res.Component, Trait: res.Trait}
+		if res.ResourceTree != nil {
+			node.Health = res.ResourceTree.HealthStatus.Status
+		}
+		id, added := addNode(node)
+		if !added {
+			continue
+		}
+		addEdge(Edge{From: g.Root.ID, To: id})
+		if res.ResourceTree != nil {
+			addLeafNodes(id, res.ResourceTree, res.Component, res.Trait)
+		}
+	}
+	return g
+}
+
+// Export renders the graph in the format
+func (g *Graph) Export(format Format) (string, error) {
+	switch format {
+	case FormatDot:
+		return g.dot(), nil
+	case FormatMermaid:
+		return g.mermaid(), nil
+	case FormatHTML:
+		return g.html(), nil
+	default:
+		return "", fmt.Errorf("unsupported format %s, must be one of: (dot, mermaid, html)", format)
+	}
+}
+
+// clusters return the clusters of the nodes in order, with the nodes of each cluster
+func (g *Graph) clusters() ([]string, map[string][]Node) {
+	nodes := map[string][]Node{}
+	for _, node := range g.Nodes {
+		nodes[node.Cluster] = append(nodes[node.Cluster], node)
+	}
+	var clusters []string
+	for cluster := range nodes {
+		clusters = append(clusters, cluster)
+	}
+	sort.Strings(clusters)
+	return clusters, nodes
+}
+
+// lines return the lines of the label of the node
+func (n Node) lines() []string {
+	name := n.Name
+	if n.Namespace != "" {
+		name = n.Namespace + "/" + n.Name
+	}
+	lines := []string{n.Kind, name}
+	if n.Component != "" {
+		lines = append(lines, "component: "+n.Component)
+	}
+	if n.Trait != "" {
+		lines = append(lines, "trait: "+n.Trait)
+	}
+	if n.Health != "" {
+		lines = append(lines, "health: "+string(n.Health))
+	}
+	return lines
+}
+
+func (g *Graph) dot() string {
+	quote := func(s string) string {
+		return `"` + strings.ReplaceAll(strings.ReplaceAll(s, `\`, `\\`), `"`, `\"`) + `"`
+	}
+	label := func(n Node) string {
+		var lines []string
+		for _, line := range n.lines() {
+			lines = append(lines, strings.ReplaceAll(strings.ReplaceAll(line, `\`, `\\`), `"`, `\"`))
+		}
+		return `"` + strings.Join(lines, `\n`) + `"`
+	}
+	sb := &strings.Builder{}
+	fmt.Fprintf(sb, "digraph %s {\n", quote(g.Root.Name))
+	sb.WriteString("  rankdir=LR;\n")
+	sb.WriteString("  node [shape=box, style=\"rounded,filled\", fontname=\"Helvetica\"];\n")
+	fmt.Fprintf(sb, "  %s [label=%s, fillcolor=\"#bbdefb\", color=\"#1565c0\"];\n", quote(g.Root.ID), label(g.Root))
+	clusters, nodes := g.clusters()
+	for i, cluster := range clusters {
+		fmt.Fprintf(sb, "  subgraph %s {\n", quote(fmt.Sprintf("cluster_%d", i)))
+		fmt.Fprintf(sb, "    label=%s;\n", quote("cluster: "+cluster))
+		for _, node := range nodes[cluster] {
+			colors := colorsOf(node.Health)
+			fmt.Fprintf(sb, "    %s [label=%s, fillcolor=%s, color=%s];\n", quote(node.ID), label(node), quote(colors[0]), quote(colors[1]))
+		}
+		sb.WriteString("  }\n")
+	}
+	for _, edge := range g.Edges {
+		fmt.Fprintf(sb, "  %s -> %s;\n", quote(edge.From), quote(edge.To))
+	}
+	sb.WriteString("}\n")
+	return sb.String()
+}
+
+func (g *Graph) mermaid() string {
+	label := func(n Node) string {
+		var lines []string
+		for _, line := range n.lines() {
+			lines = append(lines, strings.ReplaceAll(line, `"`, "#quot;"))
+		}
+		return `["` + strings.Join(lines, "<br/>") + `"]`
+	}
+	sb := &strings.Builder{}
+	sb.WriteString("flowchart LR\n")
+	fmt.Fprintf(sb, "  %s%s\n", g.Root.ID, label(g.Root))
+	classes := map[querytypes.HealthStatusCode][]string{}
+	clusters, nodes := g.clusters()
+	for i, cluster := range clusters {
+		fmt.Fprintf(sb, "  subgraph cluster_%d[\"cluster: %s\"]\n", i, strings.ReplaceAll(cluster, `"`, "#quot;"))
+		for _, node := range nodes[cluster] {
+			fmt.Fprintf(sb, "    %s%s\n", node.ID, label(node))
+			health := node.Health
+			if _, ok := healthColors[health]; !ok {
+				health = querytypes.HealthStatusUnKnown
+			}
+			classes[health] = append(classes[health], node.ID)
+		}
+		sb.WriteString("  end\n")
+	}
+	for _, edge := range g.Edges {
+		fmt.Fprintf(sb, "  %s --> %s\n", edge.From, edge.To)
+	}
+	fmt.Fprintf(sb, "  classDef application fill:#bbdefb,stroke:#1565c0\n")
+	fmt.Fprintf(sb, "  class %s application\n", g.Root.ID)
+	for _, health := range []querytypes.HealthStatusCode{querytypes.HealthStatusHealthy, querytypes.HealthStatusUnHealthy,
+		querytypes.HealthStatusProgressing, querytypes.HealthStatusUnKnown} {
+		if len(classes[health]) == 0 {
+			continue
+		}
+		colors := healthColors[health]
+		class := strings.ToLower(string(health))
+		fmt.Fprintf(sb, "  classDef %s fill:%s,stroke:%s\n", class, colors[0], colors[1])
+		fmt.Fprintf(sb, "  class %s %s\n", strings.Join(classes[health], ","), class)
+	}
+	return sb.String()
+}
+
+// svg draws the graph from left to right, the nodes are placed in the columns of their depths from the root and
+// ordered by the clusters in the columns
+func (g *Graph) svg() string {
+	depths := map[string]int{g.Root.ID: 0}
+	for queue := []string{g.Root.ID}; len(queue) > 0; queue = queue[1:] {
+		for _, edge := range g.Edges {
+			if _, visited := depths[edge.To]; !visited && edge.From == queue[0] {
+				depths[edge.To] = depths[edge.From] + 1
+				queue = append(queue, edge.To)
+			}
+		}
+	}
+	labels := map[string][]string{g.Root.ID: g.Root.lines()}
+	columns := [][]Node{{g.Root}}
+	clusters, nodes := g.clusters()
+	for _, cluster := range clusters {
+		for _, node := range nodes[cluster] {
+			depth, ok := depths[node.ID]
+			if !ok {
+				depth = 1
+			}
+			for len(columns) <= depth {
+				columns = append(columns, nil)
+			}
+			columns[depth] = append(columns[depth], node)
+			labels[node.ID] = node.lines()
+			if node.Cluster != "" {
+				labels[node.ID] = append([]string{"cluster: " + node.Cluster}, labels[node.ID]...)
+			}
+		}
+	}
+
+	type box struct{ x, y, w, h int }
+	boxes := map[string]box{}
+	width, height := svgMargin, 0
+	for _, column := range columns {
+		w := svgMinWidth
+		for _, node := range column {
+			for _, line := range labels[node.ID] {
+				w = max(w, len(line)*svgCharWidth+2*svgNodePadding)
+			}
+		}
+		y := svgMargin
+		for _, node := range column {
+			h := len(labels[node.ID])*svgLineHeight + 2*svgNodePadding
+			boxes[node.ID] = box{x: width, y: y, w: w, h: h}
+			y += h + svgRowGap
+		}
+		width += w + svgColumnGap
+		height = max(height, y)
+	}
+	width += svgMargin - svgColumnGap
+	height += svgMargin - svgRowGap
+
+	sb := &strings.Builder{}
+	fmt.Fprintf(sb, "<svg xmlns=\"http://www.w3.org/2000/svg\" width=\"%d\" height=\"%d\" font-size=\"12\">\n", width, height)
+	sb.WriteString("<defs><marker id=\"arrow\" viewBox=\"0 0 10 10\" refX=\"10\" refY=\"5\" markerWidth=\"8\" markerHeight=\"8\" orient=\"auto\">" +
+		"<path d=\"M0,0 L10,5 L0,10 z\" fill=\"#616161\"/></marker></defs>\n")
+	for _, edge := range g.Edges {
+		from, to := boxes[edge.From], boxes[edge.To]
+		x1, y1, x2, y2 := from.x+from.w, from.y+from.h/2, to.x, to.y+to.h/2
+		fmt.Fprintf(sb, "<path d=\"M%d,%d C%d,%d %d,%d %d,%d\" fill=\"none\" stroke=\"#616161\" marker-end=\"url(#arrow)\"/>\n",
+			x1, y1, (x1+x2)/2, y1, (x1+x2)/2, y2, x2, y2)
+	}
+	for _, column := range columns {
+		for _, node := range column {
+			b, colors := boxes[node.ID], colorsOf(node.Health)
+			if node.ID == g.Root.ID {
+				colors = [2]string{"#bbdefb", "#1565c0"}
+			}
+			fmt.Fprintf(sb, "<rect x=\"%d\" y=\"%d\" width=\"%d\" height=\"%d\" rx=\"6\" fill=\"%s\" stroke=\"%s\"/>\n",
+				b.x, b.y, b.w, b.h, colors[0], colors[1])
+			for i, line := range labels[node.ID] {
+				fmt.Fprintf(sb, "<text x=\"%d\" y=\"%d\">%s</text>\n", b.x+svgNodePadding, b.y+svgNodePadding+(i+1)*svgLineHeight-4,
+					html.EscapeString(line))
+			}
+		}
+	}
+	sb.WriteString("</svg>\n")
+	return sb.String()
+}
+
+// html draws the graph in a standalone page without any script, with a table of the resources
+func (g *Graph) html() string {
+	title := html.EscapeString(fmt.Sprintf("Resource topology of application %s/%s", g.Root.Namespace, g.Root.Name))
+	sb := &strings.Builder{}
+	sb.WriteString("<!DOCTYPE html>\n<html>\n<head>\n<meta charset=\"utf-8\">\n")
+	fmt.Fprintf(sb, "<title>%s</title>\n", title)
+	sb.WriteString("<style>body{font-family:Helvetica,Arial,sans-serif;margin:24px}table{border-collapse:collapse}" +
+		"th,td{border:1px solid #ccc;padding:4px 8px;text-align:left}</style>\n")
+	sb.WriteString("</head>\n<body>\n")
+	fmt.Fprintf(sb, "<h1>%s</h1>\n", title)
+	sb.WriteString(g.svg())
+	sb.WriteString("<table>\n<tr><th>Cluster</th><th>Kind</th><th>Namespace</th><th>Name</th><th>Component</th><th>Trait</th><th>Health</th></tr>\n")
+	for _, node := range g.Nodes {
+		colors := colorsOf(node.Health)
+		fmt.Fprintf(sb, "<tr><td>%s</td><td>%s</td><td>%s</td><td>%s</td><td>%s</td><td>%s</td><td style=\"background:%s\">%s</td></tr>\n",
+			html.EscapeString(node.Cluster), html.EscapeString(node.Kind), html.EscapeString(node.Namespace), html.EscapeString(node.Name),
+			html.EscapeString(node.Component), html.EscapeString(node.Trait), colors[0], html.EscapeString(string(node.Health)))
+	}
+	sb.WriteString("</table>\n")
+	sb.WriteString("</body>\n</html>\n")
+	return sb.String()
+}
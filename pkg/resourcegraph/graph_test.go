@@ -0,0 +1,89 @@
+/*
+Copyright 2026 The KubeVela Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resourcegraph
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	querytypes "github.com/oam-dev/kubevela/pkg/utils/types"
+)
+
+func testResources() []querytypes.AppliedResource {
+	pod := &querytypes.ResourceTreeNode{Cluster: "local", APIVersion: "v1", Kind: "Pod", Namespace: "default", Name: "web-abc",
+		HealthStatus: querytypes.HealthStatus{Status: querytypes.HealthStatusUnHealthy}}
+	rs := &querytypes.ResourceTreeNode{Cluster: "local", APIVersion: "apps/v1", Kind: "ReplicaSet", Namespace: "default", Name: "web-6f",
+		HealthStatus: querytypes.HealthStatus{Status: querytypes.HealthStatusProgressing}, LeafNodes: []*querytypes.ResourceTreeNode{pod}}
+	return []querytypes.AppliedResource{
+		{Cluster: "local", Component: "web", APIVersion: "apps/v1", Kind: "Deployment", Namespace: "default", Name: "web",
+			ResourceTree: &querytypes.ResourceTreeNode{HealthStatus: querytypes.HealthStatus{Status: querytypes.HealthStatusHealthy},
+				LeafNodes: []*querytypes.ResourceTreeNode{rs}}},
+		{Cluster: "local", Component: "web", Trait: "gateway", APIVersion: "networking.k8s.io/v1", Kind: "Ingress", Namespace: "default", Name: "web"},
+		{Cluster: "prod", Component: "web", APIVersion: "apps/v1", Kind: "Deployment", Namespace: "default", Name: "web"},
+		// the same resource tracked by the current and the history resourcetrackers
+		{Cluster: "prod", Component: "web", APIVersion: "apps/v1", Kind: "Deployment", Namespace: "default", Name: "web"},
+	}
+}
+
+func TestBuild(t *testing.T) {
+	g := Build("demo", "default", testResources())
+	require.Equal(t, "Application", g.Root.Kind)
+	require.Equal(t, []Node{
+		{ID: "n0", Cluster: "local", APIVersion: "apps/v1", Kind: "Deployment", Namespace: "default", Name: "web", Component: "web", Health: querytypes.HealthStatusHealthy},
+		{ID: "n1", Cluster: "local", APIVersion: "apps/v1", Kind: "ReplicaSet", Namespace: "default", Name: "web-6f", Component: "web", Health: querytypes.HealthStatusProgressing},
+		{ID: "n2", Cluster: "local", APIVersion: "v1", Kind: "Pod", Namespace: "default", Name: "web-abc", Component: "web", Health: querytypes.HealthStatusUnHealthy},
+		{ID: "n3", Cluster: "local", APIVersion: "networking.k8s.io/v1", Kind: "Ingress", Namespace: "default", Name: "web", Component: "web", Trait: "gateway"},
+		{ID: "n4", Cluster: "prod", APIVersion: "apps/v1", Kind: "Deployment", Namespace: "default", Name: "web", Component: "web"},
+	}, g.Nodes)
+	require.Equal(t, []Edge{{"app", "n0"}, {"n0", "n1"}, {"n1", "n2"}, {"app", "n3"}, {"app", "n4"}}, g.Edges)
+}
+
+func TestExport(t *testing.T) {
+	g := Build("demo", "default", testResources())
+
+	dot, err := g.Export(FormatDot)
+	require.NoError(t, err)
+	require.Contains(t, dot, `digraph "demo" {`)
+	require.Contains(t, dot, `label="cluster: prod";`)
+	require.Contains(t, dot, `"n2" [label="Pod\ndefault/web-abc\ncomponent: web\nhealth: UnHealthy", fillcolor="#ffcdd2", color="#c62828"];`)
+	require.Contains(t, dot, `"n1" -> "n2";`)
+
+	mermaid, err := g.Export(FormatMermaid)
+	require.NoError(t, err)
+	require.Contains(t, mermaid, "flowchart LR\n")
+	require.Contains(t, mermaid, `subgraph cluster_1["cluster: prod"]`)
+	require.Contains(t, mermaid, `n3["Ingress<br/>default/web<br/>component: web<br/>trait: gateway"]`)
+	require.Contains(t, mermaid, "  app --> n0\n")
+	require.Contains(t, mermaid, "class n0 healthy\n")
+	require.Contains(t, mermaid, "class n3,n4 unknown\n")
+
+	page, err := g.Export(FormatHTML)
+	require.NoError(t, err)
+	require.Contains(t, page, "<title>Resource topology of application default/demo</title>")
+	require.NotContains(t, page, "<script")
+	require.Contains(t, page, "<svg ")
+	require.Contains(t, page, "<text x=\"")
+	require.Contains(t, page, ">cluster: prod</text>")
+	require.Contains(t, page, "fill=\"#ffcdd2\" stroke=\"#c62828\"/>")
+	require.Equal(t, len(g.Edges), strings.Count(page, "marker-end=\"url(#arrow)\""))
+	require.Contains(t, page, "<td>gateway</td>")
+
+	_, err = g.Export("svg")
+	require.Error(t, err)
+}
@@ -25,6 +25,7 @@ import (
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 
 	"github.com/oam-dev/kubevela/apis/core.oam.dev/v1beta1"
+	"github.com/oam-dev/kubevela/pkg/quota"
 )
 
 var (
@@ -47,6 +48,19 @@ func (h *resourceKeeper) AdmissionCheck(ctx context.Context, manifests []*unstru
 	return nil
 }
 
+// QuotaCheck check whether resources dispatch exceeds the quotas in the namespace of the application
+func (h *resourceKeeper) QuotaCheck(ctx context.Context, manifests []*unstructured.Unstructured) error {
+	var current []v1beta1.ManagedResource
+	h.mu.Lock()
+	for _, rt := range []*v1beta1.ResourceTracker{h._rootRT, h._currentRT} {
+		if rt != nil {
+			current = append(current, rt.Spec.ManagedResources...)
+		}
+	}
+	h.mu.Unlock()
+	return quota.ValidateDispatch(ctx, h.Client, h.app, &h.otherReplicas, current, manifests)
+}
+
 // ResourceAdmissionHandler defines the handler to validate the admission of resource operation
 type ResourceAdmissionHandler interface {
 	Validate(ctx context.Context, manifests []*unstructured.Unstructured) error
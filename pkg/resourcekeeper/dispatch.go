@@ -19,6 +19,7 @@ package resourcekeeper
 import (
 	"context"
 	"fmt"
+	"time"
 
 	velaslices "github.com/kubevela/pkg/util/slices"
 	"github.com/pkg/errors"
@@ -28,6 +29,7 @@ import (
 	"github.com/oam-dev/kubevela/apis/core.oam.dev/v1alpha1"
 	"github.com/oam-dev/kubevela/pkg/auth"
 	"github.com/oam-dev/kubevela/pkg/features"
+	"github.com/oam-dev/kubevela/pkg/monitor/metrics"
 	"github.com/oam-dev/kubevela/pkg/multicluster"
 	"github.com/oam-dev/kubevela/pkg/oam"
 	"github.com/oam-dev/kubevela/pkg/resourcetracker"
@@ -68,6 +70,9 @@ func (h *resourceKeeper) Dispatch(ctx context.Context, manifests []*unstructured
 	if err = h.AdmissionCheck(ctx, manifests); err != nil {
 		return err
 	}
+	if err = h.QuotaCheck(ctx, manifests); err != nil {
+		return err
+	}
 	// 1. pre-dispatch check
 	opts := []apply.ApplyOption{apply.MustBeControlledByApp(h.app), apply.NotUpdateRenderHashEqual()}
 	if len(applyOpts) > 0 {
@@ -161,6 +166,10 @@ func (h *resourceKeeper) dispatch(ctx context.Context, manifests []*unstructured
 		if err != nil {
 			return errors.Wrapf(err, "failed to apply once policy for application %s,%s", h.app.Name, err.Error())
 		}
+		begin := time.Now()
+		defer func() {
+			metrics.ResourceDispatchDurationHistogram.WithLabelValues(clusterLabel(oam.GetCluster(manifest))).Observe(time.Since(begin).Seconds())
+		}()
 		return h.applicator.Apply(applyCtx, manifest, ao...)
 	}, velaslices.Parallelism(MaxDispatchConcurrent))
 	return velaerrors.AggregateErrors(errs)
@@ -415,12 +415,24 @@ func DeleteManagedResourceInApplication(ctx context.Context, cli client.Client,
 		return errors.Wrapf(cli.Update(_ctx, obj), "skipping deletion for resource")
 	}
 
-	if err := cli.Delete(_ctx, obj, opts...); err != nil && !kerrors.IsNotFound(err) {
-		return errors.Wrapf(err, "failed to delete resource %s", mr.ResourceKey())
+	if err := cli.Delete(_ctx, obj, opts...); err != nil {
+		if !kerrors.IsNotFound(err) {
+			return errors.Wrapf(err, "failed to delete resource %s", mr.ResourceKey())
+		}
+		return nil
 	}
+	metrics.GCDeletedResourceCounter.WithLabelValues(clusterLabel(mr.Cluster)).Inc()
 	return nil
 }
 
+// clusterLabel returns the cluster name used in the metric labels, the empty cluster is the local cluster
+func clusterLabel(cluster string) string {
+	if cluster == "" {
+		return multicluster.ClusterLocalName
+	}
+	return cluster
+}
+
 func (h *gcHandler) checkDependentComponent(mr v1beta1.ManagedResource) []string {
 	dependent := make([]string, 0)
 	outputs := make([]string, 0)
@@ -31,6 +31,7 @@ import (
 	"github.com/oam-dev/kubevela/pkg/multicluster"
 	"github.com/oam-dev/kubevela/pkg/oam"
 	"github.com/oam-dev/kubevela/pkg/policy"
+	"github.com/oam-dev/kubevela/pkg/quota"
 	"github.com/oam-dev/kubevela/pkg/resourcetracker"
 	"github.com/oam-dev/kubevela/pkg/utils/apply"
 )
@@ -66,6 +67,8 @@ type resourceKeeper struct {
 	resourceUpdatePolicy *v1alpha1.ResourceUpdatePolicySpec
 
 	cache *resourceCache
+	// otherReplicas is the replicas used by the other applications for the quota checks of the dispatches
+	otherReplicas quota.OtherReplicas
 }
 
 func (h *resourceKeeper) getRootRT(ctx context.Context) (rootRT *v1beta1.ResourceTracker, err error) {
@@ -0,0 +1,184 @@
+/*
+Copyright 2026 The KubeVela Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package supportbundle
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	workflowv1alpha1 "github.com/kubevela/workflow/api/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/oam-dev/kubevela/apis/core.oam.dev/common"
+	"github.com/oam-dev/kubevela/apis/core.oam.dev/v1beta1"
+)
+
+// Severity is the severity of a problem
+type Severity string
+
+const (
+	// SeverityError means the problem breaks the application
+	SeverityError Severity = "error"
+	// SeverityWarning means the problem may break the application
+	SeverityWarning Severity = "warning"
+)
+
+// Problem is a problem detected in the support bundle
+type Problem struct {
+	Severity Severity `json:"severity"`
+	// Source is the object having the problem, such as Pod local/default/web
+	Source  string `json:"source"`
+	Message string `json:"message"`
+}
+
+// Summary is the summary of the support bundle
+type Summary struct {
+	Problems []Problem `json:"problems"`
+}
+
+// failedWaitingReasons are the reasons of the waiting containers which would not recover by themselves
+var failedWaitingReasons = map[string]bool{
+	"CrashLoopBackOff":           true,
+	"ImagePullBackOff":           true,
+	"ErrImagePull":               true,
+	"InvalidImageName":           true,
+	"CreateContainerConfigError": true,
+	"CreateContainerError":       true,
+	"RunContainerError":          true,
+}
+
+// Analyze detects the problems in the support bundle, from the status of the application and its workflow, the
+// missing resources, the pods, the warning events and the failures of the collection. The errors come first.
+func Analyze(b *Bundle) []Problem {
+	var problems []Problem
+	add := func(severity Severity, source, format string, args ...interface{}) {
+		problems = append(problems, Problem{Severity: severity, Source: source, Message: fmt.Sprintf(format, args...)})
+	}
+	app := &v1beta1.Application{}
+	if err := b.Unmarshal(ApplicationFile, app); err != nil {
+		add(SeverityError, "Bundle", "failed to read the application: %s", err.Error())
+	} else {
+		analyzeApplication(app, add)
+	}
+	for _, resource := range b.Index.MissingResources {
+		add(SeverityError, resource, "the resource is recorded in the ResourceTracker but not found")
+	}
+	for _, name := range b.List(PodsDir) {
+		pod := &corev1.Pod{}
+		if err := b.Unmarshal(name, pod); err != nil {
+			add(SeverityWarning, name, "failed to read the pod: %s", err.Error())
+			continue
+		}
+		analyzePod(strings.TrimSuffix(strings.TrimPrefix(name, PodsDir+"/"), ".yaml"), pod, add)
+	}
+	for _, name := range b.List(EventsDir) {
+		events := &corev1.EventList{}
+		if err := b.Unmarshal(name, events); err != nil {
+			add(SeverityWarning, name, "failed to read the events: %s", err.Error())
+			continue
+		}
+		cluster := strings.SplitN(strings.TrimPrefix(name, EventsDir+"/"), "/", 2)[0]
+		for _, event := range events.Items {
+			if event.Type != corev1.EventTypeWarning {
+				continue
+			}
+			source := fmt.Sprintf("%s %s/%s/%s", event.InvolvedObject.Kind, cluster, event.InvolvedObject.Namespace, event.InvolvedObject.Name)
+			if event.Count > 1 {
+				add(SeverityWarning, source, "%s: %s (x%d)", event.Reason, event.Message, event.Count)
+			} else {
+				add(SeverityWarning, source, "%s: %s", event.Reason, event.Message)
+			}
+		}
+	}
+	for _, err := range b.Index.Errors {
+		add(SeverityWarning, "Bundle", "incomplete collection: %s", err)
+	}
+	sort.SliceStable(problems, func(i, j int) bool {
+		return problems[i].Severity == SeverityError && problems[j].Severity != SeverityError
+	})
+	return problems
+}
+
+func analyzeApplication(app *v1beta1.Application, add func(Severity, string, string, ...interface{})) {
+	source := "Application " + app.Namespace + "/" + app.Name
+	switch app.Status.Phase {
+	case common.ApplicationRunning:
+	case common.ApplicationWorkflowFailed, common.ApplicationWorkflowTerminated:
+		add(SeverityError, source, "the application is %s", app.Status.Phase)
+	case "":
+		add(SeverityWarning, source, "the application has not been reconciled")
+	default:
+		add(SeverityWarning, source, "the application is %s", app.Status.Phase)
+	}
+	for _, cond := range app.Status.Conditions {
+		if cond.Status != corev1.ConditionTrue && cond.Message != "" {
+			add(SeverityWarning, source, "condition %s is %s: %s", cond.Type, cond.Status, cond.Message)
+		}
+	}
+	if app.Status.Workflow != nil {
+		for _, step := range app.Status.Workflow.Steps {
+			analyzeStep(source, step.StepStatus, add)
+			for _, sub := range step.SubStepsStatus {
+				analyzeStep(source, sub, add)
+			}
+		}
+	}
+	for _, svc := range app.Status.Services {
+		if svc.Healthy {
+			continue
+		}
+		cluster := svc.Cluster
+		if cluster == "" {
+			cluster = "local"
+		}
+		add(SeverityError, fmt.Sprintf("Component %s/%s/%s", cluster, svc.Namespace, svc.Name), "the component is unhealthy: %s", svc.Message)
+	}
+}
+
+func analyzeStep(source string, step workflowv1alpha1.StepStatus, add func(Severity, string, string, ...interface{})) {
+	if step.Phase != workflowv1alpha1.WorkflowStepPhaseFailed {
+		return
+	}
+	msg := step.Message
+	if step.Reason != "" {
+		msg = step.Reason + ": " + msg
+	}
+	add(SeverityError, source, "workflow step %s failed: %s", step.Name, msg)
+}
+
+func analyzePod(source string, pod *corev1.Pod, add func(Severity, string, string, ...interface{})) {
+	source = "Pod " + source
+	switch pod.Status.Phase {
+	case corev1.PodRunning, corev1.PodSucceeded:
+	case corev1.PodFailed:
+		add(SeverityError, source, "the pod failed: %s", pod.Status.Message)
+	default:
+		add(SeverityWarning, source, "the pod is %s", pod.Status.Phase)
+	}
+	for _, status := range append(pod.Status.InitContainerStatuses, pod.Status.ContainerStatuses...) {
+		if waiting := status.State.Waiting; waiting != nil && failedWaitingReasons[waiting.Reason] {
+			add(SeverityError, source, "container %s is waiting for %s: %s", status.Name, waiting.Reason, waiting.Message)
+		}
+		if terminated := status.LastTerminationState.Terminated; terminated != nil && terminated.Reason == "OOMKilled" {
+			add(SeverityError, source, "container %s was killed as out of memory", status.Name)
+		}
+		if status.RestartCount > 0 {
+			add(SeverityWarning, source, "container %s restarted %d times", status.Name, status.RestartCount)
+		}
+	}
+}
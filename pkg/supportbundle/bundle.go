@@ -0,0 +1,278 @@
+/*
+Copyright 2026 The KubeVela Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package supportbundle
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"path"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+	"sigs.k8s.io/yaml"
+
+	core "github.com/oam-dev/kubevela/apis/core.oam.dev"
+	"github.com/oam-dev/kubevela/pkg/oam"
+)
+
+// scheme is used to fill the kinds of the typed objects added to the bundle
+var scheme = runtime.NewScheme()
+
+func init() {
+	_ = clientgoscheme.AddToScheme(scheme)
+	_ = core.AddToScheme(scheme)
+}
+
+const (
+	// IndexFile is the file listing the content of the bundle
+	IndexFile = "index.yaml"
+	// SummaryFile is the file recording the problems detected in the bundle
+	SummaryFile = "summary.yaml"
+	// ApplicationFile is the file of the application
+	ApplicationFile = "application.yaml"
+	// WorkflowFile is the file of the workflow status of the application
+	WorkflowFile = "workflow.yaml"
+	// RevisionsDir is the directory of the ApplicationRevisions
+	RevisionsDir = "revisions"
+	// ResourceTrackersDir is the directory of the ResourceTrackers
+	ResourceTrackersDir = "resourcetrackers"
+	// ResourcesDir is the directory of the resources managed by the application, as <cluster>/<namespace>/<kind>.<name>.yaml
+	ResourcesDir = "resources"
+	// PodsDir is the directory of the pods of the application, as <cluster>/<namespace>/<name>.yaml
+	PodsDir = "pods"
+	// EventsDir is the directory of the events of the application, as <cluster>/<namespace>.yaml
+	EventsDir = "events"
+	// LogsDir is the directory of the pod logs, as <cluster>/<namespace>/<pod>/<container>.log
+	LogsDir = "logs"
+	// ControllerLogsDir is the directory of the logs of the KubeVela controller
+	ControllerLogsDir = "logs/controller"
+
+	// RedactedValue is the value replacing the masked secrets
+	RedactedValue = "<redacted>"
+)
+
+// Index describes the content of a support bundle
+type Index struct {
+	Application string      `json:"application"`
+	Namespace   string      `json:"namespace"`
+	CollectTime metav1.Time `json:"collectTime"`
+	Files       []string    `json:"files"`
+	// MissingResources are the resources recorded in the ResourceTrackers but not found in the clusters
+	MissingResources []string `json:"missingResources,omitempty"`
+	// Errors are the failures of the collection, the bundle is incomplete if there is any
+	Errors []string `json:"errors,omitempty"`
+}
+
+// Bundle is the content of a support bundle, the files are keyed by their slash-separated paths
+type Bundle struct {
+	Index Index
+	Files map[string][]byte
+}
+
+// NewBundle creates an empty bundle of the application
+func NewBundle(app, namespace string) *Bundle {
+	return &Bundle{
+		Index: Index{Application: app, Namespace: namespace, CollectTime: metav1.NewTime(time.Now())},
+		Files: map[string][]byte{},
+	}
+}
+
+// AddFile adds the file to the bundle
+func (b *Bundle) AddFile(name string, data []byte) {
+	b.Files[path.Clean(name)] = data
+}
+
+// AddObject adds the object to the bundle in yaml, the secrets in the object are masked
+func (b *Bundle) AddObject(name string, obj interface{}) error {
+	var data interface{}
+	switch o := obj.(type) {
+	case runtime.Object:
+		if o.GetObjectKind().GroupVersionKind().Empty() {
+			if gvk, err := apiutil.GVKForObject(o, scheme); err == nil {
+				o = o.DeepCopyObject()
+				o.GetObjectKind().SetGroupVersionKind(gvk)
+			}
+		}
+		u, err := runtime.DefaultUnstructuredConverter.ToUnstructured(o)
+		if err != nil {
+			return err
+		}
+		if metadata, ok := u["metadata"].(map[string]interface{}); ok {
+			delete(metadata, "managedFields")
+		}
+		data = Redact(u)
+	default:
+		data = obj
+	}
+	bs, err := yaml.Marshal(data)
+	if err != nil {
+		return fmt.Errorf("failed to marshal %s: %w", name, err)
+	}
+	b.AddFile(name, bs)
+	return nil
+}
+
+// AddError records the failure of the collection
+func (b *Bundle) AddError(err error) {
+	b.Index.Errors = append(b.Index.Errors, err.Error())
+}
+
+// Unmarshal reads the yaml file in the bundle into the object
+func (b *Bundle) Unmarshal(name string, obj interface{}) error {
+	data, ok := b.Files[name]
+	if !ok {
+		return fmt.Errorf("%s not found in the bundle", name)
+	}
+	return yaml.Unmarshal(data, obj)
+}
+
+// List returns the paths of the files in the directory of the bundle, sorted
+func (b *Bundle) List(dir string) []string {
+	var names []string
+	for name := range b.Files {
+		if strings.HasPrefix(name, dir+"/") {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+// WriteArchive writes the bundle as a gzipped tarball, along with the index of the files
+func (b *Bundle) WriteArchive(writer io.Writer) error {
+	b.Index.Files = nil
+	for name := range b.Files {
+		if name != IndexFile {
+			b.Index.Files = append(b.Index.Files, name)
+		}
+	}
+	sort.Strings(b.Index.Files)
+	if err := b.AddObject(IndexFile, b.Index); err != nil {
+		return err
+	}
+	gw := gzip.NewWriter(writer)
+	tw := tar.NewWriter(gw)
+	modTime := b.Index.CollectTime.Time
+	for _, name := range append([]string{IndexFile}, b.Index.Files...) {
+		data := b.Files[name]
+		if err := tw.WriteHeader(&tar.Header{Typeflag: tar.TypeReg, Name: name, Mode: 0600, Size: int64(len(data)), ModTime: modTime}); err != nil {
+			return err
+		}
+		if _, err := tw.Write(data); err != nil {
+			return err
+		}
+	}
+	if err := tw.Close(); err != nil {
+		return err
+	}
+	return gw.Close()
+}
+
+// ReadArchive reads the bundle from the gzipped tarball
+func ReadArchive(reader io.Reader) (*Bundle, error) {
+	gr, err := gzip.NewReader(reader)
+	if err != nil {
+		return nil, fmt.Errorf("invalid support bundle: %w", err)
+	}
+	defer func() { _ = gr.Close() }()
+	b := &Bundle{Files: map[string][]byte{}}
+	tr := tar.NewReader(gr)
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("invalid support bundle: %w", err)
+		}
+		if header.Typeflag != tar.TypeReg {
+			continue
+		}
+		data, err := io.ReadAll(tr)
+		if err != nil {
+			return nil, err
+		}
+		b.AddFile(header.Name, data)
+	}
+	if err = b.Unmarshal(IndexFile, &b.Index); err != nil {
+		return nil, fmt.Errorf("invalid support bundle: %w", err)
+	}
+	return b, nil
+}
+
+// sensitiveName matches the names of the values to mask, such as the environment variables of passwords
+var sensitiveName = regexp.MustCompile(`(?i)(password|passwd|secret|token|credential|api_?key|private_?key)`)
+
+// referenceName matches the names of the values referring to the secrets, such as secretName, which are kept
+var referenceName = regexp.MustCompile(`(?i)(name|ref)$`)
+
+// Redact masks the secrets in the unstructured object recursively, including the data of the Secrets, the values
+// of the fields with a sensitive name at any depth, such as the password in the properties of the components, and
+// the values of the name-value pairs with a sensitive name, such as the environment variables of passwords. The
+// last applied configurations are dropped as they hold the whole objects.
+func Redact(obj map[string]interface{}) map[string]interface{} {
+	redact(obj)
+	return obj
+}
+
+func redact(value interface{}) {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		if v["kind"] == "Secret" && v["apiVersion"] == "v1" {
+			for _, key := range []string{"data", "stringData"} {
+				if data, ok := v[key].(map[string]interface{}); ok {
+					for k := range data {
+						data[k] = RedactedValue
+					}
+				}
+			}
+		}
+		if metadata, ok := v["metadata"].(map[string]interface{}); ok {
+			if annotations, ok := metadata["annotations"].(map[string]interface{}); ok {
+				delete(annotations, oam.AnnotationLastAppliedConfiguration)
+				delete(annotations, oam.AnnotationLastAppliedConfig)
+			}
+		}
+		if name, ok := v["name"].(string); ok && sensitiveName.MatchString(name) {
+			if _, ok = v["value"].(string); ok {
+				v["value"] = RedactedValue
+			}
+		}
+		for k, item := range v {
+			switch item.(type) {
+			case map[string]interface{}, []interface{}, nil:
+				redact(item)
+			default:
+				if sensitiveName.MatchString(k) && !referenceName.MatchString(k) {
+					v[k] = RedactedValue
+				}
+			}
+		}
+	case []interface{}:
+		for _, item := range v {
+			redact(item)
+		}
+	}
+}
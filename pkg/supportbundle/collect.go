@@ -0,0 +1,279 @@
+/*
+Copyright 2026 The KubeVela Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package supportbundle
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/oam-dev/kubevela/apis/core.oam.dev/v1beta1"
+	"github.com/oam-dev/kubevela/pkg/multicluster"
+	"github.com/oam-dev/kubevela/pkg/oam"
+	"github.com/oam-dev/kubevela/pkg/resourcetracker"
+	"github.com/oam-dev/kubevela/pkg/utils"
+	velaerr "github.com/oam-dev/kubevela/pkg/utils/errors"
+	querytypes "github.com/oam-dev/kubevela/pkg/utils/types"
+)
+
+// ControllerLabelSelector selects the pods of the KubeVela controller
+const ControllerLabelSelector = "app.kubernetes.io/name=vela-core"
+
+// Collector collects the support bundle of an application
+type Collector struct {
+	// Client is the multi-cluster client to read the application and its resources
+	Client client.Client
+	// ClientSet is the multi-cluster clientset to read the logs
+	ClientSet kubernetes.Interface
+	// Pods are the pods of the application, such as the ones queried by the component-pod-view
+	Pods []*querytypes.PodBase
+	// LogOptions limits the logs of the pods and the controller
+	LogOptions utils.PodLogOptions
+	// ControllerNamespace is where the KubeVela controller runs, the controller logs are not collected if empty
+	ControllerNamespace string
+}
+
+// Collect collects the application, its revisions, ResourceTrackers, managed resources, pods, events and logs into
+// the bundle, then detects the problems in it. The failures of collecting the parts other than the application
+// are recorded in the index of the bundle instead of stopping the collection.
+func (c *Collector) Collect(ctx context.Context, app *v1beta1.Application) (*Bundle, error) {
+	b := NewBundle(app.Name, app.Namespace)
+	if err := b.AddObject(ApplicationFile, app); err != nil {
+		return nil, err
+	}
+	if app.Status.Workflow != nil {
+		if err := b.AddObject(WorkflowFile, app.Status.Workflow); err != nil {
+			return nil, err
+		}
+	}
+	c.collectRevisions(ctx, b, app)
+	objects := c.collectResources(ctx, b, app)
+	for _, pod := range c.Pods {
+		key := nsKey{clusterName(pod.Cluster), pod.Metadata.Namespace}
+		objects[key] = append(objects[key], objectKey{kind: "Pod", name: pod.Metadata.Name})
+	}
+	key := nsKey{multicluster.ClusterLocalName, app.Namespace}
+	objects[key] = append(objects[key], objectKey{kind: v1beta1.ApplicationKind, name: app.Name})
+	c.collectPods(ctx, b)
+	c.collectEvents(ctx, b, objects)
+	c.collectLogs(ctx, b, app)
+	summary := Summary{Problems: Analyze(b)}
+	if err := b.AddObject(SummaryFile, summary); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+// nsKey is a namespace in a cluster
+type nsKey struct {
+	cluster   string
+	namespace string
+}
+
+// objectKey is an object in a namespace
+type objectKey struct {
+	kind string
+	name string
+}
+
+func clusterName(cluster string) string {
+	if cluster == "" {
+		return multicluster.ClusterLocalName
+	}
+	return cluster
+}
+
+func (c *Collector) collectRevisions(ctx context.Context, b *Bundle, app *v1beta1.Application) {
+	revisions := &v1beta1.ApplicationRevisionList{}
+	if err := c.Client.List(ctx, revisions, client.InNamespace(app.Namespace), client.MatchingLabels{oam.LabelAppName: app.Name}); err != nil {
+		b.AddError(fmt.Errorf("failed to list the ApplicationRevisions: %w", err))
+		return
+	}
+	for i := range revisions.Items {
+		rev := &revisions.Items[i]
+		if err := b.AddObject(path.Join(RevisionsDir, rev.Name+".yaml"), rev); err != nil {
+			b.AddError(err)
+		}
+	}
+}
+
+// collectResources collects the ResourceTrackers and the resources managed by the current ones, it returns the
+// resources in each namespace
+func (c *Collector) collectResources(ctx context.Context, b *Bundle, app *v1beta1.Application) map[nsKey][]objectKey {
+	objects := map[nsKey][]objectKey{}
+	rootRT, currentRT, historyRTs, crRT, err := resourcetracker.ListApplicationResourceTrackers(ctx, c.Client, app)
+	if err != nil {
+		b.AddError(err)
+		return objects
+	}
+	for _, rt := range append([]*v1beta1.ResourceTracker{rootRT, currentRT, crRT}, historyRTs...) {
+		if rt == nil {
+			continue
+		}
+		if err = b.AddObject(path.Join(ResourceTrackersDir, rt.Name+".yaml"), rt); err != nil {
+			b.AddError(err)
+		}
+	}
+	for _, rt := range []*v1beta1.ResourceTracker{rootRT, currentRT} {
+		if rt == nil {
+			continue
+		}
+		for _, mr := range rt.Spec.ManagedResources {
+			if mr.Deleted {
+				continue
+			}
+			cluster, namespace := clusterName(mr.Cluster), mr.Namespace
+			objects[nsKey{cluster, namespace}] = append(objects[nsKey{cluster, namespace}], objectKey{kind: mr.Kind, name: mr.Name})
+			obj := mr.ToUnstructured()
+			if err = c.Client.Get(multicluster.ContextWithClusterName(ctx, cluster), client.ObjectKeyFromObject(obj), obj); err != nil {
+				if kerrors.IsNotFound(err) {
+					b.Index.MissingResources = append(b.Index.MissingResources, mr.DisplayName())
+				} else {
+					b.AddError(fmt.Errorf("failed to get %s: %w", mr.DisplayName(), err))
+				}
+				continue
+			}
+			if namespace == "" {
+				namespace = "_cluster"
+			}
+			if err = b.AddObject(path.Join(ResourcesDir, cluster, namespace, strings.ToLower(mr.Kind)+"."+mr.Name+".yaml"), obj); err != nil {
+				b.AddError(err)
+			}
+		}
+	}
+	return objects
+}
+
+func (c *Collector) collectPods(ctx context.Context, b *Bundle) {
+	for _, p := range c.Pods {
+		cluster := clusterName(p.Cluster)
+		pod := &corev1.Pod{}
+		if err := c.Client.Get(multicluster.ContextWithClusterName(ctx, cluster), client.ObjectKey{Namespace: p.Metadata.Namespace, Name: p.Metadata.Name}, pod); err != nil {
+			b.AddError(fmt.Errorf("failed to get pod %s/%s in cluster %s: %w", p.Metadata.Namespace, p.Metadata.Name, cluster, err))
+			continue
+		}
+		if err := b.AddObject(path.Join(PodsDir, cluster, pod.Namespace, pod.Name+".yaml"), pod); err != nil {
+			b.AddError(err)
+		}
+	}
+}
+
+// collectEvents collects the events involving the objects in each namespace
+func (c *Collector) collectEvents(ctx context.Context, b *Bundle, objects map[nsKey][]objectKey) {
+	for key, keys := range objects {
+		if key.namespace == "" {
+			continue
+		}
+		events := &corev1.EventList{}
+		if err := c.Client.List(multicluster.ContextWithClusterName(ctx, key.cluster), events, client.InNamespace(key.namespace)); err != nil {
+			b.AddError(fmt.Errorf("failed to list the events in %s/%s: %w", key.cluster, key.namespace, err))
+			continue
+		}
+		matched := &corev1.EventList{}
+		for _, event := range events.Items {
+			if slices.Contains(keys, objectKey{kind: event.InvolvedObject.Kind, name: event.InvolvedObject.Name}) {
+				matched.Items = append(matched.Items, event)
+			}
+		}
+		if len(matched.Items) == 0 {
+			continue
+		}
+		sort.SliceStable(matched.Items, func(i, j int) bool {
+			return matched.Items[i].LastTimestamp.Before(&matched.Items[j].LastTimestamp)
+		})
+		if err := b.AddObject(path.Join(EventsDir, key.cluster, key.namespace+".yaml"), matched); err != nil {
+			b.AddError(err)
+		}
+	}
+}
+
+func (c *Collector) collectLogs(ctx context.Context, b *Bundle, app *v1beta1.Application) {
+	if c.ClientSet == nil {
+		return
+	}
+	if len(c.Pods) > 0 {
+		err := utils.VisitPodsLogs(ctx, c.ClientSet, c.Pods, c.LogOptions, func(p string, reader io.Reader) error {
+			data, err := io.ReadAll(reader)
+			if err != nil {
+				return err
+			}
+			b.AddFile(path.Join(LogsDir, filepath.ToSlash(p)), data)
+			return nil
+		})
+		var errs velaerr.ErrorList
+		if errors.As(err, &errs) {
+			for _, e := range errs {
+				b.AddError(e)
+			}
+		} else if err != nil {
+			b.AddError(err)
+		}
+	}
+	if c.ControllerNamespace == "" {
+		return
+	}
+	ctx = multicluster.ContextWithClusterName(ctx, multicluster.ClusterLocalName)
+	pods, err := c.ClientSet.CoreV1().Pods(c.ControllerNamespace).List(ctx, metav1.ListOptions{LabelSelector: ControllerLabelSelector})
+	if err != nil {
+		b.AddError(fmt.Errorf("failed to list the pods of the controller: %w", err))
+		return
+	}
+	for _, pod := range pods.Items {
+		logs, err := c.controllerLogs(ctx, pod, app)
+		if err != nil {
+			b.AddError(err)
+			continue
+		}
+		b.AddFile(path.Join(ControllerLogsDir, pod.Name+".log"), logs)
+	}
+}
+
+// controllerLogs reads the logs of the controller pod mentioning the application
+func (c *Collector) controllerLogs(ctx context.Context, pod corev1.Pod, app *v1beta1.Application) ([]byte, error) {
+	opts := &corev1.PodLogOptions{TailLines: c.LogOptions.TailLines}
+	if c.LogOptions.Since > 0 {
+		since := int64(c.LogOptions.Since.Seconds())
+		opts.SinceSeconds = &since
+	}
+	reader, err := c.ClientSet.CoreV1().Pods(pod.Namespace).GetLogs(pod.Name, opts).Stream(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the logs of the controller pod %s: %w", pod.Name, err)
+	}
+	defer func() { _ = reader.Close() }()
+	var logs strings.Builder
+	scanner := bufio.NewScanner(reader)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		if line := scanner.Text(); strings.Contains(line, app.Name) {
+			logs.WriteString(line + "\n")
+		}
+	}
+	return []byte(logs.String()), scanner.Err()
+}
@@ -0,0 +1,187 @@
+/*
+Copyright 2026 The KubeVela Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package supportbundle
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	workflowv1alpha1 "github.com/kubevela/workflow/api/v1alpha1"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/oam-dev/kubevela/apis/core.oam.dev/common"
+	"github.com/oam-dev/kubevela/apis/core.oam.dev/v1beta1"
+	"github.com/oam-dev/kubevela/pkg/oam"
+	querytypes "github.com/oam-dev/kubevela/pkg/utils/types"
+)
+
+func TestCollectAndAnalyze(t *testing.T) {
+	r := require.New(t)
+	ctx := context.Background()
+	app := &v1beta1.Application{
+		ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "demo", Generation: 1},
+		Spec: v1beta1.ApplicationSpec{Components: []common.ApplicationComponent{{
+			Name: "web", Type: "webservice",
+			Properties: &runtime.RawExtension{Raw: []byte(`{"image":"nginx","database":{"host":"db","password":"p@ss","secretName":"web-db"}}`)},
+		}}},
+		Status: common.AppStatus{
+			Phase: common.ApplicationWorkflowFailed,
+			Services: []common.ApplicationComponentStatus{
+				{Name: "web", Namespace: "demo", Healthy: false, Message: "Ready:0/1"},
+			},
+			Workflow: &common.WorkflowStatus{Steps: []workflowv1alpha1.WorkflowStepStatus{{
+				StepStatus: workflowv1alpha1.StepStatus{Name: "deploy", Phase: workflowv1alpha1.WorkflowStepPhaseFailed, Reason: "Timeout", Message: "wait healthy"},
+			}}},
+		},
+	}
+	secretData := `{"apiVersion":"v1","kind":"Secret","metadata":{"name":"web-db","namespace":"demo"},"stringData":{"password":"p@ss"}}`
+	managed := func(apiVersion, kind, name, data string) v1beta1.ManagedResource {
+		mr := v1beta1.ManagedResource{ClusterObjectReference: common.ClusterObjectReference{
+			ObjectReference: corev1.ObjectReference{APIVersion: apiVersion, Kind: kind, Name: name, Namespace: "demo"},
+		}}
+		if data != "" {
+			mr.Data = &runtime.RawExtension{Raw: []byte(data)}
+		}
+		return mr
+	}
+	rt := &v1beta1.ResourceTracker{
+		ObjectMeta: metav1.ObjectMeta{Name: "web-v1-demo", Labels: map[string]string{oam.LabelAppName: "web", oam.LabelAppNamespace: "demo"}},
+		Spec: v1beta1.ResourceTrackerSpec{
+			Type:                  v1beta1.ResourceTrackerTypeVersioned,
+			ApplicationGeneration: 1,
+			ManagedResources: []v1beta1.ManagedResource{
+				managed("apps/v1", "Deployment", "web", ""),
+				managed("v1", "Secret", "web-db", secretData),
+				managed("v1", "Service", "web", ""),
+			},
+		},
+	}
+	rev := &v1beta1.ApplicationRevision{ObjectMeta: metav1.ObjectMeta{Name: "web-v1", Namespace: "demo", Labels: map[string]string{oam.LabelAppName: "web"}}}
+	rev.Spec.Application = *app
+	deploy := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "demo"},
+		Spec: appsv1.DeploymentSpec{Template: corev1.PodTemplateSpec{Spec: corev1.PodSpec{Containers: []corev1.Container{{
+			Name: "main",
+			Env:  []corev1.EnvVar{{Name: "DB_PASSWORD", Value: "p@ss"}, {Name: "DB_HOST", Value: "db"}},
+		}}}}},
+	}
+	secret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "web-db", Namespace: "demo", Annotations: map[string]string{
+		oam.AnnotationLastAppliedConfig: `{"apiVersion":"v1","kind":"Secret","data":{"password":"cEBzcw=="}}`,
+	}}, Data: map[string][]byte{"password": []byte("p@ss")}}
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "web-6d8f-x2k9", Namespace: "demo"},
+		Spec:       corev1.PodSpec{Containers: []corev1.Container{{Name: "main"}}},
+		Status: corev1.PodStatus{Phase: corev1.PodRunning, ContainerStatuses: []corev1.ContainerStatus{{
+			Name:         "main",
+			RestartCount: 3,
+			State:        corev1.ContainerState{Waiting: &corev1.ContainerStateWaiting{Reason: "CrashLoopBackOff", Message: "back-off 5m0s"}},
+		}}},
+	}
+	events := []corev1.Event{
+		{ObjectMeta: metav1.ObjectMeta{Name: "e1", Namespace: "demo"}, Type: corev1.EventTypeWarning, Reason: "BackOff", Message: "Back-off restarting failed container", Count: 12,
+			InvolvedObject: corev1.ObjectReference{Kind: "Pod", Name: "web-6d8f-x2k9", Namespace: "demo"}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "e2", Namespace: "demo"}, Type: corev1.EventTypeNormal, Reason: "ScalingReplicaSet",
+			InvolvedObject: corev1.ObjectReference{Kind: "Deployment", Name: "web", Namespace: "demo"}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "e3", Namespace: "demo"}, Type: corev1.EventTypeWarning, Reason: "Failed",
+			InvolvedObject: corev1.ObjectReference{Kind: "Pod", Name: "other", Namespace: "demo"}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "e4", Namespace: "demo"}, Type: corev1.EventTypeWarning, Reason: "Failed",
+			InvolvedObject: corev1.ObjectReference{Kind: "Pod", Name: "web-6d8f-old", Namespace: "demo"}},
+	}
+	cli := fake.NewClientBuilder().WithScheme(scheme).WithObjects(app, rt, rev, deploy, secret, pod, &events[0], &events[1], &events[2], &events[3]).Build()
+	controller := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "kubevela-vela-core-0", Namespace: "vela-system", Labels: map[string]string{"app.kubernetes.io/name": "vela-core"}}}
+	clientSet := kubefake.NewSimpleClientset(pod, controller)
+	podBase, gone := &querytypes.PodBase{}, &querytypes.PodBase{}
+	podBase.Metadata.Name, podBase.Metadata.Namespace = pod.Name, pod.Namespace
+	gone.Metadata.Name, gone.Metadata.Namespace = "web-6d8f-gone", pod.Namespace
+	collector := &Collector{Client: cli, ClientSet: clientSet, Pods: []*querytypes.PodBase{gone, podBase}, ControllerNamespace: "vela-system"}
+
+	bundle, err := collector.Collect(ctx, app)
+	r.NoError(err)
+	buf := &bytes.Buffer{}
+	r.NoError(bundle.WriteArchive(buf))
+	bundle, err = ReadArchive(buf)
+	r.NoError(err)
+
+	r.Equal("web", bundle.Index.Application)
+	r.Equal([]string{"Service web (Namespace: demo)"}, bundle.Index.MissingResources)
+	// the pod gone does not stop collecting the others
+	r.Len(bundle.Index.Errors, 2)
+	for _, e := range bundle.Index.Errors {
+		r.Contains(e, "web-6d8f-gone")
+	}
+	r.Equal([]string{
+		ApplicationFile,
+		"events/local/demo.yaml",
+		"logs/controller/kubevela-vela-core-0.log",
+		"logs/local/demo/web-6d8f-x2k9/main.log",
+		"pods/local/demo/web-6d8f-x2k9.yaml",
+		"resources/local/demo/deployment.web.yaml",
+		"resources/local/demo/secret.web-db.yaml",
+		"resourcetrackers/web-v1-demo.yaml",
+		"revisions/web-v1.yaml",
+		SummaryFile,
+		WorkflowFile,
+	}, bundle.Index.Files)
+	r.Equal("fake logs", string(bundle.Files["logs/local/demo/web-6d8f-x2k9/main.log"]))
+	r.Empty(bundle.Files["logs/controller/kubevela-vela-core-0.log"])
+
+	// the secrets are masked
+	for name, data := range bundle.Files {
+		r.NotContains(string(data), "p@ss", name)
+		r.NotContains(string(data), "cEBzcw==", name)
+	}
+	r.Contains(string(bundle.Files["resources/local/demo/secret.web-db.yaml"]), "password: <redacted>")
+	r.Contains(string(bundle.Files["resources/local/demo/deployment.web.yaml"]), "value: db")
+	r.Contains(string(bundle.Files["resourcetrackers/web-v1-demo.yaml"]), "password: <redacted>")
+	r.NotContains(string(bundle.Files["resources/local/demo/secret.web-db.yaml"]), oam.AnnotationLastAppliedConfig)
+	for _, name := range []string{ApplicationFile, "revisions/web-v1.yaml"} {
+		r.Contains(string(bundle.Files[name]), "password: <redacted>", name)
+		r.Contains(string(bundle.Files[name]), "secretName: web-db", name)
+	}
+	r.NotContains(string(bundle.Files["events/local/demo.yaml"]), "web-6d8f-old")
+	r.Contains(string(bundle.Files[ApplicationFile]), "kind: Application")
+
+	summary := Summary{}
+	r.NoError(bundle.Unmarshal(SummaryFile, &summary))
+	r.Equal(summary.Problems, Analyze(bundle))
+	var messages []string
+	for _, p := range summary.Problems {
+		messages = append(messages, string(p.Severity)+" "+p.Source+" "+p.Message)
+	}
+	r.Equal([]string{
+		"error Application demo/web the application is workflowFailed",
+		"error Application demo/web workflow step deploy failed: Timeout: wait healthy",
+		"error Component local/demo/web the component is unhealthy: Ready:0/1",
+		"error Service web (Namespace: demo) the resource is recorded in the ResourceTracker but not found",
+		"error Pod local/demo/web-6d8f-x2k9 container main is waiting for CrashLoopBackOff: back-off 5m0s",
+		"warning Pod local/demo/web-6d8f-x2k9 container main restarted 3 times",
+		"warning Pod local/demo/web-6d8f-x2k9 BackOff: Back-off restarting failed container (x12)",
+		`warning Bundle incomplete collection: failed to get pod demo/web-6d8f-gone in cluster local: pods "web-6d8f-gone" not found`,
+		`warning Bundle incomplete collection: failed to get pod demo/web-6d8f-gone in cluster local: pods "web-6d8f-gone" not found`,
+	}, messages)
+
+	_, err = ReadArchive(strings.NewReader("not a bundle"))
+	r.Error(err)
+}
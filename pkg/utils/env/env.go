@@ -232,9 +232,24 @@ func SetCurrentEnv(meta *types.EnvMeta) error {
 
 // getEnvNamespaceByName get v1.Namespace object by env name
 func getEnvNamespaceByName(name string) (*v1.Namespace, error) {
-	ctx := context.Background()
+	return getEnvNamespace(context.Background(), singleton.KubeClient.Get(), name)
+}
+
+// GetEnvNamespace get the namespace of the env with the given client
+func GetEnvNamespace(ctx context.Context, cli client.Client, name string) (string, error) {
+	if name == DefaultEnvNamespace {
+		return DefaultEnvNamespace, nil
+	}
+	namespace, err := getEnvNamespace(ctx, cli, name)
+	if err != nil {
+		return "", err
+	}
+	return namespace.Name, nil
+}
+
+func getEnvNamespace(ctx context.Context, cli client.Client, name string) (*v1.Namespace, error) {
 	var nsList v1.NamespaceList
-	err := singleton.KubeClient.Get().List(ctx, &nsList, client.MatchingLabels{oam.LabelNamespaceOfEnvName: name})
+	err := cli.List(ctx, &nsList, client.MatchingLabels{oam.LabelNamespaceOfEnvName: name})
 	if err != nil {
 		return nil, err
 	}
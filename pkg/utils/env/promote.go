@@ -0,0 +1,181 @@
+/*
+Copyright 2026 The KubeVela Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package env
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/oam-dev/kubevela/apis/core.oam.dev/common"
+	"github.com/oam-dev/kubevela/apis/core.oam.dev/v1alpha1"
+	"github.com/oam-dev/kubevela/apis/core.oam.dev/v1beta1"
+	"github.com/oam-dev/kubevela/pkg/oam"
+	"github.com/oam-dev/kubevela/pkg/policy/envbinding"
+)
+
+// ErrRevisionNotPromotable indicates the source revision is not ready to be promoted yet, because it is not healthy
+// or has not soaked long enough
+var ErrRevisionNotPromotable = errors.New("application revision is not ready for promotion")
+
+// PromoteOptions describes how to promote an application revision from one env to another
+type PromoteOptions struct {
+	// AppName is the name of the application in both envs
+	AppName string
+	// SourceEnv and TargetEnv are the names of the envs
+	SourceEnv string
+	TargetEnv string
+	// Revision is the name of the application revision to promote, default to the latest revision of the source app
+	Revision string
+	// Overrides patches the components for the target env
+	Overrides []v1alpha1.EnvComponentPatch
+	// MinSoakTime is the minimal time the source revision must have been running since its workflow finished
+	MinSoakTime time.Duration
+	// DryRun if set, the promoted application is returned without being applied
+	DryRun bool
+}
+
+// Promote copies the components of the source application revision to the application in the target env.
+// The policies and workflow of an existing target application are kept, as they describe the target env.
+// The lineage of the promotion is recorded in the annotations of the target application, which are passed to
+// its application revisions by the application controller.
+func Promote(ctx context.Context, cli client.Client, opts PromoteOptions) (*v1beta1.Application, error) {
+	if opts.SourceEnv == opts.TargetEnv {
+		return nil, fmt.Errorf("cannot promote application %s to the same env %s", opts.AppName, opts.SourceEnv)
+	}
+	sourceNs, err := GetEnvNamespace(ctx, cli, opts.SourceEnv)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get source env %s", opts.SourceEnv)
+	}
+	targetNs, err := GetEnvNamespace(ctx, cli, opts.TargetEnv)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get target env %s", opts.TargetEnv)
+	}
+	if sourceNs == targetNs {
+		return nil, fmt.Errorf("env %s and env %s share the same namespace %s", opts.SourceEnv, opts.TargetEnv, sourceNs)
+	}
+	sourceApp := &v1beta1.Application{}
+	if err = cli.Get(ctx, client.ObjectKey{Namespace: sourceNs, Name: opts.AppName}, sourceApp); err != nil {
+		return nil, errors.Wrapf(err, "failed to get application %s in env %s", opts.AppName, opts.SourceEnv)
+	}
+	rev, err := getPromotableRevision(ctx, cli, sourceApp, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	components := rev.Spec.Application.Spec.DeepCopy().Components
+	if len(opts.Overrides) > 0 {
+		if components, err = envbinding.PatchComponents(components, opts.Overrides, nil); err != nil {
+			return nil, errors.Wrapf(err, "failed to apply overrides of env %s", opts.TargetEnv)
+		}
+	}
+
+	targetApp := &v1beta1.Application{}
+	err = cli.Get(ctx, client.ObjectKey{Namespace: targetNs, Name: opts.AppName}, targetApp)
+	switch {
+	case apierrors.IsNotFound(err):
+		targetApp = &v1beta1.Application{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      opts.AppName,
+				Namespace: targetNs,
+				Labels:    rev.Spec.Application.GetLabels(),
+			},
+			Spec: *rev.Spec.Application.Spec.DeepCopy(),
+		}
+	case err != nil:
+		return nil, errors.Wrapf(err, "failed to get application %s in env %s", opts.AppName, opts.TargetEnv)
+	}
+	targetApp.Spec.Components = components
+
+	source := rev.Namespace + "/" + rev.Name
+	lineage := rev.GetAnnotations()[oam.AnnotationPromotionLineage]
+	if lineage == "" {
+		lineage = source
+	} else {
+		lineage = lineage + "," + source
+	}
+	annotations := map[string]string{
+		oam.AnnotationPromotedFrom:     source,
+		oam.AnnotationPromotedFromEnv:  opts.SourceEnv,
+		oam.AnnotationPromotedAt:       time.Now().UTC().Format(time.RFC3339),
+		oam.AnnotationPromotionLineage: lineage,
+	}
+	// the workflow of the application with publish version only runs when the version changes
+	if _, found := targetApp.GetAnnotations()[oam.AnnotationPublishVersion]; found {
+		annotations[oam.AnnotationPublishVersion] = "promote-" + rev.Name
+	}
+	for k, v := range annotations {
+		metav1.SetMetaDataAnnotation(&targetApp.ObjectMeta, k, v)
+	}
+
+	if opts.DryRun {
+		return targetApp, nil
+	}
+	if targetApp.ResourceVersion == "" {
+		err = cli.Create(ctx, targetApp)
+	} else {
+		err = cli.Update(ctx, targetApp)
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to apply application %s in env %s", opts.AppName, opts.TargetEnv)
+	}
+	return targetApp, nil
+}
+
+// getPromotableRevision gets the revision to promote and checks it is healthy and has soaked long enough
+func getPromotableRevision(ctx context.Context, cli client.Client, app *v1beta1.Application, opts PromoteOptions) (*v1beta1.ApplicationRevision, error) {
+	revName := opts.Revision
+	if revName == "" {
+		if app.Status.LatestRevision == nil {
+			return nil, fmt.Errorf("application %s in env %s has no revision", app.Name, opts.SourceEnv)
+		}
+		revName = app.Status.LatestRevision.Name
+	}
+	rev := &v1beta1.ApplicationRevision{}
+	if err := cli.Get(ctx, client.ObjectKey{Namespace: app.Namespace, Name: revName}, rev); err != nil {
+		return nil, errors.Wrapf(err, "failed to get application revision %s", revName)
+	}
+	if rev.GetLabels()[oam.LabelAppName] != app.Name {
+		return nil, fmt.Errorf("application revision %s does not belong to application %s", revName, app.Name)
+	}
+	if !rev.Status.Succeeded || rev.Status.Workflow == nil || rev.Status.Workflow.EndTime.IsZero() {
+		return nil, fmt.Errorf("%w: application revision %s has not been delivered successfully", ErrRevisionNotPromotable, revName)
+	}
+	if app.Status.LatestRevision != nil && app.Status.LatestRevision.Name == revName && app.Status.Phase != common.ApplicationRunning {
+		return nil, fmt.Errorf("%w: application %s in env %s is not healthy, current phase is %s", ErrRevisionNotPromotable, app.Name, opts.SourceEnv, app.Status.Phase)
+	}
+	if soaked := time.Since(rev.Status.Workflow.EndTime.Time); soaked < opts.MinSoakTime {
+		return nil, fmt.Errorf("%w: application revision %s has only soaked for %s, at least %s is required",
+			ErrRevisionNotPromotable, revName, soaked.Truncate(time.Second), opts.MinSoakTime)
+	}
+	return rev, nil
+}
+
+// GetPromotionLineage returns the application revisions the application is promoted through
+func GetPromotionLineage(obj metav1.Object) []string {
+	lineage := obj.GetAnnotations()[oam.AnnotationPromotionLineage]
+	if lineage == "" {
+		return nil
+	}
+	return strings.Split(lineage, ",")
+}
@@ -0,0 +1,144 @@
+/*
+Copyright 2026 The KubeVela Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package env
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/oam-dev/kubevela/apis/core.oam.dev/common"
+	"github.com/oam-dev/kubevela/apis/core.oam.dev/v1alpha1"
+	"github.com/oam-dev/kubevela/apis/core.oam.dev/v1beta1"
+	"github.com/oam-dev/kubevela/pkg/oam"
+)
+
+func newPromoteTestObjects(phase common.ApplicationPhase, finishedAt time.Time) []client.Object {
+	envNamespace := func(env, ns string) *v1.Namespace {
+		return &v1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: ns, Labels: map[string]string{oam.LabelNamespaceOfEnvName: env}}}
+	}
+	comps := []common.ApplicationComponent{{
+		Name:       "web",
+		Type:       "webservice",
+		Properties: &runtime.RawExtension{Raw: []byte(`{"image":"nginx:1.21"}`)},
+	}}
+	app := &v1beta1.Application{
+		ObjectMeta: metav1.ObjectMeta{Name: "app", Namespace: "staging-ns"},
+		Spec:       v1beta1.ApplicationSpec{Components: comps},
+		Status: common.AppStatus{
+			Phase:          phase,
+			LatestRevision: &common.Revision{Name: "app-v2"},
+		},
+	}
+	rev := func(name string) *v1beta1.ApplicationRevision {
+		r := &v1beta1.ApplicationRevision{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "staging-ns", Labels: map[string]string{oam.LabelAppName: "app"}},
+		}
+		r.Spec.Application = *app.DeepCopy()
+		r.Status.Succeeded = true
+		r.Status.Workflow = &common.WorkflowStatus{EndTime: metav1.NewTime(finishedAt)}
+		return r
+	}
+	v1Rev := rev("app-v1")
+	v1Rev.SetAnnotations(map[string]string{oam.AnnotationPromotionLineage: "dev-ns/app-v5"})
+	target := &v1beta1.Application{
+		ObjectMeta: metav1.ObjectMeta{Name: "app", Namespace: "prod-ns", Annotations: map[string]string{oam.AnnotationPublishVersion: "alpha"}},
+		Spec: v1beta1.ApplicationSpec{
+			Components: []common.ApplicationComponent{{Name: "old", Type: "worker"}},
+			Policies:   []v1beta1.AppPolicy{{Name: "topology", Type: v1alpha1.TopologyPolicyType}},
+		},
+	}
+	return []client.Object{envNamespace("staging", "staging-ns"), envNamespace("prod", "prod-ns"), envNamespace("test", "test-ns"),
+		app, rev("app-v2"), v1Rev, target}
+}
+
+func TestPromote(t *testing.T) {
+	ctx := context.Background()
+	scheme := runtime.NewScheme()
+	assert.NoError(t, v1.AddToScheme(scheme))
+	assert.NoError(t, v1beta1.AddToScheme(scheme))
+	newClient := func(phase common.ApplicationPhase, finishedAt time.Time) client.Client {
+		return fake.NewClientBuilder().WithScheme(scheme).WithObjects(newPromoteTestObjects(phase, finishedAt)...).Build()
+	}
+
+	t.Run("promote to existing application", func(t *testing.T) {
+		cli := newClient(common.ApplicationRunning, time.Now().Add(-time.Hour))
+		_, err := Promote(ctx, cli, PromoteOptions{
+			AppName: "app", SourceEnv: "staging", TargetEnv: "prod", MinSoakTime: 30 * time.Minute,
+			Overrides: []v1alpha1.EnvComponentPatch{{Name: "web", Properties: &runtime.RawExtension{Raw: []byte(`{"cpu":"1"}`)}}},
+		})
+		assert.NoError(t, err)
+		promoted := &v1beta1.Application{}
+		assert.NoError(t, cli.Get(ctx, client.ObjectKey{Namespace: "prod-ns", Name: "app"}, promoted))
+		assert.Len(t, promoted.Spec.Components, 1)
+		assert.JSONEq(t, `{"image":"nginx:1.21","cpu":"1"}`, string(promoted.Spec.Components[0].Properties.Raw))
+		assert.Len(t, promoted.Spec.Policies, 1)
+		assert.Equal(t, "staging-ns/app-v2", promoted.GetAnnotations()[oam.AnnotationPromotedFrom])
+		assert.Equal(t, "staging", promoted.GetAnnotations()[oam.AnnotationPromotedFromEnv])
+		assert.Equal(t, "promote-app-v2", promoted.GetAnnotations()[oam.AnnotationPublishVersion])
+		assert.Equal(t, []string{"staging-ns/app-v2"}, GetPromotionLineage(promoted))
+	})
+
+	t.Run("promote specified revision to new application", func(t *testing.T) {
+		cli := newClient(common.ApplicationRunning, time.Now())
+		_, err := Promote(ctx, cli, PromoteOptions{AppName: "app", SourceEnv: "staging", TargetEnv: "test", Revision: "app-v1"})
+		assert.NoError(t, err)
+		promoted := &v1beta1.Application{}
+		assert.NoError(t, cli.Get(ctx, client.ObjectKey{Namespace: "test-ns", Name: "app"}, promoted))
+		assert.Equal(t, "web", promoted.Spec.Components[0].Name)
+		assert.Equal(t, []string{"dev-ns/app-v5", "staging-ns/app-v1"}, GetPromotionLineage(promoted))
+		_, found := promoted.GetAnnotations()[oam.AnnotationPublishVersion]
+		assert.False(t, found)
+	})
+
+	t.Run("dry run", func(t *testing.T) {
+		cli := newClient(common.ApplicationRunning, time.Now())
+		app, err := Promote(ctx, cli, PromoteOptions{AppName: "app", SourceEnv: "staging", TargetEnv: "prod", DryRun: true})
+		assert.NoError(t, err)
+		assert.Equal(t, "web", app.Spec.Components[0].Name)
+		existing := &v1beta1.Application{}
+		assert.NoError(t, cli.Get(ctx, client.ObjectKey{Namespace: "prod-ns", Name: "app"}, existing))
+		assert.Equal(t, "old", existing.Spec.Components[0].Name)
+	})
+
+	t.Run("refuse unhealthy application", func(t *testing.T) {
+		cli := newClient(common.ApplicationUnhealthy, time.Now())
+		_, err := Promote(ctx, cli, PromoteOptions{AppName: "app", SourceEnv: "staging", TargetEnv: "prod"})
+		assert.True(t, errors.Is(err, ErrRevisionNotPromotable))
+	})
+
+	t.Run("refuse revision not soaked", func(t *testing.T) {
+		cli := newClient(common.ApplicationRunning, time.Now())
+		_, err := Promote(ctx, cli, PromoteOptions{AppName: "app", SourceEnv: "staging", TargetEnv: "prod", MinSoakTime: time.Hour})
+		assert.True(t, errors.Is(err, ErrRevisionNotPromotable))
+	})
+
+	t.Run("refuse same env", func(t *testing.T) {
+		cli := newClient(common.ApplicationRunning, time.Now())
+		_, err := Promote(ctx, cli, PromoteOptions{AppName: "app", SourceEnv: "staging", TargetEnv: "staging"})
+		assert.Error(t, err)
+		assert.False(t, errors.Is(err, ErrRevisionNotPromotable))
+	})
+}
@@ -221,8 +221,8 @@ func IsClusterScope(gvk schema.GroupVersionKind, mapper meta.RESTMapper) (bool,
 	return isClusterScope, err
 }
 
-// GetPodsLogs get logs from pods
-func GetPodsLogs(ctx context.Context, config *rest.Config, containerName string, selectPods []*querytypes.PodBase, tmpl string, logC chan<- string, tailLines *int64) error {
+// GetPodsLogs get logs from pods, the logs in the last 48 hours are returned if since is not positive
+func GetPodsLogs(ctx context.Context, config *rest.Config, containerName string, selectPods []*querytypes.PodBase, tmpl string, logC chan<- string, tailLines *int64, since time.Duration) error {
 	if err := verifyPods(selectPods); err != nil {
 		return err
 	}
@@ -288,8 +288,10 @@ func GetPodsLogs(ctx context.Context, config *rest.Config, containerName string,
 			if tails[id] != nil {
 				continue
 			}
-			// 48h
-			dur, _ := time.ParseDuration("48h")
+			dur := since
+			if dur <= 0 {
+				dur = 48 * time.Hour
+			}
 			tail := stern.NewTail(p.Namespace, p.Pod, p.Container, template, &stern.TailOptions{
 				Timestamps:   true,
 				SinceSeconds: int64(dur.Seconds()),
@@ -0,0 +1,183 @@
+/*
+Copyright 2026 The KubeVela Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package utils
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/kubevela/pkg/multicluster"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+
+	velaerr "github.com/oam-dev/kubevela/pkg/utils/errors"
+	querytypes "github.com/oam-dev/kubevela/pkg/utils/types"
+)
+
+// PodLogOptions the options for reading the logs of pods
+type PodLogOptions struct {
+	// Container only reads the logs of the container if set, otherwise all containers of the pods
+	Container string
+	// Since only reads the logs newer than the duration if positive
+	Since time.Duration
+	// TailLines only reads the last lines of the logs if set
+	TailLines *int64
+	// Follow keeps streaming the logs until the context is done
+	Follow bool
+}
+
+// PodLogLine is one line of the logs of a container
+type PodLogLine struct {
+	Cluster   string `json:"cluster"`
+	Namespace string `json:"namespace"`
+	Pod       string `json:"pod"`
+	Container string `json:"container"`
+	Message   string `json:"message"`
+}
+
+// podContainer is a container to read logs from
+type podContainer struct {
+	cluster   string
+	namespace string
+	pod       string
+	container string
+}
+
+// listPodContainers lists the containers of the pods in their clusters
+func listPodContainers(ctx context.Context, cli kubernetes.Interface, pods []*querytypes.PodBase, container string) ([]podContainer, error) {
+	var containers []podContainer
+	for _, p := range pods {
+		cluster := p.Cluster
+		if cluster == "" {
+			cluster = multicluster.Local
+		}
+		pod, err := cli.CoreV1().Pods(p.Metadata.Namespace).Get(multicluster.WithCluster(ctx, cluster), p.Metadata.Name, metav1.GetOptions{})
+		if err != nil {
+			return nil, fmt.Errorf("failed to get pod %s/%s in cluster %s: %w", p.Metadata.Namespace, p.Metadata.Name, cluster, err)
+		}
+		for _, c := range pod.Spec.Containers {
+			if container == "" || c.Name == container {
+				containers = append(containers, podContainer{cluster: cluster, namespace: pod.Namespace, pod: pod.Name, container: c.Name})
+			}
+		}
+	}
+	return containers, nil
+}
+
+func (c podContainer) stream(ctx context.Context, cli kubernetes.Interface, opts PodLogOptions) (io.ReadCloser, error) {
+	logOpts := &corev1.PodLogOptions{Container: c.container, Follow: opts.Follow, TailLines: opts.TailLines}
+	if opts.Since > 0 {
+		since := int64(opts.Since.Seconds())
+		logOpts.SinceSeconds = &since
+	}
+	return cli.CoreV1().Pods(c.namespace).GetLogs(c.pod, logOpts).Stream(multicluster.WithCluster(ctx, c.cluster))
+}
+
+// StreamPodsLogs reads the logs of all containers of the pods across clusters at the same time, and sends them
+// to the channel line by line. It returns once all the logs are read, or the context is done when following.
+// The containers failing to stream are reported to the error channel if it is not nil.
+func StreamPodsLogs(ctx context.Context, cli kubernetes.Interface, pods []*querytypes.PodBase, opts PodLogOptions, logC chan<- PodLogLine, errC chan<- error) error {
+	containers, err := listPodContainers(ctx, cli, pods, opts.Container)
+	if err != nil {
+		return err
+	}
+	if len(containers) == 0 {
+		return fmt.Errorf("no container found in the pods")
+	}
+	wg := sync.WaitGroup{}
+	for _, c := range containers {
+		wg.Add(1)
+		go func(c podContainer) {
+			defer wg.Done()
+			reader, err := c.stream(ctx, cli, opts)
+			if err != nil {
+				if errC != nil {
+					errC <- fmt.Errorf("failed to stream the logs of %s/%s/%s in cluster %s: %w", c.namespace, c.pod, c.container, c.cluster, err)
+				}
+				return
+			}
+			defer func() { _ = reader.Close() }()
+			scanner := bufio.NewScanner(reader)
+			scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+			for scanner.Scan() {
+				line := PodLogLine{Cluster: c.cluster, Namespace: c.namespace, Pod: c.pod, Container: c.container, Message: scanner.Text()}
+				select {
+				case logC <- line:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}(c)
+	}
+	wg.Wait()
+	return nil
+}
+
+// VisitPodsLogs reads the logs of all containers of the pods one by one without following, and visits them with
+// the relative path <cluster>/<namespace>/<pod>/<container>.log of each container. The failures of the pods and
+// containers do not stop visiting the others, they are returned together as an ErrorList.
+func VisitPodsLogs(ctx context.Context, cli kubernetes.Interface, pods []*querytypes.PodBase, opts PodLogOptions, visit func(path string, reader io.Reader) error) error {
+	opts.Follow = false
+	var containers []podContainer
+	var errs []error
+	for _, pod := range pods {
+		podContainers, err := listPodContainers(ctx, cli, []*querytypes.PodBase{pod}, opts.Container)
+		errs = append(errs, err)
+		containers = append(containers, podContainers...)
+	}
+	for _, c := range containers {
+		reader, err := c.stream(ctx, cli, opts)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed to read the logs of %s/%s/%s in cluster %s: %w", c.namespace, c.pod, c.container, c.cluster, err))
+			continue
+		}
+		err = visit(filepath.Join(c.cluster, c.namespace, c.pod, c.container+".log"), reader)
+		_ = reader.Close()
+		errs = append(errs, err)
+	}
+	return velaerr.AggregateErrors(errs)
+}
+
+// ExportPodsLogs writes the logs of all containers of the pods to files in the directory, as
+// <dir>/<cluster>/<namespace>/<pod>/<container>.log. It returns the paths of the written files.
+func ExportPodsLogs(ctx context.Context, cli kubernetes.Interface, pods []*querytypes.PodBase, opts PodLogOptions, dir string) ([]string, error) {
+	var files []string
+	err := VisitPodsLogs(ctx, cli, pods, opts, func(path string, reader io.Reader) error {
+		file := filepath.Join(dir, path)
+		if err := os.MkdirAll(filepath.Dir(file), 0750); err != nil {
+			return err
+		}
+		f, err := os.Create(filepath.Clean(file))
+		if err != nil {
+			return err
+		}
+		defer func() { _ = f.Close() }()
+		if _, err = io.Copy(f, reader); err != nil {
+			return fmt.Errorf("failed to write the logs to %s: %w", file, err)
+		}
+		files = append(files, file)
+		return nil
+	})
+	return files, err
+}
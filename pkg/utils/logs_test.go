@@ -0,0 +1,81 @@
+/*
+Copyright 2026 The KubeVela Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package utils
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	querytypes "github.com/oam-dev/kubevela/pkg/utils/types"
+)
+
+func TestPodsLogs(t *testing.T) {
+	r := require.New(t)
+	ctx := context.Background()
+	newPod := func(name string, containers ...string) *corev1.Pod {
+		pod := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"}}
+		for _, c := range containers {
+			pod.Spec.Containers = append(pod.Spec.Containers, corev1.Container{Name: c})
+		}
+		return pod
+	}
+	cli := fake.NewSimpleClientset(newPod("web", "main", "sidecar"), newPod("worker", "main"))
+	newPodBase := func(cluster, name string) *querytypes.PodBase {
+		pod := &querytypes.PodBase{Cluster: cluster}
+		pod.Metadata.Name, pod.Metadata.Namespace = name, "default"
+		return pod
+	}
+	pods := []*querytypes.PodBase{newPodBase("", "web"), newPodBase("cluster-1", "worker")}
+
+	logC := make(chan PodLogLine, 16)
+	r.NoError(StreamPodsLogs(ctx, cli, pods, PodLogOptions{}, logC, nil))
+	close(logC)
+	var sources []string
+	for line := range logC {
+		r.Equal("fake logs", line.Message)
+		sources = append(sources, line.Cluster+"/"+line.Pod+"/"+line.Container)
+	}
+	sort.Strings(sources)
+	r.Equal([]string{"cluster-1/worker/main", "local/web/main", "local/web/sidecar"}, sources)
+
+	logC = make(chan PodLogLine, 16)
+	r.NoError(StreamPodsLogs(ctx, cli, pods, PodLogOptions{Container: "sidecar"}, logC, nil))
+	close(logC)
+	r.Len(logC, 1)
+	r.Error(StreamPodsLogs(ctx, cli, pods, PodLogOptions{Container: "none"}, logC, nil))
+	r.Error(StreamPodsLogs(ctx, cli, []*querytypes.PodBase{newPodBase("", "none")}, PodLogOptions{}, logC, nil))
+
+	dir := t.TempDir()
+	files, err := ExportPodsLogs(ctx, cli, pods, PodLogOptions{Follow: true}, dir)
+	r.NoError(err)
+	r.Equal([]string{
+		filepath.Join(dir, "local", "default", "web", "main.log"),
+		filepath.Join(dir, "local", "default", "web", "sidecar.log"),
+		filepath.Join(dir, "cluster-1", "default", "worker", "main.log"),
+	}, files)
+	bs, err := os.ReadFile(files[2])
+	r.NoError(err)
+	r.Equal("fake logs", string(bs))
+}
@@ -97,10 +97,12 @@ func (h *ValidatingHandler) Handle(ctx context.Context, req admission.Request) a
 
 	ctx = util.SetNamespaceInCtx(ctx, app.Namespace)
 
+	var warnings admission.Warnings
 	switch req.Operation {
 	case admissionv1.Create:
 		logger.WithStep("validate-create").Info("Validating Application creation - checking components, policies, and workflow configuration")
-		if allErrs := h.ValidateCreate(ctx, app, req); len(allErrs) > 0 {
+		var allErrs field.ErrorList
+		if allErrs, warnings = h.ValidateCreate(ctx, app, req); len(allErrs) > 0 {
 			mergedErr := mergeErrors(allErrs)
 			logger.WithStep("validate-create").WithError(mergedErr).Error(mergedErr, "Application creation validation failed - contains invalid components, policies, or workflow steps", "errorCount", len(allErrs), "applicationName", app.Name)
 			return admission.Errored(http.StatusBadRequest, fmt.Errorf("%w (requestUID=%s)", mergedErr, req.UID))
@@ -118,7 +120,8 @@ func (h *ValidatingHandler) Handle(ctx context.Context, req admission.Request) a
 		logger = logger.WithValues("oldGeneration", oldApp.Generation)
 
 		if app.ObjectMeta.DeletionTimestamp.IsZero() {
-			if allErrs := h.ValidateUpdate(ctx, app, oldApp, req); len(allErrs) > 0 {
+			var allErrs field.ErrorList
+			if allErrs, warnings = h.ValidateUpdate(ctx, app, oldApp, req); len(allErrs) > 0 {
 				mergedErr := mergeErrors(allErrs)
 				logger.WithStep("validate-update").WithError(mergedErr).Error(mergedErr, "Application update validation failed - new configuration contains invalid changes", "errorCount", len(allErrs), "applicationName", app.Name, "oldGeneration", oldApp.Generation, "newGeneration", app.Generation)
 				return admission.Errored(http.StatusBadRequest, fmt.Errorf("%w (requestUID=%s)", mergedErr, req.UID))
@@ -136,6 +139,9 @@ func (h *ValidatingHandler) Handle(ctx context.Context, req admission.Request) a
 	}
 
 	logger.WithStep("complete").WithSuccess(true, startTime).Info("Application admission validation completed successfully - resource will be admitted", "applicationName", req.Name, "operation", req.Operation, "namespace", req.Namespace)
+	if req.Operation == admissionv1.Create || (req.Operation == admissionv1.Update && app.ObjectMeta.DeletionTimestamp.IsZero()) {
+		return admission.ValidationResponse(true, "").WithWarnings(append(h.DefinitionDeprecationWarnings(ctx, app), warnings...)...)
+	}
 	return admission.ValidationResponse(true, "")
 }
 
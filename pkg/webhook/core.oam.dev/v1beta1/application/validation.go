@@ -18,12 +18,15 @@ package application
 
 import (
 	"context"
+	goerrors "errors"
 	"fmt"
 	"reflect"
+	"sort"
 	"time"
 
 	"github.com/kubevela/pkg/controller/sharding"
 	"github.com/kubevela/pkg/util/singleton"
+	admissionv1 "k8s.io/api/admission/v1"
 	authv1 "k8s.io/api/authorization/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/util/validation/field"
@@ -34,8 +37,11 @@ import (
 
 	"github.com/oam-dev/kubevela/apis/core.oam.dev/v1beta1"
 	"github.com/oam-dev/kubevela/pkg/appfile"
+	"github.com/oam-dev/kubevela/pkg/cue/definition"
 	"github.com/oam-dev/kubevela/pkg/features"
 	"github.com/oam-dev/kubevela/pkg/oam"
+	"github.com/oam-dev/kubevela/pkg/oam/util"
+	"github.com/oam-dev/kubevela/pkg/quota"
 )
 
 // ValidateWorkflow validates the Application workflow
@@ -88,10 +94,11 @@ func (in *appRevBypassCacheClient) Get(ctx context.Context, key client.ObjectKey
 	return in.Client.Get(ctx, key, obj)
 }
 
-// ValidateComponents validates the Application components
-func (h *ValidatingHandler) ValidateComponents(ctx context.Context, app *v1beta1.Application) field.ErrorList {
+// ValidateComponents validates the Application components. It returns the warnings for the fields patched with
+// different values by two traits of the same component, found when the traits are evaluated.
+func (h *ValidatingHandler) ValidateComponents(ctx context.Context, app *v1beta1.Application) (field.ErrorList, admission.Warnings) {
 	if sharding.EnableSharding && !utilfeature.DefaultMutableFeatureGate.Enabled(features.ValidateComponentWhenSharding) {
-		return nil
+		return nil, nil
 	}
 	var componentErrs field.ErrorList
 	// try to generate an app file
@@ -102,15 +109,16 @@ func (h *ValidatingHandler) ValidateComponents(ctx context.Context, app *v1beta1
 	if err != nil {
 		componentErrs = append(componentErrs, field.Invalid(field.NewPath("spec"), app, err.Error()))
 		// cannot generate appfile, no need to validate further
-		return componentErrs
+		return componentErrs, nil
 	}
 	if i, err := appParser.ValidateComponentNames(app); err != nil {
 		componentErrs = append(componentErrs, field.Invalid(field.NewPath(fmt.Sprintf("components[%d].name", i)), app, err.Error()))
 	}
 	if err := appParser.ValidateCUESchematicAppfile(af); err != nil {
 		componentErrs = append(componentErrs, field.Invalid(field.NewPath("schematic"), app, err.Error()))
+		return componentErrs, nil
 	}
-	return componentErrs
+	return componentErrs, patchConflictWarnings(af.ValidatedPatchConflicts())
 }
 
 // checkDefinitionPermission checks if user has permission to access a definition in either system namespace or app namespace
@@ -452,21 +460,129 @@ func (h *ValidatingHandler) ValidateAnnotations(_ context.Context, app *v1beta1.
 	return annotationsErrs
 }
 
-// ValidateCreate validates the Application on creation
-func (h *ValidatingHandler) ValidateCreate(ctx context.Context, app *v1beta1.Application, req admission.Request) field.ErrorList {
+// ValidateQuota validates the Application against the quotas in its namespace
+func (h *ValidatingHandler) ValidateQuota(ctx context.Context, app *v1beta1.Application, req admission.Request) field.ErrorList {
+	var quotaErrs field.ErrorList
+	if err := quota.ValidateApplication(ctx, h.Client, app, req.Operation == admissionv1.Create); err != nil {
+		var exceeded quota.ErrQuotaExceeded
+		if goerrors.As(err, &exceeded) {
+			quotaErrs = append(quotaErrs, field.Forbidden(field.NewPath("spec"), err.Error()))
+		} else {
+			quotaErrs = append(quotaErrs, field.InternalError(field.NewPath("spec"), err))
+		}
+	}
+	return quotaErrs
+}
+
+// ValidateDeployWindowOverride validates that the deploy-window override annotation is only set, or kept while
+// changing the application, by the identities allowed to override applications
+func (h *ValidatingHandler) ValidateDeployWindowOverride(ctx context.Context, newApp, oldApp *v1beta1.Application, req admission.Request) field.ErrorList {
+	var overrideErrs field.ErrorList
+	if newApp.GetAnnotations()[oam.AnnotationDeployWindowOverride] != "true" {
+		return overrideErrs
+	}
+	if oldApp != nil && oldApp.GetAnnotations()[oam.AnnotationDeployWindowOverride] == "true" &&
+		oldApp.GetAnnotations()[oam.AnnotationPublishVersion] == newApp.GetAnnotations()[oam.AnnotationPublishVersion] &&
+		reflect.DeepEqual(oldApp.Spec, newApp.Spec) {
+		return overrideErrs
+	}
+	sar := &authv1.SubjectAccessReview{
+		Spec: authv1.SubjectAccessReviewSpec{
+			User:   req.UserInfo.Username,
+			Groups: req.UserInfo.Groups,
+			ResourceAttributes: &authv1.ResourceAttributes{
+				Verb:      "override",
+				Group:     "core.oam.dev",
+				Version:   "v1beta1",
+				Resource:  "applications",
+				Namespace: newApp.Namespace,
+				Name:      newApp.Name,
+			},
+		},
+	}
+	fieldPath := field.NewPath("metadata", "annotations").Key(oam.AnnotationDeployWindowOverride)
+	if err := h.Client.Create(ctx, sar); err != nil {
+		return append(overrideErrs, field.InternalError(fieldPath, fmt.Errorf("failed to check override permission: %w", err)))
+	}
+	if !sar.Status.Allowed {
+		overrideErrs = append(overrideErrs, field.Forbidden(fieldPath,
+			fmt.Sprintf("user %q is not allowed to override the deploy windows of application %s/%s", req.UserInfo.Username, newApp.Namespace, newApp.Name)))
+	}
+	return overrideErrs
+}
+
+// DefinitionDeprecationWarnings returns the warnings for the deprecated versions of the component and trait
+// definitions used by the Application. Definitions that cannot be found are left to the validation.
+func (h *ValidatingHandler) DefinitionDeprecationWarnings(ctx context.Context, app *v1beta1.Application) []string {
+	usage := collectDefinitionUsage(app)
+	var warnings []string
+	check := func(kind string, typ string, newDef func() client.Object) {
+		def := newDef()
+		if err := util.GetCapabilityDefinition(ctx, h.Client, def, typ, app.Annotations); err != nil {
+			return
+		}
+		latest := def
+		if name, version := util.SplitDefinitionType(typ); version != "" {
+			latest = newDef()
+			if err := util.GetDefinition(ctx, h.Client, latest, name); err != nil {
+				latest = def
+			}
+		}
+		if msg, deprecated := util.DefinitionDeprecation(def.GetAnnotations(), util.GetDefinitionVersion(def), latest.GetAnnotations()); deprecated {
+			warnings = append(warnings, fmt.Sprintf("%s type %s is deprecated: %s", kind, typ, msg))
+		}
+	}
+	for _, typ := range sortedKeys(usage.componentTypes) {
+		check("component", typ, func() client.Object { return &v1beta1.ComponentDefinition{} })
+	}
+	for _, typ := range sortedKeys(usage.traitTypes) {
+		check("trait", typ, func() client.Object { return &v1beta1.TraitDefinition{} })
+	}
+	return warnings
+}
+
+// patchConflictWarnings returns the warnings for the conflicting patches of the warn or last-wins policy, the conflicts
+// of the error policy fail the validation
+func patchConflictWarnings(conflicts map[string][]definition.PatchConflict) admission.Warnings {
+	var warnings admission.Warnings
+	for _, comp := range sortedKeys(conflicts) {
+		for _, c := range conflicts[comp] {
+			if c.Policy == v1beta1.TraitConflictError {
+				continue
+			}
+			warnings = append(warnings, fmt.Sprintf("component %s: %s, the patch of trait %s is applied", comp, c.String(), c.Traits[1]))
+		}
+	}
+	return warnings
+}
+
+func sortedKeys[T any](m map[string]T) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// ValidateCreate validates the Application on creation, and returns the warnings of the valid Application
+func (h *ValidatingHandler) ValidateCreate(ctx context.Context, app *v1beta1.Application, req admission.Request) (field.ErrorList, admission.Warnings) {
 	var errs field.ErrorList
 
 	errs = append(errs, h.ValidateAnnotations(ctx, app)...)
 	errs = append(errs, h.ValidateDefinitionPermissions(ctx, app, req)...)
 	errs = append(errs, h.ValidateWorkflow(ctx, app)...)
-	errs = append(errs, h.ValidateComponents(ctx, app)...)
-	return errs
+	componentErrs, warnings := h.ValidateComponents(ctx, app)
+	errs = append(errs, componentErrs...)
+	errs = append(errs, h.ValidateQuota(ctx, app, req)...)
+	return errs, warnings
 }
 
-// ValidateUpdate validates the Application on update
-func (h *ValidatingHandler) ValidateUpdate(ctx context.Context, newApp, _ *v1beta1.Application, req admission.Request) field.ErrorList {
+// ValidateUpdate validates the Application on update, and returns the warnings of the valid Application
+func (h *ValidatingHandler) ValidateUpdate(ctx context.Context, newApp, oldApp *v1beta1.Application, req admission.Request) (field.ErrorList, admission.Warnings) {
 	// check if the newApp is valid
-	errs := h.ValidateCreate(ctx, newApp, req)
+	errs, warnings := h.ValidateCreate(ctx, newApp, req)
+	errs = append(errs, h.ValidateDeployWindowOverride(ctx, newApp, oldApp, req)...)
 	// TODO: add more validating
-	return errs
+	return errs, warnings
 }
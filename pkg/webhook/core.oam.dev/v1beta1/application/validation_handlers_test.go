@@ -182,7 +182,7 @@ func TestValidateCreate(t *testing.T) {
 				},
 			}
 
-			errs := handler.ValidateCreate(context.Background(), tc.app, req)
+			errs, _ := handler.ValidateCreate(context.Background(), tc.app, req)
 			assert.Equal(t, tc.expectedErrorCount, len(errs),
 				"Expected %d errors, got %d: %v", tc.expectedErrorCount, len(errs), errs)
 
@@ -288,7 +288,7 @@ func TestValidateUpdate(t *testing.T) {
 				},
 			}
 
-			errs := handler.ValidateUpdate(context.Background(), tc.newApp, oldApp, req)
+			errs, _ := handler.ValidateUpdate(context.Background(), tc.newApp, oldApp, req)
 			assert.Equal(t, tc.expectedErrorCount, len(errs),
 				"Expected %d errors, got %d: %v", tc.expectedErrorCount, len(errs), errs)
 		})
@@ -416,3 +416,195 @@ func TestValidateAnnotations(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateDeployWindowOverride(t *testing.T) {
+	scheme := runtime.NewScheme()
+	_ = v1beta1.AddToScheme(scheme)
+	_ = authv1.AddToScheme(scheme)
+	handler := &ValidatingHandler{
+		Client: &mockSARClient{
+			Client:             fake.NewClientBuilder().WithScheme(scheme).Build(),
+			allowedDefinitions: map[string]bool{"applications/default/privileged": true},
+		},
+	}
+	newApp := func(name, image string, override bool) *v1beta1.Application {
+		app := &v1beta1.Application{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+			Spec: v1beta1.ApplicationSpec{Components: []common.ApplicationComponent{{
+				Name: "comp1", Type: "webservice", Properties: &runtime.RawExtension{Raw: []byte(`{"image":"` + image + `"}`)},
+			}}},
+		}
+		if override {
+			app.Annotations = map[string]string{oam.AnnotationDeployWindowOverride: "true"}
+		}
+		return app
+	}
+	req := admission.Request{AdmissionRequest: admissionv1.AdmissionRequest{
+		Operation: admissionv1.Update,
+		UserInfo:  authenticationv1.UserInfo{Username: "test-user"},
+	}}
+
+	testCases := []struct {
+		name               string
+		newApp             *v1beta1.Application
+		oldApp             *v1beta1.Application
+		expectedErrorCount int
+	}{
+		{
+			name:               "no override",
+			newApp:             newApp("app", "nginx:2", false),
+			oldApp:             newApp("app", "nginx:1", false),
+			expectedErrorCount: 0,
+		},
+		{
+			name:               "set override without permission",
+			newApp:             newApp("app", "nginx:1", true),
+			oldApp:             newApp("app", "nginx:1", false),
+			expectedErrorCount: 1,
+		},
+		{
+			name:               "change spec with existing override without permission",
+			newApp:             newApp("app", "nginx:2", true),
+			oldApp:             newApp("app", "nginx:1", true),
+			expectedErrorCount: 1,
+		},
+		{
+			name:               "keep override without changing spec",
+			newApp:             newApp("app", "nginx:1", true),
+			oldApp:             newApp("app", "nginx:1", true),
+			expectedErrorCount: 0,
+		},
+		{
+			name:               "set override with permission",
+			newApp:             newApp("privileged", "nginx:2", true),
+			oldApp:             newApp("privileged", "nginx:1", false),
+			expectedErrorCount: 0,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			errs := handler.ValidateDeployWindowOverride(context.Background(), tc.newApp, tc.oldApp, req)
+			assert.Equal(t, tc.expectedErrorCount, len(errs), "unexpected errors: %v", errs)
+		})
+	}
+}
+
+func TestDefinitionDeprecationWarnings(t *testing.T) {
+	scheme := runtime.NewScheme()
+	_ = v1beta1.AddToScheme(scheme)
+
+	componentRevision := func(version string, annotations map[string]string) *v1beta1.DefinitionRevision {
+		return &v1beta1.DefinitionRevision{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "webservice-v" + version,
+				Namespace: oam.SystemDefinitionNamespace,
+				Labels:    map[string]string{oam.LabelComponentDefinitionName: "webservice"},
+			},
+			Spec: v1beta1.DefinitionRevisionSpec{
+				DefinitionType: common.ComponentType,
+				ComponentDefinition: v1beta1.ComponentDefinition{
+					ObjectMeta: metav1.ObjectMeta{Name: "webservice", Annotations: annotations},
+					Spec:       v1beta1.ComponentDefinitionSpec{Version: version},
+				},
+			},
+		}
+	}
+	webservice := &v1beta1.ComponentDefinition{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "webservice",
+			Namespace:   oam.SystemDefinitionNamespace,
+			Annotations: map[string]string{oam.AnnotationDefinitionDeprecatedVersions: "<2.0.0"},
+		},
+		Spec: v1beta1.ComponentDefinitionSpec{Version: "2.1.0"},
+	}
+	oldTrait := &v1beta1.TraitDefinition{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "old-trait",
+			Namespace:   oam.SystemDefinitionNamespace,
+			Annotations: map[string]string{oam.AnnotationDefinitionDeprecated: "use labels instead"},
+		},
+	}
+	cli := fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+		webservice, oldTrait,
+		componentRevision("1.4.0", nil),
+		componentRevision("2.1.0", nil),
+	).Build()
+	handler := &ValidatingHandler{Client: cli}
+
+	app := &v1beta1.Application{
+		ObjectMeta: metav1.ObjectMeta{Name: "app", Namespace: "default"},
+		Spec: v1beta1.ApplicationSpec{Components: []common.ApplicationComponent{
+			{Name: "old", Type: "webservice@v1"},
+			{Name: "new", Type: "webservice@v2"},
+			{Name: "latest", Type: "webservice", Traits: []common.ApplicationTrait{{Type: "old-trait"}}},
+			{Name: "missing", Type: "worker"},
+		}},
+	}
+	warnings := handler.DefinitionDeprecationWarnings(context.Background(), app)
+	assert.Equal(t, []string{
+		"component type webservice@v1 is deprecated: version 1.4.0 is deprecated",
+		"trait type old-trait is deprecated: use labels instead",
+	}, warnings)
+}
+
+func TestValidateComponentsPatchConflicts(t *testing.T) {
+	scheme := runtime.NewScheme()
+	_ = v1beta1.AddToScheme(scheme)
+
+	worker := &v1beta1.ComponentDefinition{
+		ObjectMeta: metav1.ObjectMeta{Name: "worker", Namespace: oam.SystemDefinitionNamespace},
+		Spec: v1beta1.ComponentDefinitionSpec{
+			Workload: common.WorkloadTypeDescriptor{Definition: common.WorkloadGVK{APIVersion: "apps/v1", Kind: "Deployment"}},
+			Schematic: &common.Schematic{CUE: &common.CUE{Template: `
+output: {
+	apiVersion: "apps/v1"
+	kind:       "Deployment"
+	spec: replicas: *1 | int
+}
+parameter: {}
+`}},
+		},
+	}
+	scaler := func(name string, policy v1beta1.TraitConflictPolicy) *v1beta1.TraitDefinition {
+		return &v1beta1.TraitDefinition{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: oam.SystemDefinitionNamespace},
+			Spec: v1beta1.TraitDefinitionSpec{
+				ConflictPolicy: policy,
+				Schematic: &common.Schematic{CUE: &common.CUE{Template: `
+patch: spec: replicas: parameter.replicas
+parameter: replicas: int
+`}},
+			},
+		}
+	}
+	cli := fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+		worker, scaler("scaler", v1beta1.TraitConflictWarn), scaler("hpa", v1beta1.TraitConflictLastWins),
+	).Build()
+	handler := &ValidatingHandler{Client: cli}
+
+	app := &v1beta1.Application{
+		ObjectMeta: metav1.ObjectMeta{Name: "app", Namespace: "default"},
+		Spec: v1beta1.ApplicationSpec{Components: []common.ApplicationComponent{{
+			Name: "api",
+			Type: "worker",
+			Traits: []common.ApplicationTrait{
+				{Type: "scaler", Properties: &runtime.RawExtension{Raw: []byte(`{"replicas":2}`)}},
+				{Type: "hpa", Properties: &runtime.RawExtension{Raw: []byte(`{"replicas":3}`)}},
+			},
+		}}},
+	}
+	errs, warnings := handler.ValidateComponents(context.Background(), app)
+	assert.Empty(t, errs)
+	assert.Equal(t, admission.Warnings{
+		"component api: traits scaler and hpa patch spec.replicas with different values, the patch of trait hpa is applied",
+	}, warnings)
+
+	// the conflicts of the error policy fail the validation instead
+	app.Spec.Components[0].Traits[1].Type = "pinned"
+	handler.Client = fake.NewClientBuilder().WithScheme(scheme).WithObjects(worker, scaler("scaler", v1beta1.TraitConflictWarn), scaler("pinned", "")).Build()
+	errs, warnings = handler.ValidateComponents(context.Background(), app)
+	assert.Len(t, errs, 1)
+	assert.Contains(t, errs[0].Detail, "traits scaler and pinned patch spec.replicas with different values")
+	assert.Empty(t, warnings)
+}
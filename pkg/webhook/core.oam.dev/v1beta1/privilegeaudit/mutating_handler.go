@@ -0,0 +1,79 @@
+/*
+Copyright 2026 The KubeVela Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package privilegeaudit
+
+import (
+	"context"
+	"net/http"
+
+	"gomodules.xyz/jsonpatch/v2"
+	"k8s.io/klog/v2"
+	"k8s.io/utils/strings/slices"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	"github.com/oam-dev/kubevela/apis/core.oam.dev/v1alpha1"
+	"github.com/oam-dev/kubevela/pkg/utils"
+)
+
+// MutatingHandler sets the granter of the privilege audits to the requesting user, so that the granter reported by
+// the clients cannot be forged
+type MutatingHandler struct {
+	skipUsers []string
+	Decoder   admission.Decoder
+}
+
+var _ admission.Handler = &MutatingHandler{}
+
+// Handle mutate privilege audit
+func (h *MutatingHandler) Handle(_ context.Context, req admission.Request) admission.Response {
+	audit := &v1alpha1.PrivilegeAudit{}
+	if err := h.Decoder.Decode(req, audit); err != nil {
+		return admission.Errored(http.StatusBadRequest, err)
+	}
+	granter := req.UserInfo.Username
+	if len(req.OldObject.Raw) > 0 {
+		// the granter of the recorded audit never changes
+		oldAudit := &v1alpha1.PrivilegeAudit{}
+		if err := h.Decoder.DecodeRaw(req.OldObject, oldAudit); err != nil {
+			return admission.Errored(http.StatusBadRequest, err)
+		}
+		granter = oldAudit.Spec.Granter
+	} else if slices.Contains(h.skipUsers, req.UserInfo.Username) {
+		// the controller records the revokes of the expired privileges by itself
+		return admission.Patched("")
+	}
+	if audit.Spec.Granter == granter {
+		return admission.Patched("")
+	}
+	klog.Infof("[PrivilegeAuditMutatingHandler] Setting the granter of PrivilegeAudit %s/%s to %s", audit.Namespace, audit.Name, granter)
+	// the add operation replaces the granter if set
+	return admission.Patched("", jsonpatch.NewOperation("add", "/spec/granter", granter))
+}
+
+// RegisterMutatingHandler will register privilege audit mutation handler to the webhook
+func RegisterMutatingHandler(mgr manager.Manager) {
+	server := mgr.GetWebhookServer()
+	handler := &MutatingHandler{
+		Decoder: admission.NewDecoder(mgr.GetScheme()),
+	}
+	if userInfo := utils.GetUserInfoFromConfig(mgr.GetConfig()); userInfo != nil {
+		handler.skipUsers = []string{userInfo.Username}
+	}
+	server.Register("/mutating-core-oam-dev-v1alpha1-privilegeaudits", &webhook.Admission{Handler: handler})
+}
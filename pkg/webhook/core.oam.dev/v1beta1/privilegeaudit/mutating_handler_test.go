@@ -0,0 +1,71 @@
+/*
+Copyright 2026 The KubeVela Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package privilegeaudit
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"gomodules.xyz/jsonpatch/v2"
+	admissionv1 "k8s.io/api/admission/v1"
+	authv1 "k8s.io/api/authentication/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	"github.com/oam-dev/kubevela/apis/types"
+	"github.com/oam-dev/kubevela/pkg/utils/common"
+)
+
+func TestMutatingHandler(t *testing.T) {
+	r := require.New(t)
+	handler := &MutatingHandler{skipUsers: []string{types.VelaCoreName}, Decoder: admission.NewDecoder(common.Scheme)}
+	audit := func(granter string) runtime.RawExtension {
+		return runtime.RawExtension{Raw: []byte(`{"apiVersion":"core.oam.dev/v1alpha1","kind":"PrivilegeAudit",` +
+			`"metadata":{"name":"grant-x","namespace":"vela-system"},"spec":{"action":"grant","granter":"` + granter + `"}}`)}
+	}
+	request := func(user string, object, oldObject runtime.RawExtension) admission.Request {
+		return admission.Request{AdmissionRequest: admissionv1.AdmissionRequest{
+			UserInfo: authv1.UserInfo{Username: user}, Object: object, OldObject: oldObject,
+		}}
+	}
+	granterPatch := func(granter string) []jsonpatch.JsonPatchOperation {
+		return []jsonpatch.JsonPatchOperation{{Operation: "add", Path: "/spec/granter", Value: granter}}
+	}
+
+	// the forged granter is replaced by the requesting user
+	resp := handler.Handle(context.Background(), request("alice", audit("admin"), runtime.RawExtension{}))
+	r.True(resp.Allowed)
+	r.Equal(granterPatch("alice"), resp.Patches)
+
+	resp = handler.Handle(context.Background(), request("alice", audit("alice"), runtime.RawExtension{}))
+	r.True(resp.Allowed)
+	r.Empty(resp.Patches)
+
+	// the controller records the revokes of the expired privileges as itself
+	resp = handler.Handle(context.Background(), request(types.VelaCoreName, audit("kubevela:controller"), runtime.RawExtension{}))
+	r.True(resp.Allowed)
+	r.Empty(resp.Patches)
+
+	// the granter never changes
+	resp = handler.Handle(context.Background(), request("bob", audit("bob"), audit("alice")))
+	r.True(resp.Allowed)
+	r.Equal(granterPatch("alice"), resp.Patches)
+
+	resp = handler.Handle(context.Background(), request("alice", runtime.RawExtension{Raw: []byte("bad request")}, runtime.RawExtension{}))
+	r.False(resp.Allowed)
+}
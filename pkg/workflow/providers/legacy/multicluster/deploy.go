@@ -66,7 +66,7 @@ type DeployWorkflowStepExecutor interface {
 }
 
 // NewDeployWorkflowStepExecutor .
-func NewDeployWorkflowStepExecutor(cli client.Client, af *appfile.Appfile, apply oamprovidertypes.ComponentApply, healthCheck oamprovidertypes.ComponentHealthCheck, renderer oamprovidertypes.WorkloadRender, parameter DeployParameter) DeployWorkflowStepExecutor {
+func NewDeployWorkflowStepExecutor(cli client.Client, af *appfile.Appfile, apply oamprovidertypes.ComponentApply, healthCheck oamprovidertypes.ComponentHealthCheck, renderer oamprovidertypes.WorkloadRender, parameter DeployParameter, failover []common.FailoverDecision) DeployWorkflowStepExecutor {
 	return &deployWorkflowStepExecutor{
 		cli:         cli,
 		af:          af,
@@ -74,6 +74,7 @@ func NewDeployWorkflowStepExecutor(cli client.Client, af *appfile.Appfile, apply
 		healthCheck: healthCheck,
 		renderer:    renderer,
 		parameter:   parameter,
+		failover:    failover,
 	}
 }
 
@@ -84,6 +85,7 @@ type deployWorkflowStepExecutor struct {
 	healthCheck oamprovidertypes.ComponentHealthCheck
 	renderer    oamprovidertypes.WorkloadRender
 	parameter   DeployParameter
+	failover    []common.FailoverDecision
 }
 
 // Deploy execute deploy workflow step
@@ -103,6 +105,7 @@ func (executor *deployWorkflowStepExecutor) Deploy(ctx context.Context) (bool, s
 	if err != nil {
 		return false, "", err
 	}
+	placements = pkgpolicy.ApplyFailoverDecisions(placements, executor.failover)
 	components, err = overrideConfiguration(policies, components)
 	if err != nil {
 		return false, "", err
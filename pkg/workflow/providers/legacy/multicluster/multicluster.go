@@ -25,6 +25,7 @@ import (
 
 	cuexruntime "github.com/kubevela/pkg/cue/cuex/runtime"
 
+	"github.com/oam-dev/kubevela/apis/core.oam.dev/common"
 	"github.com/oam-dev/kubevela/apis/core.oam.dev/v1alpha1"
 	"github.com/oam-dev/kubevela/apis/core.oam.dev/v1beta1"
 	"github.com/oam-dev/kubevela/pkg/multicluster"
@@ -169,7 +170,7 @@ func Deploy(ctx context.Context, params *DeployParams) (*any, error) {
 	if params.Params.Parallelism <= 0 {
 		return nil, errors.Errorf("parallelism cannot be smaller than 1")
 	}
-	executor := NewDeployWorkflowStepExecutor(params.KubeClient, params.Appfile, params.ComponentApply, params.ComponentHealthCheck, params.WorkloadRender, params.Params)
+	executor := NewDeployWorkflowStepExecutor(params.KubeClient, params.Appfile, params.ComponentApply, params.ComponentHealthCheck, params.WorkloadRender, params.Params, failoverDecisions(params.App))
 	healthy, reason, err := executor.Deploy(ctx)
 	if err != nil {
 		return nil, err
@@ -204,9 +205,17 @@ func GetPlacementsFromTopologyPolicies(ctx context.Context, params *PoliciesPara
 	if err != nil {
 		return nil, err
 	}
+	placements = pkgpolicy.ApplyFailoverDecisions(placements, failoverDecisions(params.App))
 	return &PoliciesResult{Placements: placements}, nil
 }
 
+func failoverDecisions(app *v1beta1.Application) []common.FailoverDecision {
+	if app == nil {
+		return nil
+	}
+	return app.Status.Failover
+}
+
 //go:embed multicluster.cue
 var template string
 
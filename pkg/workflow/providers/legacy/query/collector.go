@@ -30,6 +30,7 @@ import (
 
 	"github.com/oam-dev/kubevela/apis/core.oam.dev/common"
 	"github.com/oam-dev/kubevela/apis/core.oam.dev/v1beta1"
+	"github.com/oam-dev/kubevela/pkg/eventtimeline"
 	"github.com/oam-dev/kubevela/pkg/multicluster"
 	"github.com/oam-dev/kubevela/pkg/oam"
 	"github.com/oam-dev/kubevela/pkg/resourcetracker"
@@ -296,12 +297,12 @@ func getObjectCreatedByComponent(ctx context.Context, cli client.Client, objRef
 }
 
 func getEventFieldSelector(obj *unstructured.Unstructured) fields.Selector {
-	field := fields.Set{}
-	field["involvedObject.name"] = obj.GetName()
-	field["involvedObject.namespace"] = obj.GetNamespace()
-	field["involvedObject.kind"] = obj.GetObjectKind().GroupVersionKind().Kind
-	field["involvedObject.uid"] = string(obj.GetUID())
-	return field.AsSelector()
+	return eventtimeline.EventFieldSelector(corev1.ObjectReference{
+		Kind:      obj.GetObjectKind().GroupVersionKind().Kind,
+		Namespace: obj.GetNamespace(),
+		Name:      obj.GetName(),
+		UID:       obj.GetUID(),
+	})
 }
 
 func isResourceInTargetCluster(opt FilterOption, resource common.ClusterObjectReference) bool {
@@ -103,6 +103,33 @@
 	...
 }
 
+#ListAppEvents: {
+	#do:       "listAppEvents"
+	#provider: "ql"
+	app: {
+		name:      string
+		namespace: string
+		filter?: {
+			cluster?: string
+			components?: [...string]
+		}
+	}
+	list?: [...{
+		time:       null | string
+		cluster:    string
+		component?: string
+		kind:       string
+		namespace?: string
+		name:       string
+		type:       string
+		reason:     string
+		message:    string
+		count?:     int
+	}]
+	err?: string
+	...
+}
+
 #CollectLogsInPod: {
 	#do:       "collectLogsInPod"
 	#provider: "ql"
@@ -193,3 +220,22 @@
 	}]
 	...
 }
+
+#ExportApplicationTree: {
+	#do:       "exportApplicationTree"
+	#provider: "ql"
+	app: {
+		name:      string
+		namespace: string
+		filter?: {
+			cluster?:          string
+			clusterNamespace?: string
+			components?: [...string]
+			queryNewest?: bool
+		}
+	}
+	format: *"mermaid" | "dot" | "html"
+	graph?: string
+	err?:   string
+	...
+}
@@ -227,5 +227,6 @@ func GetProviders() map[string]cuexruntime.ProviderFn {
 		"load":                oamprovidertypes.GenericProviderFn[LoadVars, LoadReturns](LoadComponent),
 		"load-comps-in-order": oamprovidertypes.GenericProviderFn[LoadVars, LoadReturns](LoadComponentInOrder),
 		"load-policies":       oamprovidertypes.GenericProviderFn[LoadVars, LoadReturns](LoadPolicies),
+		"promote":             oamprovidertypes.GenericProviderFn[PromoteVars, PromoteReturns](Promote),
 	}
 }
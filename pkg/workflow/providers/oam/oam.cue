@@ -93,6 +93,36 @@
 	...
 }
 
+#Promote: {
+	#provider: "oam"
+	#do:       "promote"
+
+	$params: {
+		// +usage=The name of the application to promote, default to the current application
+		app?: string
+		// +usage=The env to promote the application revision from
+		from: string
+		// +usage=The env to promote the application revision to
+		to: string
+		// +usage=The application revision to promote, default to the latest revision in the source env
+		revision?: string
+		// +usage=The minimal time the revision must have been running in the source env, e.g. 30m
+		minSoakTime?: string
+		// +usage=The component overrides for the target env
+		overrides?: [...{...}]
+	}
+
+	$returns: {
+		name?:      string
+		namespace?: string
+		// +usage=The promoted application revision
+		revision?: string
+		// +usage=The application revisions the application is promoted through
+		lineage?: [...string]
+	}
+	...
+}
+
 // This operator will dispatch all the components in parallel when applying an application.
 // Currently it works for Addon Observability to speed up the installation. It can also works for other applications, which
 // needs to skip health check for components.
@@ -0,0 +1,88 @@
+/*
+Copyright 2026 The KubeVela Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package oam
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/oam-dev/kubevela/apis/core.oam.dev/v1alpha1"
+	"github.com/oam-dev/kubevela/pkg/oam"
+	"github.com/oam-dev/kubevela/pkg/utils/env"
+	oamprovidertypes "github.com/oam-dev/kubevela/pkg/workflow/providers/types"
+)
+
+// PromoteVars is the promote provider vars.
+type PromoteVars struct {
+	App         string                       `json:"app,omitempty"`
+	From        string                       `json:"from"`
+	To          string                       `json:"to"`
+	Revision    string                       `json:"revision,omitempty"`
+	MinSoakTime string                       `json:"minSoakTime,omitempty"`
+	Overrides   []v1alpha1.EnvComponentPatch `json:"overrides,omitempty"`
+}
+
+// PromoteReturnVars is the promote provider return vars.
+type PromoteReturnVars struct {
+	Name      string   `json:"name"`
+	Namespace string   `json:"namespace"`
+	Revision  string   `json:"revision"`
+	Lineage   []string `json:"lineage"`
+}
+
+// PromoteParams is the promote provider params.
+type PromoteParams = oamprovidertypes.Params[PromoteVars]
+
+// PromoteReturns is the promote provider returns.
+type PromoteReturns = oamprovidertypes.Returns[PromoteReturnVars]
+
+// Promote promotes the application revision from one env to another. If the revision is not ready to be
+// promoted, the step waits until it is healthy and has soaked long enough.
+func Promote(ctx context.Context, params *PromoteParams) (*PromoteReturns, error) {
+	opts := env.PromoteOptions{
+		AppName:   params.Params.App,
+		SourceEnv: params.Params.From,
+		TargetEnv: params.Params.To,
+		Revision:  params.Params.Revision,
+		Overrides: params.Params.Overrides,
+	}
+	if opts.AppName == "" {
+		opts.AppName = params.App.Name
+	}
+	if params.Params.MinSoakTime != "" {
+		d, err := time.ParseDuration(params.Params.MinSoakTime)
+		if err != nil {
+			return nil, err
+		}
+		opts.MinSoakTime = d
+	}
+	app, err := env.Promote(ctx, params.KubeClient, opts)
+	if errors.Is(err, env.ErrRevisionNotPromotable) {
+		params.Action.Wait(err.Error())
+		return &PromoteReturns{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &PromoteReturns{Returns: PromoteReturnVars{
+		Name:      app.Name,
+		Namespace: app.Namespace,
+		Revision:  app.GetAnnotations()[oam.AnnotationPromotedFrom],
+		Lineage:   env.GetPromotionLineage(app),
+	}}, nil
+}
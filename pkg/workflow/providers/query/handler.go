@@ -41,7 +41,9 @@ import (
 	"github.com/kubevela/workflow/pkg/providers/legacy/kube"
 
 	"github.com/oam-dev/kubevela/apis/core.oam.dev/v1beta1"
+	"github.com/oam-dev/kubevela/pkg/eventtimeline"
 	"github.com/oam-dev/kubevela/pkg/multicluster"
+	"github.com/oam-dev/kubevela/pkg/resourcegraph"
 	querytypes "github.com/oam-dev/kubevela/pkg/utils/types"
 	oamprovidertypes "github.com/oam-dev/kubevela/pkg/workflow/providers/types"
 )
@@ -213,6 +215,68 @@ func SearchEvents(ctx context.Context, params *SearchParams) (*ListReturns[corev
 	return &ListReturns[corev1.Event]{Returns: ListReturnVars[corev1.Event]{List: eventList.Items}}, nil
 }
 
+// ListAppEvents lists the events of the application and the resources it manages across clusters as a timeline
+func ListAppEvents(ctx context.Context, params *ListParams) (*ListReturns[eventtimeline.Event], error) {
+	opt := params.Params.App
+	cli := params.KubeClient
+	app := new(v1beta1.Application)
+	if err := cli.Get(ctx, client.ObjectKey{Name: opt.Name, Namespace: opt.Namespace}, app); err != nil {
+		// nolint:nilerr
+		return &ListReturns[eventtimeline.Event]{Returns: ListReturnVars[eventtimeline.Event]{Error: err.Error()}}, nil
+	}
+	events, err := eventtimeline.Collect(ctx, cli, app, eventtimeline.Filter{Cluster: opt.Filter.Cluster, Components: opt.Filter.Components})
+	if err != nil {
+		// nolint:nilerr
+		return &ListReturns[eventtimeline.Event]{Returns: ListReturnVars[eventtimeline.Event]{Error: err.Error()}}, nil
+	}
+	if events == nil {
+		events = make([]eventtimeline.Event, 0)
+	}
+	return &ListReturns[eventtimeline.Event]{Returns: ListReturnVars[eventtimeline.Event]{List: events}}, nil
+}
+
+// ExportVars is the vars for export
+type ExportVars struct {
+	App    Option `json:"app"`
+	Format string `json:"format"`
+}
+
+// ExportParams is the params for export
+type ExportParams = oamprovidertypes.Params[ExportVars]
+
+// ExportReturnVars is the export return vars
+type ExportReturnVars struct {
+	Graph string `json:"graph"`
+	Error string `json:"err,omitempty"`
+}
+
+// ExportReturns is the export returns
+type ExportReturns = oamprovidertypes.Returns[ExportReturnVars]
+
+// ExportApplicationTree exports the resource tree of the application in all clusters as a graph in the format,
+// one of dot, mermaid and html
+func ExportApplicationTree(ctx context.Context, params *ExportParams) (*ExportReturns, error) {
+	opt := params.Params.App
+	opt.WithTree = true
+	cli := params.KubeClient
+	app := new(v1beta1.Application)
+	if err := cli.Get(ctx, client.ObjectKey{Name: opt.Name, Namespace: opt.Namespace}, app); err != nil {
+		// nolint:nilerr
+		return &ExportReturns{Returns: ExportReturnVars{Error: err.Error()}}, nil
+	}
+	resources, err := NewAppCollector(cli, opt).ListApplicationResources(ctx, app)
+	if err != nil {
+		// nolint:nilerr
+		return &ExportReturns{Returns: ExportReturnVars{Error: err.Error()}}, nil
+	}
+	graph, err := resourcegraph.Build(app.Name, app.Namespace, resources).Export(resourcegraph.Format(params.Params.Format))
+	if err != nil {
+		// nolint:nilerr
+		return &ExportReturns{Returns: ExportReturnVars{Error: err.Error()}}, nil
+	}
+	return &ExportReturns{Returns: ExportReturnVars{Graph: graph}}, nil
+}
+
 // LogVars is the vars for log
 type LogVars struct {
 	Cluster   string                `json:"cluster"`
@@ -324,6 +388,8 @@ func GetProviders() map[string]cuexruntime.ProviderFn {
 		"listAppliedResources":    oamprovidertypes.GenericProviderFn[ListVars, ListReturns[querytypes.AppliedResource]](ListAppliedResources),
 		"collectResources":        oamprovidertypes.GenericProviderFn[ListVars, ListReturns[querytypes.ResourceItem]](CollectResources),
 		"searchEvents":            oamprovidertypes.GenericProviderFn[SearchVars, ListReturns[corev1.Event]](SearchEvents),
+		"listAppEvents":           oamprovidertypes.GenericProviderFn[ListVars, ListReturns[eventtimeline.Event]](ListAppEvents),
+		"exportApplicationTree":   oamprovidertypes.GenericProviderFn[ExportVars, ExportReturns](ExportApplicationTree),
 		"collectLogsInPod":        oamprovidertypes.GenericProviderFn[LogVars, LogReturns](CollectLogsInPod),
 		"collectServiceEndpoints": oamprovidertypes.GenericProviderFn[ListVars, ListReturns[querytypes.ServiceEndpoint]](CollectServiceEndpoints),
 	}
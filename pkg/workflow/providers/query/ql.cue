@@ -129,6 +129,38 @@
 	...
 }
 
+#ListAppEvents: {
+	#do:       "listAppEvents"
+	#provider: "query"
+
+	$params: {
+		app: {
+			name:      string
+			namespace: string
+			filter?: {
+				cluster?: string
+				components?: [...string]
+			}
+		}
+	}
+	$returns: {
+		list: [...{
+			time:       null | string
+			cluster:    string
+			component?: string
+			kind:       string
+			namespace?: string
+			name:       string
+			type:       string
+			reason:     string
+			message:    string
+			count?:     int
+		}]
+		err?: string
+	}
+	...
+}
+
 #CollectLogsInPod: {
 	#do:       "collectLogsInPod"
 	#provider: "query"
@@ -231,3 +263,27 @@
 	}]
 	...
 }
+
+#ExportApplicationTree: {
+	#do:       "exportApplicationTree"
+	#provider: "query"
+
+	$params: {
+		app: {
+			name:      string
+			namespace: string
+			filter?: {
+				cluster?:          string
+				clusterNamespace?: string
+				components?: [...string]
+				queryNewest?: bool
+			}
+		}
+		format: *"mermaid" | "dot" | "html"
+	}
+	$returns: {
+		graph?: string
+		err?:   string
+	}
+	...
+}
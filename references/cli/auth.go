@@ -18,19 +18,26 @@ package cli
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"path/filepath"
+	"slices"
 	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 	"golang.org/x/term"
 	corev1 "k8s.io/api/core/v1"
 	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	apitypes "k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/tools/clientcmd"
+	"k8s.io/client-go/util/homedir"
 	"k8s.io/kubectl/pkg/util/i18n"
 	"k8s.io/kubectl/pkg/util/templates"
 
+	"github.com/oam-dev/kubevela/apis/core.oam.dev/v1alpha1"
 	"github.com/oam-dev/kubevela/apis/types"
 	"github.com/oam-dev/kubevela/pkg/auth"
 	velacmd "github.com/oam-dev/kubevela/pkg/cmd"
@@ -52,12 +59,20 @@ func AuthCommandGroup(f velacmd.Factory, order string, streams util.IOStreams) *
 	cmd.AddCommand(NewGenKubeConfigCommand(f, streams))
 	cmd.AddCommand(NewListPrivilegesCommand(f, streams))
 	cmd.AddCommand(NewGrantPrivilegesCommand(f, streams))
+	cmd.AddCommand(NewRevokePrivilegesCommand(f, streams))
+	cmd.AddCommand(NewAuditCommand(f, streams))
+	cmd.AddCommand(NewOIDCLoginCommand(streams))
 	return cmd
 }
 
 // GenKubeConfigOptions options for create kubeconfig
 type GenKubeConfigOptions struct {
 	auth.Identity
+	ExpireTime         time.Duration
+	TokenRequest       bool
+	OIDC               auth.KubeConfigGenerateOIDCOptions
+	Clusters           []string
+	PrivilegedClusters bool
 	util.IOStreams
 }
 
@@ -71,12 +86,61 @@ func (opt *GenKubeConfigOptions) Complete(f velacmd.Factory, cmd *cobra.Command)
 
 // Validate .
 func (opt *GenKubeConfigOptions) Validate() error {
+	if opt.OIDC.IssuerURL != "" {
+		if opt.User != "" || len(opt.Groups) > 0 || opt.ServiceAccount != "" {
+			return fmt.Errorf("cannot set `oidc-issuer-url` together with `user`, `group` or `serviceaccount`")
+		}
+		if opt.OIDC.ClientID == "" {
+			return fmt.Errorf("`oidc-client-id` should be set for the OIDC issuer")
+		}
+		if opt.PrivilegedClusters {
+			return fmt.Errorf("cannot find the privileged clusters of the OIDC identity, use `cluster` instead")
+		}
+		return nil
+	}
+	if opt.TokenRequest && opt.ServiceAccount == "" {
+		return fmt.Errorf("`token-request` only works when `serviceaccount` is set")
+	}
+	if opt.TokenRequest && opt.ExpireTime > 0 && opt.ExpireTime < 10*time.Minute {
+		return fmt.Errorf("the expiration of the requested token should be at least 10m")
+	}
+	if opt.ExpireTime < 0 {
+		return fmt.Errorf("the expiration cannot be negative")
+	}
 	return opt.Identity.Validate()
 }
 
+// clusters returns the managed clusters to access through the cluster gateway
+func (opt *GenKubeConfigOptions) clusters(ctx context.Context, f velacmd.Factory) ([]string, error) {
+	if !opt.PrivilegedClusters {
+		return opt.Clusters, nil
+	}
+	clusters, err := multicluster.NewClusterClient(f.Client()).List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list clusters: %w", err)
+	}
+	var names []string
+	for _, cluster := range clusters.Items {
+		if cluster.Name != types.ClusterLocalName {
+			names = append(names, cluster.Name)
+		}
+	}
+	privileges, err := auth.ListPrivileges(ctx, f.Client(), names, &opt.Identity)
+	if err != nil {
+		return nil, err
+	}
+	selected := opt.Clusters
+	for _, name := range names {
+		if len(privileges[name]) > 0 && !slices.Contains(selected, name) {
+			selected = append(selected, name)
+		}
+	}
+	return selected, nil
+}
+
 // Run .
-func (opt *GenKubeConfigOptions) Run(f velacmd.Factory) error {
-	ctx := context.Background()
+func (opt *GenKubeConfigOptions) Run(f velacmd.Factory, cmd *cobra.Command) error {
+	ctx := cmd.Context()
 	cli, err := kubernetes.NewForConfig(f.Config())
 	if err != nil {
 		return err
@@ -85,7 +149,20 @@ func (opt *GenKubeConfigOptions) Run(f velacmd.Factory) error {
 	if err != nil {
 		return err
 	}
-	cfg, err = auth.GenerateKubeConfig(ctx, cli, cfg, opt.IOStreams.ErrOut, auth.KubeConfigWithIdentityGenerateOption(opt.Identity))
+	clusters, err := opt.clusters(ctx, f)
+	if err != nil {
+		return err
+	}
+	options := []auth.KubeConfigGenerateOption{
+		auth.KubeConfigWithIdentityGenerateOption(opt.Identity),
+		auth.KubeConfigWithExpireTimeGenerateOption(opt.ExpireTime),
+		auth.KubeConfigWithTokenRequestGenerateOption(opt.TokenRequest),
+		auth.KubeConfigWithClustersGenerateOption(clusters),
+	}
+	if opt.OIDC.IssuerURL != "" {
+		options = append(options, auth.KubeConfigWithOIDCGenerateOption(opt.OIDC))
+	}
+	cfg, err = auth.GenerateKubeConfig(ctx, cli, cfg, opt.IOStreams.ErrOut, options...)
 	if err != nil {
 		return err
 	}
@@ -115,7 +192,22 @@ var (
 
 		To generate a kubeconfig based on existing ServiceAccount in your cluster, use the 
 		--serviceaccount flag. The corresponding secret token and ca data will be embedded in 
-		the generated kubeconfig, which allows you to act as the serviceaccount.`))
+		the generated kubeconfig, which allows you to act as the serviceaccount.
+
+		To generate a short-lived kubeconfig, use the --expiration flag to set the expiry of the
+		X509 certificate or the ServiceAccount token. Setting --token-request together with
+		--serviceaccount always requests a new token through the TokenRequest API instead of
+		reusing the long-lived secret token of the ServiceAccount.
+
+		To log in through an OIDC issuer, use the --oidc-issuer-url and --oidc-client-id flags.
+		No credential is embedded in the generated kubeconfig, the id token is retrieved by the
+		exec plugin vela auth oidc-login through the device authorization flow, cached and refreshed
+		when it expires. The kubernetes apiserver should be configured to trust the OIDC issuer.
+
+		To access the managed clusters with the same credential, use the --cluster flag, or the
+		--privileged-clusters flag to add all the clusters the identity is privileged on. A context
+		named as the cluster will be added for each cluster, which accesses the cluster through the
+		cluster gateway of the control plane.`))
 
 	generateKubeConfigExample = templates.Examples(i18n.T(`
 		# Generate a kubeconfig with provided user
@@ -128,7 +220,16 @@ var (
 		vela auth gen-kubeconfig --user new-user --group kubevela:developer --group my-org:my-team
 
 		# Generate a kubeconfig with provided serviceaccount
-		vela auth gen-kubeconfig --serviceaccount default -n demo`))
+		vela auth gen-kubeconfig --serviceaccount default -n demo
+
+		# Generate a kubeconfig with a token of provided serviceaccount expiring in 1 hour
+		vela auth gen-kubeconfig --serviceaccount default -n demo --token-request --expiration 1h
+
+		# Generate a kubeconfig logging in through the OIDC issuer
+		vela auth gen-kubeconfig --oidc-issuer-url https://dex.example.com --oidc-client-id kubevela
+
+		# Generate a kubeconfig with provided user for all the clusters the user is privileged on
+		vela auth gen-kubeconfig --user new-user --expiration 8h --privileged-clusters`))
 )
 
 // NewGenKubeConfigCommand generate kubeconfig for given user and groups
@@ -147,12 +248,20 @@ func NewGenKubeConfigCommand(f velacmd.Factory, streams util.IOStreams) *cobra.C
 		Run: func(cmd *cobra.Command, args []string) {
 			o.Complete(f, cmd)
 			cmdutil.CheckErr(o.Validate())
-			cmdutil.CheckErr(o.Run(f))
+			cmdutil.CheckErr(o.Run(f, cmd))
 		},
 	}
 	cmd.Flags().StringVarP(&o.User, "user", "u", o.User, "The user of the generated kubeconfig. If set, an X509-based kubeconfig will be intended to create. It will be embedded as the Subject in the X509 certificate.")
 	cmd.Flags().StringSliceVarP(&o.Groups, "group", "g", o.Groups, "The groups of the generated kubeconfig. This flag only works when `--user` is set. It will be embedded as the Organization in the X509 certificate.")
 	cmd.Flags().StringVarP(&o.ServiceAccount, "serviceaccount", "", o.ServiceAccount, "The serviceaccount of the generated kubeconfig. If set, a kubeconfig will be generated based on the secret token of the serviceaccount. Cannot be set when `--user` presents.")
+	cmd.Flags().DurationVarP(&o.ExpireTime, "expiration", "", o.ExpireTime, "The expiration of the X509 certificate or the serviceaccount token, such as 1h. If not set, the credential expires in one year.")
+	cmd.Flags().BoolVarP(&o.TokenRequest, "token-request", "", o.TokenRequest, "If set, a new token of the serviceaccount will be requested through the TokenRequest API, even if the serviceaccount has a secret token.")
+	cmd.Flags().StringVarP(&o.OIDC.IssuerURL, "oidc-issuer-url", "", o.OIDC.IssuerURL, "The url of the OIDC issuer. If set, the generated kubeconfig will log in through the OIDC issuer by the exec plugin.")
+	cmd.Flags().StringVarP(&o.OIDC.ClientID, "oidc-client-id", "", o.OIDC.ClientID, "The client id of the OIDC issuer.")
+	cmd.Flags().StringVarP(&o.OIDC.ClientSecret, "oidc-client-secret", "", o.OIDC.ClientSecret, "The client secret of the OIDC issuer, only needed by confidential clients.")
+	cmd.Flags().StringSliceVarP(&o.OIDC.Scopes, "oidc-scope", "", o.OIDC.Scopes, "The scopes requested from the OIDC issuer. If not set, openid will be requested.")
+	cmd.Flags().StringSliceVarP(&o.Clusters, "cluster", "c", o.Clusters, "The managed clusters to access through the cluster gateway with the generated kubeconfig.")
+	cmd.Flags().BoolVarP(&o.PrivilegedClusters, "privileged-clusters", "", o.PrivilegedClusters, "If set, all the managed clusters the identity is privileged on will be accessible through the cluster gateway with the generated kubeconfig.")
 	cmdutil.CheckErr(cmd.RegisterFlagCompletionFunc(
 		"serviceaccount", func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
 			if strings.TrimSpace(o.User) != "" {
@@ -306,6 +415,7 @@ type GrantPrivilegesOptions struct {
 	GrantClusters   []string
 	ReadOnly        bool
 	CreateNamespace bool
+	TTL             time.Duration
 
 	util.IOStreams
 }
@@ -332,6 +442,9 @@ func (opt *GrantPrivilegesOptions) Validate(f velacmd.Factory, cmd *cobra.Comman
 	if opt.User == "" && len(opt.Groups) == 0 && opt.ServiceAccount == "" {
 		return fmt.Errorf("at least one idenity (user/group/serviceaccount) should be set")
 	}
+	if opt.TTL < 0 {
+		return fmt.Errorf("the ttl of the privileges cannot be negative")
+	}
 	for _, cluster := range opt.GrantClusters {
 		if _, err := multicluster.NewClusterClient(f.Client()).Get(cmd.Context(), cluster); err != nil {
 			return fmt.Errorf("failed to find cluster %s: %w", cluster, err)
@@ -371,22 +484,60 @@ func (opt *GrantPrivilegesOptions) Run(f velacmd.Factory, cmd *cobra.Command) er
 			}
 		}
 	}
-	var privileges []auth.PrivilegeDescription
-	for _, cluster := range opt.GrantClusters {
-		for _, namespace := range opt.GrantNamespaces {
-			privileges = append(privileges, &auth.ScopedPrivilege{Cluster: cluster, Namespace: namespace, ReadOnly: opt.ReadOnly})
-		}
-		if len(opt.GrantNamespaces) == 0 {
-			privileges = append(privileges, &auth.ScopedPrivilege{Cluster: cluster, ReadOnly: opt.ReadOnly})
-		}
+	scopes := privilegeScopes(opt.GrantClusters, opt.GrantNamespaces, opt.ReadOnly)
+	// the grant is recorded first, so that the privileges are never granted without their expiry
+	audit, err := recordPrivilegeAudit(ctx, f, opt.IOStreams, v1alpha1.PrivilegeGrant, &opt.Identity, scopes, opt.TTL)
+	if err != nil {
+		return err
 	}
-	if err := auth.GrantPrivileges(ctx, f.Client(), privileges, &opt.Identity, opt.IOStreams.Out); err != nil {
+	if err = auth.GrantPrivileges(ctx, f.Client(), auth.ScopedPrivileges(scopes), &opt.Identity, opt.IOStreams.Out); err != nil {
+		if audit != nil {
+			if e := f.Client().Delete(ctx, audit); e != nil && !kerrors.IsNotFound(e) {
+				_, _ = fmt.Fprintf(opt.IOStreams.ErrOut, "Warning: failed to delete the audit %s of the failed grant: %s\n", audit.Name, e.Error())
+			}
+		}
 		return err
 	}
+	if opt.TTL > 0 {
+		_, _ = fmt.Fprintf(opt.IOStreams.Out, "Privileges granted, expiring in %s.\n", opt.TTL)
+		return nil
+	}
 	_, _ = fmt.Fprintf(opt.IOStreams.Out, "Privileges granted.\n")
 	return nil
 }
 
+func privilegeScopes(clusters []string, namespaces []string, readOnly bool) []v1alpha1.PrivilegeScope {
+	var scopes []v1alpha1.PrivilegeScope
+	for _, cluster := range clusters {
+		for _, namespace := range namespaces {
+			scopes = append(scopes, v1alpha1.PrivilegeScope{Cluster: cluster, Namespace: namespace, ReadOnly: readOnly})
+		}
+		if len(namespaces) == 0 {
+			scopes = append(scopes, v1alpha1.PrivilegeScope{Cluster: cluster, ReadOnly: readOnly})
+		}
+	}
+	return scopes
+}
+
+// recordPrivilegeAudit records the grant or revoke in the control plane, and returns the recorded audit. The
+// privileges without a ttl are still granted or revoked if the PrivilegeAudit is not installed, without audit. The
+// granter reported here is replaced by the requesting user if the admission webhooks of KubeVela are enabled.
+func recordPrivilegeAudit(ctx context.Context, f velacmd.Factory, streams util.IOStreams, action v1alpha1.PrivilegeAction, identity *auth.Identity, scopes []v1alpha1.PrivilegeScope, ttl time.Duration) (*v1alpha1.PrivilegeAudit, error) {
+	granter := "unknown"
+	if cli, err := kubernetes.NewForConfig(f.Config()); err == nil {
+		if granter, err = auth.GetGranter(ctx, cli); err != nil {
+			_, _ = fmt.Fprintf(streams.ErrOut, "Warning: %s, the granter is recorded as unknown.\n", err.Error())
+			granter = "unknown"
+		}
+	}
+	audit, err := auth.RecordPrivilegeAudit(ctx, f.Client(), types.DefaultKubeVelaNS, action, identity, granter, scopes, ttl)
+	if err != nil && ttl == 0 && meta.IsNoMatchError(err) {
+		_, _ = fmt.Fprintf(streams.ErrOut, "Warning: PrivilegeAudit is not installed, the %s is not audited.\n", action)
+		return nil, nil
+	}
+	return audit, err
+}
+
 var (
 	grantPrivilegesLong = templates.LongDesc(i18n.T(`
 		Grant privileges for user
@@ -407,7 +558,11 @@ var (
 		intended privileges respectively.
 
 		If --kubeconfig is set, the user/serviceaccount information in the kubeconfig will be used as
-		the identity to grant privileges. Groups will be ignored.`))
+		the identity to grant privileges. Groups will be ignored.
+
+		Setting --ttl will revoke the granted privileges automatically once the ttl is reached. Every
+		grant is recorded as a PrivilegeAudit in the vela-system namespace of the control plane, which
+		can be listed by vela auth audit.`))
 
 	grantPrivilegesExample = templates.Examples(i18n.T(`
 		# Grant privileges for User alice in the namespace demo of the control plane
@@ -426,7 +581,10 @@ var (
 		vela auth grant-privileges --serviceaccount observer -n test --for-namespace test --readonly
 
 		# Grant privileges for identity in kubeconfig in cluster-1
-		vela auth grant-privileges --kubeconfig ./example.kubeconfig --for-cluster cluster-1`))
+		vela auth grant-privileges --kubeconfig ./example.kubeconfig --for-cluster cluster-1
+
+		# Grant privileges for User alice in the namespace demo of the control plane for 8 hours
+		vela auth grant-privileges --user alice --for-namespace demo --ttl 8h`))
 )
 
 // NewGrantPrivilegesCommand grant privileges to given identity
@@ -456,6 +614,130 @@ func NewGrantPrivilegesCommand(f velacmd.Factory, streams util.IOStreams) *cobra
 	cmd.Flags().StringSliceVarP(&o.GrantNamespaces, "for-namespace", "", o.GrantNamespaces, "The namespaces privileges to grant. If empty, cluster-scoped privileges will be granted.")
 	cmd.Flags().BoolVarP(&o.ReadOnly, "readonly", "", o.ReadOnly, "If set, only read privileges of resources will be granted. Otherwise, read/write privileges will be granted.")
 	cmd.Flags().BoolVarP(&o.CreateNamespace, "create-namespace", "", o.CreateNamespace, "If set, non-exist namespace will be created automatically.")
+	cmd.Flags().DurationVarP(&o.TTL, "ttl", "", o.TTL, "The time to live of the granted privileges, such as 30m or 8h. If not set, the privileges never expire.")
+	cmdutil.CheckErr(cmd.RegisterFlagCompletionFunc(
+		"serviceaccount", func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+			if strings.TrimSpace(o.User) != "" {
+				return nil, cobra.ShellCompDirectiveNoFileComp
+			}
+			namespace := velacmd.GetNamespace(f, cmd)
+			return velacmd.GetServiceAccountForCompletion(cmd.Context(), f, namespace, toComplete)
+		}))
+
+	return velacmd.NewCommandBuilder(f, cmd).
+		WithNamespaceFlag(velacmd.UsageOption("The namespace of the serviceaccount. This flag only works when `--serviceaccount` is set.")).
+		WithStreams(streams).
+		WithResponsiveWriter().
+		Build()
+}
+
+// RevokePrivilegesOptions options for revoke privileges
+type RevokePrivilegesOptions struct {
+	auth.Identity
+	KubeConfig       string
+	RevokeNamespaces []string
+	RevokeClusters   []string
+	ReadOnly         bool
+
+	util.IOStreams
+}
+
+// Complete .
+func (opt *RevokePrivilegesOptions) Complete(f velacmd.Factory, cmd *cobra.Command) {
+	if opt.KubeConfig != "" {
+		identity, err := auth.ReadIdentityFromKubeConfig(opt.KubeConfig)
+		cmdutil.CheckErr(err)
+		opt.Identity = *identity
+		opt.Identity.Groups = nil
+	}
+	if opt.Identity.ServiceAccount != "" {
+		opt.Identity.ServiceAccountNamespace = velacmd.GetNamespace(f, cmd)
+	}
+	opt.Regularize()
+	if len(opt.RevokeClusters) == 0 {
+		opt.RevokeClusters = []string{types.ClusterLocalName}
+	}
+}
+
+// Validate .
+func (opt *RevokePrivilegesOptions) Validate(f velacmd.Factory, cmd *cobra.Command) error {
+	if opt.User == "" && len(opt.Groups) == 0 && opt.ServiceAccount == "" {
+		return fmt.Errorf("at least one idenity (user/group/serviceaccount) should be set")
+	}
+	for _, cluster := range opt.RevokeClusters {
+		if _, err := multicluster.NewClusterClient(f.Client()).Get(cmd.Context(), cluster); err != nil {
+			return fmt.Errorf("failed to find cluster %s: %w", cluster, err)
+		}
+	}
+	return nil
+}
+
+// Run .
+func (opt *RevokePrivilegesOptions) Run(f velacmd.Factory, cmd *cobra.Command) error {
+	ctx := cmd.Context()
+	scopes := privilegeScopes(opt.RevokeClusters, opt.RevokeNamespaces, opt.ReadOnly)
+	if err := auth.RevokePrivileges(ctx, f.Client(), auth.ScopedPrivileges(scopes), &opt.Identity, opt.IOStreams.Out); err != nil {
+		return err
+	}
+	if _, err := recordPrivilegeAudit(ctx, f, opt.IOStreams, v1alpha1.PrivilegeRevoke, &opt.Identity, scopes, 0); err != nil {
+		return err
+	}
+	_, _ = fmt.Fprintf(opt.IOStreams.Out, "Privileges revoked.\n")
+	return nil
+}
+
+var (
+	revokePrivilegesLong = templates.LongDesc(i18n.T(`
+		Revoke privileges for user
+
+		Revoke the privileges granted by vela auth grant-privileges from user/group/serviceaccount.
+		The --for-namespace, --for-cluster and --readonly flags should be the same as the ones used
+		to grant the privileges. 
+
+		Only the identity is removed from the subjects of the RoleBinding/ClusterRoleBinding of the
+		privileges, the RoleBinding/ClusterRoleBinding is deleted when no subject is left. Other
+		privileges of the identity are not revoked, use vela auth list-privileges to check them.
+
+		Every revoke is recorded as a PrivilegeAudit in the vela-system namespace of the control
+		plane, which can be listed by vela auth audit.`))
+
+	revokePrivilegesExample = templates.Examples(i18n.T(`
+		# Revoke privileges for User alice in the namespace demo of the control plane
+		vela auth revoke-privileges --user alice --for-namespace demo
+
+		# Revoke cluster-scoped privileges for Group org:dev-team in the control plane and cluster-1
+		vela auth revoke-privileges --group org:dev-team --for-cluster local --for-cluster cluster-1
+
+		# Revoke read privileges for ServiceAccount observer in test namespace on the control plane
+		vela auth revoke-privileges --serviceaccount observer -n test --for-namespace test --readonly`))
+)
+
+// NewRevokePrivilegesCommand revoke privileges from given identity
+func NewRevokePrivilegesCommand(f velacmd.Factory, streams util.IOStreams) *cobra.Command {
+	o := &RevokePrivilegesOptions{IOStreams: streams}
+	cmd := &cobra.Command{
+		Use:                   "revoke-privileges",
+		DisableFlagsInUseLine: true,
+		Short:                 i18n.T("Revoke privileges for user/group/serviceaccount"),
+		Long:                  revokePrivilegesLong,
+		Example:               revokePrivilegesExample,
+		Annotations: map[string]string{
+			types.TagCommandType: types.TypeCD,
+		},
+		Args: cobra.ExactArgs(0),
+		Run: func(cmd *cobra.Command, args []string) {
+			o.Complete(f, cmd)
+			cmdutil.CheckErr(o.Validate(f, cmd))
+			cmdutil.CheckErr(o.Run(f, cmd))
+		},
+	}
+	cmd.Flags().StringVarP(&o.User, "user", "u", o.User, "The user to revoke privileges.")
+	cmd.Flags().StringSliceVarP(&o.Groups, "group", "g", o.Groups, "The group to revoke privileges.")
+	cmd.Flags().StringVarP(&o.ServiceAccount, "serviceaccount", "", o.ServiceAccount, "The serviceaccount to revoke privileges.")
+	cmd.Flags().StringVarP(&o.KubeConfig, "kubeconfig", "", o.KubeConfig, "The kubeconfig to revoke privileges. If set, it will override all the other identity flags.")
+	cmd.Flags().StringSliceVarP(&o.RevokeClusters, "for-cluster", "", o.RevokeClusters, "The clusters privileges to revoke. If empty, the control plane will be used.")
+	cmd.Flags().StringSliceVarP(&o.RevokeNamespaces, "for-namespace", "", o.RevokeNamespaces, "The namespaces privileges to revoke. If empty, cluster-scoped privileges will be revoked.")
+	cmd.Flags().BoolVarP(&o.ReadOnly, "readonly", "", o.ReadOnly, "If set, the read privileges will be revoked. Otherwise, the read/write privileges will be revoked.")
 	cmdutil.CheckErr(cmd.RegisterFlagCompletionFunc(
 		"serviceaccount", func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
 			if strings.TrimSpace(o.User) != "" {
@@ -471,3 +753,158 @@ func NewGrantPrivilegesCommand(f velacmd.Factory, streams util.IOStreams) *cobra
 		WithResponsiveWriter().
 		Build()
 }
+
+// AuditOptions options for listing the audits of the privileges
+type AuditOptions struct {
+	auth.Identity
+	Clusters []string
+	util.IOStreams
+}
+
+// Complete .
+func (opt *AuditOptions) Complete(f velacmd.Factory, cmd *cobra.Command) {
+	if opt.Identity.ServiceAccount != "" {
+		opt.Identity.ServiceAccountNamespace = velacmd.GetNamespace(f, cmd)
+	}
+	opt.Regularize()
+}
+
+// Run .
+func (opt *AuditOptions) Run(f velacmd.Factory, cmd *cobra.Command) error {
+	var identity *auth.Identity
+	if opt.User != "" || len(opt.Groups) > 0 || opt.ServiceAccount != "" {
+		identity = &opt.Identity
+	}
+	audits, err := auth.ListPrivilegeAudits(cmd.Context(), f.Client(), types.DefaultKubeVelaNS, opt.Clusters, identity)
+	if err != nil {
+		return err
+	}
+	if len(audits) == 0 {
+		_, _ = fmt.Fprintf(opt.Out, "No privilege audit found.\n")
+		return nil
+	}
+	table := newUITable().AddRow("TIME", "ACTION", "IDENTITY", "GRANTER", "SCOPES", "EXPIRE", "PHASE")
+	for _, audit := range audits {
+		expire := "-"
+		if audit.Spec.ExpireTime != nil {
+			expire = audit.Spec.ExpireTime.Format(time.RFC3339)
+		}
+		phase := string(audit.Status.Phase)
+		if phase == "" {
+			phase = "-"
+		}
+		table.AddRow(audit.CreationTimestamp.Format(time.RFC3339), audit.Spec.Action,
+			auth.IdentityFromPrivilegeIdentity(audit.Spec.Identity).String(), audit.Spec.Granter,
+			auth.FormatPrivilegeScopes(audit.Spec.Scopes), expire, phase)
+	}
+	_, _ = fmt.Fprintln(opt.Out, table.String())
+	return nil
+}
+
+var (
+	auditLong = templates.LongDesc(i18n.T(`
+		List the audits of the privileges
+
+		List who was granted or revoked which privileges, by whom and when. The grants and revokes
+		by vela auth grant-privileges and vela auth revoke-privileges, and the revokes of the expired
+		privileges by the controller are recorded as PrivilegeAudits in the vela-system namespace of
+		the control plane.
+
+		Use --user/--group/--serviceaccount to only list the audits of the identity, and --cluster to
+		only list the audits of the privileges in the clusters. All the audits are listed by default.`))
+
+	auditExample = templates.Examples(i18n.T(`
+		# List all the audits of the privileges
+		vela auth audit
+
+		# List the audits of the privileges of User alice in cluster-1
+		vela auth audit --user alice --cluster cluster-1`))
+)
+
+// NewAuditCommand list the audits of the privileges
+func NewAuditCommand(f velacmd.Factory, streams util.IOStreams) *cobra.Command {
+	o := &AuditOptions{IOStreams: streams}
+	cmd := &cobra.Command{
+		Use:                   "audit",
+		DisableFlagsInUseLine: true,
+		Short:                 i18n.T("List the audits of the privileges granted and revoked"),
+		Long:                  auditLong,
+		Example:               auditExample,
+		Annotations: map[string]string{
+			types.TagCommandType: types.TypeCD,
+		},
+		Args: cobra.ExactArgs(0),
+		Run: func(cmd *cobra.Command, args []string) {
+			o.Complete(f, cmd)
+			cmdutil.CheckErr(o.Run(f, cmd))
+		},
+	}
+	cmd.Flags().StringVarP(&o.User, "user", "u", o.User, "The user to list the audits.")
+	cmd.Flags().StringSliceVarP(&o.Groups, "group", "g", o.Groups, "The group to list the audits.")
+	cmd.Flags().StringVarP(&o.ServiceAccount, "serviceaccount", "", o.ServiceAccount, "The serviceaccount to list the audits.")
+	cmd.Flags().StringSliceVarP(&o.Clusters, "cluster", "c", o.Clusters, "The clusters to list the audits of the privileges in. If not set, the audits of all the clusters will be listed.")
+	cmdutil.CheckErr(cmd.RegisterFlagCompletionFunc(
+		"cluster", func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+			return velacmd.GetClustersForCompletion(cmd.Context(), f, toComplete)
+		}))
+
+	return velacmd.NewCommandBuilder(f, cmd).
+		WithNamespaceFlag(velacmd.UsageOption("The namespace of the serviceaccount. This flag only works when `--serviceaccount` is set.")).
+		WithStreams(streams).
+		WithResponsiveWriter().
+		Build()
+}
+
+// OIDCLoginOptions options for logging in the OIDC issuer as the exec plugin
+type OIDCLoginOptions struct {
+	auth.OIDCLoginOptions
+	util.IOStreams
+}
+
+// Run .
+func (opt *OIDCLoginOptions) Run(cmd *cobra.Command) error {
+	if opt.IssuerURL == "" || opt.ClientID == "" {
+		return fmt.Errorf("both `issuer-url` and `client-id` should be set")
+	}
+	// the instructions go to stderr as stdout is read by kubectl
+	cred, err := auth.OIDCLogin(cmd.Context(), opt.OIDCLoginOptions, opt.ErrOut)
+	if err != nil {
+		return err
+	}
+	bs, err := json.Marshal(cred)
+	if err != nil {
+		return err
+	}
+	_, err = opt.Out.Write(bs)
+	return err
+}
+
+// NewOIDCLoginCommand log in the OIDC issuer as the exec plugin of kubeconfig
+func NewOIDCLoginCommand(streams util.IOStreams) *cobra.Command {
+	o := &OIDCLoginOptions{IOStreams: streams}
+	cmd := &cobra.Command{
+		Use:                   "oidc-login",
+		DisableFlagsInUseLine: true,
+		Short:                 i18n.T("Log in the OIDC issuer as the exec plugin of kubeconfig"),
+		Long: templates.LongDesc(i18n.T(`
+			Log in the OIDC issuer as the exec plugin of kubeconfig
+
+			Print the ExecCredential with the id token issued by the OIDC issuer. The id token is
+			cached and refreshed when it expires. If no valid token is cached, the device
+			authorization flow is used to log in. This command is run by kubectl with the kubeconfig
+			generated by vela auth gen-kubeconfig --oidc-issuer-url.`)),
+		Annotations: map[string]string{
+			types.TagCommandType: types.TypeCD,
+		},
+		Args: cobra.ExactArgs(0),
+		Run: func(cmd *cobra.Command, args []string) {
+			cmdutil.CheckErr(o.Run(cmd))
+		},
+	}
+	cmd.Flags().StringVarP(&o.IssuerURL, "issuer-url", "", o.IssuerURL, "The url of the OIDC issuer.")
+	cmd.Flags().StringVarP(&o.ClientID, "client-id", "", o.ClientID, "The client id of the OIDC issuer.")
+	cmd.Flags().StringVarP(&o.ClientSecret, "client-secret", "", o.ClientSecret, "The client secret of the OIDC issuer.")
+	cmd.Flags().StringSliceVarP(&o.Scopes, "scope", "", o.Scopes, "The scopes requested from the OIDC issuer.")
+	cmd.Flags().StringVarP(&o.CacheDir, "cache-dir", "", filepath.Join(homedir.HomeDir(), ".kube", "cache", "vela-oidc"), "The directory to cache the tokens.")
+	return cmd
+}
@@ -104,10 +104,12 @@ func NewCommandWithIOStreams(ioStream util.IOStreams) *cobra.Command {
 		NewExecCommand(commandArgs, "5", ioStream),
 		RevisionCommandGroup(commandArgs, "6"),
 		NewDebugCommand(commandArgs, "7", ioStream),
+		NewSupportBundleCommand(commandArgs, "8", ioStream),
 
 		// Continuous Delivery
 		NewWorkflowCommand(commandArgs, "1", ioStream),
 		NewAdoptCommand(f, "2", ioStream),
+		NewPromoteCommand(f, "3", ioStream),
 
 		// Platform
 		NewTopCommand(commandArgs, "1", ioStream),
@@ -20,6 +20,7 @@ import (
 	"bufio"
 	"bytes"
 	"context"
+	j "encoding/json"
 	"fmt"
 	"io"
 	"os"
@@ -39,6 +40,7 @@ import (
 	crossplane "github.com/oam-dev/terraform-controller/api/types/crossplane-runtime"
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
 	"go.yaml.in/yaml/v3"
 	errors2 "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -52,15 +54,22 @@ import (
 	commontype "github.com/oam-dev/kubevela/apis/core.oam.dev/common"
 	"github.com/oam-dev/kubevela/apis/core.oam.dev/v1beta1"
 	"github.com/oam-dev/kubevela/apis/types"
+	pkgappfile "github.com/oam-dev/kubevela/pkg/appfile"
+	"github.com/oam-dev/kubevela/pkg/appfile/debugger"
 	"github.com/oam-dev/kubevela/pkg/cue/process"
 	pkgdef "github.com/oam-dev/kubevela/pkg/definition"
+	"github.com/oam-dev/kubevela/pkg/definition/deftest"
 	"github.com/oam-dev/kubevela/pkg/definition/gen_sdk"
+	"github.com/oam-dev/kubevela/pkg/definition/gengo"
 	"github.com/oam-dev/kubevela/pkg/definition/goloader"
+	"github.com/oam-dev/kubevela/pkg/definition/lint"
+	oamutil "github.com/oam-dev/kubevela/pkg/oam/util"
 	"github.com/oam-dev/kubevela/pkg/utils"
 	addonutil "github.com/oam-dev/kubevela/pkg/utils/addon"
 	"github.com/oam-dev/kubevela/pkg/utils/common"
 	"github.com/oam-dev/kubevela/pkg/utils/filters"
 	"github.com/oam-dev/kubevela/pkg/utils/util"
+	"github.com/oam-dev/kubevela/references/appfile"
 	"github.com/oam-dev/kubevela/references/cuegen"
 	providergen "github.com/oam-dev/kubevela/references/cuegen/generators/provider"
 	"github.com/oam-dev/kubevela/references/docgen"
@@ -94,11 +103,17 @@ func DefinitionCommandGroup(c common.Args, order string, ioStreams util.IOStream
 		NewDefinitionDelCommand(c),
 		NewDefinitionInitCommand(c),
 		NewDefinitionValidateCommand(c),
+		NewDefinitionTestCommand(c),
+		NewDefinitionUpgradeCommand(c),
 		NewDefinitionDocGenCommand(c, ioStreams),
 		NewCapabilityShowCommand(c, "", ioStreams),
 		NewDefinitionGenAPICommand(c),
 		NewDefinitionGenCUECommand(c, ioStreams),
 		NewDefinitionGenDocCommand(c, ioStreams),
+		NewDefinitionGenGoCommand(c),
+		NewDefinitionDebugCommand(c),
+		NewDefinitionConflictsCommand(c),
+		NewDefinitionImpactCommand(c),
 		// Module commands for Go definition modules
 		NewDefinitionInitModuleCommand(c, ioStreams),
 		NewDefinitionApplyModuleCommand(c, ioStreams),
@@ -1003,6 +1018,11 @@ func NewDefinitionListCommand(c common.Args) *cobra.Command {
 				if annotations := definition.GetAnnotations(); annotations != nil {
 					desc = annotations[pkgdef.DescriptionKey]
 				}
+				version, _, _ := unstructured.NestedString(definition.Object, "spec", "version")
+				// the listed definitions are the latest ones, which declare the deprecated versions themselves
+				if msg, deprecated := oamutil.DefinitionDeprecation(definition.GetAnnotations(), version, definition.GetAnnotations()); deprecated {
+					desc = strings.TrimSpace(fmt.Sprintf("[DEPRECATED: %s] %s", msg, desc))
+				}
 
 				// Do not show SOURCE-ADDON column
 				if !showSourceAddon {
@@ -1379,8 +1399,8 @@ func NewDefinitionRenderCommand(c common.Args) *cobra.Command {
 						outputExt = CUEExtension
 					}
 
-					// Handle CUE files
-					if fileSuffix == CUEExtension {
+					// Handle CUE files (skip test files)
+					if fileSuffix == CUEExtension && !deftest.IsTestFile(path) {
 						inputFilenames = append(inputFilenames, path)
 						if output != "" {
 							outputFilenames = append(outputFilenames, filepath.Join(output, strings.ReplaceAll(filename, CUEExtension, outputExt)))
@@ -1486,6 +1506,10 @@ func NewDefinitionApplyCommand(c common.Args, streams util.IOStreams) *cobra.Com
 
 // isDefinitionFile checks if the path is a definition file (JSON, YAML, CUE, or Go)
 func isDefinitionFile(path string) bool {
+	// Skip the unit test files of definitions
+	if deftest.IsTestFile(path) {
+		return false
+	}
 	// Check for standard definition file types
 	if utils.IsJSONYAMLorCUEFile(path) {
 		return true
@@ -1719,6 +1743,9 @@ func NewDefinitionDelCommand(c common.Args) *cobra.Command {
 
 // isCUEorGoDefinitionFile checks if a file is a CUE file or a Go definition file
 func isCUEorGoDefinitionFile(path string) bool {
+	if deftest.IsTestFile(path) {
+		return false
+	}
 	if utils.IsCUEFile(path) {
 		return true
 	}
@@ -1733,13 +1760,18 @@ func isCUEorGoDefinitionFile(path string) bool {
 }
 
 func NewDefinitionValidateCommand(c common.Args) *cobra.Command {
+	var skipLint bool
+	var lintConfigFile, output string
 	cmd := &cobra.Command{
 		Use:   "vet DEFINITION.cue|DEFINITION.go",
 		Short: "Validate X-Definition.",
 		Long: "Validate definition file by checking whether it has the valid CUE or Go format with fields set correctly.\n" +
 			"Supports both CUE files and Go definition files using the defkit package.\n" +
 			"* For CUE files, this command checks the CUE syntax and validates the definition structure.\n" +
-			"* For Go files, it verifies the Go syntax and ensures the generated CUE is valid.",
+			"* For Go files, it verifies the Go syntax and ensures the generated CUE is valid.\n" +
+			"* The valid definitions are then checked against the best-practice rules, such as documented parameters, " +
+			"labeled outputs and declared health policies. The rules are configured by the " + lint.ConfigFileName + " file, " +
+			"and the command fails if any rule of the error severity is violated.",
 		Example: "# Command below will validate the my-def.cue file.\n" +
 			"> vela def vet my-def.cue\n" +
 			"# Validate a Go definition file.\n" +
@@ -1747,39 +1779,128 @@ func NewDefinitionValidateCommand(c common.Args) *cobra.Command {
 			"# Validate every CUE and Go definition file provided\n" +
 			"> vela def vet my-def1.cue my-def2.go my-def3.cue\n" +
 			"# Validate every CUE and Go definition file in the specified directories\n" +
-			"> vela def vet ./test1/ ./test2/",
+			"> vela def vet ./test1/ ./test2/\n" +
+			"# Report the lint findings in SARIF for code scanning\n" +
+			"> vela def vet ./definitions -o sarif > vela-lint.sarif",
 		Args: cobra.MinimumNArgs(1),
 		Annotations: map[string]string{
 			types.TagCommandType:  types.TypeDefManagement,
 			types.TagCommandOrder: "8",
 		},
 		RunE: func(cmd *cobra.Command, args []string) error {
+			switch output {
+			case "text", "json", "sarif":
+			default:
+				return errors.Errorf("unsupported output format %s, supported formats are text, json and sarif", output)
+			}
+			var findings []lint.Finding
 			for _, arg := range args {
 				files, err := utils.LoadDataFromPath(cmd.Context(), arg, isCUEorGoDefinitionFile)
 				if err != nil {
 					return errors.Wrapf(err, "failed to get file from %s", arg)
 				}
+				lintConfig, err := loadLintConfig(lintConfigFile, arg)
+				if err != nil {
+					return err
+				}
 				for _, file := range files {
-					validateRes, err := validateDefinitionFile(file.Path, file.Data, c)
+					validateRes, sources, err := validateDefinitionFile(file.Path, file.Data, c)
 					if err != nil {
 						return err
 					}
-					fmt.Fprintf(cmd.OutOrStdout(), "%s", validateRes)
+					if output == "text" {
+						fmt.Fprintf(cmd.OutOrStdout(), "%s", validateRes)
+					}
+					if skipLint {
+						continue
+					}
+					for _, src := range sources {
+						fileFindings, err := src.lint(file.Path, lintConfig)
+						if err != nil {
+							return err
+						}
+						findings = append(findings, fileFindings...)
+					}
 				}
 			}
+			if err := printLintFindings(cmd.OutOrStdout(), findings, output); err != nil {
+				return err
+			}
+			if lint.HasErrors(findings) {
+				return errors.New("definition lint failed")
+			}
 			return nil
 		},
 	}
+	cmd.Flags().BoolVarP(&skipLint, "skip-lint", "", false, "Skip checking the definitions against the best-practice rules.")
+	cmd.Flags().StringVarP(&lintConfigFile, "lint-config", "", "", "The lint config file, defaults to the nearest "+lint.ConfigFileName+" from the definition up to the root.")
+	cmd.Flags().StringVarP(&output, "output", "o", "text", "Output format of the lint findings, one of: text, json, sarif.")
 	return cmd
 }
 
-func validateDefinitionFile(fileName string, fileData []byte, c common.Args) (string, error) {
+func loadLintConfig(file string, path string) (*lint.Config, error) {
+	if file != "" {
+		return lint.LoadConfig(file)
+	}
+	return lint.FindConfig(path)
+}
+
+func printLintFindings(w io.Writer, findings []lint.Finding, output string) error {
+	switch output {
+	case "sarif":
+		return lint.WriteSARIF(w, findings)
+	case "json":
+		if findings == nil {
+			findings = []lint.Finding{}
+		}
+		bs, err := j.MarshalIndent(findings, "", "  ")
+		if err != nil {
+			return err
+		}
+		_, err = fmt.Fprintln(w, string(bs))
+		return err
+	default:
+		for _, f := range findings {
+			location := f.File
+			switch {
+			case f.Column > 0:
+				location = fmt.Sprintf("%s:%d:%d", f.File, f.Line, f.Column)
+			case f.Line > 0:
+				location = fmt.Sprintf("%s:%d", f.File, f.Line)
+			}
+			fmt.Fprintf(w, "%s: %s: %s (%s)\n", location, f.Severity, f.Message, f.Rule)
+		}
+		return nil
+	}
+}
+
+// definitionSource is the CUE source of a definition to lint. For the CUE generated from a Go definition, line is the
+// line of the Go function declaring the definition, as the positions in the generated CUE don't exist in the Go file.
+type definitionSource struct {
+	cue       string
+	generated bool
+	line      int
+}
+
+func (src definitionSource) lint(file string, config *lint.Config) ([]lint.Finding, error) {
+	if src.generated {
+		return lint.LintGeneratedFile(file, src.line, []byte(src.cue), config)
+	}
+	return lint.LintFile(file, []byte(src.cue), config)
+}
+
+// validateDefinitionFile validates the definition file and returns the CUE sources of the definitions to lint
+func validateDefinitionFile(fileName string, fileData []byte, c common.Args) (string, []definitionSource, error) {
 	// Handle Go definition files
 	if strings.HasSuffix(fileName, GoExtension) {
 		return validateGoDefinitionFile(fileName, c)
 	}
 	// Handle CUE files
-	return validateCueFile(fileName, fileData, c)
+	res, err := validateCueFile(fileName, fileData, c)
+	if err != nil {
+		return "", nil, err
+	}
+	return res, []definitionSource{{cue: string(fileData)}}, nil
 }
 
 func validateCueFile(fileName string, fileData []byte, c common.Args) (string, error) {
@@ -1794,11 +1915,11 @@ func validateCueFile(fileName string, fileData []byte, c common.Args) (string, e
 	return fmt.Sprintf("Validation %s succeed.\n", fileName), nil
 }
 
-func validateGoDefinitionFile(fileName string, c common.Args) (string, error) {
+func validateGoDefinitionFile(fileName string, c common.Args) (string, []definitionSource, error) {
 	// Load and generate CUE from the Go file
 	results, err := goloader.LoadFromFile(fileName)
 	if err != nil {
-		return "", errors.Wrapf(err, "failed to load Go definition: %s", fileName)
+		return "", nil, errors.Wrapf(err, "failed to load Go definition: %s", fileName)
 	}
 
 	config, err := c.GetConfig()
@@ -1807,23 +1928,221 @@ func validateGoDefinitionFile(fileName string, c common.Args) (string, error) {
 	}
 
 	var validatedDefs []string
+	var sources []definitionSource
 	for _, result := range results {
 		if result.Error != nil {
-			return "", errors.Wrapf(result.Error, "failed to generate CUE for %s in %s", result.Definition.FunctionName, fileName)
+			return "", nil, errors.Wrapf(result.Error, "failed to generate CUE for %s in %s", result.Definition.FunctionName, fileName)
 		}
 
 		// Validate the generated CUE
 		def := pkgdef.Definition{Unstructured: unstructured.Unstructured{}}
 		if err := def.FromCUEString(result.CUE, config); err != nil {
-			return "", errors.Wrapf(err, "generated CUE is invalid for %s in %s", result.Definition.FunctionName, fileName)
+			return "", nil, errors.Wrapf(err, "generated CUE is invalid for %s in %s", result.Definition.FunctionName, fileName)
 		}
 		validatedDefs = append(validatedDefs, result.Definition.Name)
+		sources = append(sources, definitionSource{cue: result.CUE, generated: true, line: result.Definition.Line})
 	}
 
 	if len(validatedDefs) == 1 {
-		return fmt.Sprintf("Validation %s succeed (definition: %s).\n", fileName, validatedDefs[0]), nil
+		return fmt.Sprintf("Validation %s succeed (definition: %s).\n", fileName, validatedDefs[0]), sources, nil
 	}
-	return fmt.Sprintf("Validation %s succeed (definitions: %s).\n", fileName, strings.Join(validatedDefs, ", ")), nil
+	return fmt.Sprintf("Validation %s succeed (definitions: %s).\n", fileName, strings.Join(validatedDefs, ", ")), sources, nil
+}
+
+// NewDefinitionTestCommand create the `vela def test` command to run the tests of definitions locally
+func NewDefinitionTestCommand(_ common.Args) *cobra.Command {
+	var update bool
+	var junitFile, run string
+	cmd := &cobra.Command{
+		Use:   "test [PATH...]",
+		Short: "Run unit tests of X-Definitions.",
+		Long: "Run the declarative unit tests of CUE X-Definitions without a cluster.\n" +
+			"* A test file is named after the definition with the _test suffix and sits next to it, " +
+			"e.g. webservice_test.yaml or webservice_test.cue for webservice.cue.\n" +
+			"* Each test case renders the definition with the parameter and mocked context, then asserts on the " +
+			"rendered output, outputs, health and status, or compares the rendered resources with a golden file.\n" +
+			"* The path could be a test file, a definition file or a directory. Defaults to the current directory.",
+		Example: "# Run the tests of the webservice definition\n" +
+			"> vela def test webservice.cue\n" +
+			"# Run all tests in the directory and write the JUnit report\n" +
+			"> vela def test ./definitions --junit report.xml\n" +
+			"# Update the golden files of the matched test cases\n" +
+			"> vela def test ./definitions --run expose --update",
+		Annotations: map[string]string{
+			types.TagCommandType:  types.TypeDefManagement,
+			types.TagCommandOrder: "9",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts := deftest.Options{Update: update}
+			if run != "" {
+				re, err := regexp.Compile(run)
+				if err != nil {
+					return errors.Wrapf(err, "invalid --run pattern %s", run)
+				}
+				opts.Run = re
+			}
+			if len(args) == 0 {
+				args = []string{"."}
+			}
+			var files []string
+			for _, arg := range args {
+				found, err := deftest.FindTestFiles(arg)
+				if err != nil {
+					return errors.Wrapf(err, "failed to find test files from %s", arg)
+				}
+				files = append(files, found...)
+			}
+			if len(files) == 0 {
+				return errors.Errorf("no test file found in %s", strings.Join(args, ", "))
+			}
+			var results []*deftest.SuiteResult
+			failed := 0
+			for _, file := range files {
+				result := deftest.RunFile(cmd.Context(), file, opts)
+				printDefinitionTestResult(cmd, result)
+				results = append(results, result)
+				failed += result.Failed()
+			}
+			if junitFile != "" {
+				f, err := os.Create(filepath.Clean(junitFile))
+				if err != nil {
+					return errors.Wrapf(err, "failed to create JUnit report %s", junitFile)
+				}
+				defer func() { _ = f.Close() }()
+				if err = deftest.WriteJUnit(f, results); err != nil {
+					return errors.Wrapf(err, "failed to write JUnit report %s", junitFile)
+				}
+			}
+			if failed > 0 {
+				return errors.Errorf("%d test(s) failed", failed)
+			}
+			return nil
+		},
+	}
+	cmd.Flags().BoolVarP(&update, "update", "u", false, "Update the golden files with the rendered resources instead of comparing with them.")
+	cmd.Flags().StringVarP(&junitFile, "junit", "", "", "Write the test results to the file in the JUnit XML format.")
+	cmd.Flags().StringVarP(&run, "run", "", "", "Only run the test cases whose names match the regular expression.")
+	return cmd
+}
+
+func printDefinitionTestResult(cmd *cobra.Command, result *deftest.SuiteResult) {
+	out := cmd.OutOrStdout()
+	if result.Err != nil {
+		fmt.Fprintf(out, "FAIL\t%s\n\t%s\n", result.File, result.Err.Error())
+		return
+	}
+	for _, c := range result.Cases {
+		switch {
+		case !c.Passed():
+			fmt.Fprintf(out, "--- FAIL: %s (%.2fs)\n", c.Name, c.Duration.Seconds())
+			for _, failure := range c.Failures {
+				fmt.Fprintf(out, "    %s\n", strings.ReplaceAll(failure, "\n", "\n    "))
+			}
+		case c.Updated:
+			fmt.Fprintf(out, "--- PASS: %s (%.2fs) golden file updated\n", c.Name, c.Duration.Seconds())
+		default:
+			fmt.Fprintf(out, "--- PASS: %s (%.2fs)\n", c.Name, c.Duration.Seconds())
+		}
+	}
+	status := "ok"
+	if result.Failed() > 0 {
+		status = "FAIL"
+	}
+	fmt.Fprintf(out, "%s\t%s\t%.3fs\n", status, result.File, result.Duration.Seconds())
+}
+
+// NewDefinitionUpgradeCommand create the `vela def upgrade` command to upgrade applications to the next major version of a definition
+func NewDefinitionUpgradeCommand(c common.Args) *cobra.Command {
+	var to, appName string
+	var allNamespaces, dryRun bool
+	cmd := &cobra.Command{
+		Use:   "upgrade DEFINITION_NAME",
+		Short: "Upgrade applications to the next major version of X-Definition.",
+		Long: "Upgrade the components and traits of applications pinned to the previous major version of the definition, " +
+			"e.g. webservice@v1, to the given major version. The properties are rewritten by the migration CUE declared in " +
+			"the definition.oam.dev/migration annotation of the target version. Applications using the latest definition are left as is.",
+		Example: "# Preview the upgrade of the applications in the default namespace from webservice v1 to v2\n" +
+			"> vela def upgrade webservice --to v2 --dry-run\n" +
+			"# Upgrade the application my-app\n" +
+			"> vela def upgrade webservice --to v2 --app my-app -n my-namespace",
+		Args: cobra.ExactArgs(1),
+		Annotations: map[string]string{
+			types.TagCommandType:  types.TypeDefManagement,
+			types.TagCommandOrder: "10",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			target, err := strconv.ParseUint(strings.TrimPrefix(to, "v"), 10, 64)
+			if err != nil || target < 1 {
+				return errors.Errorf("invalid major version %q to upgrade to, expected like v2", to)
+			}
+			namespace, err := cmd.Flags().GetString(FlagNamespace)
+			if err != nil {
+				return errors.Wrapf(err, "failed to get `%s`", Namespace)
+			}
+			if allNamespaces {
+				namespace = metav1.NamespaceAll
+			}
+			k8sClient, err := c.GetClient()
+			if err != nil {
+				return errors.Wrapf(err, "failed to get k8s client")
+			}
+			var apps []v1beta1.Application
+			if appName != "" {
+				app := v1beta1.Application{}
+				if err = k8sClient.Get(cmd.Context(), types2.NamespacedName{Namespace: namespace, Name: appName}, &app); err != nil {
+					return errors.Wrapf(err, "failed to get application %s", appName)
+				}
+				apps = append(apps, app)
+			} else {
+				appList := v1beta1.ApplicationList{}
+				if err = k8sClient.List(cmd.Context(), &appList, client.InNamespace(namespace)); err != nil {
+					return errors.Wrapf(err, "failed to list applications")
+				}
+				apps = appList.Items
+			}
+			table := newUITable()
+			table.AddRow("NAMESPACE", "APP", "COMPONENT", "TRAIT", "FROM", "TO")
+			upgraded := 0
+			for i := range apps {
+				app := &apps[i]
+				upgrades, err := pkgdef.UpgradeApplication(cmd.Context(), k8sClient, app, args[0], target)
+				if err != nil {
+					return errors.Wrapf(err, "failed to upgrade application %s/%s", app.Namespace, app.Name)
+				}
+				if len(upgrades) == 0 {
+					continue
+				}
+				for _, u := range upgrades {
+					table.AddRow(app.Namespace, app.Name, u.Component, u.Trait, u.FromType+" ("+u.FromVersion+")", u.ToType+" ("+u.ToVersion+")")
+				}
+				upgraded++
+				if dryRun {
+					continue
+				}
+				if err = k8sClient.Update(cmd.Context(), app); err != nil {
+					return errors.Wrapf(err, "failed to update application %s/%s", app.Namespace, app.Name)
+				}
+			}
+			if upgraded == 0 {
+				cmd.Printf("No application pinned to %s v%d found.\n", args[0], target-1)
+				return nil
+			}
+			cmd.Println(table)
+			if dryRun {
+				cmd.Printf("%d application(s) would be upgraded (dry run).\n", upgraded)
+			} else {
+				cmd.Printf("%d application(s) upgraded.\n", upgraded)
+			}
+			return nil
+		},
+	}
+	cmd.Flags().StringVar(&to, "to", "", "Specify the major version to upgrade to, such as v2.")
+	cmd.Flags().StringVar(&appName, "app", "", "Specify the application to upgrade. If empty, all applications in the namespace are upgraded.")
+	cmd.Flags().BoolVarP(&allNamespaces, "all-namespaces", "A", false, "Upgrade the applications in all namespaces.")
+	cmd.Flags().BoolVar(&dryRun, FlagDryRun, false, "Only print the upgrades without updating the applications.")
+	cmd.Flags().StringP(Namespace, "n", "default", "Specify the namespace of the applications.")
+	_ = cmd.MarkFlagRequired("to")
+	return cmd
 }
 
 // NewDefinitionGenAPICommand create the `vela def gen-api` command to help user generate Go code from the definition
@@ -1836,13 +2155,21 @@ func NewDefinitionGenAPICommand(c common.Args) *cobra.Command {
 		Short: "Generate SDK from X-Definition.",
 		Long: "Generate SDK from X-definition file.\n" +
 			"* This command leverage openapi-generator project. Therefore demands \"docker\" exist in PATH\n" +
+			"* For terraform, the provider schema is generated without docker. Each definition becomes a typed resource composing into the application resource.\n" +
+			"* For python and typescript, the typed builders are generated without docker. Each definition becomes a class composing into the Application builder, which serializes to YAML and JSON.\n" +
 			"* Currently, this function is still working in progress and not all formats of parameter in X-definition are supported yet.",
 		Example: "# Generate SDK for golang with scaffold initialized\n" +
 			"> vela def gen-api --init --language go -f /path/to/def -o /path/to/sdk\n" +
 			"# Generate incremental definition files to existing sdk directory\n" +
 			"> vela def gen-api --language go -f /path/to/def -o /path/to/sdk\n" +
 			"# Generate definitions to a sub-module\n" +
-			"> vela def gen-api --language go -f /path/to/def -o /path/to/sdk --submodule --api-dir path/relative/to/output --language-args arg1=val1,arg2=val2\n",
+			"> vela def gen-api --language go -f /path/to/def -o /path/to/sdk --submodule --api-dir path/relative/to/output --language-args arg1=val1,arg2=val2\n" +
+			"# Generate terraform provider schema with scaffold initialized\n" +
+			"> vela def gen-api --init --language terraform -f /path/to/def -o /path/to/provider -p registry.terraform.io/my-org/vela\n" +
+			"# Generate python SDK from the definitions installed in the cluster\n" +
+			"> vela def gen-api --init --lang python --installed -o /path/to/sdk -p my_vela_sdk\n" +
+			"# Generate typescript SDK from the definitions installed in the cluster and the local files\n" +
+			"> vela def gen-api --init --lang typescript --installed -f /path/to/def -o /path/to/sdk -p @my-org/vela-sdk\n",
 		Annotations: map[string]string{
 			types.TagCommandType:  types.TypeDefGeneration,
 			types.TagCommandOrder: "2",
@@ -1870,13 +2197,22 @@ func NewDefinitionGenAPICommand(c common.Args) *cobra.Command {
 	}
 
 	cmd.Flags().StringVarP(&meta.Output, "output", "o", "./apis", "Output directory path")
-	cmd.Flags().StringVar(&meta.APIDirectory, "api-dir", "", "API directory path to put definition API files, relative to output directory. Default value: go: pkg/apis")
+	cmd.Flags().StringVar(&meta.APIDirectory, "api-dir", "", "API directory path to put definition API files, relative to output directory. Default value: go: pkg/apis, terraform: definitions, python: <package>/apis, typescript: src/apis")
 	cmd.Flags().BoolVar(&meta.IsSubModule, "submodule", false, "Whether the generated code is a submodule of the project. If set, the directory specified by `api-dir` will be treated as a submodule of the project")
-	cmd.Flags().StringVarP(&meta.Package, "package", "p", gen_sdk.PackagePlaceHolder, "Package name of generated code")
-	cmd.Flags().StringVarP(&meta.Lang, "language", "g", "go", "Language to generate code. Valid languages: go")
+	cmd.Flags().StringVarP(&meta.Package, "package", "p", gen_sdk.PackagePlaceHolder, "Package name of generated code. For terraform, it is the provider source address, default: "+gen_sdk.TerraformProviderPlaceHolder+
+		". For python, default: "+gen_sdk.PythonPackagePlaceHolder+". For typescript, default: "+gen_sdk.TypeScriptPackagePlaceHolder)
+	cmd.Flags().StringVarP(&meta.Lang, "language", "g", "go", "Language to generate code. Valid languages: go, terraform, python, typescript")
+	cmd.Flags().SetNormalizeFunc(func(_ *pflag.FlagSet, name string) pflag.NormalizedName {
+		if name == "lang" {
+			name = "language"
+		}
+		return pflag.NormalizedName(name)
+	})
 	cmd.Flags().StringVarP(&meta.Template, "template", "t", "", "Template file path, if not specified, the default template will be used")
 	cmd.Flags().StringSliceVarP(&meta.File, "file", "f", nil, "File name of definitions, can be specified multiple times, or use comma to separate multiple files. If directory specified, all files found recursively in the directory will be used")
 	cmd.Flags().BoolVar(&meta.InitSDK, "init", false, "Init the whole SDK project, if not set, only the API file will be generated")
+	cmd.Flags().BoolVar(&meta.Installed, "installed", false, "Generate the API of the definitions installed in the cluster besides the files specified by --file")
+	cmd.Flags().StringVarP(&meta.Namespace, Namespace, "n", "", "Namespace of the installed definitions, all namespaces if not specified")
 	cmd.Flags().BoolVarP(&meta.Verbose, "verbose", "v", false, "Print verbose logs")
 	var langArgsDescStr string
 	for lang, args := range gen_sdk.LangArgsRegistry {
@@ -1996,3 +2332,316 @@ func NewDefinitionGenDocCommand(_ common.Args, streams util.IOStreams) *cobra.Co
 
 	return cmd
 }
+
+// NewDefinitionGenGoCommand create the `vela def gen-go` command to convert the CUE definition to the Go definition with defkit
+func NewDefinitionGenGoCommand(_ common.Args) *cobra.Command {
+	var output, pkg string
+	var verify bool
+	cmd := &cobra.Command{
+		Use:   "gen-go [flags] DEFINITION.cue",
+		Args:  cobra.ExactArgs(1),
+		Short: "Convert a CUE X-Definition to a Go definition written with defkit.",
+		Long: "Convert a CUE X-Definition to a Go definition written with defkit, which could be added to a Go definition module.\n" +
+			"* The parameters, the output and outputs resources, the trait patches and the policy fields are converted to the defkit builders.\n" +
+			"* The parts without an equivalent builder are kept as raw CUE, which are reported on the stderr for a review.\n" +
+			"* With --verify, the CUE definition is regenerated from the Go definition and compared with the original one, " +
+			"including the metadata, the parameter schema and the resources rendered with sample parameters. It requires the go toolchain.",
+		Example: "# Convert the webservice component to Go\n" +
+			"> vela def gen-go webservice.cue -o webservice.go\n" +
+			"# Convert the trait into the package of a Go definition module and verify the round trip\n" +
+			"> vela def gen-go my-trait.cue --package traits -o traits/my_trait.go --verify",
+		Annotations: map[string]string{
+			types.TagCommandType:  types.TypeDefGeneration,
+			types.TagCommandOrder: "5",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			bs, err := os.ReadFile(filepath.Clean(args[0]))
+			if err != nil {
+				return errors.Wrapf(err, "failed to read %s", args[0])
+			}
+			result, err := gengo.Generate(string(bs), gengo.Options{Package: pkg})
+			if err != nil {
+				return errors.Wrapf(err, "failed to convert %s", args[0])
+			}
+			for _, note := range result.Notes {
+				cmd.PrintErrf("NOTE: %s\n", note)
+			}
+			if output == "" {
+				_, err = cmd.OutOrStdout().Write(result.Source)
+			} else {
+				err = os.WriteFile(output, result.Source, 0600)
+			}
+			if err != nil {
+				return errors.Wrap(err, "failed to write the Go definition")
+			}
+			if !verify {
+				return nil
+			}
+			report, err := gengo.Verify(cmd.Context(), string(bs), result)
+			if err != nil {
+				return errors.Wrap(err, "failed to verify the Go definition")
+			}
+			for _, skipped := range report.Skipped {
+				cmd.PrintErrf("SKIP: %s\n", skipped)
+			}
+			for _, mismatch := range report.Mismatches {
+				cmd.PrintErrf("MISMATCH: %s\n", mismatch)
+			}
+			if !report.Equivalent() {
+				return errors.Errorf("the Go definition %s is not equivalent to %s", result.Function, args[0])
+			}
+			cmd.PrintErrf("the Go definition %s is equivalent to %s\n", result.Function, args[0])
+			return nil
+		},
+	}
+	cmd.Flags().StringVarP(&output, "output", "o", "", "Write the Go definition to the file instead of the stdout.")
+	cmd.Flags().StringVarP(&pkg, "package", "", gengo.DefaultPackage, "The package name of the Go definition.")
+	cmd.Flags().BoolVarP(&verify, "verify", "", false, "Verify the Go definition renders the same as the CUE definition.")
+	return cmd
+}
+
+// NewDefinitionDebugCommand create the `vela def debug` command to step through the rendering of a component
+func NewDefinitionDebugCommand(c common.Args) *cobra.Command {
+	var compName, definitionFile, definitionNamespace string
+	var offline bool
+	cmd := &cobra.Command{
+		Use:   "debug [flags] APP",
+		Args:  cobra.ExactArgs(1),
+		Short: "Step through the rendering of a component by the CUE templates of the definitions.",
+		Long: "Step through the rendering of a component of an application, given as a file or as the name of an application in the cluster.\n" +
+			"* Each stage evaluates the template of the component or of a trait, showing the context and the parameter passed to the template, " +
+			"the resources rendered and the changes made by the trait patches.\n" +
+			"* The unification conflicts are shown with the positions in the template, the parameter and the context.\n" +
+			"* At each stage, CUE expressions could be evaluated in the scope of the template, e.g. patch or context.output.spec. " +
+			"Type help in the debugger for the commands. Without an input, all the stages are printed.",
+		Example: "# Debug the component api of the application file with the local definitions, without a cluster\n" +
+			"> vela def debug app.yaml --component api -d ./definitions --offline\n" +
+			"# Debug the component of the application deployed in the cluster\n" +
+			"> vela def debug my-app -n default",
+		Annotations: map[string]string{
+			types.TagCommandType:  types.TypeDefManagement,
+			types.TagCommandOrder: "11",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, af, err := loadAppfileWithDefinitions(cmd, c, args[0], definitionFile, definitionNamespace, offline)
+			if err != nil {
+				return err
+			}
+			if compName == "" {
+				if len(af.ParsedComponents) != 1 {
+					return errors.Errorf("the application has %d components, specify the component to debug with --component", len(af.ParsedComponents))
+				}
+				compName = af.ParsedComponents[0].Name
+			}
+			stages, err := debugger.Render(ctx, af, compName)
+			if err != nil {
+				return err
+			}
+			debugger.NewConsole(stages, cmd.OutOrStdout()).Run(cmd.InOrStdin())
+			return nil
+		},
+	}
+	cmd.Flags().StringVarP(&compName, "component", "c", "", "The component to debug, required if the application has more than one component.")
+	cmd.Flags().StringVarP(&definitionFile, "definition", "d", "", "Specify a definition file or directory, used in preference to the definitions in the cluster.")
+	cmd.Flags().BoolVar(&offline, "offline", false, "Debug without a cluster, the definitions must be given with --definition.")
+	cmd.Flags().StringVarP(&definitionNamespace, "definition-namespace", "x", "", "Specify which namespace the definition locates. (default \"vela-system\")")
+	addNamespaceAndEnvArg(cmd)
+	return cmd
+}
+
+// loadAppfileWithDefinitions parses the application given as a file or as the name of an application in the cluster,
+// with the definitions of the definition file in preference to the definitions in the cluster
+func loadAppfileWithDefinitions(cmd *cobra.Command, c common.Args, appArg, definitionFile, definitionNamespace string, offline bool) (context.Context, *pkgappfile.Appfile, error) {
+	namespace, err := GetFlagNamespace(cmd, c)
+	if err != nil {
+		return nil, nil, err
+	}
+	var objs []*unstructured.Unstructured
+	if definitionFile != "" {
+		if objs, err = ReadDefinitionsFromFile(definitionFile, util.IOStreams{Out: cmd.OutOrStdout(), ErrOut: cmd.ErrOrStderr()}); err != nil {
+			return nil, nil, err
+		}
+	}
+	var cli client.Client
+	if offline {
+		cli, err = c.GetFakeClient(includeBuiltinWorkflowStepDefinition(objs))
+	} else {
+		cli, err = c.GetClient()
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var app *v1beta1.Application
+	if _, statErr := os.Stat(appArg); statErr == nil || offline {
+		if app, err = readApplicationFromFile(appArg); err != nil {
+			return nil, nil, errors.WithMessagef(err, "failed to read application file %s", appArg)
+		}
+	} else {
+		if namespace == "" {
+			if namespace, err = GetNamespaceFromEnv(cmd, c); err != nil {
+				return nil, nil, err
+			}
+		}
+		if app, err = appfile.LoadApplication(namespace, appArg, c); err != nil {
+			return nil, nil, err
+		}
+	}
+	if namespace != "" {
+		app.Namespace = namespace
+	}
+	if app.Namespace == "" {
+		app.Namespace = types.DefaultAppNamespace
+	}
+
+	ctx := oamutil.SetNamespaceInCtx(cmd.Context(), app.Namespace)
+	ctx = oamutil.SetXDefinitionNamespaceInCtx(ctx, definitionNamespace)
+	af, err := pkgappfile.NewDryRunApplicationParser(cli, objs).GenerateAppFileFromApp(ctx, app)
+	if err != nil {
+		return nil, nil, errors.WithMessage(err, "failed to parse the application")
+	}
+	return ctx, af, nil
+}
+
+// NewDefinitionConflictsCommand create the `vela def conflicts` command to find the fields patched with different values by the traits of a component
+func NewDefinitionConflictsCommand(c common.Args) *cobra.Command {
+	var compName, definitionFile, definitionNamespace string
+	var offline bool
+	cmd := &cobra.Command{
+		Use:   "conflicts [flags] APP",
+		Args:  cobra.ExactArgs(1),
+		Short: "Find the fields patched with different values by the traits of the components.",
+		Long: "Find the fields of the workloads patched with different values by two traits of the same component, " +
+			"in an application given as a file or as the name of an application in the cluster.\n" +
+			"* The policy applied is the stricter conflictPolicy of the two trait definitions: error fails the rendering, " +
+			"warn and last-wins apply the patch of the latter trait.\n" +
+			"* The command fails if a conflict has the error policy.",
+		Example: "# Find the conflicts of the application file with the local definitions, without a cluster\n" +
+			"> vela def conflicts app.yaml -d ./definitions --offline\n" +
+			"# Find the conflicts of the component api of the application deployed in the cluster\n" +
+			"> vela def conflicts my-app -n default -c api",
+		Annotations: map[string]string{
+			types.TagCommandType:  types.TypeDefManagement,
+			types.TagCommandOrder: "12",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			_, af, err := loadAppfileWithDefinitions(cmd, c, args[0], definitionFile, definitionNamespace, offline)
+			if err != nil {
+				return err
+			}
+			conflicts, err := af.PatchConflicts()
+			if err != nil {
+				return err
+			}
+			table := newUITable()
+			table.AddRow("COMPONENT", "PATH", "TRAITS", "POLICY")
+			failed := 0
+			for _, comp := range af.ParsedComponents {
+				if compName != "" && comp.Name != compName {
+					continue
+				}
+				for _, conflict := range conflicts[comp.Name] {
+					table.AddRow(comp.Name, conflict.Path, strings.Join(conflict.Traits[:], ", "), conflict.Policy)
+					if conflict.Policy == v1beta1.TraitConflictError {
+						failed++
+					}
+				}
+			}
+			if len(table.Rows) == 1 {
+				cmd.Println("No conflicting patches found.")
+				return nil
+			}
+			cmd.Println(table.String())
+			if failed > 0 {
+				return errors.Errorf("%d conflicting patches fail the rendering", failed)
+			}
+			return nil
+		},
+	}
+	cmd.Flags().StringVarP(&compName, "component", "c", "", "Only check the component of the application.")
+	cmd.Flags().StringVarP(&definitionFile, "definition", "d", "", "Specify a definition file or directory, used in preference to the definitions in the cluster.")
+	cmd.Flags().BoolVar(&offline, "offline", false, "Check without a cluster, the definitions must be given with --definition.")
+	cmd.Flags().StringVarP(&definitionNamespace, "definition-namespace", "x", "", "Specify which namespace the definition locates. (default \"vela-system\")")
+	addNamespaceAndEnvArg(cmd)
+	return cmd
+}
+
+// NewDefinitionImpactCommand create the `vela def impact` command to find the applications affected by a new version of a definition
+func NewDefinitionImpactCommand(c common.Args) *cobra.Command {
+	var namespace string
+	var revisions, failOnRisk bool
+	cmd := &cobra.Command{
+		Use:   "impact DEFINITION_FILE",
+		Args:  cobra.ExactArgs(1),
+		Short: "Analyze the impact of a new version of a definition on the applications using it.",
+		Long: "Find the applications and the application revisions using the definition, and render each with the definition " +
+			"in the cluster and the definition of the file, to compare the resources rendered.\n" +
+			"* Only ComponentDefinition and TraitDefinition are supported.\n" +
+			"* The components and traits pinned to a version of the definition, e.g. webservice@v1, are not affected.\n" +
+			"* The previous revisions are rendered with the definitions recorded in the revision, as a rollback would.",
+		Example: "# Analyze the impact of the definition on the applications of all namespaces\n" +
+			"> vela def impact webservice.cue\n" +
+			"# Analyze the impact on the applications of the default namespace, failing if a resource would be removed\n" +
+			"> vela def impact webservice.yaml -n default --fail-on-risk",
+		Annotations: map[string]string{
+			types.TagCommandType:  types.TypeDefManagement,
+			types.TagCommandOrder: "13",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			defs, err := ReadDefinitionsFromFile(args[0], util.IOStreams{Out: cmd.OutOrStdout(), ErrOut: cmd.ErrOrStderr()})
+			if err != nil {
+				return err
+			}
+			if len(defs) != 1 {
+				return errors.Errorf("the file must contain one definition, found %d", len(defs))
+			}
+			k8sClient, err := c.GetClient()
+			if err != nil {
+				return errors.Wrapf(err, "failed to get k8s client")
+			}
+			report, err := pkgdef.AnalyzeImpact(cmd.Context(), k8sClient, defs[0], pkgdef.ImpactOptions{Namespace: namespace, Revisions: revisions})
+			if err != nil {
+				return err
+			}
+			if len(report.Impacts) == 0 {
+				cmd.Printf("No application uses %s %s.\n", report.Kind, report.Name)
+				return nil
+			}
+			table := newUITable()
+			table.AddRow("NAMESPACE", "NAME", "KIND", "IMPACT")
+			for _, i := range report.Impacts {
+				var impact string
+				switch {
+				case i.Skipped != "":
+					impact = "unknown: " + i.Skipped
+				case i.Err != nil:
+					impact = "fails to render: " + i.Err.Error()
+				case len(i.Changes) == 0:
+					impact = "unchanged"
+				default:
+					var changes []string
+					for _, change := range i.Changes {
+						changes = append(changes, change.String())
+					}
+					impact = strings.Join(changes, ", ")
+				}
+				table.AddRow(i.Namespace, i.Name, i.Kind, impact)
+			}
+			cmd.Println(table.String())
+			cmd.Println(report.Summary())
+			if !report.Risky() {
+				return nil
+			}
+			cmd.Printf("The new definition removes resources or breaks the rendering. To roll it out gradually, publish it as a new major version, "+
+				"pin the applications to the current one, e.g. %s@v1, and move them with vela def upgrade.\n", report.Name)
+			if failOnRisk {
+				return errors.New("the new definition is risky")
+			}
+			return nil
+		},
+	}
+	cmd.Flags().StringVarP(&namespace, Namespace, "n", "", "Specify the namespace of the applications. If empty, the applications of all namespaces are analyzed.")
+	cmd.Flags().BoolVar(&revisions, "revisions", true, "Analyze the previous revisions of the applications, which a rollback would render with the new definition.")
+	cmd.Flags().BoolVar(&failOnRisk, "fail-on-risk", false, "Fail if the new definition would remove a resource or break the rendering of an application.")
+	return cmd
+}
@@ -34,6 +34,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"k8s.io/apimachinery/pkg/api/errors"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	dynamicfake "k8s.io/client-go/dynamic/fake"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
@@ -42,6 +43,8 @@ import (
 	common3 "github.com/oam-dev/kubevela/apis/core.oam.dev/common"
 	"github.com/oam-dev/kubevela/apis/core.oam.dev/v1beta1"
 	pkgdef "github.com/oam-dev/kubevela/pkg/definition"
+	"github.com/oam-dev/kubevela/pkg/definition/lint"
+	"github.com/oam-dev/kubevela/pkg/oam"
 	addonutil "github.com/oam-dev/kubevela/pkg/utils/addon"
 	common2 "github.com/oam-dev/kubevela/pkg/utils/common"
 	"github.com/oam-dev/kubevela/pkg/utils/util"
@@ -507,6 +510,85 @@ func TestNewDefinitionListCommand(t *testing.T) {
 	}
 }
 
+func TestNewDefinitionListCommandDeprecatedVersion(t *testing.T) {
+	c := initArgs()
+	cli, err := c.GetClient()
+	require.NoError(t, err)
+	require.NoError(t, cli.Create(context.Background(), &v1beta1.ComponentDefinition{
+		ObjectMeta: v1.ObjectMeta{
+			Name:        "deprecated-web",
+			Namespace:   oam.SystemDefinitionNamespace,
+			Annotations: map[string]string{oam.AnnotationDefinitionDeprecatedVersions: "<2.0.0"},
+		},
+		Spec: v1beta1.ComponentDefinitionSpec{Version: "1.2.0"},
+	}))
+	cmd := NewDefinitionListCommand(c)
+	initCommand(cmd)
+	buf := &bytes.Buffer{}
+	cmd.SetOut(buf)
+	cmd.SetArgs([]string{"--type", "component"})
+	require.NoError(t, cmd.Execute())
+	require.Contains(t, buf.String(), "[DEPRECATED: version 1.2.0 is deprecated]")
+}
+
+func TestNewDefinitionUpgradeCommand(t *testing.T) {
+	c := initArgs()
+	cli, err := c.GetClient()
+	require.NoError(t, err)
+	ctx := context.Background()
+	for version, annotations := range map[string]map[string]string{
+		"1.0.0": nil,
+		"2.0.0": {oam.AnnotationDefinitionMigration: "output: {image: parameter.image, replicas: parameter.count}"},
+	} {
+		require.NoError(t, cli.Create(ctx, &v1beta1.DefinitionRevision{
+			ObjectMeta: v1.ObjectMeta{
+				Name:      "web-v" + version,
+				Namespace: oam.SystemDefinitionNamespace,
+				Labels:    map[string]string{oam.LabelComponentDefinitionName: "web"},
+			},
+			Spec: v1beta1.DefinitionRevisionSpec{
+				DefinitionType: common3.ComponentType,
+				ComponentDefinition: v1beta1.ComponentDefinition{
+					ObjectMeta: v1.ObjectMeta{Name: "web", Annotations: annotations},
+					Spec:       v1beta1.ComponentDefinitionSpec{Version: version},
+				},
+			},
+		}))
+	}
+	require.NoError(t, cli.Create(ctx, &v1beta1.Application{
+		ObjectMeta: v1.ObjectMeta{Name: "upgrade-app", Namespace: "default"},
+		Spec: v1beta1.ApplicationSpec{Components: []common3.ApplicationComponent{{
+			Name:       "web",
+			Type:       "web@v1",
+			Properties: &runtime.RawExtension{Raw: []byte(`{"image":"nginx","count":2}`)},
+		}}},
+	}))
+
+	buf := bytes.NewBuffer(nil)
+	cmd := NewDefinitionUpgradeCommand(c)
+	initCommand(cmd)
+	cmd.SetOut(buf)
+	cmd.SetArgs([]string{"web", "--to", "v2", "--dry-run"})
+	require.NoError(t, cmd.Execute())
+	require.Contains(t, buf.String(), "1 application(s) would be upgraded (dry run).")
+	app := &v1beta1.Application{}
+	require.NoError(t, cli.Get(ctx, types.NamespacedName{Namespace: "default", Name: "upgrade-app"}, app))
+	require.Equal(t, "web@v1", app.Spec.Components[0].Type)
+
+	cmd = NewDefinitionUpgradeCommand(c)
+	initCommand(cmd)
+	cmd.SetArgs([]string{"web", "--to", "v2", "--app", "upgrade-app"})
+	require.NoError(t, cmd.Execute())
+	require.NoError(t, cli.Get(ctx, types.NamespacedName{Namespace: "default", Name: "upgrade-app"}, app))
+	require.Equal(t, "web@v2", app.Spec.Components[0].Type)
+	require.JSONEq(t, `{"image":"nginx","replicas":2}`, string(app.Spec.Components[0].Properties.Raw))
+
+	cmd = NewDefinitionUpgradeCommand(c)
+	initCommand(cmd)
+	cmd.SetArgs([]string{"web", "--to", "two"})
+	require.Error(t, cmd.Execute())
+}
+
 func TestNewDefinitionEditCommand(t *testing.T) {
 	c := initArgs()
 	// normal test
@@ -577,6 +659,55 @@ func TestNewDefinitionRenderCommand(t *testing.T) {
 	}
 }
 
+func TestNewDefinitionTestCommand(t *testing.T) {
+	c := initArgs()
+	testdata := "../../pkg/definition/deftest/testdata"
+	dir := t.TempDir()
+	junit := filepath.Join(dir, "report.xml")
+	buf := bytes.NewBuffer(nil)
+	cmd := NewDefinitionTestCommand(c)
+	initCommand(cmd)
+	cmd.SetOut(buf)
+	cmd.SetArgs([]string{testdata, "--junit", junit})
+	require.NoError(t, cmd.Execute())
+	require.Contains(t, buf.String(), "--- PASS: exposes the port by cluster version")
+	require.Contains(t, buf.String(), "ok\t"+filepath.Join(testdata, "replicas_test.cue"))
+	report, err := os.ReadFile(junit)
+	require.NoError(t, err)
+	require.Contains(t, string(report), `<testsuites tests="6" failures="0" errors="0"`)
+
+	// the test files are not taken as definitions
+	require.False(t, isCUEorGoDefinitionFile(filepath.Join(testdata, "replicas_test.cue")))
+	require.True(t, isCUEorGoDefinitionFile(filepath.Join(testdata, "replicas.cue")))
+
+	def, err := os.ReadFile(filepath.Join(testdata, "web.cue"))
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "web.cue"), def, 0600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "web_test.yaml"), []byte(`
+tests:
+  - name: wrong replicas
+    parameter:
+      image: nginx
+    expect:
+      output:
+        spec:
+          replicas: 3
+`), 0600))
+	buf.Reset()
+	cmd = NewDefinitionTestCommand(c)
+	initCommand(cmd)
+	cmd.SetOut(buf)
+	cmd.SetArgs([]string{filepath.Join(dir, "web.cue")})
+	require.EqualError(t, cmd.Execute(), "1 test(s) failed")
+	require.Contains(t, buf.String(), "--- FAIL: wrong replicas")
+	require.Contains(t, buf.String(), "output.spec.replicas: expected 3, got 1")
+
+	cmd = NewDefinitionTestCommand(c)
+	initCommand(cmd)
+	cmd.SetArgs([]string{dir, "--run", "("})
+	require.Error(t, cmd.Execute())
+}
+
 func TestNewDefinitionApplyCommand(t *testing.T) {
 	c := initArgs()
 	ioStreams := util.IOStreams{In: os.Stdin, Out: bytes.NewBuffer(nil), ErrOut: bytes.NewBuffer(nil)}
@@ -664,18 +795,119 @@ func TestNewDefinitionVetCommand(t *testing.T) {
 	}
 }
 
+func TestNewDefinitionVetCommandLint(t *testing.T) {
+	dir := t.TempDir()
+	defFile := filepath.Join(dir, "worker.cue")
+	require.NoError(t, os.WriteFile(defFile, []byte(`worker: {
+	type: "component"
+	attributes: {
+		workload: type: "deployments.apps"
+		status: healthPolicy: "isHealth: true"
+	}
+}
+template: {
+	output: {
+		apiVersion: "apps/v1"
+		kind:       "Deployment"
+		metadata: {
+			name:      context.name
+			namespace: "production"
+			labels: app: context.name
+		}
+	}
+	parameter: {}
+}
+`), 0600))
+
+	run := func(args ...string) (string, error) {
+		cmd := NewDefinitionValidateCommand(initArgs())
+		initCommand(cmd)
+		buf := bytes.NewBuffer(nil)
+		cmd.SetOut(buf)
+		cmd.SetArgs(args)
+		err := cmd.Execute()
+		return buf.String(), err
+	}
+
+	out, err := run(defFile)
+	require.NoError(t, err)
+	require.Contains(t, out, "succeed")
+	require.Contains(t, out, "worker.cue:14:4: warning: output hard-codes metadata.namespace \"production\"")
+
+	out, err = run(defFile, "-o", "json")
+	require.NoError(t, err)
+	require.NotContains(t, out, "succeed")
+	var findings []lint.Finding
+	require.NoError(t, yaml.Unmarshal([]byte(out), &findings))
+	require.Len(t, findings, 1)
+	require.Equal(t, "hardcoded-namespace", findings[0].Rule)
+
+	out, err = run(defFile, "-o", "sarif")
+	require.NoError(t, err)
+	require.Contains(t, out, `"ruleId": "hardcoded-namespace"`)
+
+	_, err = run(defFile, "-o", "xml")
+	require.ErrorContains(t, err, "unsupported output format")
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, lint.ConfigFileName), []byte("rules:\n  hardcoded-namespace: error\n"), 0600))
+	out, err = run(defFile)
+	require.ErrorContains(t, err, "definition lint failed")
+	require.Contains(t, out, "error: output hard-codes metadata.namespace")
+
+	out, err = run(defFile, "--skip-lint")
+	require.NoError(t, err)
+	require.NotContains(t, out, "hard-codes")
+
+	config := filepath.Join(t.TempDir(), "lint.yaml")
+	require.NoError(t, os.WriteFile(config, []byte("rules:\n  hardcoded-namespace: off\n"), 0600))
+	out, err = run(defFile, "--lint-config", config)
+	require.NoError(t, err)
+	require.NotContains(t, out, "hard-codes")
+}
+
 func TestNewDefinitionGenAPICommand(t *testing.T) {
 	c := initArgs()
 	cmd := NewDefinitionGenAPICommand(c)
 	initCommand(cmd)
 	internalDefPath := "../../vela-templates/definitions/internal/"
 
-	cmd.SetArgs([]string{"-f", internalDefPath, "-o", "../vela-sdk-gen", "--init", "--verbose"})
+	cmd.SetArgs([]string{"-f", internalDefPath, "-o", t.TempDir(), "--init", "--verbose"})
 	if err := cmd.Execute(); err != nil {
 		t.Fatalf("unexpeced error when executing genapi command: %v", err)
 	}
 }
 
+func TestNewDefinitionGenAPICommandNativeLangs(t *testing.T) {
+	internalDefPath := "../../vela-templates/definitions/internal/"
+	for lang, file := range map[string]string{
+		"python":     "vela_sdk/apis/components/webservice.py",
+		"typescript": "src/apis/components/webservice.ts",
+	} {
+		t.Run(lang, func(t *testing.T) {
+			c := initArgs()
+			cmd := NewDefinitionGenAPICommand(c)
+			initCommand(cmd)
+			output := t.TempDir()
+			cmd.SetArgs([]string{"--lang", lang, "-f", internalDefPath, "-o", output, "--init"})
+			require.NoError(t, cmd.Execute())
+			require.FileExists(t, filepath.Join(output, file))
+		})
+	}
+}
+
+func TestNewDefinitionGenAPICommandInstalled(t *testing.T) {
+	c := initArgs()
+	createNamespacedTrait(c, "installed-trait", VelaTestNamespace, "", t)
+	cmd := NewDefinitionGenAPICommand(c)
+	initCommand(cmd)
+	output := t.TempDir()
+	cmd.SetArgs([]string{"--lang", "typescript", "--installed", "-n", VelaTestNamespace, "-o", output})
+	require.NoError(t, cmd.Execute())
+	b, err := os.ReadFile(filepath.Join(output, "src", "apis", "traits", "installed-trait.ts"))
+	require.NoError(t, err)
+	require.Contains(t, string(b), "export class InstalledTrait extends Trait<")
+}
+
 // re-use the provider testdata
 const providerTestDataPath = "../cuegen/generators/provider/testdata"
 
@@ -718,3 +950,267 @@ func TestNewDefinitionGenDocCommand(t *testing.T) {
 
 	assert.Equal(t, string(expected), got.String())
 }
+
+func TestNewDefinitionGenGoCommand(t *testing.T) {
+	c := initArgs()
+	testdata := "../../pkg/definition/gengo/testdata"
+	out, errOut := bytes.NewBuffer(nil), bytes.NewBuffer(nil)
+	cmd := NewDefinitionGenGoCommand(c)
+	initCommand(cmd)
+	cmd.SetOut(out)
+	cmd.SetErr(errOut)
+	cmd.SetArgs([]string{filepath.Join(testdata, "webserver.cue")})
+	require.NoError(t, cmd.Execute())
+	expected, err := os.ReadFile(filepath.Join(testdata, "webserver.go.golden"))
+	require.NoError(t, err)
+	require.Equal(t, string(expected), out.String())
+	require.Empty(t, errOut.String())
+
+	output := filepath.Join(t.TempDir(), "mounts.go")
+	out.Reset()
+	cmd = NewDefinitionGenGoCommand(c)
+	initCommand(cmd)
+	cmd.SetOut(out)
+	cmd.SetErr(errOut)
+	cmd.SetArgs([]string{filepath.Join(testdata, "mounts.cue"), "-o", output, "--package", "components"})
+	require.NoError(t, cmd.Execute())
+	require.Empty(t, out.String())
+	require.Contains(t, errOut.String(), "NOTE: the definition is kept as raw CUE")
+	src, err := os.ReadFile(output)
+	require.NoError(t, err)
+	require.Contains(t, string(src), "package components\n")
+	require.Contains(t, string(src), "func MountsComponent() *defkit.ComponentDefinition {")
+
+	cmd = NewDefinitionGenGoCommand(c)
+	initCommand(cmd)
+	cmd.SetArgs([]string{filepath.Join(testdata, "not-found.cue")})
+	require.Error(t, cmd.Execute())
+}
+
+func TestNewDefinitionDebugCommand(t *testing.T) {
+	c := initArgs()
+	dir := t.TempDir()
+	defs := map[string]string{
+		"worker.yaml": `apiVersion: core.oam.dev/v1beta1
+kind: ComponentDefinition
+metadata:
+  name: worker
+  namespace: vela-system
+spec:
+  workload:
+    definition:
+      apiVersion: apps/v1
+      kind: Deployment
+  schematic:
+    cue:
+      template: |
+        output: {
+        	apiVersion: "apps/v1"
+        	kind:       "Deployment"
+        	spec: replicas: parameter.replicas
+        }
+        parameter: replicas: *1 | int
+`,
+		"pinned.yaml": `apiVersion: core.oam.dev/v1beta1
+kind: TraitDefinition
+metadata:
+  name: pinned
+  namespace: vela-system
+spec:
+  schematic:
+    cue:
+      template: |
+        patch: spec: replicas: parameter.replicas
+        parameter: replicas: int
+`,
+	}
+	for name, def := range defs {
+		require.NoError(t, os.MkdirAll(filepath.Join(dir, "defs"), 0755))
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "defs", name), []byte(def), 0600))
+	}
+	appFile := filepath.Join(dir, "app.yaml")
+	require.NoError(t, os.WriteFile(appFile, []byte(`apiVersion: core.oam.dev/v1beta1
+kind: Application
+metadata:
+  name: demo
+spec:
+  components:
+  - name: api
+    type: worker
+    properties:
+      replicas: 2
+    traits:
+    - type: pinned
+      properties:
+        replicas: 3
+`), 0600))
+
+	out := bytes.NewBuffer(nil)
+	cmd := NewDefinitionDebugCommand(c)
+	initCommand(cmd)
+	cmd.SetOut(out)
+	cmd.SetIn(strings.NewReader("context.name\nnext\npatch\nquit\n"))
+	cmd.SetArgs([]string{appFile, "-d", filepath.Join(dir, "defs"), "--offline"})
+	require.NoError(t, cmd.Execute())
+	require.Contains(t, out.String(), "== [1/2] component worker ==")
+	require.Contains(t, out.String(), "(debug) \"api\"\n")
+	require.Contains(t, out.String(), "== [2/2] trait pinned ==")
+	require.Contains(t, out.String(), "conflict at patch.spec.replicas: conflicting values 3 and 2")
+
+	cmd = NewDefinitionDebugCommand(c)
+	initCommand(cmd)
+	cmd.SetArgs([]string{appFile, "-d", filepath.Join(dir, "defs"), "--offline", "-c", "web"})
+	require.EqualError(t, cmd.Execute(), "component web not found in application demo")
+}
+
+func TestNewDefinitionConflictsCommand(t *testing.T) {
+	c := initArgs()
+	dir := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "defs"), 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "defs", "worker.yaml"), []byte(`apiVersion: core.oam.dev/v1beta1
+kind: ComponentDefinition
+metadata:
+  name: worker
+  namespace: vela-system
+spec:
+  workload:
+    definition:
+      apiVersion: apps/v1
+      kind: Deployment
+  schematic:
+    cue:
+      template: |
+        output: {
+        	apiVersion: "apps/v1"
+        	kind:       "Deployment"
+        	spec: replicas: *1 | int
+        }
+        parameter: {}
+`), 0600))
+	for name, policy := range map[string]string{"scaler": "warn", "hpa": "last-wins", "pinned": "error"} {
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "defs", name+".yaml"), []byte(fmt.Sprintf(`apiVersion: core.oam.dev/v1beta1
+kind: TraitDefinition
+metadata:
+  name: %s
+  namespace: vela-system
+spec:
+  conflictPolicy: %s
+  schematic:
+    cue:
+      template: |
+        patch: spec: replicas: parameter.replicas
+        parameter: replicas: int
+`, name, policy)), 0600))
+	}
+	appFile := filepath.Join(dir, "app.yaml")
+	require.NoError(t, os.WriteFile(appFile, []byte(`apiVersion: core.oam.dev/v1beta1
+kind: Application
+metadata:
+  name: demo
+spec:
+  components:
+  - name: api
+    type: worker
+    traits:
+    - type: scaler
+      properties:
+        replicas: 2
+    - type: hpa
+      properties:
+        replicas: 3
+  - name: web
+    type: worker
+    traits:
+    - type: scaler
+      properties:
+        replicas: 2
+    - type: pinned
+      properties:
+        replicas: 3
+  - name: db
+    type: worker
+`), 0600))
+
+	out := bytes.NewBuffer(nil)
+	cmd := NewDefinitionConflictsCommand(c)
+	initCommand(cmd)
+	cmd.SetOut(out)
+	cmd.SetArgs([]string{appFile, "-d", filepath.Join(dir, "defs"), "--offline"})
+	require.EqualError(t, cmd.Execute(), "1 conflicting patches fail the rendering")
+	require.Regexp(t, `api\s+spec.replicas\s+scaler, hpa\s+warn`, out.String())
+	require.Regexp(t, `web\s+spec.replicas\s+scaler, pinned\s+error`, out.String())
+
+	out.Reset()
+	cmd = NewDefinitionConflictsCommand(c)
+	initCommand(cmd)
+	cmd.SetOut(out)
+	cmd.SetArgs([]string{appFile, "-d", filepath.Join(dir, "defs"), "--offline", "-c", "db"})
+	require.NoError(t, cmd.Execute())
+	require.Contains(t, out.String(), "No conflicting patches found.")
+}
+
+func TestNewDefinitionImpactCommand(t *testing.T) {
+	c := initArgs()
+	cli, err := c.GetClient()
+	require.NoError(t, err)
+	ctx := context.Background()
+	workerTemplate := `
+output: {
+	apiVersion: "apps/v1"
+	kind:       "Deployment"
+}
+outputs: service: {
+	apiVersion: "v1"
+	kind:       "Service"
+	metadata: name: context.name
+}
+`
+	require.NoError(t, cli.Create(ctx, &v1beta1.ComponentDefinition{
+		ObjectMeta: v1.ObjectMeta{Name: "impact-worker", Namespace: oam.SystemDefinitionNamespace},
+		Spec: v1beta1.ComponentDefinitionSpec{
+			Workload:  common3.WorkloadTypeDescriptor{Definition: common3.WorkloadGVK{APIVersion: "apps/v1", Kind: "Deployment"}},
+			Schematic: &common3.Schematic{CUE: &common3.CUE{Template: workerTemplate}},
+		},
+	}))
+	require.NoError(t, cli.Create(ctx, &v1beta1.Application{
+		ObjectMeta: v1.ObjectMeta{Name: "impacted", Namespace: "default"},
+		Spec:       v1beta1.ApplicationSpec{Components: []common3.ApplicationComponent{{Name: "api", Type: "impact-worker"}}},
+	}))
+	defFile := filepath.Join(t.TempDir(), "impact-worker.yaml")
+	require.NoError(t, os.WriteFile(defFile, []byte(`apiVersion: core.oam.dev/v1beta1
+kind: ComponentDefinition
+metadata:
+  name: impact-worker
+  namespace: vela-system
+spec:
+  workload:
+    definition:
+      apiVersion: apps/v1
+      kind: Deployment
+  schematic:
+    cue:
+      template: |
+        output: {
+        	apiVersion: "apps/v1"
+        	kind:       "Deployment"
+        }
+`), 0600))
+
+	out := bytes.NewBuffer(nil)
+	cmd := NewDefinitionImpactCommand(c)
+	initCommand(cmd)
+	cmd.SetOut(out)
+	cmd.SetArgs([]string{defFile, "--fail-on-risk"})
+	require.EqualError(t, cmd.Execute(), "the new definition is risky")
+	require.Regexp(t, `default\s+impacted\s+Application\s+-Service/api`, out.String())
+	require.Contains(t, out.String(), "1 of 1 apps affected, 1 would lose a Service")
+	require.Contains(t, out.String(), "impact-worker@v1")
+
+	out.Reset()
+	cmd = NewDefinitionImpactCommand(c)
+	initCommand(cmd)
+	cmd.SetOut(out)
+	cmd.SetArgs([]string{defFile, "-n", "prod"})
+	require.NoError(t, cmd.Execute())
+	require.Contains(t, out.String(), "No application uses ComponentDefinition impact-worker.")
+}
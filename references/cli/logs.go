@@ -18,12 +18,19 @@ package cli
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"regexp"
 	"strings"
+	"time"
 
 	"github.com/fatih/color"
+	pkgmulticluster "github.com/kubevela/pkg/multicluster"
 	"github.com/spf13/cobra"
+	"github.com/tidwall/gjson"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"k8s.io/kubectl/pkg/util/templates"
 
 	"github.com/oam-dev/kubevela/apis/core.oam.dev/v1beta1"
 	"github.com/oam-dev/kubevela/apis/types"
@@ -43,10 +50,31 @@ func NewLogsCommand(c common.Args, order string, ioStreams util.IOStreams) *cobr
 	cmd := &cobra.Command{
 		Use:   "logs",
 		Short: "Tail logs for application.",
-		Long:  "Tail logs for vela application.",
-		Args:  cobra.ExactArgs(1),
+		Long: templates.LongDesc(`
+			Tail logs for vela application.
+
+			By default, the logs of one pod selected from the application are tailed. With --all, the logs of all
+			pods of the application across clusters are tailed at the same time, prefixed by the cluster, pod and
+			container. The JSON logs can be filtered by fields with --field, and the logs of all pods can be exported
+			to files per cluster and pod with --export.`),
+		Example: templates.Examples(`
+			# Tail the logs of a pod selected from the application
+			vela logs my-app
+
+			# Tail the logs of all pods of the application across clusters
+			vela logs my-app --all
+
+			# Tail the error logs in the last 10 minutes, parsed from the JSON logs
+			vela logs my-app --all --since 10m --field level=error
+
+			# Export the last 1000 lines of logs of all pods to files
+			vela logs my-app --export ./logs --tail 1000`),
+		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			var err error
+			if err = largs.Validate(); err != nil {
+				return err
+			}
 			largs.Namespace, err = GetFlagNamespace(cmd, c)
 			if err != nil {
 				return err
@@ -79,6 +107,11 @@ func NewLogsCommand(c common.Args, order string, ioStreams util.IOStreams) *cobr
 	cmd.Flags().StringVarP(&largs.ClusterName, "cluster", "", "", "filter the pod by the cluster name")
 	cmd.Flags().StringVarP(&largs.PodName, "pod", "p", "", "specify the pod name")
 	cmd.Flags().StringVarP(&largs.ContainerName, "container", "", "", "specify the container name")
+	cmd.Flags().BoolVarP(&largs.AllPods, "all", "", false, "tail the logs of all pods of the application across clusters at the same time")
+	cmd.Flags().DurationVarP(&largs.Since, "since", "", 0, "only return logs newer than a relative duration like 5s, 2m, or 3h, defaults to 48h when tailing one pod")
+	cmd.Flags().Int64VarP(&largs.Tail, "tail", "", -1, "lines of recent logs to display, all logs are displayed if not positive")
+	cmd.Flags().StringSliceVarP(&largs.Fields, "field", "", nil, "filter the JSON logs by fields, such as level=error or level!=debug, nested fields are joined by dots")
+	cmd.Flags().StringVarP(&largs.ExportDir, "export", "", "", "export the logs of all pods to files in the directory per cluster and pod instead of tailing them")
 	addNamespaceAndEnvArg(cmd)
 	return cmd
 }
@@ -96,6 +129,89 @@ type Args struct {
 	ComponentName string
 	StepName      string
 	App           *v1beta1.Application
+	AllPods       bool
+	Since         time.Duration
+	Tail          int64
+	Fields        []string
+	ExportDir     string
+}
+
+// logFieldFilter filters the JSON logs by the value of a field
+type logFieldFilter struct {
+	key    string
+	value  string
+	negate bool
+}
+
+func parseLogFieldFilters(fields []string) ([]logFieldFilter, error) {
+	var filters []logFieldFilter
+	for _, field := range fields {
+		filter := logFieldFilter{}
+		key, value, found := strings.Cut(field, "!=")
+		if found {
+			filter.negate = true
+		} else if key, value, found = strings.Cut(field, "="); !found {
+			return nil, fmt.Errorf("invalid field filter %q, it should be like key=value or key!=value", field)
+		}
+		filter.key, filter.value = strings.TrimSpace(key), strings.TrimSpace(value)
+		if filter.key == "" {
+			return nil, fmt.Errorf("invalid field filter %q, the key is empty", field)
+		}
+		filters = append(filters, filter)
+	}
+	return filters, nil
+}
+
+// parseJSONLog returns the JSON object in the log line, the prefix before the object such as the timestamp is skipped
+func parseJSONLog(line string) (gjson.Result, bool) {
+	idx := strings.Index(line, "{")
+	if idx < 0 {
+		return gjson.Result{}, false
+	}
+	obj := strings.TrimSpace(line[idx:])
+	if !gjson.Valid(obj) {
+		return gjson.Result{}, false
+	}
+	return gjson.Parse(obj), true
+}
+
+// matchLogFields tells if the log line matches all the field filters. The lines not in JSON never match any filter.
+func matchLogFields(line string, filters []logFieldFilter) bool {
+	if len(filters) == 0 {
+		return true
+	}
+	obj, ok := parseJSONLog(line)
+	if !ok {
+		return false
+	}
+	for _, filter := range filters {
+		v := obj.Get(filter.key)
+		if (v.Exists() && strings.EqualFold(v.String(), filter.value)) == filter.negate {
+			return false
+		}
+	}
+	return true
+}
+
+// Validate validates the arguments of the `logs` command
+func (l *Args) Validate() error {
+	if _, err := parseLogFieldFilters(l.Fields); err != nil {
+		return err
+	}
+	if l.ExportDir != "" && len(l.Fields) > 0 {
+		return fmt.Errorf("--field is not supported when exporting the logs")
+	}
+	if l.Since < 0 {
+		return fmt.Errorf("--since must be positive")
+	}
+	return nil
+}
+
+func (l *Args) tailLines() *int64 {
+	if l.Tail <= 0 {
+		return nil
+	}
+	return &l.Tail
 }
 
 func (l *Args) printPodLogs(ctx context.Context, ioStreams util.IOStreams, selectPod *querytypes.PodBase, filters []string) error {
@@ -104,6 +220,10 @@ func (l *Args) printPodLogs(ctx context.Context, ioStreams util.IOStreams, selec
 		return err
 	}
 	logC := make(chan string, 1024)
+	fieldFilters, err := parseLogFieldFilters(l.Fields)
+	if err != nil {
+		return err
+	}
 
 	var t string
 	switch l.Output {
@@ -129,6 +249,9 @@ func (l *Args) printPodLogs(ctx context.Context, ioStreams util.IOStreams, selec
 						break
 					}
 				}
+				if show && !matchLogFields(str, fieldFilters) {
+					show = false
+				}
 				if show {
 					match := re.FindStringSubmatch(str)
 					if len(match) > 1 {
@@ -142,7 +265,7 @@ func (l *Args) printPodLogs(ctx context.Context, ioStreams util.IOStreams, selec
 		}
 	}()
 
-	err = utils.GetPodsLogs(ctx, config, l.ContainerName, []*querytypes.PodBase{selectPod}, t, logC, nil)
+	err = utils.GetPodsLogs(ctx, config, l.ContainerName, []*querytypes.PodBase{selectPod}, t, logC, l.tailLines(), l.Since)
 	if err != nil {
 		return err
 	}
@@ -159,6 +282,9 @@ func (l *Args) Run(ctx context.Context, ioStreams util.IOStreams) error {
 	if err != nil {
 		return err
 	}
+	if l.AllPods || l.ExportDir != "" {
+		return l.runAllPods(ctx, ioStreams, pods)
+	}
 	var selectPod *querytypes.PodBase
 	if l.PodName != "" {
 		for i, pod := range pods {
@@ -187,3 +313,106 @@ func (l *Args) Run(ctx context.Context, ioStreams util.IOStreams) error {
 	}
 	return l.printPodLogs(ctx, ioStreams, selectPod, nil)
 }
+
+// logPrefixColors are the colors of the prefixes to tell the logs of the containers apart
+var logPrefixColors = []color.Attribute{color.FgCyan, color.FgGreen, color.FgMagenta, color.FgYellow, color.FgBlue, color.FgHiCyan, color.FgHiGreen, color.FgHiMagenta, color.FgHiYellow, color.FgHiBlue}
+
+// aggregatedLog is the log line printed in the json output, with the fields parsed from the JSON logs
+type aggregatedLog struct {
+	utils.PodLogLine `json:",inline"`
+	Fields           map[string]interface{} `json:"fields,omitempty"`
+}
+
+// runAllPods tails the logs of all the pods at the same time, or exports them to files
+func (l *Args) runAllPods(ctx context.Context, ioStreams util.IOStreams, pods []querytypes.PodBase) error {
+	var selectPods []*querytypes.PodBase
+	for i := range pods {
+		if l.PodName == "" || pods[i].Metadata.Name == l.PodName {
+			selectPods = append(selectPods, &pods[i])
+		}
+	}
+	if len(selectPods) == 0 {
+		return fmt.Errorf("no pod found in the application %s", l.Name)
+	}
+	config, err := l.Args.GetConfig()
+	if err != nil {
+		return err
+	}
+	config = rest.CopyConfig(config)
+	config.Wrap(pkgmulticluster.NewTransportWrapper())
+	cli, err := kubernetes.NewForConfig(config)
+	if err != nil {
+		return err
+	}
+	opts := utils.PodLogOptions{Container: l.ContainerName, Since: l.Since, TailLines: l.tailLines(), Follow: true}
+	if l.ExportDir != "" {
+		files, err := utils.ExportPodsLogs(ctx, cli, selectPods, opts, l.ExportDir)
+		for _, file := range files {
+			ioStreams.Infof("Exported %s\n", file)
+		}
+		return err
+	}
+	return l.printAggregatedLogs(ctx, ioStreams, func(logC chan<- utils.PodLogLine, errC chan<- error) error {
+		return utils.StreamPodsLogs(ctx, cli, selectPods, opts, logC, errC)
+	})
+}
+
+// printAggregatedLogs prints the logs streamed by the stream function until it returns
+func (l *Args) printAggregatedLogs(ctx context.Context, ioStreams util.IOStreams, stream func(logC chan<- utils.PodLogLine, errC chan<- error) error) error {
+	fieldFilters, err := parseLogFieldFilters(l.Fields)
+	if err != nil {
+		return err
+	}
+	logC, errC, done := make(chan utils.PodLogLine, 1024), make(chan error, 16), make(chan struct{})
+	go func() {
+		defer close(done)
+		prefixes := map[string]string{}
+		for {
+			select {
+			case line, ok := <-logC:
+				if !ok {
+					return
+				}
+				if !matchLogFields(line.Message, fieldFilters) {
+					continue
+				}
+				ioStreams.Infonln(l.formatAggregatedLog(line, prefixes))
+			case err := <-errC:
+				ioStreams.Errorf("Warning: %s\n", err.Error())
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	err = stream(logC, errC)
+	close(logC)
+	<-done
+	return err
+}
+
+func (l *Args) formatAggregatedLog(line utils.PodLogLine, prefixes map[string]string) string {
+	switch l.Output {
+	case "raw":
+		return line.Message + "\n"
+	case "json":
+		log := aggregatedLog{PodLogLine: line}
+		if obj, ok := parseJSONLog(line.Message); ok {
+			if fields, ok := obj.Value().(map[string]interface{}); ok {
+				log.Fields = fields
+			}
+		}
+		bs, _ := json.Marshal(log)
+		return string(bs) + "\n"
+	default:
+		key := line.Cluster + "/" + line.Pod + "/" + line.Container
+		prefix, ok := prefixes[key]
+		if !ok {
+			prefix = "[" + key + "]"
+			if !color.NoColor {
+				prefix = color.New(logPrefixColors[len(prefixes)%len(logPrefixColors)]).Sprint(prefix)
+			}
+			prefixes[key] = prefix
+		}
+		return prefix + " " + line.Message + "\n"
+	}
+}
@@ -0,0 +1,77 @@
+/*
+Copyright 2026 The KubeVela Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cli
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/fatih/color"
+	"github.com/stretchr/testify/require"
+
+	"github.com/oam-dev/kubevela/pkg/utils"
+	"github.com/oam-dev/kubevela/pkg/utils/util"
+)
+
+func TestMatchLogFields(t *testing.T) {
+	r := require.New(t)
+	filters, err := parseLogFieldFilters([]string{"level=error", "source.component != db"})
+	r.NoError(err)
+	r.True(matchLogFields(`{"level":"ERROR","msg":"failed","source":{"component":"web"}}`, filters))
+	r.True(matchLogFields(`2026-10-18T00:00:00Z {"level":"error","msg":"failed"}`, filters))
+	r.False(matchLogFields(`{"level":"error","source":{"component":"db"}}`, filters))
+	r.False(matchLogFields(`{"level":"info"}`, filters))
+	r.False(matchLogFields(`level=error plain text`, filters))
+	r.True(matchLogFields(`plain text`, nil))
+
+	for _, invalid := range []string{"level", "=error", "!=error"} {
+		_, err = parseLogFieldFilters([]string{invalid})
+		r.Error(err, invalid)
+	}
+	r.Error((&Args{ExportDir: "logs", Fields: []string{"level=error"}}).Validate())
+	r.NoError((&Args{ExportDir: "logs", Tail: 100}).Validate())
+}
+
+func TestPrintAggregatedLogs(t *testing.T) {
+	r := require.New(t)
+	noColor := color.NoColor
+	color.NoColor = true
+	defer func() { color.NoColor = noColor }()
+	lines := []utils.PodLogLine{
+		{Cluster: "local", Pod: "web", Container: "main", Message: `{"level":"error","msg":"failed"}`},
+		{Cluster: "cluster-1", Pod: "web", Container: "main", Message: `{"level":"info","msg":"ok"}`},
+		{Cluster: "cluster-1", Pod: "web", Container: "main", Message: `plain text`},
+	}
+	render := func(args *Args) string {
+		buf := &bytes.Buffer{}
+		err := args.printAggregatedLogs(context.Background(), util.IOStreams{Out: buf, ErrOut: buf}, func(logC chan<- utils.PodLogLine, errC chan<- error) error {
+			for _, line := range lines {
+				logC <- line
+			}
+			return nil
+		})
+		r.NoError(err)
+		return buf.String()
+	}
+	r.Equal("[local/web/main] {\"level\":\"error\",\"msg\":\"failed\"}\n"+
+		"[cluster-1/web/main] {\"level\":\"info\",\"msg\":\"ok\"}\n"+
+		"[cluster-1/web/main] plain text\n", render(&Args{Output: "default"}))
+	r.Equal("{\"level\":\"error\",\"msg\":\"failed\"}\n", render(&Args{Output: "raw", Fields: []string{"level=error"}}))
+	r.Equal(`{"cluster":"cluster-1","namespace":"","pod":"web","container":"main","message":"{\"level\":\"info\",\"msg\":\"ok\"}","fields":{"level":"info","msg":"ok"}}`+"\n",
+		render(&Args{Output: "json", Fields: []string{"level!=error"}}))
+}
@@ -18,7 +18,10 @@ package cli
 
 import (
 	"context"
+	"fmt"
+	"io"
 	"strings"
+	"time"
 
 	"github.com/oam-dev/kubevela/pkg/utils"
 
@@ -55,7 +58,14 @@ func NewListCommand(c common.Args, order string, ioStreams cmdutil.IOStreams) *c
 		DisableFlagsInUseLine: true,
 		Short:                 "List applications.",
 		Long:                  "List all vela applications.",
-		Example:               `vela ls`,
+		Example: `  # List the applications
+  vela ls
+
+  # Watch the applications and redraw the list as they change
+  vela ls -w
+
+  # Wait until all the applications selected are healthy
+  vela ls -l team=a -w --until healthy --timeout 10m`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			newClient, err := c.GetClient()
 			if err != nil {
@@ -74,6 +84,13 @@ func NewListCommand(c common.Args, order string, ioStreams cmdutil.IOStreams) *c
 			if AllNamespace {
 				namespace = ""
 			}
+			watch, until, timeout, err := getWatchFlags(cmd)
+			if err != nil {
+				return err
+			}
+			if watch {
+				return watchApplicationList(ctx, c, namespace, until, timeout, ioStreams)
+			}
 			return printApplicationList(ctx, newClient, namespace, ioStreams)
 		},
 		Annotations: map[string]string{
@@ -85,9 +102,39 @@ func NewListCommand(c common.Args, order string, ioStreams cmdutil.IOStreams) *c
 	cmd.Flags().BoolVarP(&AllNamespace, "all-namespaces", "A", false, "If true, check the specified action in all namespaces.")
 	cmd.Flags().StringVarP(&LabelSelector, "selector", "l", LabelSelector, "Selector (label query) to filter on, supports '=', '==', and '!='.(e.g. -l key1=value1,key2=value2).")
 	cmd.Flags().StringVar(&FieldSelector, "field-selector", FieldSelector, "Selector (field query) to filter on, supports '=', '==', and '!='.(e.g. --field-selector key1=value1,key2=value2).")
+	addWatchFlags(cmd)
 	return cmd
 }
 
+// watchApplicationList watches the applications and redraws the list on changes, the --until condition is
+// evaluated on all the applications listed
+func watchApplicationList(ctx context.Context, c common.Args, namespace string, until string, timeout time.Duration, ioStreams cmdutil.IOStreams) error {
+	w := &appWatcher{
+		objects: []client.Object{&v1beta1.Application{}},
+		render: func(ctx context.Context, cli client.Client, out io.Writer) error {
+			table, err := buildApplicationListTable(ctx, cli, namespace)
+			if err != nil {
+				return err
+			}
+			_, err = fmt.Fprintln(out, table.String())
+			return err
+		},
+		until:   until,
+		timeout: timeout,
+		out:     ioStreams.Out,
+	}
+	if until != "" {
+		w.evaluate = func(ctx context.Context, cli client.Client) (watchResult, error) {
+			apps, err := listApplications(ctx, cli, namespace)
+			if err != nil {
+				return watchPending, err
+			}
+			return evaluateWatchCondition(until, apps), nil
+		}
+	}
+	return w.run(ctx, c, namespace)
+}
+
 func printApplicationList(ctx context.Context, c client.Reader, namespace string, ioStreams cmdutil.IOStreams) error {
 	table, err := buildApplicationListTable(ctx, c, namespace)
 	if err != nil {
@@ -105,36 +152,12 @@ func buildApplicationListTable(ctx context.Context, c client.Reader, namespace s
 	}
 	table.AddRow(header...)
 
-	labelSelector := labels.NewSelector()
-	if len(LabelSelector) > 0 {
-		selector, err := labels.Parse(LabelSelector)
-		if err != nil {
-			return nil, err
-		}
-		labelSelector = selector
-	}
-
-	applist := v1beta1.ApplicationList{}
-	if err := c.List(ctx, &applist, client.InNamespace(namespace), &client.ListOptions{LabelSelector: labelSelector}); err != nil {
-		if apierrors.IsNotFound(err) {
-			return table, nil
-		}
+	apps, err := listApplications(ctx, c, namespace)
+	if err != nil {
 		return nil, err
 	}
 
-	if len(FieldSelector) > 0 {
-		fieldSelector, err := fields.ParseSelector(FieldSelector)
-		if err != nil {
-			return nil, err
-		}
-		var objects []runtime.Object
-		for i := range applist.Items {
-			objects = append(objects, &applist.Items[i])
-		}
-		applist.Items = objectsToApps(utils.FilterObjectsByFieldSelector(objects, fieldSelector))
-	}
-
-	for _, a := range applist.Items {
+	for _, a := range apps {
 		service := map[string]commontypes.ApplicationComponentStatus{}
 		for _, s := range a.Status.Services {
 			service[s.Name] = s
@@ -178,6 +201,39 @@ func buildApplicationListTable(ctx context.Context, c client.Reader, namespace s
 	return table, nil
 }
 
+// listApplications lists the applications in the namespace filtered by the label and field selectors
+func listApplications(ctx context.Context, c client.Reader, namespace string) ([]v1beta1.Application, error) {
+	labelSelector := labels.NewSelector()
+	if len(LabelSelector) > 0 {
+		selector, err := labels.Parse(LabelSelector)
+		if err != nil {
+			return nil, err
+		}
+		labelSelector = selector
+	}
+
+	applist := v1beta1.ApplicationList{}
+	if err := c.List(ctx, &applist, client.InNamespace(namespace), &client.ListOptions{LabelSelector: labelSelector}); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	if len(FieldSelector) > 0 {
+		fieldSelector, err := fields.ParseSelector(FieldSelector)
+		if err != nil {
+			return nil, err
+		}
+		var objects []runtime.Object
+		for i := range applist.Items {
+			objects = append(objects, &applist.Items[i])
+		}
+		applist.Items = objectsToApps(utils.FilterObjectsByFieldSelector(objects, fieldSelector))
+	}
+	return applist.Items, nil
+}
+
 func getHealthString(healthy bool) string {
 	if healthy {
 		return "healthy"
@@ -0,0 +1,151 @@
+/*
+Copyright 2026 The KubeVela Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cli
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"k8s.io/kubectl/pkg/util/i18n"
+	"k8s.io/kubectl/pkg/util/templates"
+	"sigs.k8s.io/yaml"
+
+	"github.com/oam-dev/kubevela/apis/core.oam.dev/v1alpha1"
+	"github.com/oam-dev/kubevela/apis/types"
+	velacmd "github.com/oam-dev/kubevela/pkg/cmd"
+	cmdutil "github.com/oam-dev/kubevela/pkg/cmd/util"
+	"github.com/oam-dev/kubevela/pkg/oam"
+	"github.com/oam-dev/kubevela/pkg/utils/env"
+	"github.com/oam-dev/kubevela/pkg/utils/util"
+)
+
+// PromoteOptions options for vela promote command
+type PromoteOptions struct {
+	env.PromoteOptions
+	OverrideFile string
+
+	util.IOStreams
+}
+
+// Complete .
+func (opt *PromoteOptions) Complete(args []string) error {
+	opt.AppName = args[0]
+	if opt.OverrideFile == "" {
+		return nil
+	}
+	bs, err := os.ReadFile(opt.OverrideFile)
+	if err != nil {
+		return errors.Wrapf(err, "failed to read override file %s", opt.OverrideFile)
+	}
+	spec := &v1alpha1.OverridePolicySpec{}
+	if err = yaml.Unmarshal(bs, spec); err != nil {
+		return errors.Wrapf(err, "failed to parse override file %s", opt.OverrideFile)
+	}
+	opt.Overrides = spec.Components
+	return nil
+}
+
+// Validate .
+func (opt *PromoteOptions) Validate() error {
+	if opt.SourceEnv == "" || opt.TargetEnv == "" {
+		return fmt.Errorf("both --from and --to must be specified")
+	}
+	return nil
+}
+
+// Run .
+func (opt *PromoteOptions) Run(f velacmd.Factory, cmd *cobra.Command) error {
+	app, err := env.Promote(cmd.Context(), f.Client(), opt.PromoteOptions)
+	if err != nil {
+		return err
+	}
+	if opt.DryRun {
+		bs, err := yaml.Marshal(app)
+		if err != nil {
+			return err
+		}
+		opt.Info(string(bs))
+		return nil
+	}
+	opt.Infof("Application %s/%s promoted from %s (env: %s) to env %s.\n", app.Namespace, app.Name,
+		app.GetAnnotations()[oam.AnnotationPromotedFrom], opt.SourceEnv, opt.TargetEnv)
+	opt.Infof("Lineage: %s\n", strings.Join(env.GetPromotionLineage(app), " -> "))
+	return nil
+}
+
+var (
+	promoteLong = templates.LongDesc(i18n.T(`
+		Promote an application revision from one env to another.
+
+		The components of the application revision in the source env are copied to the
+		application with the same name in the target env. The policies and workflow of
+		the existing target application are kept as they describe the target env. The
+		components can be further customized for the target env with an override file,
+		which has the same format as the properties of the override policy.
+
+		The promotion is refused if the source revision is not delivered successfully,
+		the source application is not healthy, or the revision has not soaked long
+		enough. The lineage of the promotion is recorded in the annotations of the
+		target application and its application revisions.`))
+
+	promoteExample = templates.Examples(i18n.T(`
+		# Promote the latest revision of app "my-app" from env staging to env prod
+		vela promote my-app --from staging --to prod
+
+		# Promote a specific revision and require it to run for at least 1 hour
+		vela promote my-app --from staging --to prod --revision my-app-v3 --min-soak-time 1h
+
+		# Promote with overrides for the target env
+		vela promote my-app --from staging --to prod --override prod-override.yaml
+
+		# Print the promoted application without applying it
+		vela promote my-app --from staging --to prod --dry-run`))
+)
+
+// NewPromoteCommand command for promoting application revision across envs
+func NewPromoteCommand(f velacmd.Factory, order string, streams util.IOStreams) *cobra.Command {
+	o := &PromoteOptions{IOStreams: streams}
+	cmd := &cobra.Command{
+		Use:     "promote <app>",
+		Short:   i18n.T("Promote an application revision across envs."),
+		Long:    promoteLong,
+		Example: promoteExample,
+		Args:    cobra.ExactArgs(1),
+		Annotations: map[string]string{
+			types.TagCommandType:  types.TypeCD,
+			types.TagCommandOrder: order,
+		},
+		Run: func(cmd *cobra.Command, args []string) {
+			cmdutil.CheckErr(o.Complete(args))
+			cmdutil.CheckErr(o.Validate())
+			cmdutil.CheckErr(o.Run(f, cmd))
+		},
+	}
+	cmd.Flags().StringVarP(&o.SourceEnv, "from", "", "", "The env to promote the application revision from.")
+	cmd.Flags().StringVarP(&o.TargetEnv, "to", "", "", "The env to promote the application revision to.")
+	cmd.Flags().StringVarP(&o.Revision, "revision", "r", "", "The application revision to promote. Default to the latest revision of the application in the source env.")
+	cmd.Flags().DurationVarP(&o.MinSoakTime, "min-soak-time", "", time.Duration(0), "The minimal time the revision must have been running in the source env.")
+	cmd.Flags().StringVarP(&o.OverrideFile, "override", "", "", "The file of component overrides for the target env.")
+	cmd.Flags().BoolVarP(&o.DryRun, "dry-run", "", false, "If true, the promoted application will only be printed.")
+	return velacmd.NewCommandBuilder(f, cmd).
+		WithResponsiveWriter().
+		Build()
+}
@@ -21,18 +21,25 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"slices"
 	"time"
 
 	"cuelang.org/go/cue"
 	"k8s.io/client-go/rest"
 
 	"github.com/fatih/color"
+	"github.com/gosuri/uitable"
 	"github.com/olekukonko/tablewriter"
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
 	"golang.org/x/term"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
 	pkgtypes "k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/duration"
 	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	wfTypesv1alpha1 "github.com/kubevela/pkg/apis/oam/v1alpha1"
@@ -46,12 +53,16 @@ import (
 	"github.com/oam-dev/kubevela/apis/core.oam.dev/v1beta1"
 	"github.com/oam-dev/kubevela/apis/types"
 	pkgappfile "github.com/oam-dev/kubevela/pkg/appfile"
+	"github.com/oam-dev/kubevela/pkg/eventtimeline"
 	"github.com/oam-dev/kubevela/pkg/multicluster"
+	"github.com/oam-dev/kubevela/pkg/oam"
 	"github.com/oam-dev/kubevela/pkg/policy"
+	"github.com/oam-dev/kubevela/pkg/resourcegraph"
 	"github.com/oam-dev/kubevela/pkg/resourcetracker"
 	"github.com/oam-dev/kubevela/pkg/utils/common"
 	types2 "github.com/oam-dev/kubevela/pkg/utils/types"
 	cmdutil "github.com/oam-dev/kubevela/pkg/utils/util"
+	"github.com/oam-dev/kubevela/pkg/workflow/providers/legacy/query"
 	"github.com/oam-dev/kubevela/references/appfile"
 	references "github.com/oam-dev/kubevela/references/common"
 )
@@ -85,6 +96,11 @@ func NewAppStatusCommand(c common.Args, order string, ioStreams cmdutil.IOStream
   # Show detailed info in tree
   vela status first-vela-app --tree --detail --detail-format list
 
+  # Export the resource topology in all clusters as a Graphviz, Mermaid or HTML graph
+  vela status first-vela-app --tree -o dot | dot -Tsvg > topology.svg
+  vela status first-vela-app --tree -o mermaid
+  vela status first-vela-app --tree -o html > topology.html
+
   # Show pod list
   vela status first-vela-app --pod
   vela status first-vela-app --pod --component express-server --cluster local
@@ -92,6 +108,10 @@ func NewAppStatusCommand(c common.Args, order string, ioStreams cmdutil.IOStream
   # Show endpoint list
   vela status first-vela-app --endpoint
 
+  # Show the event timeline of the application and its resources in all clusters
+  vela status first-vela-app --events
+  vela status first-vela-app --events --component express-server --cluster local
+
   # Get raw Application yaml (without managedFields)
   vela status first-vela-app -o yaml
 
@@ -99,7 +119,13 @@ func NewAppStatusCommand(c common.Args, order string, ioStreams cmdutil.IOStream
   vela status first-vela-app -o jsonpath='{.status}'
   
   # Get Application metrics status
-  vela status first-vela-app --metrics`,
+  vela status first-vela-app --metrics
+
+  # Watch the status and redraw the component health and workflow steps as they change
+  vela status first-vela-app -w
+
+  # Watch the resource tree until the application is healthy, exit with non-zero if it fails or times out
+  vela status first-vela-app -w --tree --until healthy --timeout 10m`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			// check args
 			argsLength := len(args)
@@ -119,7 +145,18 @@ func NewAppStatusCommand(c common.Args, order string, ioStreams cmdutil.IOStream
 					return err
 				}
 			}
+			watch, until, timeout, err := getWatchFlags(cmd)
+			if err != nil {
+				return err
+			}
+			if watch {
+				printTree, _ := cmd.Flags().GetBool("tree")
+				return watchAppStatus(ctx, c, cmd, ioStreams, appName, namespace, printTree, detail, until, timeout)
+			}
 			if printTree, err := cmd.Flags().GetBool("tree"); err == nil && printTree {
+				if outputFormat != "" {
+					return exportApplicationTree(ctx, c, cmd.OutOrStdout(), appName, namespace, outputFormat)
+				}
 				return printApplicationTree(c, cmd, appName, namespace)
 			}
 			if printPod, err := cmd.Flags().GetBool("pod"); err == nil && printPod {
@@ -131,6 +168,15 @@ func NewAppStatusCommand(c common.Args, order string, ioStreams cmdutil.IOStream
 				}
 				return printAppPods(appName, namespace, f, c)
 			}
+			if printEvents, err := cmd.Flags().GetBool("events"); err == nil && printEvents {
+				component, _ := cmd.Flags().GetString("component")
+				cluster, _ := cmd.Flags().GetString("cluster")
+				f := Filter{
+					Component: component,
+					Cluster:   cluster,
+				}
+				return printAppEvents(ctx, appName, namespace, f, c, ioStreams)
+			}
 
 			newClient, err := c.GetClient()
 			if err != nil {
@@ -173,14 +219,17 @@ func NewAppStatusCommand(c common.Args, order string, ioStreams cmdutil.IOStream
 	}
 	cmd.Flags().StringP("svc", "s", "", "service name")
 	cmd.Flags().BoolP("endpoint", "p", false, "show all service endpoints of the application")
-	cmd.Flags().StringP("component", "c", "", "filter the endpoints or pods by component name")
-	cmd.Flags().StringP("cluster", "", "", "filter the endpoints or pods by cluster name")
+	cmd.Flags().StringP("component", "c", "", "filter the endpoints, pods or events by component name")
+	cmd.Flags().StringP("cluster", "", "", "filter the endpoints, pods or events by cluster name")
 	cmd.Flags().BoolP("tree", "t", false, "display the application resources into tree structure")
 	cmd.Flags().BoolP("pod", "", false, "show pod list of the application")
+	cmd.Flags().BoolP("events", "", false, "show the event timeline of the application and its resources in all clusters")
 	cmd.Flags().BoolVarP(&detail, "detail", "d", false, "display more details in the application like input/output data in context. Note that if you want to show the realtime details of application resources, please use it with --tree")
 	cmd.Flags().StringP("detail-format", "", "inline", "the format for displaying details, must be used with --detail. Can be one of inline, wide, list, table, raw.")
-	cmd.Flags().StringVarP(&outputFormat, "output", "o", "", "raw Application output format. One of: (json, yaml, jsonpath)")
+	cmd.Flags().StringVarP(&outputFormat, "output", "o", "", "raw Application output format. One of: (json, yaml, jsonpath). "+
+		"Used with --tree, the format to export the resource topology. One of: (dot, mermaid, html)")
 	cmd.Flags().BoolP("metrics", "m", false, "show resource quota and consumption metrics of the application")
+	addWatchFlags(cmd)
 	addNamespaceAndEnvArg(cmd)
 	return cmd
 }
@@ -202,6 +251,15 @@ func printAppStatus(_ context.Context, c client.Client, ioStreams cmdutil.IOStre
 	table.AddRow("  Created at:", app.CreationTimestamp.String())
 	table.AddRow("  Healthy:", healthStatusEmoji)
 	table.AddRow("  Details:", getAppPhaseColor(app.Status.Phase).Sprint(app.Status.Phase))
+	if dw := app.Status.DeployWindow; dw != nil {
+		table.AddRow("  Pending revision:", dw.PendingRevision)
+		table.AddRow("  Held by:", dw.Message)
+		if dw.NextWindowTime.IsZero() {
+			table.AddRow("  Next window:", "none scheduled")
+		} else {
+			table.AddRow("  Next window:", fmt.Sprintf("%s (in %s)", dw.NextWindowTime.String(), duration.HumanDuration(time.Until(dw.NextWindowTime.Time))))
+		}
+	}
 	cmd.Printf("%s\n\n", table.String())
 	if err := printWorkflowStatus(c, ioStreams, appName, namespace, detail); err != nil {
 		return err
@@ -209,6 +267,49 @@ func printAppStatus(_ context.Context, c client.Client, ioStreams cmdutil.IOStre
 	return loopCheckStatus(c, ioStreams, appName, namespace)
 }
 
+// watchAppStatus watches the application and its resourcetrackers, and redraws the status or the resource tree
+// on changes
+func watchAppStatus(ctx context.Context, c common.Args, cmd *cobra.Command, ioStreams cmdutil.IOStreams, appName, namespace string,
+	printTree, detail bool, until string, timeout time.Duration) error {
+	out := cmd.OutOrStdout()
+	defer cmd.SetOut(out)
+	w := &appWatcher{
+		objects: []client.Object{&v1beta1.Application{}, &v1beta1.ResourceTracker{}},
+		byObject: map[client.Object]cache.ByObject{
+			&v1beta1.Application{}: {Field: fields.OneTermEqualSelector("metadata.name", appName)},
+			&v1beta1.ResourceTracker{}: {Label: labels.SelectorFromSet(labels.Set{
+				oam.LabelAppName: appName, oam.LabelAppNamespace: namespace,
+			})},
+		},
+		render: func(ctx context.Context, cli client.Client, buf io.Writer) error {
+			watchArgs := c
+			watchArgs.SetClient(cli)
+			cmd.SetOut(buf)
+			if printTree {
+				return printApplicationTree(watchArgs, cmd, appName, namespace)
+			}
+			streams := cmdutil.IOStreams{In: ioStreams.In, Out: buf, ErrOut: buf}
+			return printAppStatus(ctx, cli, streams, appName, namespace, cmd, watchArgs, detail)
+		},
+		until:   until,
+		timeout: timeout,
+		out:     out,
+	}
+	if until != "" {
+		w.evaluate = func(ctx context.Context, cli client.Client) (watchResult, error) {
+			app := &v1beta1.Application{}
+			if err := cli.Get(ctx, client.ObjectKey{Namespace: namespace, Name: appName}, app); err != nil {
+				if kerrors.IsNotFound(err) {
+					return watchUnreachable, nil
+				}
+				return watchPending, err
+			}
+			return evaluateWatchCondition(until, []v1beta1.Application{*app}), nil
+		}
+	}
+	return w.run(ctx, c, namespace)
+}
+
 func formatEndpoints(endpoints []types2.ServiceEndpoint) [][]string {
 	var result [][]string
 	result = append(result, []string{"Cluster", "Component", "Ref(Kind/Namespace/Name)", "Endpoint", "Inner"})
@@ -246,6 +347,42 @@ func printAppEndpoints(ctx context.Context, appName string, namespace string, f
 	return nil
 }
 
+func printAppEvents(ctx context.Context, appName string, namespace string, f Filter, velaC common.Args, ioStreams cmdutil.IOStreams) error {
+	events, err := GetApplicationEvents(ctx, appName, namespace, velaC, f)
+	if err != nil {
+		return err
+	}
+	if len(events) == 0 {
+		ioStreams.Infof("No events found for application %s.\n", appName)
+		return nil
+	}
+	ioStreams.Info(formatAppEvents(events).String())
+	return nil
+}
+
+// formatAppEvents formats the event timeline into a table, the objects are shown as kind/name
+func formatAppEvents(events []eventtimeline.Event) *uitable.Table {
+	table := newUITable().AddRow("TIME", "CLUSTER", "COMPONENT", "OBJECT", "TYPE", "REASON", "MESSAGE")
+	table.MaxColWidth = 100
+	table.Wrap = true
+	for _, e := range events {
+		t := "-"
+		if !e.Time.IsZero() {
+			t = e.Time.Format(time.RFC3339)
+		}
+		component := e.Component
+		if component == "" {
+			component = "-"
+		}
+		message := e.Message
+		if e.Count > 1 {
+			message = fmt.Sprintf("%s (x%d)", message, e.Count)
+		}
+		table.AddRow(t, e.Cluster, component, e.Kind+"/"+e.Name, e.Type, e.Reason, message)
+	}
+	return table
+}
+
 func loadRemoteApplication(c client.Client, ns string, name string) (*v1beta1.Application, error) {
 	app := new(v1beta1.Application)
 	err := c.Get(context.Background(), client.ObjectKey{
@@ -438,6 +575,10 @@ TrackDeployLoop:
 		case commontypes.ApplicationWorkflowSuspending, commontypes.ApplicationRunning:
 			ioStreams.Info(green.Sprintf("\n%sApplication Deployed Successfully!", emojiSucceed))
 			break TrackDeployLoop
+		case commontypes.ApplicationPendingDeployWindow:
+			ioStreams.Info(blue.Sprintf("\nApplication revision is held until the deploy window opens."))
+			ioStreams.Info(blue.Sprintf("Please run the following command to check details: \n   vela status %s -n %s\n", appName, namespace))
+			break TrackDeployLoop
 		case commontypes.ApplicationWorkflowTerminated, commontypes.ApplicationWorkflowFailed:
 			ioStreams.Info(red.Sprintf("\n%sApplication Deployment Failed!", emojiFail))
 			ioStreams.Info(red.Sprintf("Please run the following command to check details: \n   vela status %s -n %s\n", appName, namespace))
@@ -474,7 +615,7 @@ func getAppPhaseColor(appPhase commontypes.ApplicationPhase) *color.Color {
 	switch appPhase {
 	case commontypes.ApplicationUnhealthy:
 		return red
-	case commontypes.ApplicationWorkflowSuspending:
+	case commontypes.ApplicationWorkflowSuspending, commontypes.ApplicationPendingDeployWindow:
 		return blue
 	case commontypes.ApplicationRunning:
 		return green
@@ -527,6 +668,7 @@ func printApplicationTree(c common.Args, cmd *cobra.Command, appName string, app
 	af, err := pkgappfile.NewApplicationParser(cli).GenerateAppFile(context.Background(), app)
 	if err == nil {
 		placements, _ = policy.GetPlacementsFromTopologyPolicies(context.Background(), cli, app.GetNamespace(), af.Policies, true)
+		placements = policy.ApplyFailoverDecisions(placements, app.Status.Failover)
 	}
 	format, _ := cmd.Flags().GetString("detail-format")
 	var maxWidth *int
@@ -546,6 +688,41 @@ func printApplicationTree(c common.Args, cmd *cobra.Command, appName string, app
 	return nil
 }
 
+// exportApplicationTree exports the resource topology of the application in all clusters as a graph in the format
+func exportApplicationTree(ctx context.Context, c common.Args, out io.Writer, appName string, appNs string, format string) error {
+	config, err := c.GetConfig()
+	if err != nil {
+		return err
+	}
+	config.Wrap(pkgmulticluster.NewTransportWrapper())
+	cli, err := c.GetClient()
+	if err != nil {
+		return err
+	}
+	app, err := loadRemoteApplication(cli, appNs, appName)
+	if err != nil {
+		return err
+	}
+	return writeApplicationGraph(ctx, cli, out, app, resourcegraph.Format(format))
+}
+
+func writeApplicationGraph(ctx context.Context, cli client.Client, out io.Writer, app *v1beta1.Application, format resourcegraph.Format) error {
+	if !slices.Contains(resourcegraph.Formats, format) {
+		return fmt.Errorf("invalid output format %s for the resource tree, must be one of: (dot, mermaid, html)", format)
+	}
+	collector := query.NewAppCollector(cli, query.Option{Name: app.Name, Namespace: app.Namespace, WithTree: true})
+	resources, err := collector.ListApplicationResources(ctx, app)
+	if err != nil {
+		return err
+	}
+	graph, err := resourcegraph.Build(app.Name, app.Namespace, resources).Export(format)
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprint(out, graph)
+	return err
+}
+
 // printRawApplication prints raw Application in yaml/json/jsonpath (without managedFields).
 func printRawApplication(ctx context.Context, c common.Args, format string, out io.Writer, ns, appName string) error {
 	var err error
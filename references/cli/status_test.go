@@ -0,0 +1,64 @@
+/*
+Copyright 2026 The KubeVela Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cli
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/oam-dev/kubevela/apis/core.oam.dev/common"
+	"github.com/oam-dev/kubevela/apis/core.oam.dev/v1beta1"
+	"github.com/oam-dev/kubevela/pkg/oam"
+	"github.com/oam-dev/kubevela/pkg/resourcegraph"
+	common2 "github.com/oam-dev/kubevela/pkg/utils/common"
+)
+
+func TestWriteApplicationGraph(t *testing.T) {
+	app := &v1beta1.Application{ObjectMeta: metav1.ObjectMeta{Name: "demo", Namespace: "default", Generation: 1}}
+	rt := &v1beta1.ResourceTracker{
+		ObjectMeta: metav1.ObjectMeta{Name: "demo-v1-default", Labels: map[string]string{
+			oam.LabelAppName:      "demo",
+			oam.LabelAppNamespace: "default",
+		}},
+		Spec: v1beta1.ResourceTrackerSpec{
+			Type:                  v1beta1.ResourceTrackerTypeVersioned,
+			ApplicationGeneration: 1,
+			ManagedResources: []v1beta1.ManagedResource{{
+				ClusterObjectReference: common.ClusterObjectReference{ObjectReference: corev1.ObjectReference{
+					APIVersion: "v1", Kind: "ConfigMap", Namespace: "default", Name: "demo-config",
+				}},
+				OAMObjectReference: common.OAMObjectReference{Component: "config"},
+			}},
+		},
+	}
+	cm := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "demo-config", Namespace: "default"}}
+	cli := fake.NewClientBuilder().WithScheme(common2.Scheme).WithObjects(app, rt, cm).Build()
+
+	buf := &bytes.Buffer{}
+	require.NoError(t, writeApplicationGraph(context.Background(), cli, buf, app, resourcegraph.FormatMermaid))
+	require.Contains(t, buf.String(), `subgraph cluster_0["cluster: local"]`)
+	require.Contains(t, buf.String(), `n0["ConfigMap<br/>default/demo-config<br/>component: config<br/>health: Healthy"]`)
+	require.Contains(t, buf.String(), "app --> n0")
+
+	require.Error(t, writeApplicationGraph(context.Background(), cli, buf, app, "svg"))
+}
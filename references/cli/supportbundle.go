@@ -0,0 +1,191 @@
+/*
+Copyright 2026 The KubeVela Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cli
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	pkgmulticluster "github.com/kubevela/pkg/multicluster"
+	"github.com/spf13/cobra"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"k8s.io/kubectl/pkg/util/templates"
+
+	"github.com/oam-dev/kubevela/apis/types"
+	"github.com/oam-dev/kubevela/pkg/supportbundle"
+	"github.com/oam-dev/kubevela/pkg/utils"
+	"github.com/oam-dev/kubevela/pkg/utils/common"
+	"github.com/oam-dev/kubevela/pkg/utils/util"
+	"github.com/oam-dev/kubevela/references/appfile"
+)
+
+// SupportBundleOptions options for the `support-bundle` command
+type SupportBundleOptions struct {
+	Name                string
+	Namespace           string
+	Output              string
+	Since               time.Duration
+	Tail                int64
+	ControllerNamespace string
+}
+
+// NewSupportBundleCommand creates the `support-bundle` command to collect the diagnostics of an application
+func NewSupportBundleCommand(c common.Args, order string, ioStreams util.IOStreams) *cobra.Command {
+	o := &SupportBundleOptions{}
+	cmd := &cobra.Command{
+		Use:   "support-bundle",
+		Short: "Collect the diagnostics of an application into a support bundle.",
+		Long: templates.LongDesc(`
+			Collect the diagnostics of an application into a support bundle.
+
+			The bundle is a gzipped tarball including the application, its ApplicationRevisions, ResourceTrackers,
+			workflow status, managed resources, pods, events, pod logs in all clusters and the logs of the KubeVela
+			controller mentioning the application. The data of the Secrets and the sensitive environment variables are
+			masked. The bundle has an index of its files and a summary of the detected problems, and can be analysed
+			offline by 'vela system diagnose --bundle'.`),
+		Example: templates.Examples(`
+			# Collect the support bundle of the application
+			vela support-bundle my-app -n demo
+
+			# Collect the logs in the last hour only into the given file
+			vela support-bundle my-app --since 1h -o my-app.tar.gz`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var err error
+			if o.Namespace, err = GetFlagNamespace(cmd, c); err != nil {
+				return err
+			}
+			if o.Namespace == "" {
+				if o.Namespace, err = GetNamespaceFromEnv(cmd, c); err != nil {
+					return err
+				}
+			}
+			o.Name = args[0]
+			return o.Run(cmd.Context(), c, ioStreams)
+		},
+		Annotations: map[string]string{
+			types.TagCommandOrder: order,
+			types.TagCommandType:  types.TypeApp,
+		},
+	}
+	cmd.Flags().StringVarP(&o.Output, "output", "o", "", "the file to write the bundle to, defaults to <app>-support-bundle-<time>.tar.gz")
+	cmd.Flags().DurationVarP(&o.Since, "since", "", 0, "only collect the logs newer than a relative duration like 5s, 2m, or 3h")
+	cmd.Flags().Int64VarP(&o.Tail, "tail", "", 1000, "lines of recent logs to collect from each container, all logs are collected if not positive")
+	cmd.Flags().StringVarP(&o.ControllerNamespace, "controller-namespace", "", types.DefaultKubeVelaNS, "the namespace of the KubeVela controller to collect the logs from, the controller logs are not collected if empty")
+	addNamespaceAndEnvArg(cmd)
+	return cmd
+}
+
+// Run collects the support bundle and writes it to the output file
+func (o *SupportBundleOptions) Run(ctx context.Context, c common.Args, ioStreams util.IOStreams) error {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	app, err := appfile.LoadApplication(o.Namespace, o.Name, c)
+	if err != nil {
+		return err
+	}
+	cli, err := c.GetClient()
+	if err != nil {
+		return err
+	}
+	config, err := c.GetConfig()
+	if err != nil {
+		return err
+	}
+	config = rest.CopyConfig(config)
+	config.Wrap(pkgmulticluster.NewTransportWrapper())
+	clientSet, err := kubernetes.NewForConfig(config)
+	if err != nil {
+		return err
+	}
+	collector := &supportbundle.Collector{
+		Client:              cli,
+		ClientSet:           clientSet,
+		LogOptions:          utils.PodLogOptions{Since: o.Since},
+		ControllerNamespace: o.ControllerNamespace,
+	}
+	if o.Tail > 0 {
+		collector.LogOptions.TailLines = &o.Tail
+	}
+	pods, err := GetApplicationPods(ctx, app.Name, app.Namespace, c, Filter{})
+	if err != nil {
+		ioStreams.Errorf("Warning: failed to query the pods of the application: %s\n", err.Error())
+	}
+	for i := range pods {
+		collector.Pods = append(collector.Pods, &pods[i])
+	}
+	bundle, err := collector.Collect(ctx, app)
+	if err != nil {
+		return err
+	}
+	if o.Output == "" {
+		o.Output = fmt.Sprintf("%s-support-bundle-%s.tar.gz", app.Name, time.Now().Format("20060102150405"))
+	}
+	f, err := os.Create(filepath.Clean(o.Output))
+	if err != nil {
+		return err
+	}
+	defer func() { _ = f.Close() }()
+	if err = bundle.WriteArchive(f); err != nil {
+		return fmt.Errorf("failed to write the support bundle: %w", err)
+	}
+	ioStreams.Infof("Collected %d files of application %s into %s.\n", len(bundle.Index.Files), app.Name, o.Output)
+	summary := supportbundle.Summary{}
+	if err = bundle.Unmarshal(supportbundle.SummaryFile, &summary); err != nil {
+		return err
+	}
+	printBundleProblems(ioStreams, summary.Problems)
+	return nil
+}
+
+// printBundleProblems prints the problems detected in the support bundle
+func printBundleProblems(ioStreams util.IOStreams, problems []supportbundle.Problem) {
+	if len(problems) == 0 {
+		ioStreams.Info("No problem detected.")
+		return
+	}
+	table := newUITable().AddRow("SEVERITY", "SOURCE", "MESSAGE")
+	table.MaxColWidth = 120
+	table.Wrap = true
+	for _, p := range problems {
+		table.AddRow(p.Severity, p.Source, p.Message)
+	}
+	ioStreams.Infof("Detected %d problems:\n", len(problems))
+	ioStreams.Info(table.String())
+}
+
+// diagnoseBundle analyses the support bundle offline and prints the problems detected
+func diagnoseBundle(ioStreams util.IOStreams, file string) error {
+	f, err := os.Open(filepath.Clean(file))
+	if err != nil {
+		return err
+	}
+	defer func() { _ = f.Close() }()
+	bundle, err := supportbundle.ReadArchive(f)
+	if err != nil {
+		return err
+	}
+	ioStreams.Infof("Diagnosing the support bundle of application %s/%s collected at %s...\n",
+		bundle.Index.Namespace, bundle.Index.Application, bundle.Index.CollectTime.Format(time.RFC3339))
+	printBundleProblems(ioStreams, supportbundle.Analyze(bundle))
+	return nil
+}
@@ -35,11 +35,14 @@ import (
 	apiregistrationV1beta "k8s.io/kube-aggregator/pkg/apis/apiregistration/v1beta1"
 	apiregistration "k8s.io/kube-aggregator/pkg/client/clientset_generated/clientset/typed/apiregistration/v1beta1"
 	metrics "k8s.io/metrics/pkg/client/clientset/versioned"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/yaml"
 
 	"github.com/oam-dev/kubevela/apis/types"
 	"github.com/oam-dev/kubevela/pkg/multicluster"
+	"github.com/oam-dev/kubevela/pkg/quota"
 	"github.com/oam-dev/kubevela/pkg/utils/common"
+	"github.com/oam-dev/kubevela/pkg/utils/util"
 )
 
 const (
@@ -47,6 +50,8 @@ const (
 	FlagSpecify = "specify"
 	// FlagOutputFormat specifies the output format. One of: (wide | yaml)
 	FlagOutputFormat = "output"
+	// FlagBundle specifies the support bundle to diagnose offline
+	FlagBundle = "bundle"
 	// APIServiceName is the name of APIService
 	APIServiceName = "v1alpha1.cluster.core.oam.dev"
 	// UnknownMetric represent that we can't compute the metric data
@@ -64,7 +69,9 @@ func NewSystemCommand(c common.Args, order string) *cobra.Command {
 			"# Specify a deployment name with a namespace to check detail information:\n" +
 			"> vela system info -s kubevela-vela-core -n vela-system\n" +
 			"# Diagnose the system's health:\n" +
-			"> vela system diagnose\n",
+			"> vela system diagnose\n" +
+			"# Summarize the key metrics of the controller:\n" +
+			"> vela system metrics\n",
 		Annotations: map[string]string{
 			types.TagCommandType:  types.TypeSystem,
 			types.TagCommandOrder: order,
@@ -72,7 +79,8 @@ func NewSystemCommand(c common.Args, order string) *cobra.Command {
 	}
 	cmd.AddCommand(
 		NewSystemInfoCommand(c),
-		NewSystemDiagnoseCommand(c))
+		NewSystemDiagnoseCommand(c),
+		NewSystemMetricsCommand(c))
 	return cmd
 }
 
@@ -81,7 +89,7 @@ func NewSystemInfoCommand(c common.Args) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "info",
 		Short: "Print the system deployment detail information in all namespaces with label app.kubernetes.io/name=vela-core.",
-		Long:  "Print the system deployment detail information in all namespaces with label app.kubernetes.io/name=vela-core.",
+		Long:  "Print the system deployment detail information in all namespaces with label app.kubernetes.io/name=vela-core, and the usage of the vela quotas.",
 		Args:  cobra.ExactArgs(0),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			// Get deploymentName from flag
@@ -162,12 +170,18 @@ func NewSystemInfoCommand(c common.Args) *cobra.Command {
 						return err
 					}
 					cmd.Println(table.String())
+					if err = printQuotaUsage(ctx, cmd, c); err != nil {
+						return err
+					}
 				case "wide":
 					table, err := WideFormatPrinter(ctx, deployments, mc)
 					if err != nil {
 						return err
 					}
 					cmd.Println(table.String())
+					if err = printQuotaUsage(ctx, cmd, c); err != nil {
+						return err
+					}
 				case "yaml":
 					str, err := YamlFormatPrinter(deployments)
 					if err != nil {
@@ -205,6 +219,48 @@ func SpecifiedFormatPrinter(deployment v1.Deployment) *uitable.Table {
 	return table
 }
 
+// QuotaFormatPrinter prints the usage of the vela quotas in all namespaces, nil is returned if there is no quota
+func QuotaFormatPrinter(ctx context.Context, cli client.Client) (*uitable.Table, error) {
+	quotas, err := quota.ListQuotas(ctx, cli, metav1.NamespaceAll)
+	if err != nil || len(quotas) == 0 {
+		return nil, err
+	}
+	table := newUITable().AddRow("QUOTA", "NAMESPACE", "RESOURCE", "USED", "LIMIT")
+	usages := map[string]quota.Usage{}
+	for i := range quotas {
+		q := &quotas[i]
+		usage, found := usages[q.Namespace]
+		if !found {
+			if usage, err = quota.GetUsage(ctx, cli, q.Namespace); err != nil {
+				return nil, err
+			}
+			usages[q.Namespace] = usage
+		}
+		for _, resource := range quota.Resources {
+			if limit := quota.GetLimit(q, resource); limit != nil {
+				table.AddRow(q.Name, q.Namespace, resource, usage[resource], *limit)
+			}
+		}
+	}
+	return table, nil
+}
+
+func printQuotaUsage(ctx context.Context, cmd *cobra.Command, c common.Args) error {
+	cli, err := c.GetClient()
+	if err != nil {
+		return err
+	}
+	table, err := QuotaFormatPrinter(ctx, cli)
+	if err != nil {
+		return errors.Wrapf(err, "failed to get the usage of vela quotas")
+	}
+	if table != nil {
+		cmd.Println()
+		cmd.Println(table.String())
+	}
+	return nil
+}
+
 // CPUMem returns the upsage of cpu and memory
 func CPUMem(resourceList corev1.ResourceList) string {
 	b := new(bytes.Buffer)
@@ -325,8 +381,15 @@ func NewSystemDiagnoseCommand(c common.Args) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "diagnose",
 		Short: "Diagnoses system problems.",
-		Long:  "Diagnoses system problems.",
+		Long:  "Diagnoses system problems, or the problems of an application offline from its support bundle collected by 'vela support-bundle'.",
+		Example: "# Diagnose the system's health:\n" +
+			"> vela system diagnose\n" +
+			"# Diagnose the problems of an application from its support bundle:\n" +
+			"> vela system diagnose --bundle my-app-support-bundle.tar.gz\n",
 		RunE: func(cmd *cobra.Command, args []string) error {
+			if bundle, _ := cmd.Flags().GetString(FlagBundle); bundle != "" {
+				return diagnoseBundle(util.IOStreams{Out: cmd.OutOrStdout(), ErrOut: cmd.ErrOrStderr()}, bundle)
+			}
 			// Diagnose clusters' health
 			fmt.Println("------------------------------------------------------")
 			fmt.Println("Diagnosing health of clusters...")
@@ -388,6 +451,7 @@ func NewSystemDiagnoseCommand(c common.Args) *cobra.Command {
 			types.TagCommandType: types.TypeSystem,
 		},
 	}
+	cmd.Flags().String(FlagBundle, "", "Analyse the support bundle of an application offline instead of diagnosing the system.")
 	return cmd
 }
 
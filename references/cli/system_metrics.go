@@ -0,0 +1,373 @@
+/*
+Copyright 2026 The KubeVela Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cli
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gosuri/uitable"
+	"github.com/pkg/errors"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/prometheus/common/expfmt"
+	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/kubectl/pkg/util/templates"
+
+	"github.com/oam-dev/kubevela/apis/types"
+	"github.com/oam-dev/kubevela/pkg/utils/common"
+)
+
+const (
+	// FlagMetricsPort specifies the port of the metrics endpoint of the controller
+	FlagMetricsPort = "port"
+	// defaultMetricsPort is the port of the metrics endpoint set in the vela-core chart
+	defaultMetricsPort = "8080"
+	// topRenderDefinitions is the number of the slowest definitions shown in the summary
+	topRenderDefinitions = 10
+)
+
+// NewSystemMetricsCommand summarizes the key metrics from the metrics endpoint of the vela-core controller
+func NewSystemMetricsCommand(c common.Args) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "metrics",
+		Short: "Summarize the key metrics of the vela-core controller.",
+		Long: templates.LongDesc(`
+			Summarize the key metrics of the vela-core controller.
+
+			The metrics are read from the metrics endpoint of the running vela-core pods through the pod proxy
+			of the Kubernetes APIServer, including the application phases, the reconcile latency, the dispatch
+			latency and the garbage collected resources of each cluster, the slowest CUE definitions to render,
+			the connectivity of the clusters and the template cache. The per-application metrics are summarized
+			if enabled by the feature gate EnableApplicationStatusMetrics.`),
+		Example: templates.Examples(`
+			# Summarize the metrics of the vela-core controller
+			vela system metrics
+
+			# Summarize the metrics of the vela-core controller in a specified namespace
+			vela system metrics -n vela-system`),
+		Args: cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			namespace, err := cmd.Flags().GetString(FlagNamespace)
+			if err != nil {
+				return err
+			}
+			port, err := cmd.Flags().GetString(FlagMetricsPort)
+			if err != nil {
+				return err
+			}
+			config, err := c.GetConfig()
+			if err != nil {
+				return err
+			}
+			clientset, err := kubernetes.NewForConfig(config)
+			if err != nil {
+				return err
+			}
+			ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+			defer cancel()
+			pods, err := clientset.CoreV1().Pods(namespace).List(ctx, metav1.ListOptions{
+				LabelSelector: "app.kubernetes.io/name=vela-core",
+			})
+			if err != nil {
+				return err
+			}
+			found := false
+			for _, pod := range pods.Items {
+				if pod.Status.Phase != corev1.PodRunning {
+					continue
+				}
+				found = true
+				data, err := clientset.CoreV1().Pods(pod.Namespace).ProxyGet("http", pod.Name, port, "/metrics", nil).DoRaw(ctx)
+				if err != nil {
+					return errors.Wrapf(err, "failed to get the metrics of pod %s/%s", pod.Namespace, pod.Name)
+				}
+				families, err := parseMetrics(bytes.NewReader(data))
+				if err != nil {
+					return errors.Wrapf(err, "failed to parse the metrics of pod %s/%s", pod.Namespace, pod.Name)
+				}
+				cmd.Printf("Metrics of pod %s/%s\n\n", pod.Namespace, pod.Name)
+				for _, section := range summarizeMetrics(families) {
+					cmd.Printf("%s\n%s\n\n", section.title, section.table.String())
+				}
+			}
+			if !found {
+				return errors.New("no running vela-core pod found")
+			}
+			return nil
+		},
+		Annotations: map[string]string{
+			types.TagCommandType: types.TypeSystem,
+		},
+	}
+	cmd.Flags().StringP(FlagNamespace, "n", metav1.NamespaceAll, "Specify the namespace of the vela-core pods. If empty, the pods in all namespaces are summarized.")
+	cmd.Flags().String(FlagMetricsPort, defaultMetricsPort, "Specify the port of the metrics endpoint of the vela-core pods.")
+	return cmd
+}
+
+// metricsSection is a table in the summary of the metrics
+type metricsSection struct {
+	title string
+	table *uitable.Table
+}
+
+// parseMetrics parses the metric families in the prometheus text format
+func parseMetrics(r io.Reader) (map[string]*dto.MetricFamily, error) {
+	parser := expfmt.TextParser{}
+	return parser.TextToMetricFamilies(r)
+}
+
+// summarizeMetrics summarizes the key metrics of the controller, the sections without samples are skipped
+func summarizeMetrics(families map[string]*dto.MetricFamily) []metricsSection {
+	var sections []metricsSection
+	addSection := func(title string, table *uitable.Table) {
+		if len(table.Rows) > 1 {
+			sections = append(sections, metricsSection{title: title, table: table})
+		}
+	}
+
+	table := newUITable().AddRow("PHASE", "APPLICATIONS")
+	for _, v := range metricValues(families["application_phase_number"], "phase") {
+		table.AddRow(v.labels[0], formatMetricValue(v.value))
+	}
+	addSection("Application Phases", table)
+
+	if health := metricValues(families["kubevela_application_health_status"], "app_name", "namespace"); len(health) > 0 {
+		unhealthy := 0
+		for _, v := range health {
+			if v.value == 0 {
+				unhealthy++
+			}
+		}
+		table = newUITable().AddRow("APPLICATIONS", "UNHEALTHY", "UNHEALTHY COMPONENTS", "MANAGED RESOURCES")
+		var unhealthyComponents, resources float64
+		for _, v := range metricValues(families["kubevela_component_health_status"], "app_name", "namespace", "component", "cluster") {
+			if v.value == 0 {
+				unhealthyComponents++
+			}
+		}
+		for _, v := range metricValues(families["kubevela_application_managed_resources"]) {
+			resources += v.value
+		}
+		table.AddRow(len(health), unhealthy, formatMetricValue(unhealthyComponents), formatMetricValue(resources))
+		addSection("Application Health", table)
+	}
+
+	table = newUITable().AddRow("BEGIN PHASE", "END PHASE", "COUNT", "AVG", "P99")
+	for _, s := range histogramStats(families["application_reconcile_time_seconds"], "begin_phase", "end_phase") {
+		table.AddRow(s.labels[0], s.labels[1], s.count, formatSeconds(s.avg()), formatSeconds(s.quantile(0.99)))
+	}
+	addSection("Reconcile Latency", table)
+
+	table = newUITable().AddRow("CLUSTER", "DISPATCHED", "AVG", "P99", "GC DELETED")
+	deleted := map[string]float64{}
+	for _, v := range metricValues(families["kubevela_gc_deleted_resources_total"], "cluster") {
+		deleted[v.labels[0]] = v.value
+	}
+	dispatched := histogramStats(families["kubevela_resource_dispatch_duration_seconds"], "cluster")
+	for _, s := range dispatched {
+		table.AddRow(s.labels[0], s.count, formatSeconds(s.avg()), formatSeconds(s.quantile(0.99)), formatMetricValue(deleted[s.labels[0]]))
+		delete(deleted, s.labels[0])
+	}
+	for _, cluster := range sortedKeys(deleted) {
+		table.AddRow(cluster, 0, "-", "-", formatMetricValue(deleted[cluster]))
+	}
+	addSection("Dispatch & Garbage Collection", table)
+
+	rendered := histogramStats(families["kubevela_cue_render_duration_seconds"], "definition_type", "definition")
+	sort.SliceStable(rendered, func(i, j int) bool { return rendered[i].avg() > rendered[j].avg() })
+	if len(rendered) > topRenderDefinitions {
+		rendered = rendered[:topRenderDefinitions]
+	}
+	table = newUITable().AddRow("TYPE", "DEFINITION", "RENDERED", "AVG", "P99")
+	for _, s := range rendered {
+		table.AddRow(s.labels[0], s.labels[1], s.count, formatSeconds(s.avg()), formatSeconds(s.quantile(0.99)))
+	}
+	addSection("Slowest Definitions to Render", table)
+
+	table = newUITable().AddRow("CLUSTER", "CONNECTED")
+	for _, v := range metricValues(families["cluster_isconnected"], "cluster") {
+		table.AddRow(v.labels[0], v.value == 1)
+	}
+	addSection("Clusters", table)
+
+	cache := map[string]float64{}
+	for _, v := range metricValues(families["kubevela_template_cache_requests_total"], "result") {
+		cache[v.labels[0]] = v.value
+	}
+	if requests := cache["hit"] + cache["miss"]; requests > 0 {
+		table = newUITable().AddRow("REQUESTS", "HIT RATIO", "EVICTIONS", "SIZE")
+		var evictions, size float64
+		for _, v := range metricValues(families["kubevela_template_cache_evictions_total"]) {
+			evictions += v.value
+		}
+		for _, v := range metricValues(families["kubevela_template_cache_size_bytes"]) {
+			size += v.value
+		}
+		table.AddRow(formatMetricValue(requests), fmt.Sprintf("%.1f%%", cache["hit"]/requests*100),
+			formatMetricValue(evictions), formatMetricValue(size)+"B")
+		addSection("Template Cache", table)
+	}
+	return sections
+}
+
+// metricValue is the value of a counter or gauge sample with the values of the labels to group by
+type metricValue struct {
+	labels []string
+	value  float64
+}
+
+// metricValues returns the values of the counter or gauge summed by the labels, sorted by the labels
+func metricValues(family *dto.MetricFamily, labels ...string) []metricValue {
+	if family == nil {
+		return nil
+	}
+	values := map[string]*metricValue{}
+	for _, m := range family.GetMetric() {
+		keys := labelValues(m, labels)
+		key := strings.Join(keys, "/")
+		if values[key] == nil {
+			values[key] = &metricValue{labels: keys}
+		}
+		switch {
+		case m.GetCounter() != nil:
+			values[key].value += m.GetCounter().GetValue()
+		case m.GetGauge() != nil:
+			values[key].value += m.GetGauge().GetValue()
+		case m.GetUntyped() != nil:
+			values[key].value += m.GetUntyped().GetValue()
+		}
+	}
+	var res []metricValue
+	for _, key := range sortedKeys(values) {
+		res = append(res, *values[key])
+	}
+	return res
+}
+
+// histogramStat is the histogram merged by the labels
+type histogramStat struct {
+	labels []string
+	count  uint64
+	sum    float64
+	// buckets are the cumulative counts by the upper bounds
+	buckets map[float64]uint64
+}
+
+func (s histogramStat) avg() float64 {
+	if s.count == 0 {
+		return 0
+	}
+	return s.sum / float64(s.count)
+}
+
+// quantile estimates the quantile by the linear interpolation in the bucket, the same as histogram_quantile of PromQL
+func (s histogramStat) quantile(q float64) float64 {
+	if s.count == 0 {
+		return 0
+	}
+	bounds := sortedKeys(s.buckets)
+	rank := q * float64(s.count)
+	lower, lowerCount := 0.0, uint64(0)
+	for _, bound := range bounds {
+		count := s.buckets[bound]
+		if float64(count) >= rank {
+			if math.IsInf(bound, 1) {
+				return lower
+			}
+			if count == lowerCount {
+				return bound
+			}
+			return lower + (bound-lower)*(rank-float64(lowerCount))/float64(count-lowerCount)
+		}
+		lower, lowerCount = bound, count
+	}
+	return lower
+}
+
+// histogramStats returns the histograms merged by the labels, sorted by the labels
+func histogramStats(family *dto.MetricFamily, labels ...string) []histogramStat {
+	if family == nil {
+		return nil
+	}
+	stats := map[string]*histogramStat{}
+	for _, m := range family.GetMetric() {
+		h := m.GetHistogram()
+		if h == nil {
+			continue
+		}
+		keys := labelValues(m, labels)
+		key := strings.Join(keys, "/")
+		if stats[key] == nil {
+			stats[key] = &histogramStat{labels: keys, buckets: map[float64]uint64{}}
+		}
+		s := stats[key]
+		s.count += h.GetSampleCount()
+		s.sum += h.GetSampleSum()
+		for _, b := range h.GetBucket() {
+			if !math.IsInf(b.GetUpperBound(), 1) {
+				s.buckets[b.GetUpperBound()] += b.GetCumulativeCount()
+			}
+		}
+		s.buckets[math.Inf(1)] += h.GetSampleCount()
+	}
+	var res []histogramStat
+	for _, key := range sortedKeys(stats) {
+		if stats[key].count > 0 {
+			res = append(res, *stats[key])
+		}
+	}
+	return res
+}
+
+func labelValues(m *dto.Metric, labels []string) []string {
+	values := make([]string, len(labels))
+	for i, label := range labels {
+		for _, pair := range m.GetLabel() {
+			if pair.GetName() == label {
+				values[i] = pair.GetValue()
+			}
+		}
+	}
+	return values
+}
+
+func sortedKeys[K string | float64, V any](m map[K]V) []K {
+	keys := make([]K, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+	return keys
+}
+
+func formatMetricValue(v float64) string {
+	return strconv.FormatFloat(v, 'f', -1, 64)
+}
+
+func formatSeconds(seconds float64) string {
+	return time.Duration(seconds * float64(time.Second)).Round(time.Microsecond).String()
+}
@@ -0,0 +1,78 @@
+/*
+Copyright 2026 The KubeVela Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cli
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const testControllerMetrics = `# TYPE application_phase_number gauge
+application_phase_number{phase="running"} 3
+application_phase_number{phase="workflowFailed"} 1
+# TYPE kubevela_application_health_status gauge
+kubevela_application_health_status{app_name="web",namespace="default"} 1
+kubevela_application_health_status{app_name="db",namespace="default"} 0
+# TYPE kubevela_component_health_status gauge
+kubevela_component_health_status{app_name="db",cluster="local",component="db",namespace="default"} 0
+kubevela_component_health_status{app_name="db",cluster="local",component="cache",namespace="default"} 0
+kubevela_component_health_status{app_name="web",cluster="local",component="web",namespace="default"} 1
+# TYPE kubevela_resource_dispatch_duration_seconds histogram
+kubevela_resource_dispatch_duration_seconds_bucket{cluster="local",le="0.1"} 8
+kubevela_resource_dispatch_duration_seconds_bucket{cluster="local",le="0.5"} 10
+kubevela_resource_dispatch_duration_seconds_bucket{cluster="local",le="+Inf"} 10
+kubevela_resource_dispatch_duration_seconds_sum{cluster="local"} 1
+kubevela_resource_dispatch_duration_seconds_count{cluster="local"} 10
+# TYPE kubevela_gc_deleted_resources_total counter
+kubevela_gc_deleted_resources_total{cluster="local"} 2
+kubevela_gc_deleted_resources_total{cluster="prod"} 5
+# TYPE kubevela_cue_render_duration_seconds histogram
+kubevela_cue_render_duration_seconds_bucket{definition="webservice",definition_type="component",le="0.1"} 4
+kubevela_cue_render_duration_seconds_bucket{definition="webservice",definition_type="component",le="+Inf"} 4
+kubevela_cue_render_duration_seconds_sum{definition="webservice",definition_type="component"} 0.2
+kubevela_cue_render_duration_seconds_count{definition="webservice",definition_type="component"} 4
+kubevela_cue_render_duration_seconds_bucket{definition="gateway",definition_type="trait",le="0.1"} 0
+kubevela_cue_render_duration_seconds_bucket{definition="gateway",definition_type="trait",le="+Inf"} 2
+kubevela_cue_render_duration_seconds_sum{definition="gateway",definition_type="trait"} 0.6
+kubevela_cue_render_duration_seconds_count{definition="gateway",definition_type="trait"} 2
+# TYPE kubevela_template_cache_requests_total counter
+kubevela_template_cache_requests_total{result="hit"} 3
+kubevela_template_cache_requests_total{result="miss"} 1
+`
+
+func TestSummarizeMetrics(t *testing.T) {
+	r := require.New(t)
+	families, err := parseMetrics(strings.NewReader(testControllerMetrics))
+	r.NoError(err)
+	sections := map[string]string{}
+	var titles []string
+	for _, section := range summarizeMetrics(families) {
+		titles = append(titles, section.title)
+		sections[section.title] = section.table.String()
+	}
+	r.Equal([]string{"Application Phases", "Application Health", "Dispatch & Garbage Collection",
+		"Slowest Definitions to Render", "Template Cache"}, titles)
+	r.Contains(sections["Application Phases"], "workflowFailed")
+	r.Regexp(`2\s+1\s+2\s+0`, sections["Application Health"])
+	r.Regexp(`local\s+10\s+100ms\s+480ms\s+2`, sections["Dispatch & Garbage Collection"])
+	r.Regexp(`prod\s+0\s+-\s+-\s+5`, sections["Dispatch & Garbage Collection"])
+	render := sections["Slowest Definitions to Render"]
+	r.Less(strings.Index(render, "gateway"), strings.Index(render, "webservice"))
+	r.Contains(sections["Template Cache"], "75.0%")
+}
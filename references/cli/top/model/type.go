@@ -66,6 +66,8 @@ var (
 	CtxKeyPod = "pod"
 	// CtxKeyContainer request context key of container
 	CtxKeyContainer = "container"
+	// CtxKeyWorkflowStep request context key of workflow step name
+	CtxKeyWorkflowStep = "workflowStep"
 )
 
 const (
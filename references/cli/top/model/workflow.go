@@ -0,0 +1,283 @@
+/*
+Copyright 2026 The KubeVela Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package model
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	wfTypesv1alpha1 "github.com/kubevela/pkg/apis/oam/v1alpha1"
+	workflowv1alpha1 "github.com/kubevela/workflow/api/v1alpha1"
+	"github.com/kubevela/workflow/pkg/debug"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+
+	"github.com/oam-dev/kubevela/apis/core.oam.dev/v1beta1"
+	"github.com/oam-dev/kubevela/pkg/workflow/operation"
+)
+
+// WorkflowStep is the workflow step object, the sub steps of a step group are listed after the group
+type WorkflowStep struct {
+	name             string
+	group            string
+	stepType         string
+	phase            string
+	dependsOn        string
+	message          string
+	firstExecuteTime string
+	lastExecuteTime  string
+}
+
+// WorkflowStepList is workflow step list
+type WorkflowStepList []WorkflowStep
+
+// ToTableBody generate body of table in workflow view
+func (l WorkflowStepList) ToTableBody() [][]string {
+	data := make([][]string, len(l))
+	for index, step := range l {
+		data[index] = []string{step.name, step.group, step.stepType, step.phase, step.dependsOn, step.message, step.firstExecuteTime, step.lastExecuteTime}
+	}
+	return data
+}
+
+// WorkflowAction is an operation on the workflow of the application
+type WorkflowAction string
+
+const (
+	// WorkflowSuspend suspends the workflow
+	WorkflowSuspend WorkflowAction = "suspend"
+	// WorkflowResume resumes the suspended workflow
+	WorkflowResume WorkflowAction = "resume"
+	// WorkflowRestartStep restarts the workflow from the step
+	WorkflowRestartStep WorkflowAction = "restart"
+	// WorkflowTerminate terminates the workflow
+	WorkflowTerminate WorkflowAction = "terminate"
+	// WorkflowRollback rolls back the application to the latest revision
+	WorkflowRollback WorkflowAction = "rollback"
+)
+
+// WorkflowActions are all the workflow actions in the order of the key bindings
+var WorkflowActions = []WorkflowAction{WorkflowSuspend, WorkflowResume, WorkflowRestartStep, WorkflowTerminate, WorkflowRollback}
+
+// ListWorkflowSteps list the workflow steps of the application, the steps not started yet are listed in pending phase
+func ListWorkflowSteps(ctx context.Context, c client.Client) (WorkflowStepList, error) {
+	name := ctx.Value(&CtxKeyAppName).(string)
+	ns := ctx.Value(&CtxKeyNamespace).(string)
+	app, err := LoadApplication(c, name, ns)
+	if err != nil {
+		return WorkflowStepList{}, err
+	}
+	var statuses []workflowv1alpha1.WorkflowStepStatus
+	if app.Status.Workflow != nil {
+		statuses = app.Status.Workflow.Steps
+	}
+	var specs []wfTypesv1alpha1.WorkflowStep
+	if app.Spec.Workflow != nil {
+		specs = app.Spec.Workflow.Steps
+	}
+
+	list := WorkflowStepList{}
+	listed := map[string]bool{}
+	for _, status := range statuses {
+		spec := findStepSpec(specs, status.Name)
+		list = append(list, newWorkflowStep(status.StepStatus, "", spec))
+		listed[status.Name] = true
+		for _, sub := range status.SubStepsStatus {
+			list = append(list, newWorkflowStep(sub, status.Name, findStepSpec(specs, sub.Name)))
+			listed[sub.Name] = true
+		}
+	}
+	for _, spec := range specs {
+		if listed[spec.Name] {
+			continue
+		}
+		list = append(list, newWorkflowStep(workflowv1alpha1.StepStatus{Name: spec.Name, Type: spec.Type, Phase: workflowv1alpha1.WorkflowStepPhasePending}, "", &spec.WorkflowStepBase))
+		for i := range spec.SubSteps {
+			sub := spec.SubSteps[i]
+			list = append(list, newWorkflowStep(workflowv1alpha1.StepStatus{Name: sub.Name, Type: sub.Type, Phase: workflowv1alpha1.WorkflowStepPhasePending}, spec.Name, &sub))
+		}
+	}
+	return list, nil
+}
+
+func newWorkflowStep(status workflowv1alpha1.StepStatus, group string, spec *wfTypesv1alpha1.WorkflowStepBase) WorkflowStep {
+	step := WorkflowStep{
+		name:             status.Name,
+		group:            group,
+		stepType:         status.Type,
+		phase:            string(status.Phase),
+		dependsOn:        "-",
+		message:          status.Message,
+		firstExecuteTime: stepTime(status.FirstExecuteTime),
+		lastExecuteTime:  stepTime(status.LastExecuteTime),
+	}
+	if group == "" {
+		step.group = "-"
+	}
+	if spec != nil && len(spec.DependsOn) > 0 {
+		step.dependsOn = strings.Join(spec.DependsOn, ",")
+	}
+	return step
+}
+
+func stepTime(t metav1.Time) string {
+	if t.IsZero() {
+		return "-"
+	}
+	return t.String()
+}
+
+// findStepSpec finds the spec of the step or the sub step by name
+func findStepSpec(specs []wfTypesv1alpha1.WorkflowStep, name string) *wfTypesv1alpha1.WorkflowStepBase {
+	for i := range specs {
+		if specs[i].Name == name {
+			return &specs[i].WorkflowStepBase
+		}
+		for j := range specs[i].SubSteps {
+			if specs[i].SubSteps[j].Name == name {
+				return &specs[i].SubSteps[j]
+			}
+		}
+	}
+	return nil
+}
+
+// findStepStatus finds the status of the step or the sub step by name
+func findStepStatus(statuses []workflowv1alpha1.WorkflowStepStatus, name string) *workflowv1alpha1.StepStatus {
+	for i := range statuses {
+		if statuses[i].Name == name {
+			return &statuses[i].StepStatus
+		}
+		for j := range statuses[i].SubStepsStatus {
+			if statuses[i].SubStepsStatus[j].Name == name {
+				return &statuses[i].SubStepsStatus[j]
+			}
+		}
+	}
+	return nil
+}
+
+// WorkflowStepDetail return the yaml text of the spec, status, inputs, outputs and debug values of the workflow step
+func WorkflowStepDetail(ctx context.Context, c client.Client, name, ns, stepName string) (string, error) {
+	app, err := LoadApplication(c, name, ns)
+	if err != nil {
+		return "", err
+	}
+	detail := map[string]interface{}{"name": stepName}
+	if app.Spec.Workflow != nil {
+		if spec := findStepSpec(app.Spec.Workflow.Steps, stepName); spec != nil {
+			detail["type"] = spec.Type
+			if len(spec.DependsOn) > 0 {
+				detail["dependsOn"] = spec.DependsOn
+			}
+			if len(spec.Inputs) > 0 {
+				detail["inputs"] = spec.Inputs
+			}
+			if len(spec.Outputs) > 0 {
+				detail["outputs"] = spec.Outputs
+			}
+			if spec.Properties != nil && len(spec.Properties.Raw) > 0 {
+				var properties interface{}
+				if err := json.Unmarshal(spec.Properties.Raw, &properties); err == nil {
+					detail["properties"] = properties
+				}
+			}
+		}
+	}
+	if app.Status.Workflow != nil {
+		if status := findStepStatus(app.Status.Workflow.Steps, stepName); status != nil {
+			detail["status"] = status
+			detail["debug"] = stepDebugValues(ctx, c, app, status)
+		}
+	}
+	bs, err := yaml.Marshal(detail)
+	if err != nil {
+		return "", err
+	}
+	return string(bs), nil
+}
+
+// stepDebugValues return the debug values recorded by the debug policy of the application
+func stepDebugValues(ctx context.Context, c client.Client, app *v1beta1.Application, status *workflowv1alpha1.StepStatus) string {
+	cm := &corev1.ConfigMap{}
+	key := client.ObjectKey{Name: debug.GenerateContextName(app.Name, status.ID, string(app.UID)), Namespace: app.Namespace}
+	if err := c.Get(ctx, key, cm); err != nil || cm.Data["debug"] == "" {
+		return "no debug values recorded, add the debug policy to the application to record them"
+	}
+	return cm.Data["debug"]
+}
+
+// OperateWorkflow performs the action on the workflow of the application, the step is only used by the step actions
+func OperateWorkflow(ctx context.Context, c client.Client, name, ns string, action WorkflowAction, step string) error {
+	app, err := LoadApplication(c, name, ns)
+	if err != nil {
+		return err
+	}
+	operator := operation.NewApplicationWorkflowOperator(c, nil, app)
+	switch action {
+	case WorkflowSuspend:
+		return operator.Suspend(ctx)
+	case WorkflowResume:
+		return operator.Resume(ctx)
+	case WorkflowRestartStep:
+		return operation.NewApplicationWorkflowStepOperator(c, nil, app).Restart(ctx, step)
+	case WorkflowTerminate:
+		return operator.Terminate(ctx)
+	case WorkflowRollback:
+		return operator.Rollback(ctx)
+	default:
+		return fmt.Errorf("unknown workflow action %s", action)
+	}
+}
+
+// AllowedWorkflowActions return whether the current user is allowed to perform each workflow action on the
+// application. The actions changing the workflow status need to update the status of the application, and the
+// rollback needs to update the application itself. The action is allowed if the access can't be reviewed, the
+// operation will report the error then.
+func AllowedWorkflowActions(ctx context.Context, c client.Client, name, ns string) map[WorkflowAction]bool {
+	allowed := func(subresource string) bool {
+		review := &authorizationv1.SelfSubjectAccessReview{
+			Spec: authorizationv1.SelfSubjectAccessReviewSpec{
+				ResourceAttributes: &authorizationv1.ResourceAttributes{
+					Namespace:   ns,
+					Verb:        "update",
+					Group:       v1beta1.Group,
+					Resource:    "applications",
+					Subresource: subresource,
+					Name:        name,
+				},
+			},
+		}
+		if err := c.Create(ctx, review); err != nil {
+			return true
+		}
+		return review.Status.Allowed
+	}
+	updateStatus, update := allowed("status"), allowed("")
+	return map[WorkflowAction]bool{
+		WorkflowSuspend:     updateStatus,
+		WorkflowResume:      updateStatus,
+		WorkflowRestartStep: updateStatus,
+		WorkflowTerminate:   updateStatus,
+		WorkflowRollback:    update,
+	}
+}
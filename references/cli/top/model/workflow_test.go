@@ -0,0 +1,131 @@
+/*
+Copyright 2026 The KubeVela Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package model
+
+import (
+	"context"
+	"testing"
+
+	wfTypesv1alpha1 "github.com/kubevela/pkg/apis/oam/v1alpha1"
+	workflowv1alpha1 "github.com/kubevela/workflow/api/v1alpha1"
+	"github.com/stretchr/testify/assert"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	"github.com/oam-dev/kubevela/apis/core.oam.dev/common"
+	"github.com/oam-dev/kubevela/apis/core.oam.dev/v1beta1"
+	velacommon "github.com/oam-dev/kubevela/pkg/utils/common"
+)
+
+func testWorkflowApp() *v1beta1.Application {
+	return &v1beta1.Application{
+		ObjectMeta: metav1.ObjectMeta{Name: "app", Namespace: "default", UID: "0123456789"},
+		Spec: v1beta1.ApplicationSpec{
+			Workflow: &v1beta1.Workflow{Steps: []wfTypesv1alpha1.WorkflowStep{
+				{WorkflowStepBase: wfTypesv1alpha1.WorkflowStepBase{Name: "apply", Type: "apply-component",
+					Outputs: wfTypesv1alpha1.StepOutputs{{Name: "ip", ValueFrom: "output.status.podIP"}}}},
+				{WorkflowStepBase: wfTypesv1alpha1.WorkflowStepBase{Name: "group", Type: "step-group", DependsOn: []string{"apply"}},
+					SubSteps: []wfTypesv1alpha1.WorkflowStepBase{{Name: "notify", Type: "notification",
+						Inputs: wfTypesv1alpha1.StepInputs{{From: "ip", ParameterKey: "message"}}}}},
+				{WorkflowStepBase: wfTypesv1alpha1.WorkflowStepBase{Name: "approve", Type: "suspend", DependsOn: []string{"group"}}},
+			}},
+		},
+		Status: common.AppStatus{Workflow: &common.WorkflowStatus{Steps: []workflowv1alpha1.WorkflowStepStatus{
+			{StepStatus: workflowv1alpha1.StepStatus{ID: "a1", Name: "apply", Type: "apply-component", Phase: workflowv1alpha1.WorkflowStepPhaseSucceeded}},
+			{StepStatus: workflowv1alpha1.StepStatus{ID: "g1", Name: "group", Type: "step-group", Phase: workflowv1alpha1.WorkflowStepPhaseRunning},
+				SubStepsStatus: []workflowv1alpha1.StepStatus{{ID: "n1", Name: "notify", Type: "notification", Phase: workflowv1alpha1.WorkflowStepPhaseRunning, Message: "sending"}}},
+		}}},
+	}
+}
+
+func TestListWorkflowSteps(t *testing.T) {
+	c := fake.NewClientBuilder().WithScheme(velacommon.Scheme).WithObjects(testWorkflowApp()).Build()
+	ctx := context.WithValue(context.Background(), &CtxKeyAppName, "app")
+	ctx = context.WithValue(ctx, &CtxKeyNamespace, "default")
+	steps, err := ListWorkflowSteps(ctx, c)
+	assert.NoError(t, err)
+	assert.Equal(t, [][]string{
+		{"apply", "-", "apply-component", "succeeded", "-", "", "-", "-"},
+		{"group", "-", "step-group", "running", "apply", "", "-", "-"},
+		{"notify", "group", "notification", "running", "-", "sending", "-", "-"},
+		{"approve", "-", "suspend", "pending", "group", "", "-", "-"},
+	}, steps.ToTableBody())
+
+	ctx = context.WithValue(ctx, &CtxKeyAppName, "not-exist")
+	_, err = ListWorkflowSteps(ctx, c)
+	assert.Error(t, err)
+}
+
+func TestWorkflowStepDetail(t *testing.T) {
+	app := testWorkflowApp()
+	cm := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "app-a1-debug-56789", Namespace: "default"},
+		Data:       map[string]string{"debug": "output: status: podIP: \"10.0.0.1\""},
+	}
+	c := fake.NewClientBuilder().WithScheme(velacommon.Scheme).WithObjects(app, cm).Build()
+
+	detail, err := WorkflowStepDetail(context.Background(), c, "app", "default", "apply")
+	assert.NoError(t, err)
+	assert.Contains(t, detail, "valueFrom: output.status.podIP")
+	assert.Contains(t, detail, "phase: succeeded")
+	assert.Contains(t, detail, `podIP: "10.0.0.1"`)
+
+	detail, err = WorkflowStepDetail(context.Background(), c, "app", "default", "notify")
+	assert.NoError(t, err)
+	assert.Contains(t, detail, "parameterKey: message")
+	assert.Contains(t, detail, "no debug values recorded")
+
+	detail, err = WorkflowStepDetail(context.Background(), c, "app", "default", "approve")
+	assert.NoError(t, err)
+	assert.Contains(t, detail, "- group")
+	assert.NotContains(t, detail, "status:")
+}
+
+func TestOperateWorkflow(t *testing.T) {
+	c := fake.NewClientBuilder().WithScheme(velacommon.Scheme).WithObjects(testWorkflowApp()).
+		WithStatusSubresource(&v1beta1.Application{}).Build()
+	assert.NoError(t, OperateWorkflow(context.Background(), c, "app", "default", WorkflowTerminate, ""))
+	app := &v1beta1.Application{}
+	assert.NoError(t, c.Get(context.Background(), client.ObjectKey{Name: "app", Namespace: "default"}, app))
+	assert.True(t, app.Status.Workflow.Terminated)
+	assert.Equal(t, workflowv1alpha1.WorkflowStepPhaseFailed, app.Status.Workflow.Steps[1].Phase)
+
+	assert.Error(t, OperateWorkflow(context.Background(), c, "app", "default", WorkflowAction("unknown"), ""))
+	assert.Error(t, OperateWorkflow(context.Background(), c, "not-exist", "default", WorkflowSuspend, ""))
+}
+
+func TestAllowedWorkflowActions(t *testing.T) {
+	c := fake.NewClientBuilder().WithScheme(velacommon.Scheme).WithInterceptorFuncs(interceptor.Funcs{
+		Create: func(_ context.Context, _ client.WithWatch, obj client.Object, _ ...client.CreateOption) error {
+			review := obj.(*authorizationv1.SelfSubjectAccessReview)
+			// only allowed to update the status of the application
+			review.Status.Allowed = review.Spec.ResourceAttributes.Subresource == "status"
+			return nil
+		},
+	}).Build()
+	assert.Equal(t, map[WorkflowAction]bool{
+		WorkflowSuspend:     true,
+		WorkflowResume:      true,
+		WorkflowRestartStep: true,
+		WorkflowTerminate:   true,
+		WorkflowRollback:    false,
+	}, AllowedWorkflowActions(context.Background(), c, "app", "default"))
+}
@@ -110,7 +110,8 @@ func (v *ApplicationView) ColorizeStatusText(rowNum int) {
 		switch common.ApplicationPhase(status) {
 		case common.ApplicationStarting:
 			highlightColor = v.app.config.Theme.Status.Starting.String()
-		case common.ApplicationRendering, common.ApplicationPolicyGenerating, common.ApplicationRunningWorkflow, common.ApplicationWorkflowSuspending:
+		case common.ApplicationRendering, common.ApplicationPolicyGenerating, common.ApplicationRunningWorkflow, common.ApplicationWorkflowSuspending,
+			common.ApplicationPendingDeployWindow:
 			highlightColor = v.app.config.Theme.Status.Waiting.String()
 		case common.ApplicationUnhealthy, common.ApplicationWorkflowTerminated, common.ApplicationWorkflowFailed, common.ApplicationDeleting:
 			highlightColor = v.app.config.Theme.Status.Failed.String()
@@ -140,6 +141,7 @@ func (v *ApplicationView) bindKeys() {
 		component.KeyY: model.KeyAction{Description: "Yaml", Action: v.yamlView, Visible: true, Shared: true},
 		component.KeyR: model.KeyAction{Description: "Refresh", Action: v.Refresh, Visible: true, Shared: true},
 		component.KeyT: model.KeyAction{Description: "Topology", Action: v.topologyView, Visible: true, Shared: true},
+		component.KeyW: model.KeyAction{Description: "Workflow", Action: v.workflowView, Visible: true, Shared: true},
 	})
 }
 
@@ -194,3 +196,17 @@ func (v *ApplicationView) topologyView(event *tcell.EventKey) *tcell.EventKey {
 	v.app.command.run(ctx, "topology")
 	return nil
 }
+
+func (v *ApplicationView) workflowView(event *tcell.EventKey) *tcell.EventKey {
+	row, _ := v.GetSelection()
+	if row == 0 {
+		return event
+	}
+	name, namespace := v.GetCell(row, 0).Text, v.GetCell(row, 1).Text
+
+	ctx := context.WithValue(context.Background(), &model.CtxKeyAppName, name)
+	ctx = context.WithValue(ctx, &model.CtxKeyNamespace, namespace)
+
+	v.app.command.run(ctx, "workflow")
+	return nil
+}
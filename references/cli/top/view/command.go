@@ -55,6 +55,8 @@ func (c *Command) run(ctx context.Context, cmd string) {
 		component = NewTopologyView(ctx, c.app)
 	case cmd == "log":
 		component = NewLogView(ctx, c.app)
+	case cmd == "step":
+		component = NewWorkflowStepView(ctx, c.app)
 	default:
 		if resourceView, ok := ResourceViewMap[cmd]; ok {
 			resourceView.InitView(ctx, c.app)
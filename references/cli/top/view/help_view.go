@@ -69,6 +69,7 @@ At present, the tool has provided the following feature:
 [highlight:]*[normal:] Platform information overview
 [highlight:]*[normal:] Display of resource status information in Application, Managed Resource, Pod and Container levels
 [highlight:]*[normal:] Application Resource Topology
+[highlight:]*[normal:] Application Workflow steps, step detail and debug values, and workflow operations (suspend, resume, restart step, terminate and rollback)
 [highlight:]*[normal:] Resource YAML text display
 [highlight:]*[normal:] Theme switching
 
@@ -52,6 +52,7 @@ var ResourceViewMap = map[string]ResourceView{
 	"cns":       new(ClusterNamespaceView),
 	"pod":       new(PodView),
 	"container": new(ContainerView),
+	"workflow":  new(WorkflowView),
 }
 
 // CommonResourceView is an abstract of resource view
@@ -0,0 +1,110 @@
+/*
+Copyright 2026 The KubeVela Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package view
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+
+	"github.com/oam-dev/kubevela/references/cli/top/component"
+	"github.com/oam-dev/kubevela/references/cli/top/model"
+)
+
+// WorkflowStepView is the workflow step view, this view display the inputs, outputs, status and debug values of the step
+type WorkflowStepView struct {
+	*tview.TextView
+	app     *App
+	actions model.KeyActions
+	ctx     context.Context
+}
+
+var workflowStepViewInstance = new(WorkflowStepView)
+
+// NewWorkflowStepView return a new workflow step view
+func NewWorkflowStepView(ctx context.Context, app *App) model.View {
+	workflowStepViewInstance.ctx = ctx
+	if workflowStepViewInstance.TextView == nil {
+		workflowStepViewInstance.TextView = tview.NewTextView()
+		workflowStepViewInstance.actions = make(model.KeyActions)
+		workflowStepViewInstance.app = app
+	}
+	return workflowStepViewInstance
+}
+
+// Init the workflow step view
+func (v *WorkflowStepView) Init() {
+	step, _ := v.ctx.Value(&model.CtxKeyWorkflowStep).(string)
+	title := fmt.Sprintf("[ %s (%s) ]", v.Name(), step)
+	v.SetDynamicColors(true)
+	v.SetRegions(true)
+	v.SetBorder(true)
+	v.SetBorderAttributes(tcell.AttrItalic)
+	v.SetTitle(title).SetTitleColor(v.app.config.Theme.Table.Title.Color())
+	v.bindKeys()
+	v.SetInputCapture(v.keyboard)
+}
+
+// Start the workflow step view
+func (v *WorkflowStepView) Start() {
+	v.Clear()
+	name, _ := v.ctx.Value(&model.CtxKeyAppName).(string)
+	namespace, _ := v.ctx.Value(&model.CtxKeyNamespace).(string)
+	step, _ := v.ctx.Value(&model.CtxKeyWorkflowStep).(string)
+	detail, err := model.WorkflowStepDetail(v.ctx, v.app.client, name, namespace, step)
+	if err != nil {
+		v.SetText(fmt.Sprintf("can't load the detail of the workflow step!, because  %s", err))
+		return
+	}
+	yamlView := &YamlView{app: v.app}
+	v.SetText(yamlView.HighlightText(detail))
+}
+
+// Stop the workflow step view
+func (v *WorkflowStepView) Stop() {
+	v.Clear()
+}
+
+// Name return the name of workflow step view
+func (v *WorkflowStepView) Name() string {
+	return "Workflow Step"
+}
+
+// Hint return the menu hints of workflow step view
+func (v *WorkflowStepView) Hint() []model.MenuHint {
+	return v.actions.Hint()
+}
+
+func (v *WorkflowStepView) keyboard(event *tcell.EventKey) *tcell.EventKey {
+	key := event.Key()
+	if key == tcell.KeyUp || key == tcell.KeyDown {
+		return event
+	}
+	if a, ok := v.actions[component.StandardizeKey(event)]; ok {
+		return a.Action(event)
+	}
+	return event
+}
+
+func (v *WorkflowStepView) bindKeys() {
+	v.actions.Add(model.KeyActions{
+		component.KeyQ:    model.KeyAction{Description: "Back", Action: v.app.Back, Visible: true, Shared: true},
+		component.KeyHelp: model.KeyAction{Description: "Help", Action: v.app.helpView, Visible: true, Shared: true},
+	})
+}
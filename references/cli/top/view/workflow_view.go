@@ -0,0 +1,252 @@
+/*
+Copyright 2026 The KubeVela Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package view
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gdamore/tcell/v2"
+	workflowv1alpha1 "github.com/kubevela/workflow/api/v1alpha1"
+	"github.com/rivo/tview"
+
+	"github.com/oam-dev/kubevela/references/cli/top/component"
+	"github.com/oam-dev/kubevela/references/cli/top/model"
+)
+
+const (
+	// workflowConfirmPage is the page name of the confirmation dialog of the workflow actions
+	workflowConfirmPage = "workflow-confirm"
+	// workflowResultPage is the page name of the result dialog of the workflow actions
+	workflowResultPage = "workflow-result"
+)
+
+// WorkflowView is the workflow view, this view display the workflow steps of the application and operate the workflow
+type WorkflowView struct {
+	*CommonResourceView
+	ctx context.Context
+}
+
+// workflowActionKeys is the key binding of the workflow actions
+var workflowActionKeys = map[model.WorkflowAction]tcell.Key{
+	model.WorkflowSuspend:     component.KeyS,
+	model.WorkflowResume:      component.KeyC,
+	model.WorkflowRestartStep: component.KeyX,
+	model.WorkflowTerminate:   component.KeyT,
+	model.WorkflowRollback:    component.KeyB,
+}
+
+// Name return workflow view name
+func (v *WorkflowView) Name() string {
+	return "Workflow"
+}
+
+// Init the workflow view
+func (v *WorkflowView) Init() {
+	v.CommonResourceView.Init()
+	v.SetTitle(fmt.Sprintf("[ %s ]", v.Title())).SetTitleColor(v.app.config.Theme.Table.Title.Color())
+	v.bindKeys()
+}
+
+// Start the workflow view
+func (v *WorkflowView) Start() {
+	v.Clear()
+	v.Update(func() {})
+	v.CommonResourceView.AutoRefresh(v.Update)
+}
+
+// Stop the workflow view
+func (v *WorkflowView) Stop() {
+	v.CommonResourceView.Stop()
+}
+
+// Hint return key action menu hints of the workflow view
+func (v *WorkflowView) Hint() []model.MenuHint {
+	return v.Actions().Hint()
+}
+
+// InitView init a new workflow view
+func (v *WorkflowView) InitView(ctx context.Context, app *App) {
+	v.ctx = ctx
+	if v.CommonResourceView == nil {
+		v.CommonResourceView = NewCommonView(app)
+	}
+}
+
+// Refresh the view content
+func (v *WorkflowView) Refresh(_ *tcell.EventKey) *tcell.EventKey {
+	v.CommonResourceView.Refresh(true, v.Update)
+	return nil
+}
+
+// Update refresh the content of body of view
+func (v *WorkflowView) Update(timeoutCancel func()) {
+	v.BuildHeader()
+	v.BuildBody()
+	timeoutCancel()
+}
+
+// BuildHeader render the header of table
+func (v *WorkflowView) BuildHeader() {
+	header := []string{"Name", "Group", "Type", "Phase", "DependsOn", "Message", "FirstExecuteTime", "LastExecuteTime"}
+	v.CommonResourceView.BuildHeader(header)
+}
+
+// BuildBody render the body of table
+func (v *WorkflowView) BuildBody() {
+	steps, err := model.ListWorkflowSteps(v.ctx, v.app.client)
+	if err != nil {
+		return
+	}
+	stepInfos := steps.ToTableBody()
+	v.CommonResourceView.BuildBody(stepInfos)
+	rowNum := len(stepInfos)
+	v.ColorizePhaseText(rowNum)
+}
+
+// ColorizePhaseText colorize the phase column text
+func (v *WorkflowView) ColorizePhaseText(rowNum int) {
+	for i := 1; i < rowNum+1; i++ {
+		phase := v.Table.GetCell(i, 3).Text
+		highlightColor := v.app.config.Theme.Table.Body.String()
+
+		switch workflowv1alpha1.WorkflowStepPhase(phase) {
+		case workflowv1alpha1.WorkflowStepPhaseSucceeded:
+			highlightColor = v.app.config.Theme.Status.Healthy.String()
+		case workflowv1alpha1.WorkflowStepPhaseFailed:
+			highlightColor = v.app.config.Theme.Status.Failed.String()
+		case workflowv1alpha1.WorkflowStepPhaseRunning, workflowv1alpha1.WorkflowStepPhaseSuspending:
+			highlightColor = v.app.config.Theme.Status.Waiting.String()
+		case workflowv1alpha1.WorkflowStepPhasePending:
+			highlightColor = v.app.config.Theme.Status.Starting.String()
+		case workflowv1alpha1.WorkflowStepPhaseSkipped:
+			highlightColor = v.app.config.Theme.Status.Unknown.String()
+		default:
+		}
+		v.Table.GetCell(i, 3).SetText(fmt.Sprintf("[%s::]%s", highlightColor, phase))
+	}
+}
+
+// Title return the table title of workflow view
+func (v *WorkflowView) Title() string {
+	name, _ := v.ctx.Value(&model.CtxKeyAppName).(string)
+	namespace, _ := v.ctx.Value(&model.CtxKeyNamespace).(string)
+	return fmt.Sprintf("Workflow"+" (%s/%s)", namespace, name)
+}
+
+func (v *WorkflowView) bindKeys() {
+	v.Actions().Delete([]tcell.Key{tcell.KeyEnter})
+	v.Actions().Add(model.KeyActions{
+		tcell.KeyEnter: model.KeyAction{Description: "Step Detail", Action: v.stepView, Visible: true, Shared: true},
+		component.KeyR: model.KeyAction{Description: "Refresh", Action: v.Refresh, Visible: true, Shared: true},
+	})
+	// the actions the user is not allowed to perform are marked as denied in the menu and rejected with a message
+	name, _ := v.ctx.Value(&model.CtxKeyAppName).(string)
+	namespace, _ := v.ctx.Value(&model.CtxKeyNamespace).(string)
+	allowed := model.AllowedWorkflowActions(v.ctx, v.app.client, name, namespace)
+	descriptions := map[model.WorkflowAction]string{
+		model.WorkflowSuspend:     "Suspend",
+		model.WorkflowResume:      "Resume",
+		model.WorkflowRestartStep: "Restart Step",
+		model.WorkflowTerminate:   "Terminate",
+		model.WorkflowRollback:    "Rollback",
+	}
+	for _, action := range model.WorkflowActions {
+		description := descriptions[action]
+		if !allowed[action] {
+			description += " (denied)"
+		}
+		v.Actions().Add(model.KeyActions{
+			workflowActionKeys[action]: model.KeyAction{Description: description, Action: v.workflowAction(action, allowed[action]), Visible: allowed[action], Shared: true},
+		})
+	}
+}
+
+// selectedStep return the step name of the selected row, empty if the header is selected
+func (v *WorkflowView) selectedStep() string {
+	row, _ := v.GetSelection()
+	if row == 0 {
+		return ""
+	}
+	return v.GetCell(row, 0).Text
+}
+
+func (v *WorkflowView) stepView(event *tcell.EventKey) *tcell.EventKey {
+	step := v.selectedStep()
+	if step == "" {
+		return event
+	}
+	ctx := context.WithValue(v.ctx, &model.CtxKeyWorkflowStep, step)
+	v.app.command.run(ctx, "step")
+	return nil
+}
+
+// workflowAction return the key action to confirm and perform the workflow action
+func (v *WorkflowView) workflowAction(action model.WorkflowAction, allowed bool) func(event *tcell.EventKey) *tcell.EventKey {
+	return func(event *tcell.EventKey) *tcell.EventKey {
+		name, _ := v.ctx.Value(&model.CtxKeyAppName).(string)
+		namespace, _ := v.ctx.Value(&model.CtxKeyNamespace).(string)
+		if !allowed {
+			v.showResult(fmt.Sprintf("You are not allowed to %s the workflow of application %s/%s", action, namespace, name))
+			return nil
+		}
+		step := ""
+		question := fmt.Sprintf("Are you sure to %s the workflow of application %s/%s?", action, namespace, name)
+		if action == model.WorkflowRestartStep {
+			if step = v.selectedStep(); step == "" {
+				return event
+			}
+			question = fmt.Sprintf("Are you sure to restart the workflow of application %s/%s from step %s?", namespace, name, step)
+		}
+		v.confirm(question, func() {
+			if err := model.OperateWorkflow(v.ctx, v.app.client, name, namespace, action, step); err != nil {
+				v.showResult(fmt.Sprintf("Failed to %s the workflow: %s", action, err.Error()))
+				return
+			}
+			v.showResult(fmt.Sprintf("Successfully %s the workflow of application %s/%s", action, namespace, name))
+			v.Refresh(nil)
+		})
+		return nil
+	}
+}
+
+// confirm shows the confirmation dialog and runs the action once confirmed
+func (v *WorkflowView) confirm(question string, action func()) {
+	dialog := tview.NewModal().
+		SetText(question).
+		AddButtons([]string{"Confirm", "Cancel"}).
+		SetDoneFunc(func(_ int, label string) {
+			v.app.Main.RemovePage(workflowConfirmPage)
+			v.app.SetFocus(v)
+			if label == "Confirm" {
+				action()
+			}
+		})
+	v.app.Main.AddPage(workflowConfirmPage, dialog, true, true)
+}
+
+// showResult shows the result of the workflow action in a dialog
+func (v *WorkflowView) showResult(message string) {
+	dialog := tview.NewModal().
+		SetText(message).
+		AddButtons([]string{"OK"}).
+		SetDoneFunc(func(_ int, _ string) {
+			v.app.Main.RemovePage(workflowResultPage)
+			v.app.SetFocus(v)
+		})
+	v.app.Main.AddPage(workflowResultPage, dialog, true, true)
+}
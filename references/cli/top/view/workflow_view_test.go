@@ -0,0 +1,148 @@
+/*
+Copyright 2026 The KubeVela Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package view
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	workflowv1alpha1 "github.com/kubevela/workflow/api/v1alpha1"
+	"github.com/rivo/tview"
+	"github.com/stretchr/testify/assert"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	"github.com/oam-dev/kubevela/apis/core.oam.dev/common"
+	"github.com/oam-dev/kubevela/apis/core.oam.dev/v1beta1"
+	velacommon "github.com/oam-dev/kubevela/pkg/utils/common"
+	"github.com/oam-dev/kubevela/references/cli/top/component"
+	"github.com/oam-dev/kubevela/references/cli/top/model"
+)
+
+func TestWorkflowView(t *testing.T) {
+	testApp := &v1beta1.Application{
+		ObjectMeta: metav1.ObjectMeta{Name: "app", Namespace: "default"},
+		Status: common.AppStatus{Workflow: &common.WorkflowStatus{Steps: []workflowv1alpha1.WorkflowStepStatus{
+			{StepStatus: workflowv1alpha1.StepStatus{ID: "a1", Name: "apply", Type: "apply-component", Phase: workflowv1alpha1.WorkflowStepPhaseSucceeded}},
+		}}},
+	}
+	testClient := fake.NewClientBuilder().WithScheme(velacommon.Scheme).WithObjects(testApp).WithInterceptorFuncs(interceptor.Funcs{
+		Create: func(_ context.Context, _ client.WithWatch, obj client.Object, _ ...client.CreateOption) error {
+			review := obj.(*authorizationv1.SelfSubjectAccessReview)
+			review.Status.Allowed = review.Spec.ResourceAttributes.Subresource == "status"
+			return nil
+		},
+	}).Build()
+	app := NewApp(testClient, &rest.Config{}, "")
+
+	ctx := context.WithValue(context.Background(), &model.CtxKeyAppName, "app")
+	ctx = context.WithValue(ctx, &model.CtxKeyNamespace, "default")
+
+	workflowView := new(WorkflowView)
+
+	t.Run("init view", func(t *testing.T) {
+		assert.Empty(t, workflowView.CommonResourceView)
+		workflowView.InitView(ctx, app)
+		assert.NotEmpty(t, workflowView.CommonResourceView)
+	})
+
+	t.Run("init", func(t *testing.T) {
+		workflowView.Init()
+		assert.Equal(t, workflowView.Table.GetTitle(), "[ Workflow (default/app) ]")
+	})
+
+	t.Run("hint", func(t *testing.T) {
+		assert.Equal(t, len(workflowView.Hint()), 10)
+		assert.Equal(t, workflowView.Actions()[component.KeyB].Description, "Rollback (denied)")
+		assert.Equal(t, workflowView.Actions()[component.KeyS].Description, "Suspend")
+	})
+
+	t.Run("update", func(t *testing.T) {
+		workflowView.Update(func() {})
+		assert.Equal(t, workflowView.GetCell(0, 0).Text, "Name")
+		assert.Equal(t, workflowView.GetCell(1, 0).Text, "apply")
+		assert.Equal(t, workflowView.GetCell(1, 3).Text, fmt.Sprintf("[%s::]%s", app.config.Theme.Status.Healthy.String(), workflowv1alpha1.WorkflowStepPhaseSucceeded))
+	})
+
+	t.Run("colorize text", func(t *testing.T) {
+		testData := []string{"failed", "running", "suspending", "pending", "skipped"}
+		for i, phase := range testData {
+			workflowView.Table.SetCell(1+i, 3, tview.NewTableCell(phase))
+		}
+		workflowView.ColorizePhaseText(len(testData))
+		theme := app.config.Theme.Status
+		assert.Equal(t, workflowView.GetCell(1, 3).Text, fmt.Sprintf("[%s::]%s", theme.Failed.String(), "failed"))
+		assert.Equal(t, workflowView.GetCell(2, 3).Text, fmt.Sprintf("[%s::]%s", theme.Waiting.String(), "running"))
+		assert.Equal(t, workflowView.GetCell(3, 3).Text, fmt.Sprintf("[%s::]%s", theme.Waiting.String(), "suspending"))
+		assert.Equal(t, workflowView.GetCell(4, 3).Text, fmt.Sprintf("[%s::]%s", theme.Starting.String(), "pending"))
+		assert.Equal(t, workflowView.GetCell(5, 3).Text, fmt.Sprintf("[%s::]%s", theme.Unknown.String(), "skipped"))
+	})
+
+	t.Run("denied action", func(t *testing.T) {
+		workflowView.Actions()[component.KeyB].Action(nil)
+		assert.True(t, app.Main.HasPage(workflowResultPage))
+	})
+
+	t.Run("confirm action", func(t *testing.T) {
+		workflowView.Actions()[component.KeyT].Action(nil)
+		assert.True(t, app.Main.HasPage(workflowConfirmPage))
+	})
+
+	t.Run("stop", func(t *testing.T) {
+		workflowView.Stop()
+	})
+}
+
+func TestWorkflowStepView(t *testing.T) {
+	testApp := &v1beta1.Application{
+		ObjectMeta: metav1.ObjectMeta{Name: "app", Namespace: "default"},
+		Status: common.AppStatus{Workflow: &common.WorkflowStatus{Steps: []workflowv1alpha1.WorkflowStepStatus{
+			{StepStatus: workflowv1alpha1.StepStatus{ID: "a1", Name: "apply", Type: "apply-component", Phase: workflowv1alpha1.WorkflowStepPhaseSucceeded}},
+		}}},
+	}
+	testClient := fake.NewClientBuilder().WithScheme(velacommon.Scheme).WithObjects(testApp).Build()
+	app := NewApp(testClient, &rest.Config{}, "")
+
+	ctx := context.WithValue(context.Background(), &model.CtxKeyAppName, "app")
+	ctx = context.WithValue(ctx, &model.CtxKeyNamespace, "default")
+	ctx = context.WithValue(ctx, &model.CtxKeyWorkflowStep, "apply")
+	stepView, ok := NewWorkflowStepView(ctx, app).(*WorkflowStepView)
+	assert.True(t, ok)
+
+	t.Run("init", func(t *testing.T) {
+		stepView.Init()
+		assert.Equal(t, stepView.GetTitle(), "[ Workflow Step (apply) ]")
+		assert.Equal(t, len(stepView.Hint()), 2)
+	})
+
+	t.Run("start", func(t *testing.T) {
+		stepView.Start()
+		content := stepView.TextView.GetText(true)
+		assert.Contains(t, content, "apply-component")
+		assert.Contains(t, content, "no debug values recorded")
+	})
+
+	t.Run("stop", func(t *testing.T) {
+		stepView.Stop()
+		assert.Empty(t, stepView.TextView.GetText(true))
+	})
+}
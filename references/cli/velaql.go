@@ -29,6 +29,7 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/oam-dev/kubevela/apis/types"
+	"github.com/oam-dev/kubevela/pkg/eventtimeline"
 	"github.com/oam-dev/kubevela/pkg/utils"
 	"github.com/oam-dev/kubevela/pkg/utils/common"
 	querytypes "github.com/oam-dev/kubevela/pkg/utils/types"
@@ -339,6 +340,40 @@ func GetApplicationServices(ctx context.Context, appName string, namespace strin
 	return response.Services, nil
 }
 
+// GetApplicationEvents get the event timeline of the application by velaQL
+func GetApplicationEvents(ctx context.Context, appName string, namespace string, velaC common.Args, f Filter) ([]eventtimeline.Event, error) {
+	params := map[string]string{
+		"appName": appName,
+		"appNs":   namespace,
+	}
+	if f.Component != "" {
+		params["name"] = f.Component
+	}
+	if f.Cluster != "" {
+		params["cluster"] = f.Cluster
+	}
+	velaQL := MakeVelaQL("application-events-view", params, "status")
+	queryView, err := velaql.ParseVelaQL(velaQL)
+	if err != nil {
+		return nil, err
+	}
+	queryValue, err := QueryValue(ctx, velaC, &queryView)
+	if err != nil {
+		return nil, err
+	}
+	var response = struct {
+		Events []eventtimeline.Event `json:"events"`
+		Error  string                `json:"error"`
+	}{}
+	if err := value.UnmarshalTo(queryValue, &response); err != nil {
+		return nil, err
+	}
+	if response.Error != "" {
+		return nil, fmt.Errorf("%s", response.Error)
+	}
+	return response.Events, nil
+}
+
 // setFilterParams will convert Filter fields to velaQL params
 func setFilterParams(f Filter, params map[string]string) {
 	if f.Component != "" {
@@ -0,0 +1,318 @@
+/*
+Copyright 2026 The KubeVela Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cli
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+	"golang.org/x/term"
+	"k8s.io/apimachinery/pkg/runtime"
+	toolscache "k8s.io/client-go/tools/cache"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	commontypes "github.com/oam-dev/kubevela/apis/core.oam.dev/common"
+	"github.com/oam-dev/kubevela/apis/core.oam.dev/v1beta1"
+	"github.com/oam-dev/kubevela/pkg/utils/common"
+)
+
+const (
+	// FlagWatch watches the changes and redraws the output
+	FlagWatch = "watch"
+	// FlagUntil stops watching once the applications reach the condition
+	FlagUntil = "until"
+	// FlagWatchTimeout stops watching with the timeout exit code if the condition is not reached in time
+	FlagWatchTimeout = "timeout"
+)
+
+// The conditions to stop watching the applications
+const (
+	watchUntilHealthy          = "healthy"
+	watchUntilFailed           = "failed"
+	watchUntilWorkflowFinished = "workflowFinished"
+)
+
+// The exit codes of the watch mode with the --until condition. The condition reached exits with 0 and the
+// errors exit with 1 as the other commands.
+const (
+	// watchExitUnreachable means the applications reached a state where the condition can no longer be met,
+	// e.g. the workflow failed when waiting for healthy, or the application was deleted
+	watchExitUnreachable = 2
+	// watchExitTimeout means the condition was not reached before the timeout
+	watchExitTimeout = 3
+)
+
+// ExitError is the error exiting the command with the code other than 1
+type ExitError struct {
+	Code int
+	Err  error
+}
+
+// Error returns the message of the error
+func (e *ExitError) Error() string {
+	return e.Err.Error()
+}
+
+// Unwrap returns the underlying error
+func (e *ExitError) Unwrap() error {
+	return e.Err
+}
+
+// watchRedrawDelay coalesces the changes arriving together into one redraw
+const watchRedrawDelay = 100 * time.Millisecond
+
+// watchResult is the result of the --until condition on the watched applications
+type watchResult int
+
+const (
+	watchPending watchResult = iota
+	watchReached
+	watchUnreachable
+)
+
+// addWatchFlags adds the flags of the watch mode
+func addWatchFlags(cmd *cobra.Command) {
+	cmd.Flags().BoolP(FlagWatch, "w", false, "watch the changes of the applications and redraw the output as they happen")
+	cmd.Flags().String(FlagUntil, "", "used with --watch, stop watching once the applications reach the condition. One of: (healthy, failed, workflowFinished). "+
+		"Exits with 0 if reached, 2 if the condition can no longer be met, 3 on timeout")
+	cmd.Flags().Duration(FlagWatchTimeout, 0, "used with --until, the timeout to wait for the condition, no timeout if 0")
+}
+
+// getWatchFlags returns the watch flags and validates the condition
+func getWatchFlags(cmd *cobra.Command) (watch bool, until string, timeout time.Duration, err error) {
+	if watch, err = cmd.Flags().GetBool(FlagWatch); err != nil {
+		return
+	}
+	if until, err = cmd.Flags().GetString(FlagUntil); err != nil {
+		return
+	}
+	if timeout, err = cmd.Flags().GetDuration(FlagWatchTimeout); err != nil {
+		return
+	}
+	switch until {
+	case "", watchUntilHealthy, watchUntilFailed, watchUntilWorkflowFinished:
+	default:
+		err = fmt.Errorf("invalid --until condition %s, must be one of: (healthy, failed, workflowFinished)", until)
+		return
+	}
+	if until != "" && !watch {
+		err = fmt.Errorf("--until must be used with --watch")
+	}
+	return
+}
+
+// evaluateWatchCondition evaluates the condition on all the applications. The condition is reached if all the
+// applications reach it, and unreachable if any application can no longer reach it.
+func evaluateWatchCondition(until string, apps []v1beta1.Application) watchResult {
+	if len(apps) == 0 {
+		return watchPending
+	}
+	result := watchReached
+	for i := range apps {
+		switch evaluateAppCondition(until, &apps[i]) {
+		case watchUnreachable:
+			return watchUnreachable
+		case watchPending:
+			result = watchPending
+		}
+	}
+	return result
+}
+
+func evaluateAppCondition(until string, app *v1beta1.Application) watchResult {
+	if app.DeletionTimestamp != nil || app.Status.Phase == commontypes.ApplicationDeleting {
+		return watchUnreachable
+	}
+	failed := app.Status.Phase == commontypes.ApplicationWorkflowFailed || app.Status.Phase == commontypes.ApplicationWorkflowTerminated
+	// the status of the previous generation is not taken as reached
+	observed := app.Status.ObservedGeneration == app.Generation
+	switch until {
+	case watchUntilFailed:
+		if failed && observed {
+			return watchReached
+		}
+	case watchUntilHealthy:
+		if failed && observed {
+			return watchUnreachable
+		}
+		if observed && app.Status.Phase == commontypes.ApplicationRunning && getAppHealth(app) {
+			return watchReached
+		}
+	case watchUntilWorkflowFinished:
+		if failed && observed {
+			return watchUnreachable
+		}
+		if observed && app.Status.Workflow != nil && app.Status.Workflow.Finished {
+			return watchReached
+		}
+	}
+	return watchPending
+}
+
+// watchClient reads the applications and the resourcetrackers from the informer cache, and the other objects
+// through the client
+type watchClient struct {
+	client.Client
+	cache cache.Cache
+}
+
+func isWatchedObject(obj runtime.Object) bool {
+	switch obj.(type) {
+	case *v1beta1.Application, *v1beta1.ApplicationList, *v1beta1.ResourceTracker, *v1beta1.ResourceTrackerList:
+		return true
+	default:
+		return false
+	}
+}
+
+// Get reads the watched objects from the cache
+func (c *watchClient) Get(ctx context.Context, key client.ObjectKey, obj client.Object, opts ...client.GetOption) error {
+	if isWatchedObject(obj) {
+		return c.cache.Get(ctx, key, obj, opts...)
+	}
+	return c.Client.Get(ctx, key, obj, opts...)
+}
+
+// List lists the watched objects from the cache
+func (c *watchClient) List(ctx context.Context, list client.ObjectList, opts ...client.ListOption) error {
+	if isWatchedObject(list) {
+		return c.cache.List(ctx, list, opts...)
+	}
+	return c.Client.List(ctx, list, opts...)
+}
+
+// appWatcher redraws the output when the watched objects change, until the condition is reached
+type appWatcher struct {
+	// objects are the kinds of the objects to watch
+	objects []client.Object
+	// byObject restricts the objects cached by the informers, e.g. to the ones of the application
+	byObject map[client.Object]cache.ByObject
+	// render renders the output with the client reading from the cache
+	render func(ctx context.Context, cli client.Client, out io.Writer) error
+	// evaluate evaluates the --until condition with the client reading from the cache, nil if not set
+	evaluate func(ctx context.Context, cli client.Client) (watchResult, error)
+	until    string
+	timeout  time.Duration
+	out      io.Writer
+}
+
+// run watches the objects and redraws the output on changes. It returns once the condition is reached, with
+// an ExitError if the condition is unreachable or the timeout passes, and runs until the context is done if no
+// condition is set.
+func (w *appWatcher) run(ctx context.Context, c common.Args, namespace string) error {
+	cfg, err := c.GetConfig()
+	if err != nil {
+		return err
+	}
+	opts := cache.Options{Scheme: c.Schema, ByObject: w.byObject}
+	if namespace != "" {
+		opts.DefaultNamespaces = map[string]cache.Config{namespace: {}}
+	}
+	informers, err := cache.New(cfg, opts)
+	if err != nil {
+		return err
+	}
+	changed := make(chan struct{}, 1)
+	notify := func(interface{}) {
+		select {
+		case changed <- struct{}{}:
+		default:
+		}
+	}
+	for _, obj := range w.objects {
+		informer, err := informers.GetInformer(ctx, obj)
+		if err != nil {
+			return err
+		}
+		if _, err = informer.AddEventHandler(toolscache.ResourceEventHandlerFuncs{
+			AddFunc:    notify,
+			UpdateFunc: func(_, obj interface{}) { notify(obj) },
+			DeleteFunc: func(obj interface{}) {
+				if tombstone, ok := obj.(toolscache.DeletedFinalStateUnknown); ok {
+					obj = tombstone.Obj
+				}
+				notify(obj)
+			},
+		}); err != nil {
+			return err
+		}
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	go func() {
+		_ = informers.Start(ctx)
+	}()
+	if !informers.WaitForCacheSync(ctx) {
+		return fmt.Errorf("failed to sync the informers")
+	}
+	cli, err := c.GetClient()
+	if err != nil {
+		return err
+	}
+	cli = &watchClient{Client: cli, cache: informers}
+
+	var timeout <-chan time.Time
+	if w.timeout > 0 && w.evaluate != nil {
+		timer := time.NewTimer(w.timeout)
+		defer timer.Stop()
+		timeout = timer.C
+	}
+	for {
+		w.redraw(ctx, cli)
+		if w.evaluate != nil {
+			result, err := w.evaluate(ctx, cli)
+			if err != nil {
+				return err
+			}
+			switch result {
+			case watchReached:
+				return nil
+			case watchUnreachable:
+				return &ExitError{Code: watchExitUnreachable, Err: fmt.Errorf("the condition %s can no longer be met", w.until)}
+			}
+		}
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-timeout:
+			return &ExitError{Code: watchExitTimeout, Err: fmt.Errorf("timeout waiting for the condition %s after %s", w.until, w.timeout)}
+		case <-changed:
+			time.Sleep(watchRedrawDelay)
+		}
+	}
+}
+
+// redraw renders the output into a buffer first, so that the screen is cleared and redrawn at once
+func (w *appWatcher) redraw(ctx context.Context, cli client.Client) {
+	buf := &bytes.Buffer{}
+	if err := w.render(ctx, cli, buf); err != nil {
+		_, _ = fmt.Fprintf(buf, "Error: %s\n", err.Error())
+	}
+	if f, ok := w.out.(*os.File); ok && term.IsTerminal(int(f.Fd())) {
+		_, _ = fmt.Fprint(w.out, "\033[H\033[2J")
+	} else {
+		_, _ = fmt.Fprintln(w.out, "---")
+	}
+	_, _ = fmt.Fprintf(w.out, "Watching, last updated at %s (press Ctrl+C to exit)\n\n", time.Now().Format(time.RFC3339))
+	_, _ = w.out.Write(buf.Bytes())
+}
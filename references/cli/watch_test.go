@@ -0,0 +1,93 @@
+/*
+Copyright 2026 The KubeVela Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cli
+
+import (
+	"testing"
+
+	workflowv1alpha1 "github.com/kubevela/workflow/api/v1alpha1"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/oam-dev/kubevela/apis/core.oam.dev/common"
+	"github.com/oam-dev/kubevela/apis/core.oam.dev/v1beta1"
+)
+
+func TestEvaluateWatchCondition(t *testing.T) {
+	newApp := func(phase common.ApplicationPhase, healthy bool, update func(app *v1beta1.Application)) v1beta1.Application {
+		app := v1beta1.Application{ObjectMeta: metav1.ObjectMeta{Name: "app", Generation: 2}}
+		app.Status.ObservedGeneration = 2
+		app.Status.Phase = phase
+		app.Status.Services = []common.ApplicationComponentStatus{{Name: "web", Healthy: healthy}}
+		app.Status.Workflow = &common.WorkflowStatus{Phase: workflowv1alpha1.WorkflowStateExecuting}
+		if update != nil {
+			update(&app)
+		}
+		return app
+	}
+	running := newApp(common.ApplicationRunning, true, func(app *v1beta1.Application) { app.Status.Workflow.Finished = true })
+	outdated := newApp(common.ApplicationRunning, true, func(app *v1beta1.Application) { app.Generation = 3 })
+	executing := newApp(common.ApplicationRunningWorkflow, false, nil)
+	failed := newApp(common.ApplicationWorkflowFailed, false, nil)
+	outdatedFailed := newApp(common.ApplicationWorkflowFailed, false, func(app *v1beta1.Application) { app.Generation = 3 })
+	deleting := newApp(common.ApplicationRunning, true, func(app *v1beta1.Application) { app.DeletionTimestamp = &metav1.Time{} })
+
+	testCases := map[string]struct {
+		until    string
+		apps     []v1beta1.Application
+		expected watchResult
+	}{
+		"no application":                       {until: watchUntilHealthy, expected: watchPending},
+		"healthy":                              {until: watchUntilHealthy, apps: []v1beta1.Application{running}, expected: watchReached},
+		"healthy of the previous generation":   {until: watchUntilHealthy, apps: []v1beta1.Application{outdated}, expected: watchPending},
+		"not all healthy":                      {until: watchUntilHealthy, apps: []v1beta1.Application{running, executing}, expected: watchPending},
+		"failed when waiting for healthy":      {until: watchUntilHealthy, apps: []v1beta1.Application{running, failed}, expected: watchUnreachable},
+		"deleted when waiting for healthy":     {until: watchUntilHealthy, apps: []v1beta1.Application{deleting}, expected: watchUnreachable},
+		"failed":                               {until: watchUntilFailed, apps: []v1beta1.Application{failed}, expected: watchReached},
+		"not failed":                           {until: watchUntilFailed, apps: []v1beta1.Application{running}, expected: watchPending},
+		"failed of the previous generation":    {until: watchUntilFailed, apps: []v1beta1.Application{outdatedFailed}, expected: watchPending},
+		"workflow finished":                    {until: watchUntilWorkflowFinished, apps: []v1beta1.Application{running}, expected: watchReached},
+		"workflow executing":                   {until: watchUntilWorkflowFinished, apps: []v1beta1.Application{executing}, expected: watchPending},
+		"workflow failed when waiting for end": {until: watchUntilWorkflowFinished, apps: []v1beta1.Application{failed}, expected: watchUnreachable},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.expected, evaluateWatchCondition(tc.until, tc.apps))
+		})
+	}
+}
+
+func TestGetWatchFlags(t *testing.T) {
+	r := require.New(t)
+	newCmd := func(args ...string) *cobra.Command {
+		cmd := &cobra.Command{}
+		addWatchFlags(cmd)
+		r.NoError(cmd.ParseFlags(args))
+		return cmd
+	}
+	watch, until, timeout, err := getWatchFlags(newCmd("-w", "--until", "healthy", "--timeout", "5m"))
+	r.NoError(err)
+	r.True(watch)
+	r.Equal(watchUntilHealthy, until)
+	r.Equal("5m0s", timeout.String())
+
+	_, _, _, err = getWatchFlags(newCmd("-w", "--until", "ready"))
+	r.Error(err)
+	_, _, _, err = getWatchFlags(newCmd("--until", "failed"))
+	r.Error(err)
+}
@@ -17,6 +17,7 @@ limitations under the License.
 package main
 
 import (
+	"errors"
 	"os"
 
 	utilfeature "k8s.io/apiserver/pkg/util/feature"
@@ -34,6 +35,10 @@ func main() {
 	command := cli.NewCommand()
 
 	if err := command.Execute(); err != nil {
+		var exitErr *cli.ExitError
+		if errors.As(err, &exitErr) {
+			os.Exit(exitErr.Code)
+		}
 		os.Exit(1)
 	}
 }
@@ -0,0 +1,32 @@
+"deploy-window": {
+	annotations: {}
+	description: "Hold the rollout of new application revisions outside the deploy windows or during the blackouts."
+	labels: {}
+	attributes: {}
+	type: "policy"
+}
+
+template: {
+	#DeployWindow: {
+		// +usage=Specify the cron expression of the start of the window, e.g. "0 22 * * 1-5"
+		schedule: string
+		// +usage=Specify the length of the window, e.g. "4h"
+		duration: string
+	}
+	#DeployBlackout: {
+		// +usage=Specify the start time of the blackout in RFC3339 format
+		start: string
+		// +usage=Specify the end time of the blackout in RFC3339 format
+		end: string
+		// +usage=Specify the reason of the blackout
+		reason?: string
+	}
+	parameter: {
+		// +usage=Specify the windows in which the rollout is allowed, if empty the rollout is allowed at any time except in the blackouts
+		windows?: [...#DeployWindow]
+		// +usage=Specify the periods in which the rollout is frozen, they take precedence over the windows
+		blackouts?: [...#DeployBlackout]
+		// +usage=Specify the IANA time zone used to evaluate the schedules of the windows
+		timeZone: *"UTC" | string
+	}
+}
@@ -0,0 +1,20 @@
+"failover": {
+	annotations: {}
+	description: "Move the components to standby clusters when the clusters selected by topology policies become unhealthy."
+	labels: {}
+	attributes: {}
+	type: "policy"
+}
+
+template: {
+	parameter: {
+		// +usage=Specify the names of the topology policies to watch, default watch all the topology policies.
+		topologies?: [...string]
+		// +usage=Specify the label selector for the standby clusters
+		standbyClusterLabelSelector: [string]: string
+		// +usage=Specify the seconds a cluster must stay unhealthy before failover, and stay healthy before failback.
+		gracePeriodSeconds: *300 | int
+		// +usage=If set, the components will stay on the standby clusters after the failed clusters recover.
+		disableFailback: *false | bool
+	}
+}
@@ -0,0 +1,61 @@
+import (
+	"vela/oam"
+)
+
+"promote": {
+	type: "workflow-step"
+	annotations: {
+		"category": "Application Delivery"
+	}
+	labels: {}
+	description: "Promote the application revision from one env to another."
+}
+template: {
+	promote: oam.#Promote & {
+		$params: {
+			app:  parameter.app
+			from: parameter.from
+			to:   parameter.to
+			if parameter.revision != _|_ {
+				revision: parameter.revision
+			}
+			if parameter.minSoakTime != _|_ {
+				minSoakTime: parameter.minSoakTime
+			}
+			if parameter.overrides != _|_ {
+				overrides: parameter.overrides
+			}
+		}
+	}
+
+	parameter: {
+		// +usage=Specify the name of the application to promote, default to the current application
+		app: *context.name | string
+		// +usage=Specify the env to promote the application revision from
+		from: string
+		// +usage=Specify the env to promote the application revision to
+		to: string
+		// +usage=Specify the application revision to promote, default to the latest revision in the source env
+		revision?: string
+		// +usage=Specify the minimal time the revision must have been running in the source env before promotion, e.g. 30m
+		minSoakTime?: string
+		// +usage=Specify the component overrides for the target env
+		overrides?: [...{
+			// +usage=Specify the name of the patch component, if empty, all components will be merged
+			name?: string
+			// +usage=Specify the type of the patch component.
+			type?: string
+			// +usage=Specify the properties to override.
+			properties?: {...}
+			// +usage=Specify the traits to override.
+			traits?: [...{
+				// +usage=Specify the type of the trait to be patched.
+				type: string
+				// +usage=Specify the properties to override.
+				properties?: {...}
+				// +usage=Specify if the trait should be remove, default false
+				disable: *false | bool
+			}]
+		}]
+	}
+}